@@ -21,28 +21,6 @@ func NewAccessAvailabilityInformation(status, atype uint8) *IE {
 	return newUint8ValIE(AccessAvailabilityInformation, ((status&0x03)<<2)|atype&0x03)
 }
 
-// AccessAvailabilityInformation returns AccessAvailabilityInformation in uint8 if the type of IE matches.
-func (i *IE) AccessAvailabilityInformation() (uint8, error) {
-	switch i.Type {
-	case AccessAvailabilityInformation:
-		return i.ValueAsUint8()
-	case AccessAvailabilityReport:
-		ies, err := i.AccessAvailabilityReport()
-		if err != nil {
-			return 0, err
-		}
-
-		for _, x := range ies {
-			if x.Type == AccessAvailabilityInformation {
-				return x.AccessAvailabilityInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // AvailabilityStatus returns AvailabilityStatus in uint8 if the type of IE matches.
 func (i *IE) AvailabilityStatus() (uint8, error) {
 	v, err := i.AccessAvailabilityInformation()
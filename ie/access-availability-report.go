@@ -8,12 +8,3 @@ package ie
 func NewAccessAvailabilityReport(info *IE) *IE {
 	return newGroupedIE(AccessAvailabilityReport, 0, info)
 }
-
-// AccessAvailabilityReport returns the IEs above AccessAvailabilityReport if the type of IE matches.
-func (i *IE) AccessAvailabilityReport() ([]*IE, error) {
-	if i.Type != AccessAvailabilityReport {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -21,20 +21,6 @@ func NewAlternativeSMFIPAddress(v4, v6 net.IP) *IE {
 	return New(AlternativeSMFIPAddress, b)
 }
 
-// AlternativeSMFIPAddress returns AlternativeSMFIPAddress in structured format if the type of IE matches.
-func (i *IE) AlternativeSMFIPAddress() (*AlternativeSMFIPAddressFields, error) {
-	if i.Type != AlternativeSMFIPAddress {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	fields, err := ParseAlternativeSMFIPAddressFields(i.Payload)
-	if err != nil {
-		return nil, err
-	}
-
-	return fields, nil
-}
-
 // AlternativeSMFIPAddressFields represents a fields contained in AlternativeSMFIPAddress IE.
 type AlternativeSMFIPAddressFields struct {
 	Flags       uint8
@@ -4,9 +4,15 @@
 
 package ie
 
-import "io"
+import (
+	"fmt"
+	"io"
+)
 
 // NewApplyAction creates a new ApplyAction IE.
+//
+// The flags are given by octets, or as ApplyActionFlags like
+// NewApplyAction(NewApplyActionFlags(ApplyActionFORW, ApplyActionDUPL)...).
 func NewApplyAction(flagsOctets ...uint8) *IE {
 	return New(ApplyAction, flagsOctets)
 }
@@ -48,44 +54,16 @@ func (i *IE) ApplyAction() ([]byte, error) {
 
 // ValidateApplyAction can be used to facilitate the detection of some inconsistencies in Apply Action flags.
 // Its use is optional because validation could also be done on upper layers, or completely skipped for testing purposes.
+//
+// See ApplyActionFlags.Validate for the rules. ErrMalformed is returned as it is
+// if the flags are not allowed; use ApplyActionFlags.Validate to get the reason.
 func (i *IE) ValidateApplyAction() error {
 	if i.Type != ApplyAction {
 		return &InvalidTypeError{Type: i.Type}
 	}
-	// One and only one of the DROP, FORW, BUFF, IPMA and IPMD flags shall be set to "1".
-	flags := []bool{i.HasDROP(), i.HasFORW(), i.HasBUFF(), i.HasIPMA(), i.HasIPMD()}
-	counter := 0
-	for _, v := range flags {
-		if v {
-			counter++
-		}
-	}
-	if counter != 1 {
-		return ErrMalformed
-	}
-	// The NOCP flag and BDPN flag may only be set if the BUFF flag is set.
-	if (i.HasNOCP() || i.HasBDPN()) && !i.HasBUFF() {
+	if err := ApplyActionFlags(i.Payload).Validate(); err != nil {
 		return ErrMalformed
 	}
-	// The DUPL flag may be set with any of the DROP, FORW, BUFF and NOCP flags.
-	if i.HasDUPL() && !(i.HasDROP() || i.HasFORW() || i.HasBUFF() || i.HasNOCP()) {
-		return ErrMalformed
-	}
-	// The DFRT flag may only be set if the FORW flag is set.
-	// Note: in TS 29.244 V18.0.1 (most recent as of writing), there is a typo and DFRN is stated instead of DFRT
-	if i.HasDFRT() && !i.HasFORW() {
-		return ErrMalformed
-	}
-	// The DDPN flag may be set with any of the DROP and BUFF flags.
-	if i.HasDDPN() && !(i.HasDROP() || i.HasBUFF()) {
-		return ErrMalformed
-	}
-
-	// Note: The following is also stated in TS 29.244 section 8.2.26 V18.0.1,
-	// but since "may" is used and not "may only"
-	// it cannot be used to check for inconsistent IEs:
-	// - The EDRT flag may be set if the FORW flag is set.
-	// - Both the MBSU flag and the FSSM flag may be set […]
 	return nil
 }
 
@@ -250,3 +228,125 @@ func (i *IE) HasMBSU() bool {
 	}
 	return has5thBit(v[1])
 }
+
+// ApplyActionFlag is a flag in ApplyAction IE, represented as the position of
+// the bit counted from bit 1 of the 5th octet.
+type ApplyActionFlag uint8
+
+// ApplyActionFlag definitions.
+const (
+	// 5th octet
+	ApplyActionDROP ApplyActionFlag = iota
+	ApplyActionFORW
+	ApplyActionBUFF
+	ApplyActionNOCP
+	ApplyActionDUPL
+	ApplyActionIPMA
+	ApplyActionIPMD
+	ApplyActionDFRT
+
+	// 6th octet
+	ApplyActionEDRT
+	ApplyActionBDPN
+	ApplyActionDDPN
+	ApplyActionFSSM
+	ApplyActionMBSU
+)
+
+// String returns the name of the flag, e.g., "FORW".
+func (f ApplyActionFlag) String() string {
+	return flagName(applyActionFlagNames, uint8(f))
+}
+
+// ApplyActionFlags is the value of ApplyAction IE.
+//
+// As it is a []byte, it can be given to NewApplyAction as it is. The methods
+// that modify the flags return the modified copy, and never change the original.
+type ApplyActionFlags []byte
+
+// NewApplyActionFlags creates a new ApplyActionFlags with the given flags set.
+func NewApplyActionFlags(flags ...ApplyActionFlag) ApplyActionFlags {
+	return ApplyActionFlags{0}.Set(flags...)
+}
+
+// Has reports whether all the given flags are set.
+func (f ApplyActionFlags) Has(flags ...ApplyActionFlag) bool {
+	return hasFlags(f, flags...)
+}
+
+// Set returns the copy of f with the given flags set.
+func (f ApplyActionFlags) Set(flags ...ApplyActionFlag) ApplyActionFlags {
+	return setFlags(f, flags...)
+}
+
+// Clear returns the copy of f with the given flags cleared.
+func (f ApplyActionFlags) Clear(flags ...ApplyActionFlag) ApplyActionFlags {
+	return clearFlags(f, flags...)
+}
+
+// Flags returns the flags set in f in the order of the bit position.
+func (f ApplyActionFlags) Flags() []ApplyActionFlag {
+	return listFlags[ApplyActionFlag](f)
+}
+
+// String returns the names of the flags set in f separated by "|", e.g.,
+// "FORW|DUPL", or "none" if no flag is set.
+func (f ApplyActionFlags) String() string {
+	return joinFlags(f, applyActionFlagNames)
+}
+
+// Validate checks if the combination of the flags is allowed in 3GPP TS 29.244
+// clause 8.2.26, and returns *FlagsError if not.
+//
+//   - One and only one of the DROP, FORW, BUFF, IPMA and IPMD flags shall be set.
+//   - The NOCP flag and BDPN flag may only be set if the BUFF flag is set.
+//   - The DUPL flag may be set with any of the DROP, FORW, BUFF and NOCP flags.
+//   - The DFRT flag may only be set if the FORW flag is set.
+//   - The DDPN flag may be set with any of the DROP and BUFF flags.
+//
+// The EDRT flag "may be set if the FORW flag is set", and both the MBSU flag and
+// the FSSM flag "may be set", which are not the restrictions and not checked.
+func (f ApplyActionFlags) Validate() error {
+	if len(f) < 1 {
+		return &FlagsError{Type: ApplyAction, Reason: "no octet"}
+	}
+
+	var actions []string
+	for _, x := range []ApplyActionFlag{ApplyActionDROP, ApplyActionFORW, ApplyActionBUFF, ApplyActionIPMA, ApplyActionIPMD} {
+		if f.Has(x) {
+			actions = append(actions, x.String())
+		}
+	}
+	if len(actions) != 1 {
+		return &FlagsError{
+			Type:   ApplyAction,
+			Reason: fmt.Sprintf("one and only one of DROP, FORW, BUFF, IPMA and IPMD shall be set, got %d", len(actions)),
+		}
+	}
+
+	for _, x := range []ApplyActionFlag{ApplyActionNOCP, ApplyActionBDPN} {
+		if f.Has(x) && !f.Has(ApplyActionBUFF) {
+			return &FlagsError{Type: ApplyAction, Reason: x.String() + " may only be set with BUFF"}
+		}
+	}
+	if f.Has(ApplyActionDUPL) && !(f.Has(ApplyActionDROP) || f.Has(ApplyActionFORW) || f.Has(ApplyActionBUFF) || f.Has(ApplyActionNOCP)) {
+		return &FlagsError{Type: ApplyAction, Reason: "DUPL may only be set with DROP, FORW, BUFF or NOCP"}
+	}
+	// Note: in TS 29.244 V18.0.1, there is a typo and DFRN is stated instead of DFRT
+	if f.Has(ApplyActionDFRT) && !f.Has(ApplyActionFORW) {
+		return &FlagsError{Type: ApplyAction, Reason: "DFRT may only be set with FORW"}
+	}
+	if f.Has(ApplyActionDDPN) && !(f.Has(ApplyActionDROP) || f.Has(ApplyActionBUFF)) {
+		return &FlagsError{Type: ApplyAction, Reason: "DDPN may only be set with DROP or BUFF"}
+	}
+	return nil
+}
+
+// ApplyActionFlags returns ApplyAction in ApplyActionFlags if the type of IE matches.
+func (i *IE) ApplyActionFlags() (ApplyActionFlags, error) {
+	v, err := i.ApplyAction()
+	if err != nil {
+		return nil, err
+	}
+	return ApplyActionFlags(v), nil
+}
@@ -9,27 +9,6 @@ func NewATSSSLLControlInformation(lli uint8) *IE {
 	return newUint8ValIE(ATSSSLLControlInformation, lli&0x01)
 }
 
-// ATSSSLLControlInformation returns ATSSSLLControlInformation in uint8 if the type of IE matches.
-func (i *IE) ATSSSLLControlInformation() (uint8, error) {
-	switch i.Type {
-	case ATSSSLLControlInformation:
-		return i.ValueAsUint8()
-	case ProvideATSSSControlInformation:
-		ies, err := i.ProvideATSSSControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == ATSSSLLControlInformation {
-				return x.ATSSSLLControlInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasLLI reports whether an IE has LLI bit.
 func (i *IE) HasLLI() bool {
 	switch i.Type {
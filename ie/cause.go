@@ -24,16 +24,3 @@ const (
 	CauseSystemFailure                   uint8 = 77
 	CauseRedirectionRequested            uint8 = 78
 )
-
-// NewCause creates a new Cause IE.
-func NewCause(cause uint8) *IE {
-	return newUint8ValIE(Cause, cause)
-}
-
-// Cause returns Cause in uint8 if the type of IE matches.
-func (i *IE) Cause() (uint8, error) {
-	if i.Type != Cause {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-	return i.ValueAsUint8()
-}
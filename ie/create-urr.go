@@ -9,12 +9,3 @@ func NewCreateURR(ies ...*IE) *IE {
 
 	return newGroupedIE(CreateURR, 0, ies...)
 }
-
-// CreateURR returns the IEs above CreateURR if the type of IE matches.
-func (i *IE) CreateURR() ([]*IE, error) {
-	if i.Type != CreateURR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
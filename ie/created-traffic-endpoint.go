@@ -4,20 +4,6 @@
 
 package ie
 
-// NewCreatedTrafficEndpoint creates a new CreatedTrafficEndpoint IE.
-func NewCreatedTrafficEndpoint(ies ...*IE) *IE {
-	return newGroupedIE(CreatedTrafficEndpoint, 0, ies...)
-}
-
-// CreatedTrafficEndpoint returns the IEs above CreatedTrafficEndpoint if the type of IE matches.
-func (i *IE) CreatedTrafficEndpoint() ([]*IE, error) {
-	if i.Type != CreatedTrafficEndpoint {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
-
 // LocalFTEID returns FTEID that is found first in a grouped IE in structured format
 // if the type of IE matches.
 //
@@ -0,0 +1,607 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// value returns the value of an IE decoded into the most suitable Go type.
+//
+// The value is the same as the one returned by the accessor method that has the
+// same name as the type of IE, e.g., *FTEIDFields for FTEID IE. IEs whose
+// accessors return raw bytes are decoded further into the values defined in this
+// file, e.g., the list of flag names for ApplyAction IE. Vendor-specific IEs are
+// decoded by the function registered with RegisterVendorIE. For grouped IEs and the
+// IEs that this package doesn't know how to decode, this returns nil without error.
+func (i *IE) value() (any, error) {
+	if i.IsGrouped() {
+		return nil, nil
+	}
+	if i.IsVendorSpecific() {
+		if v, ok := i.vendorIE(); ok && v.Decode != nil {
+			return v.Decode(i.Payload)
+		}
+		return nil, nil
+	}
+
+	switch i.Type {
+	case Cause:
+		return i.Cause()
+	case SourceInterface:
+		return i.SourceInterface()
+	case FTEID:
+		return i.FTEID()
+	case NetworkInstance:
+		return i.NetworkInstance()
+	case SDFFilter:
+		return i.SDFFilter()
+	case ApplicationID:
+		return i.ApplicationID()
+	case GateStatus:
+		return i.GateStatus()
+	case QERCorrelationID:
+		return i.QERCorrelationID()
+	case Precedence:
+		return i.Precedence()
+	case TransportLevelMarking:
+		return i.TransportLevelMarking()
+	case VolumeThreshold:
+		return i.VolumeThreshold()
+	case TimeThreshold:
+		return i.TimeThreshold()
+	case MonitoringTime:
+		return i.MonitoringTime()
+	case SubsequentVolumeThreshold:
+		return i.SubsequentVolumeThreshold()
+	case SubsequentTimeThreshold:
+		return i.SubsequentTimeThreshold()
+	case InactivityDetectionTime:
+		return i.InactivityDetectionTime()
+	case RedirectInformation:
+		return i.RedirectInformation()
+	case ReportType:
+		return i.ReportType()
+	case OffendingIE:
+		return i.OffendingIE()
+	case DestinationInterface:
+		return i.DestinationInterface()
+	case DownlinkDataNotificationDelay:
+		return i.DownlinkDataNotificationDelay()
+	case DLBufferingDuration:
+		return i.DLBufferingDuration()
+	case DLBufferingSuggestedPacketCount:
+		return i.DLBufferingSuggestedPacketCount()
+	case PFCPSMReqFlags:
+		return i.PFCPSMReqFlags()
+	case PFCPSRRspFlags:
+		return i.PFCPSRRspFlags()
+	case SequenceNumber:
+		return i.SequenceNumber()
+	case Metric:
+		return i.Metric()
+	case Timer:
+		return i.Timer()
+	case PDRID:
+		return i.PDRID()
+	case FSEID:
+		return i.FSEID()
+	case NodeID:
+		return i.NodeID()
+	case PFDContents:
+		return i.PFDContents()
+	case MeasurementMethod:
+		return i.MeasurementMethod()
+	case MeasurementPeriod:
+		return i.MeasurementPeriod()
+	case VolumeMeasurement:
+		return i.VolumeMeasurement()
+	case DurationMeasurement:
+		return i.DurationMeasurement()
+	case TimeOfFirstPacket:
+		return i.TimeOfFirstPacket()
+	case TimeOfLastPacket:
+		return i.TimeOfLastPacket()
+	case QuotaHoldingTime:
+		return i.QuotaHoldingTime()
+	case DroppedDLTrafficThreshold:
+		return i.DroppedDLTrafficThreshold()
+	case VolumeQuota:
+		return i.VolumeQuota()
+	case TimeQuota:
+		return i.TimeQuota()
+	case StartTime:
+		return i.StartTime()
+	case EndTime:
+		return i.EndTime()
+	case URRID:
+		return i.URRID()
+	case LinkedURRID:
+		return i.LinkedURRID()
+	case OuterHeaderCreation:
+		return i.OuterHeaderCreation()
+	case BARID:
+		return i.BARID()
+	case UsageInformation:
+		return i.UsageInformation()
+	case ApplicationInstanceID:
+		return i.ApplicationInstanceID()
+	case UEIPAddress:
+		return i.UEIPAddress()
+	case PacketRate:
+		return i.PacketRate()
+	case RecoveryTimeStamp:
+		return i.RecoveryTimeStamp()
+	case DLFlowLevelMarking:
+		return i.DLFlowLevelMarking()
+	case HeaderEnrichment:
+		return i.HeaderEnrichment()
+	case MeasurementInformation:
+		return i.MeasurementInformation()
+	case NodeReportType:
+		return i.NodeReportType()
+	case RemoteGTPUPeer:
+		return i.RemoteGTPUPeer()
+	case URSEQN:
+		return i.URSEQN()
+	case ActivatePredefinedRules:
+		return i.ActivatePredefinedRules()
+	case DeactivatePredefinedRules:
+		return i.DeactivatePredefinedRules()
+	case FARID:
+		return i.FARID()
+	case QERID:
+		return i.QERID()
+	case OCIFlags:
+		return i.OCIFlags()
+	case PFCPAssociationReleaseRequest:
+		return i.PFCPAssociationReleaseRequest()
+	case GracefulReleasePeriod:
+		return i.GracefulReleasePeriod()
+	case PDNType:
+		return i.PDNType()
+	case FailedRuleID:
+		return i.FailedRuleID()
+	case UserPlaneIPResourceInformation:
+		return i.UserPlaneIPResourceInformation()
+	case UserPlaneInactivityTimer:
+		return i.UserPlaneInactivityTimer()
+	case AggregatedURRID:
+		return i.AggregatedURRID()
+	case SubsequentVolumeQuota:
+		return i.SubsequentVolumeQuota()
+	case SubsequentTimeQuota:
+		return i.SubsequentTimeQuota()
+	case RQI:
+		return i.RQI()
+	case QFI:
+		return i.QFI()
+	case QueryURRReference:
+		return i.QueryURRReference()
+	case AdditionalUsageReportsInformation:
+		return i.AdditionalUsageReportsInformation()
+	case TrafficEndpointID:
+		return i.TrafficEndpointID()
+	case MACAddress:
+		return i.MACAddress()
+	case CTAG:
+		return i.CTAG()
+	case STAG:
+		return i.STAG()
+	case Ethertype:
+		return i.Ethertype()
+	case Proxying:
+		return i.Proxying()
+	case EthernetFilterID:
+		return i.EthernetFilterID()
+	case EthernetFilterProperties:
+		return i.EthernetFilterProperties()
+	case SuggestedBufferingPacketsCount:
+		return i.SuggestedBufferingPacketsCount()
+	case UserID:
+		return i.UserID()
+	case EthernetPDUSessionInformation:
+		return i.EthernetPDUSessionInformation()
+	case MACAddressesDetected:
+		return i.MACAddressesDetected()
+	case MACAddressesRemoved:
+		return i.MACAddressesRemoved()
+	case EthernetInactivityTimer:
+		return i.EthernetInactivityTimer()
+	case EventQuota:
+		return i.EventQuota()
+	case EventThreshold:
+		return i.EventThreshold()
+	case SubsequentEventQuota:
+		return i.SubsequentEventQuota()
+	case SubsequentEventThreshold:
+		return i.SubsequentEventThreshold()
+	case TraceInformation:
+		return i.TraceInformation()
+	case FramedRoute:
+		return i.FramedRoute()
+	case FramedRouting:
+		return i.FramedRouting()
+	case FramedIPv6Route:
+		return i.FramedIPv6Route()
+	case EventTimeStamp:
+		return i.EventTimeStamp()
+	case AveragingWindow:
+		return i.AveragingWindow()
+	case PagingPolicyIndicator:
+		return i.PagingPolicyIndicator()
+	case APNDNN:
+		return i.APNDNN()
+	case TGPPInterfaceType:
+		return i.TGPPInterfaceType()
+	case PFCPSRReqFlags:
+		return i.PFCPSRReqFlags()
+	case PFCPAUReqFlags:
+		return i.PFCPAUReqFlags()
+	case ActivationTime:
+		return i.ActivationTime()
+	case DeactivationTime:
+		return i.DeactivationTime()
+	case MARID:
+		return i.MARID()
+	case SteeringFunctionality:
+		return i.SteeringFunctionality()
+	case SteeringMode:
+		return i.SteeringMode()
+	case Weight:
+		return i.Weight()
+	case Priority:
+		return i.Priority()
+	case AlternativeSMFIPAddress:
+		return i.AlternativeSMFIPAddress()
+	case PacketReplicationAndDetectionCarryOnInformation:
+		return i.PacketReplicationAndDetectionCarryOnInformation()
+	case SMFSetID:
+		return i.SMFSetID()
+	case QuotaValidityTime:
+		return i.QuotaValidityTime()
+	case NumberOfReports:
+		return i.NumberOfReports()
+	case PFCPASRspFlags:
+		return i.PFCPASRspFlags()
+	case CPPFCPEntityIPAddress:
+		return i.CPPFCPEntityIPAddress()
+	case PFCPSEReqFlags:
+		return i.PFCPSEReqFlags()
+	case IPMulticastAddress:
+		return i.IPMulticastAddress()
+	case SourceIPAddress:
+		return i.SourceIPAddress()
+	case PacketRateStatus:
+		return i.PacketRateStatus()
+	case CreateBridgeInfoForTSC:
+		return i.CreateBridgeInfoForTSC()
+	case DSTTPortNumber:
+		return i.DSTTPortNumber()
+	case NWTTPortNumber:
+		return i.NWTTPortNumber()
+	case TSNBridgeID:
+		return i.TSNBridgeID()
+	case PortManagementInformationContainer:
+		return i.PortManagementInformationContainer()
+	case RequestedClockDriftInformation:
+		return i.RequestedClockDriftInformation()
+	case TSNTimeDomainNumber:
+		return i.TSNTimeDomainNumber()
+	case TimeOffsetThreshold:
+		return i.TimeOffsetThreshold()
+	case CumulativeRateRatioThreshold:
+		return i.CumulativeRateRatioThreshold()
+	case TimeOffsetMeasurement:
+		return i.TimeOffsetMeasurement()
+	case CumulativeRateRatioMeasurement:
+		return i.CumulativeRateRatioMeasurement()
+	case SRRID:
+		return i.SRRID()
+	case RequestedAccessAvailabilityInformation:
+		return i.RequestedAccessAvailabilityInformation()
+	case AccessAvailabilityInformation:
+		return i.AccessAvailabilityInformation()
+	case MPTCPControlInformation:
+		return i.MPTCPControlInformation()
+	case ATSSSLLControlInformation:
+		return i.ATSSSLLControlInformation()
+	case PMFControlInformation:
+		return i.PMFControlInformation()
+	case MPTCPAddressInformation:
+		return i.MPTCPAddressInformation()
+	case UELinkSpecificIPAddress:
+		return i.UELinkSpecificIPAddress()
+	case PMFAddressInformation:
+		return i.PMFAddressInformation()
+	case ATSSSLLInformation:
+		return i.ATSSSLLInformation()
+	case DataNetworkAccessIdentifier:
+		return i.DataNetworkAccessIdentifier()
+	case AveragePacketDelay:
+		return i.AveragePacketDelay()
+	case MinimumPacketDelay:
+		return i.MinimumPacketDelay()
+	case MaximumPacketDelay:
+		return i.MaximumPacketDelay()
+	case QoSReportTrigger:
+		return i.QoSReportTrigger()
+	case GTPUPathInterfaceType:
+		return i.GTPUPathInterfaceType()
+	case RequestedQoSMonitoring:
+		return i.RequestedQoSMonitoring()
+	case ReportingFrequency:
+		return i.ReportingFrequency()
+	case PacketDelayThresholds:
+		return i.PacketDelayThresholds()
+	case MinimumWaitTime:
+		return i.MinimumWaitTime()
+	case QoSMonitoringMeasurement:
+		return i.QoSMonitoringMeasurement()
+	case MTEDTControlInformation:
+		return i.MTEDTControlInformation()
+	case DLDataPacketsSize:
+		return i.DLDataPacketsSize()
+	case QERControlIndications:
+		return i.QERControlIndications()
+	case IPVersion:
+		return i.IPVersion()
+	case PFCPASReqFlags:
+		return i.PFCPASReqFlags()
+	case DataStatus:
+		return i.DataStatus()
+	case RDSConfigurationInformation:
+		return i.RDSConfigurationInformation()
+	case MPTCPApplicableIndication:
+		return i.MPTCPApplicableIndication()
+	case BridgeManagementInformationContainer:
+		return i.BridgeManagementInformationContainer()
+	case NumberOfUEIPAddresses:
+		return i.NumberOfUEIPAddresses()
+	case ValidityTimer:
+		return i.ValidityTimer()
+	case MBR:
+		ul, err := i.MBRUL()
+		if err != nil {
+			return nil, err
+		}
+		dl, err := i.MBRDL()
+		if err != nil {
+			return nil, err
+		}
+		return &bitRateValue{UL: ul, DL: dl}, nil
+	case GBR:
+		ul, err := i.GBRUL()
+		if err != nil {
+			return nil, err
+		}
+		dl, err := i.GBRDL()
+		if err != nil {
+			return nil, err
+		}
+		return &bitRateValue{UL: ul, DL: dl}, nil
+	case ReportingTriggers:
+		v, err := i.ReportingTriggers()
+		if err != nil {
+			return nil, err
+		}
+		return flagNames(v, reportingTriggersFlagNames), nil
+	case ForwardingPolicy:
+		return i.ForwardingPolicyIdentifier()
+	case UPFunctionFeatures, CPFunctionFeatures:
+		return i.Payload, nil
+	case ApplyAction:
+		v, err := i.ApplyAction()
+		if err != nil {
+			return nil, err
+		}
+		return flagNames(v, applyActionFlagNames), nil
+	case DownlinkDataServiceInformation:
+		if len(i.Payload) < 1 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		v := &downlinkDataServiceInformationValue{}
+		if i.HasPPI() {
+			ppi, err := i.PPI()
+			if err != nil {
+				return nil, err
+			}
+			v.PPI = &ppi
+		}
+		if i.HasQFI() {
+			qfi, err := i.QFI()
+			if err != nil {
+				return nil, err
+			}
+			v.QFI = &qfi
+		}
+		return v, nil
+	case UsageReportTrigger:
+		v, err := i.UsageReportTrigger()
+		if err != nil {
+			return nil, err
+		}
+		return flagNames(v, usageReportTriggerFlagNames), nil
+	case FQCSID:
+		typ, err := i.NodeIDType()
+		if err != nil {
+			return nil, err
+		}
+		addr, err := i.NodeAddress()
+		if err != nil {
+			return nil, err
+		}
+		csids, err := i.CSIDs()
+		if err != nil {
+			return nil, err
+		}
+		v := &fqcsidValue{NodeIDType: typ, CSIDs: csids}
+		switch typ {
+		case nodeIDIPv4, nodeIDIPv6:
+			v.NodeAddress = net.IP(addr).String()
+		default:
+			v.NodeAddress = hexString(addr)
+		}
+		return v, nil
+	case FlowInformation:
+		dir, err := i.FlowDirection()
+		if err != nil {
+			return nil, err
+		}
+		desc, err := i.FlowDescription()
+		if err != nil {
+			return nil, err
+		}
+		return &flowInformationValue{FlowDirection: dir, FlowDescription: desc}, nil
+	case OuterHeaderRemoval:
+		desc, err := i.OuterHeaderRemovalDescription()
+		if err != nil {
+			return nil, err
+		}
+		ext, err := i.GTPUExtensionHeaderDeletion()
+		if err != nil {
+			return nil, err
+		}
+		return &outerHeaderRemovalValue{Description: desc, GTPUExtensionHeaderDeletion: ext}, nil
+	case TimeQuotaMechanism:
+		v, err := i.TimeQuotaMechanism()
+		if err != nil {
+			return nil, err
+		}
+		if len(v) < 5 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return &timeQuotaMechanismValue{
+			BaseTimeIntervalType: v[0] & 0x03,
+			BaseTimeInterval:     time.Duration(binary.BigEndian.Uint32(v[1:5])) * time.Second,
+		}, nil
+	case Multiplier:
+		digits, err := i.ValueDigits()
+		if err != nil {
+			return nil, err
+		}
+		exp, err := i.Exponent()
+		if err != nil {
+			return nil, err
+		}
+		return &multiplierValue{ValueDigits: digits, Exponent: exp}, nil
+	case UEIPAddressPoolIdentity:
+		return i.UEIPAddressPoolIdentityString()
+	case NFInstanceID:
+		return i.NFInstanceID()
+	case SNSSAI:
+		sst, err := i.SST()
+		if err != nil {
+			return nil, err
+		}
+		sd, err := i.SD()
+		if err != nil {
+			return nil, err
+		}
+		return &snssaiValue{SST: sst, SD: sd}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// bitRateValue is the decoded value of MBR and GBR IE, in kbps.
+type bitRateValue struct {
+	UL uint64
+	DL uint64
+}
+
+// downlinkDataServiceInformationValue is the decoded value of DownlinkDataServiceInformation IE.
+type downlinkDataServiceInformationValue struct {
+	PPI *uint8
+	QFI *uint8
+}
+
+// fqcsidValue is the decoded value of FQCSID IE.
+type fqcsidValue struct {
+	NodeIDType  uint8
+	NodeAddress string
+	CSIDs       []uint16
+}
+
+// flowInformationValue is the decoded value of FlowInformation IE.
+type flowInformationValue struct {
+	FlowDirection   uint8
+	FlowDescription string
+}
+
+// outerHeaderRemovalValue is the decoded value of OuterHeaderRemoval IE.
+type outerHeaderRemovalValue struct {
+	Description                 uint8
+	GTPUExtensionHeaderDeletion uint8
+}
+
+// timeQuotaMechanismValue is the decoded value of TimeQuotaMechanism IE.
+type timeQuotaMechanismValue struct {
+	BaseTimeIntervalType uint8
+	BaseTimeInterval     time.Duration
+}
+
+// multiplierValue is the decoded value of Multiplier IE.
+type multiplierValue struct {
+	ValueDigits uint64
+	Exponent    uint32
+}
+
+// snssaiValue is the decoded value of S-NSSAI IE.
+type snssaiValue struct {
+	SST uint8
+	SD  uint32
+}
+
+// The names of flags in the flag-type IEs, listed per octet from bit 1 to bit 8.
+// Empty strings are the spare bits.
+var (
+	applyActionFlagNames = [][8]string{
+		{"DROP", "FORW", "BUFF", "NOCP", "DUPL", "IPMA", "IPMD", "DFRT"},
+		{"EDRT", "BDPN", "DDPN", "FSSM", "MBSU"},
+	}
+	reportingTriggersFlagNames = [][8]string{
+		{"PERIO", "VOLTH", "TIMTH", "QUHTI", "START", "STOPT", "DROTH", "LIUSA"},
+		{"VOLQU", "TIMQU", "ENVCL", "MACAR", "EVETH", "EVEQU", "IPMJL", "QUVTI"},
+		{"REEMR", "UPINT"},
+	}
+	usageReportTriggerFlagNames = [][8]string{
+		{"PERIO", "VOLTH", "TIMTH", "QUHTI", "START", "STOPT", "DROTH", "IMMER"},
+		{"VOLQU", "TIMQU", "LIUSA", "TERMR", "MONIT", "ENVCL", "MACAR", "EVETH"},
+		{"EVEQU", "TEBUR", "IPMJL", "QUVTI", "EMRRE", "UPINT"},
+	}
+)
+
+// flagNames returns the names of flags set in b.
+// Bits without names are shown as "Octet<n>Bit<m>" not to lose any information.
+func flagNames(b []byte, names [][8]string) []string {
+	flags := []string{}
+	for o, octet := range b {
+		for bit := 0; bit < 8; bit++ {
+			if octet&(1<<bit) == 0 {
+				continue
+			}
+
+			var name string
+			if o < len(names) {
+				name = names[o][bit]
+			}
+			if name == "" {
+				name = fmt.Sprintf("Octet%dBit%d", o+5, bit+1)
+			}
+			flags = append(flags, name)
+		}
+	}
+	return flags
+}
+
+func hexString(b []byte) string {
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,320 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DiffKind represents the kind of Difference.
+type DiffKind uint8
+
+// DiffKind definitions.
+const (
+	DiffAdded DiffKind = iota + 1
+	DiffRemoved
+	DiffChanged
+)
+
+// String returns the name of DiffKind.
+func (k DiffKind) String() string {
+	switch k {
+	case DiffAdded:
+		return "added"
+	case DiffRemoved:
+		return "removed"
+	case DiffChanged:
+		return "changed"
+	default:
+		return fmt.Sprintf("DiffKind(%d)", k)
+	}
+}
+
+// Difference is a difference between two IE trees found by Diff.
+//
+// Path is the location of the difference, consisting of the names of IE types
+// separated by "/", e.g., "CreatePDR[PDRID=1]/PDI/FTEID/TEID". The IEs that are
+// repeated in the same level are distinguished by their rule ID like "[PDRID=1]"
+// if they have one, otherwise by the index in the order of appearance like "[0]".
+// If the difference is in a field of the decoded value, the last element of Path
+// is the name of the field.
+//
+// Old and New are the values in human-readable form. A and B are the IEs at the
+// Path in the old and new tree respectively, which are nil if the IE is added or
+// removed.
+type Difference struct {
+	Kind     DiffKind
+	Path     string
+	Old, New string
+	A, B     *IE
+}
+
+// String returns the Difference in human-readable form.
+func (d *Difference) String() string {
+	switch d.Kind {
+	case DiffAdded:
+		return fmt.Sprintf("+ %s: %s", d.Path, d.New)
+	case DiffRemoved:
+		return fmt.Sprintf("- %s: %s", d.Path, d.Old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", d.Path, d.Old, d.New)
+	}
+}
+
+// Diff compares two IEs and returns the differences found in them.
+//
+// Grouped IEs are compared recursively, and the IEs known to this package are
+// compared by their decoded values field by field. It returns nil if there is no
+// difference.
+func Diff(a, b *IE) []*Difference {
+	var as, bs []*IE
+	if a != nil {
+		as = []*IE{a}
+	}
+	if b != nil {
+		bs = []*IE{b}
+	}
+	return DiffIEs(as, bs)
+}
+
+// DiffIEs compares two lists of IEs and returns the differences found in them.
+//
+// IEs are matched by type and instance. Repeated grouped IEs that have a rule ID,
+// e.g., CreatePDR with PDRID, are matched by the rule ID regardless of the order.
+// The others are matched in the order of appearance.
+func DiffIEs(a, b []*IE) []*Difference {
+	return diffIEs("", a, b)
+}
+
+// diffKey identifies the IEs of the same kind in a list.
+type diffKey struct {
+	typ IEType
+	eid uint16
+}
+
+func diffIEs(prefix string, a, b []*IE) []*Difference {
+	var (
+		keys    []diffKey
+		groupsA = map[diffKey][]*IE{}
+		groupsB = map[diffKey][]*IE{}
+	)
+	for _, l := range []struct {
+		ies    []*IE
+		groups map[diffKey][]*IE
+	}{{a, groupsA}, {b, groupsB}} {
+		for _, i := range l.ies {
+			if i == nil {
+				continue
+			}
+
+			k := diffKey{i.Type, i.EnterpriseID}
+			if _, ok := groupsA[k]; !ok {
+				if _, ok := groupsB[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			l.groups[k] = append(l.groups[k], i)
+		}
+	}
+
+	var diffs []*Difference
+	for _, k := range keys {
+		diffs = append(diffs, diffGroup(prefix, groupsA[k], groupsB[k])...)
+	}
+	return diffs
+}
+
+// diffGroup compares the IEs of the same kind.
+func diffGroup(prefix string, a, b []*IE) []*Difference {
+	var diffs []*Difference
+
+	repeated := len(a) > 1 || len(b) > 1
+	matchedB := make([]bool, len(b))
+	for n, x := range a {
+		path := prefix + diffPathElem(x, n, repeated)
+
+		m := -1
+		if id, ok := ruleIDString(x); ok {
+			for j, y := range b {
+				if yid, ok := ruleIDString(y); ok && !matchedB[j] && yid == id {
+					m = j
+					break
+				}
+			}
+		} else if n < len(b) && !matchedB[n] {
+			if _, ok := ruleIDString(b[n]); !ok {
+				m = n
+			}
+		}
+
+		if m < 0 {
+			diffs = append(diffs, &Difference{Kind: DiffRemoved, Path: path, Old: diffValueString(x), A: x})
+			continue
+		}
+		matchedB[m] = true
+		diffs = append(diffs, diffIE(path, x, b[m])...)
+	}
+
+	for j, y := range b {
+		if matchedB[j] {
+			continue
+		}
+		path := prefix + diffPathElem(y, j, repeated)
+		diffs = append(diffs, &Difference{Kind: DiffAdded, Path: path, New: diffValueString(y), B: y})
+	}
+
+	return diffs
+}
+
+// diffIE compares the matched IEs.
+func diffIE(path string, a, b *IE) []*Difference {
+	if a.IsGrouped() && b.IsGrouped() {
+		ac, errA := a.ValueAsGrouped()
+		bc, errB := b.ValueAsGrouped()
+		if errA == nil && errB == nil {
+			return diffIEs(path+"/", ac, bc)
+		}
+	}
+
+	if bytes.Equal(a.Payload, b.Payload) {
+		return nil
+	}
+
+	changed := func(path, o, n string) *Difference {
+		return &Difference{Kind: DiffChanged, Path: path, Old: o, New: n, A: a, B: b}
+	}
+
+	va, errA := a.value()
+	vb, errB := b.value()
+	if errA != nil || errB != nil || va == nil || vb == nil {
+		return []*Difference{changed(path, fmt.Sprintf("%#x", a.Payload), fmt.Sprintf("%#x", b.Payload))}
+	}
+
+	ra, rb := reflect.Indirect(reflect.ValueOf(va)), reflect.Indirect(reflect.ValueOf(vb))
+	if ra.Kind() != reflect.Struct || ra.Type() != rb.Type() {
+		return []*Difference{changed(path, formatValue(a.Type, va), formatValue(b.Type, vb))}
+	}
+
+	var diffs []*Difference
+	for n := 0; n < ra.NumField(); n++ {
+		f := ra.Type().Field(n)
+		if !f.IsExported() {
+			continue
+		}
+
+		fa, fb := formatReflect(ra.Field(n)), formatReflect(rb.Field(n))
+		if fa == fb {
+			continue
+		}
+		diffs = append(diffs, changed(path+"/"+f.Name, fa, fb))
+	}
+
+	// the payloads differ only in the bits that are not decoded, e.g., spare bits.
+	if len(diffs) == 0 {
+		return []*Difference{changed(path, fmt.Sprintf("%#x", a.Payload), fmt.Sprintf("%#x", b.Payload))}
+	}
+	return diffs
+}
+
+// diffPathElem returns the element of path that represents the IE.
+func diffPathElem(i *IE, n int, repeated bool) string {
+	name := i.TypeName()
+	if i.EnterpriseID != 0 {
+		name = fmt.Sprintf("%s{%d}", name, i.EnterpriseID)
+	}
+
+	if id, ok := ruleIDString(i); ok {
+		return fmt.Sprintf("%s[%s]", name, id)
+	}
+	if repeated {
+		return fmt.Sprintf("%s[%d]", name, n)
+	}
+	return name
+}
+
+// diffValueString returns the value of IE in a line.
+// Grouped IEs are shown with their children in braces.
+func diffValueString(i *IE) string {
+	if i.IsGrouped() {
+		children, err := i.ValueAsGrouped()
+		if err != nil {
+			return fmt.Sprintf("<malformed: %v> %#x", err, i.Payload)
+		}
+
+		s := make([]string, len(children))
+		for n, c := range children {
+			s[n] = c.Type.String() + ": " + diffValueString(c)
+		}
+		return "{" + strings.Join(s, ", ") + "}"
+	}
+	return i.valueString()
+}
+
+// ruleIDTypes is the type of IE that identifies the grouped IE.
+var ruleIDTypes = map[IEType]IEType{
+	CreatePDR:  PDRID,
+	UpdatePDR:  PDRID,
+	RemovePDR:  PDRID,
+	CreatedPDR: PDRID,
+	UpdatedPDR: PDRID,
+	CreateFAR:  FARID,
+	UpdateFAR:  FARID,
+	RemoveFAR:  FARID,
+	CreateURR:  URRID,
+	UpdateURR:  URRID,
+	RemoveURR:  URRID,
+	QueryURR:   URRID,
+	UsageReportWithinSessionModificationResponse: URRID,
+	UsageReportWithinSessionDeletionResponse:     URRID,
+	UsageReportWithinSessionReportRequest:        URRID,
+	CreateQER:                                    QERID,
+	UpdateQER:                                    QERID,
+	RemoveQER:                                    QERID,
+	CreateBAR:                                    BARID,
+	UpdateBARWithinSessionModificationRequest:    BARID,
+	UpdateBARWithinSessionReportResponse:         BARID,
+	RemoveBAR:                                    BARID,
+	CreateMAR:                                    MARID,
+	UpdateMAR:                                    MARID,
+	RemoveMAR:                                    MARID,
+	CreateSRR:                                    SRRID,
+	UpdateSRR:                                    SRRID,
+	RemoveSRR:                                    SRRID,
+	SessionReport:                                SRRID,
+	CreateTrafficEndpoint:                        TrafficEndpointID,
+	CreatedTrafficEndpoint:                       TrafficEndpointID,
+	UpdateTrafficEndpoint:                        TrafficEndpointID,
+	RemoveTrafficEndpoint:                        TrafficEndpointID,
+	ApplicationIDsPFDs:                           ApplicationID,
+	EthernetPacketFilter:                         EthernetFilterID,
+}
+
+// ruleIDString returns the rule ID of the grouped IE in the form of "PDRID=1".
+func ruleIDString(i *IE) (string, bool) {
+	t, ok := ruleIDTypes[i.Type]
+	if !ok || i.EnterpriseID != 0 {
+		return "", false
+	}
+
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return "", false
+	}
+	for _, c := range children {
+		if c.Type != t {
+			continue
+		}
+
+		v, err := c.value()
+		if err != nil || v == nil {
+			return fmt.Sprintf("%s=%#x", t, c.Payload), true
+		}
+		return fmt.Sprintf("%s=%s", t, strings.Trim(formatValue(t, v), `"`)), true
+	}
+	return "", false
+}
@@ -20,43 +20,6 @@ func NewDLFlowLevelMarking(flags uint8, ttc, sci uint16) *IE {
 	return New(DLFlowLevelMarking, b)
 }
 
-// DLFlowLevelMarking returns DLFlowLevelMarking in *DLFlowLevelMarkingFields if the type of IE matches.
-func (i *IE) DLFlowLevelMarking() (*DLFlowLevelMarkingFields, error) {
-	switch i.Type {
-	case DLFlowLevelMarking:
-		f, err := ParseDLFlowLevelMarkingFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return f, nil
-	case CreateQER:
-		ies, err := i.CreateQER()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == DLFlowLevelMarking {
-				return x.DLFlowLevelMarking()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateQER:
-		ies, err := i.UpdateQER()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == DLFlowLevelMarking {
-				return x.DLFlowLevelMarking()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasTTC reports whether an IE has TTC bit.
 func (i *IE) HasTTC() bool {
 	if i.Type != DLFlowLevelMarking {
@@ -38,40 +38,6 @@ func NewDroppedDLTrafficThreshold(dlpa, dlby bool, packets, bytes uint64) *IE {
 	return New(DroppedDLTrafficThreshold, []byte{0x00})
 }
 
-// DroppedDLTrafficThreshold returns DroppedDLTrafficThreshold in uint8 if the type of IE matches.
-//
-// TODO: implement!
-func (i *IE) DroppedDLTrafficThreshold() (uint8, error) {
-	switch i.Type {
-	case DroppedDLTrafficThreshold:
-		return i.ValueAsUint8()
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == DroppedDLTrafficThreshold {
-				return x.DroppedDLTrafficThreshold()
-			}
-		}
-		return 0, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == DroppedDLTrafficThreshold {
-				return x.DroppedDLTrafficThreshold()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasDLBY reports whether an IE has DLBY bit.
 func (i *IE) HasDLBY() bool {
 	v, err := i.DroppedDLTrafficThreshold()
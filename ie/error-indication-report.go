@@ -8,12 +8,3 @@ package ie
 func NewErrorIndicationReport(fteid *IE) *IE {
 	return newGroupedIE(ErrorIndicationReport, 0, fteid)
 }
-
-// ErrorIndicationReport returns the IEs above ErrorIndicationReport if the type of IE matches.
-func (i *IE) ErrorIndicationReport() ([]*IE, error) {
-	if i.Type != ErrorIndicationReport {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
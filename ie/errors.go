@@ -7,6 +7,7 @@ package ie
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Error definitions.
@@ -41,3 +42,103 @@ type InvalidNodeIDError struct {
 func (e *InvalidNodeIDError) Error() string {
 	return fmt.Sprintf("got invalid NodeID: %d", e.ID)
 }
+
+// GroupedFieldError indicates the child IE in a grouped IE could not be decoded
+// into the typed fields, or the mandatory one is missing.
+type GroupedFieldError struct {
+	Parent IEType
+	Type   IEType
+	Err    error
+}
+
+// Error returns message with the types of IEs and the cause.
+func (e *GroupedFieldError) Error() string {
+	return fmt.Sprintf("failed to decode %s in %s: %v", e.Type, e.Parent, e.Err)
+}
+
+// Unwrap returns the cause of the error.
+func (e *GroupedFieldError) Unwrap() error {
+	return e.Err
+}
+
+// IPFilterRuleError indicates the IPFilterRule is invalid.
+//
+// Field is the part of the rule that is invalid, e.g., "source port", and Value
+// is the token found in it, which is empty if it is missing.
+type IPFilterRuleError struct {
+	Field  string
+	Value  string
+	Reason string
+}
+
+// Error returns message with the invalid part of the rule.
+func (e *IPFilterRuleError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("invalid IPFilterRule: %s: %s", e.Field, e.Reason)
+	}
+	return fmt.Sprintf("invalid IPFilterRule: %s %q: %s", e.Field, e.Value, e.Reason)
+}
+
+// FlagsError indicates the combination of flags in a flag-type IE, e.g.,
+// ApplyAction, is not allowed in the spec.
+//
+// It wraps ErrMalformed so that errors.Is(err, ErrMalformed) is true.
+type FlagsError struct {
+	Type   IEType
+	Reason string
+}
+
+// Error returns message with the type of IE and the reason.
+func (e *FlagsError) Error() string {
+	return fmt.Sprintf("invalid flags in %s: %s", e.Type, e.Reason)
+}
+
+// Unwrap returns ErrMalformed.
+func (e *FlagsError) Unwrap() error {
+	return ErrMalformed
+}
+
+// ValidationError indicates the IE is not valid as specified in 3GPP TS 29.244.
+//
+// Parents is the types of grouped IEs that contain the invalid IE, from the
+// outermost one. Cause is the value of the Cause IE that should be sent back
+// to the peer, which is either CauseInvalidLength or CauseMandatoryIEIncorrect.
+//
+// It wraps ErrInvalidLength or ErrMalformed depending on the Cause.
+type ValidationError struct {
+	Type    IEType
+	Parents []IEType
+	Cause   uint8
+	Reason  string
+}
+
+// Error returns message with the path to the invalid IE and the reason.
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	for _, p := range e.Parents {
+		b.WriteString(p.String())
+		b.WriteByte('/')
+	}
+	b.WriteString(e.Type.String())
+	return fmt.Sprintf("invalid %s: %s", b.String(), e.Reason)
+}
+
+// Unwrap returns ErrInvalidLength if the Cause is CauseInvalidLength, and
+// ErrMalformed otherwise.
+func (e *ValidationError) Unwrap() error {
+	if e.Cause == CauseInvalidLength {
+		return ErrInvalidLength
+	}
+	return ErrMalformed
+}
+
+// QueryError indicates the path expression given to Query is invalid.
+type QueryError struct {
+	Query  string
+	Reason string
+}
+
+// Error returns message with the query and the reason.
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("invalid query %q: %s", e.Query, e.Reason)
+}
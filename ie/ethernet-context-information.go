@@ -8,12 +8,3 @@ package ie
 func NewEthernetContextInformation(mac *IE) *IE {
 	return newGroupedIE(EthernetContextInformation, 0, mac)
 }
-
-// EthernetContextInformation returns the IEs above EthernetContextInformation if the type of IE matches.
-func (i *IE) EthernetContextInformation() ([]*IE, error) {
-	if i.Type != EthernetContextInformation {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
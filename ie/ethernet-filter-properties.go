@@ -4,43 +4,6 @@
 
 package ie
 
-// NewEthernetFilterProperties creates a new EthernetFilterProperties IE.
-func NewEthernetFilterProperties(props uint8) *IE {
-	return newUint8ValIE(EthernetFilterProperties, props)
-}
-
-// EthernetFilterProperties returns EthernetFilterProperties in uint8 if the type of IE matches.
-func (i *IE) EthernetFilterProperties() (uint8, error) {
-	switch i.Type {
-	case EthernetFilterProperties:
-		return i.ValueAsUint8()
-	case PDI:
-		ies, err := i.PDI()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == EthernetFilterProperties {
-				return x.EthernetFilterProperties()
-			}
-		}
-		return 0, ErrIENotFound
-	case EthernetPacketFilter:
-		ies, err := i.EthernetPacketFilter()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == EthernetFilterProperties {
-				return x.EthernetFilterProperties()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasBIDE reports whether an IE has BIDE bit.
 func (i *IE) HasBIDE() bool {
 	v, err := i.EthernetFilterProperties()
@@ -22,20 +22,6 @@ func NewFSEID(seid uint64, v4, v6 net.IP) *IE {
 	return New(FSEID, b)
 }
 
-// FSEID returns FSEID in structured format if the type of IE matches.
-func (i *IE) FSEID() (*FSEIDFields, error) {
-	if i.Type != FSEID {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	fields, err := ParseFSEIDFields(i.Payload)
-	if err != nil {
-		return nil, err
-	}
-
-	return fields, nil
-}
-
 // FSEIDFields represents a fields contained in FSEID IE.
 type FSEIDFields struct {
 	Flags       uint8
@@ -11,6 +11,14 @@ import (
 	"net"
 )
 
+// F-TEID flag definitions.
+const (
+	FTEIDV4   uint8 = 0x01
+	FTEIDV6   uint8 = 0x02
+	FTEIDCH   uint8 = 0x04
+	FTEIDCHID uint8 = 0x08
+)
+
 // NewFTEID creates a new FTEID IE.
 func NewFTEID(flags uint8, teid uint32, v4, v6 net.IP, chid uint8) *IE {
 	fields := NewFTEIDFields(flags, teid, v4, v6, chid)
@@ -145,7 +153,7 @@ func (f *FTEIDFields) HasChID() bool {
 
 // SetChIDFlag sets CHID flag in FTEID.
 func (f *FTEIDFields) SetChIDFlag() {
-	f.Flags |= 0x08
+	f.Flags |= FTEIDCHID
 }
 
 // HasCh reports whether CH flag is set.
@@ -155,7 +163,7 @@ func (f *FTEIDFields) HasCh() bool {
 
 // SetChFlag sets CH flag in FTEID.
 func (f *FTEIDFields) SetChFlag() {
-	f.Flags |= 0x04
+	f.Flags |= FTEIDCH
 }
 
 // HasIPv6 reports whether IPv6 flag is set.
@@ -165,7 +173,7 @@ func (f *FTEIDFields) HasIPv6() bool {
 
 // SetIPv6Flag sets IPv6 flag in FTEID.
 func (f *FTEIDFields) SetIPv6Flag() {
-	f.Flags |= 0x02
+	f.Flags |= FTEIDV6
 }
 
 // HasIPv4 reports whether IPv4 flag is set.
@@ -175,7 +183,7 @@ func (f *FTEIDFields) HasIPv4() bool {
 
 // SetIPv4Flag sets IPv4 flag in FTEID.
 func (f *FTEIDFields) SetIPv4Flag() {
-	f.Flags |= 0x01
+	f.Flags |= FTEIDV4
 }
 
 // ParseFTEIDFields parses b into FTEIDFields.
@@ -0,0 +1,104 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "fmt"
+
+// Procedure is an optional procedure in PFCP that is available only when the
+// CP function and/or the UP function support the corresponding features.
+type Procedure uint8
+
+// Procedure definitions.
+const (
+	ProcedureUPFTEIDAllocation       Procedure = iota // F-TEID allocation by UP function (FTUP)
+	ProcedureUPUEIPAllocation                         // UE IP address allocation by UP function (UEIP)
+	ProcedurePFDManagement                            // PFD Management procedure (PFDM)
+	ProcedureBufferingInCP                            // downlink data buffering in CP function (BUCP)
+	ProcedureDDNDelay                                 // Downlink Data Notification Delay (DDND)
+	ProcedureDLBufferingDuration                      // DL Buffering Duration (DLBD)
+	ProcedureTrafficSteering                          // traffic steering (TRST)
+	ProcedureHeaderEnrichment                         // header enrichment of uplink traffic (HEEU)
+	ProcedureTrafficRedirection                       // traffic redirection enforcement (TREU)
+	ProcedureQuotaAction                              // Quota Action on exhausted quota (QUOAC)
+	ProcedureTrace                                    // trace (TRACE)
+	ProcedureActivatePredefinedRules                  // activation and deactivation of predefined PDRs (ADPDP)
+	ProcedureEndMarkerOnFARRemoval                    // end marker on FAR removal, by both (EPFAR)
+	ProcedureSMFSet                                   // sessions controlled by SMF Set, by both (SSET)
+	ProcedureMessageBundling                          // PFCP messages bundling, by both (BUNDL)
+	ProcedureMultipleAssociations                     // multiple PFCP associations, by both (MPAS)
+	ProcedureLoadControl                              // load control by CP function (LOAD)
+	ProcedureOverloadControl                          // overload control by CP function (OVRL)
+)
+
+// procedureRequirements is the features required for each Procedure.
+// The procedure is available if all the features in up and cp are supported.
+var procedureRequirements = []struct {
+	name string
+	up   []UPFeature
+	cp   []CPFeature
+}{
+	ProcedureUPFTEIDAllocation:       {"UPFTEIDAllocation", []UPFeature{UPFeatureFTUP}, nil},
+	ProcedureUPUEIPAllocation:        {"UPUEIPAllocation", []UPFeature{UPFeatureUEIP}, nil},
+	ProcedurePFDManagement:           {"PFDManagement", []UPFeature{UPFeaturePFDM}, nil},
+	ProcedureBufferingInCP:           {"BufferingInCP", []UPFeature{UPFeatureBUCP}, nil},
+	ProcedureDDNDelay:                {"DDNDelay", []UPFeature{UPFeatureDDND}, nil},
+	ProcedureDLBufferingDuration:     {"DLBufferingDuration", []UPFeature{UPFeatureDLBD}, nil},
+	ProcedureTrafficSteering:         {"TrafficSteering", []UPFeature{UPFeatureTRST}, nil},
+	ProcedureHeaderEnrichment:        {"HeaderEnrichment", []UPFeature{UPFeatureHEEU}, nil},
+	ProcedureTrafficRedirection:      {"TrafficRedirection", []UPFeature{UPFeatureTREU}, nil},
+	ProcedureQuotaAction:             {"QuotaAction", []UPFeature{UPFeatureQUOAC}, nil},
+	ProcedureTrace:                   {"Trace", []UPFeature{UPFeatureTRACE}, nil},
+	ProcedureActivatePredefinedRules: {"ActivatePredefinedRules", []UPFeature{UPFeatureADPDP}, nil},
+	ProcedureEndMarkerOnFARRemoval:   {"EndMarkerOnFARRemoval", []UPFeature{UPFeatureEPFAR}, []CPFeature{CPFeatureEPFAR}},
+	ProcedureSMFSet:                  {"SMFSet", []UPFeature{UPFeatureSSET}, []CPFeature{CPFeatureSSET}},
+	ProcedureMessageBundling:         {"MessageBundling", []UPFeature{UPFeatureBUNDL}, []CPFeature{CPFeatureBUNDL}},
+	ProcedureMultipleAssociations:    {"MultipleAssociations", []UPFeature{UPFeatureMPAS}, []CPFeature{CPFeatureMPAS}},
+	ProcedureLoadControl:             {"LoadControl", nil, []CPFeature{CPFeatureLOAD}},
+	ProcedureOverloadControl:         {"OverloadControl", nil, []CPFeature{CPFeatureOVRL}},
+}
+
+// String returns the name of the Procedure.
+func (p Procedure) String() string {
+	if int(p) < len(procedureRequirements) {
+		return procedureRequirements[p].name
+	}
+	return fmt.Sprintf("Procedure(%d)", p)
+}
+
+// FeatureNegotiation is the result of comparing the features of CP function
+// and UP function exchanged in Association Setup procedure.
+type FeatureNegotiation struct {
+	CP CPFeatures
+	UP UPFeatures
+}
+
+// NegotiateFeatures returns the FeatureNegotiation of the given features.
+//
+// CP function should give its own features and the UP function's ones taken
+// from the Association Setup Request or Response, and vice versa for UP
+// function. The features are the zero value if the IE is absent.
+func NegotiateFeatures(cp CPFeatures, up UPFeatures) *FeatureNegotiation {
+	return &FeatureNegotiation{CP: cp, UP: up}
+}
+
+// Supports reports whether the Procedure can be used in the association.
+func (n *FeatureNegotiation) Supports(p Procedure) bool {
+	if int(p) >= len(procedureRequirements) {
+		return false
+	}
+	r := procedureRequirements[p]
+	return n.UP.Has(r.up...) && n.CP.Has(r.cp...)
+}
+
+// Procedures returns all the Procedures that can be used in the association.
+func (n *FeatureNegotiation) Procedures() []Procedure {
+	var ps []Procedure
+	for p := range procedureRequirements {
+		if n.Supports(Procedure(p)) {
+			ps = append(ps, Procedure(p))
+		}
+	}
+	return ps
+}
@@ -0,0 +1,77 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "strings"
+
+// The helpers for the multi-octet flags, in which the n-th flag is the bit
+// (n%8)+1 of the (n/8)+1-th octet in b. They are shared by the typed flag sets
+// such as ApplyActionFlags, whose flags are the positions.
+
+// hasFlags reports whether all the flags are set in b.
+func hasFlags[F ~uint8](b []byte, ns ...F) bool {
+	for _, n := range ns {
+		o := int(n / 8)
+		if o >= len(b) || b[o]&(1<<(n%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// setFlags returns the copy of b with the flags set, extended if necessary.
+func setFlags[F ~uint8](b []byte, ns ...F) []byte {
+	c := append([]byte{}, b...)
+	for _, n := range ns {
+		for int(n/8) >= len(c) {
+			c = append(c, 0)
+		}
+		c[n/8] |= 1 << (n % 8)
+	}
+	return c
+}
+
+// clearFlags returns the copy of b with the flags cleared. The length is kept
+// as it is.
+func clearFlags[F ~uint8](b []byte, ns ...F) []byte {
+	c := append([]byte{}, b...)
+	for _, n := range ns {
+		if int(n/8) < len(c) {
+			c[n/8] &^= 1 << (n % 8)
+		}
+	}
+	return c
+}
+
+// listFlags returns the flags set in b in the order of the position.
+func listFlags[F ~uint8](b []byte) []F {
+	var ns []F
+	for o, x := range b {
+		for bit := 0; bit < 8; bit++ {
+			if x&(1<<bit) != 0 {
+				ns = append(ns, F(o*8+bit))
+			}
+		}
+	}
+	return ns
+}
+
+// flagName returns the name of the n-th flag in the table, or the position
+// in the form of "Octet5Bit1" if it is not defined.
+func flagName(names [][8]string, n uint8) string {
+	var b [32]byte
+	b[n/8] = 1 << (n % 8)
+	return flagNames(b[:n/8+1], names)[0]
+}
+
+// joinFlags returns the names of flags set in b separated by "|", or "none"
+// if no flag is set.
+func joinFlags(b []byte, names [][8]string) string {
+	s := flagNames(b, names)
+	if len(s) == 0 {
+		return "none"
+	}
+	return strings.Join(s, "|")
+}
@@ -0,0 +1,278 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// String returns the IE in human-readable form.
+//
+// Each IE is shown with its type name and the decoded value in a line, e.g.,
+// "FTEID: TEID=0x1 v4=10.0.0.1". Grouped IEs are shown as an indented tree with
+// their children in the following lines.
+func (i *IE) String() string {
+	if i == nil {
+		return "<nil>"
+	}
+
+	b := &strings.Builder{}
+	i.writeTree(b, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// writeTree writes the IE and its children into b with the given depth of indent.
+func (i *IE) writeTree(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString(i.TypeName())
+	if i.EnterpriseID != 0 {
+		fmt.Fprintf(b, " [EnterpriseID=%d]", i.EnterpriseID)
+	}
+	b.WriteString(":")
+
+	if i.IsGrouped() {
+		children, err := i.ValueAsGrouped()
+		if err != nil {
+			fmt.Fprintf(b, " <malformed: %v> %#x\n", err, i.Payload)
+			return
+		}
+		b.WriteString("\n")
+		for _, c := range children {
+			c.writeTree(b, depth+1)
+		}
+		return
+	}
+
+	fmt.Fprintf(b, " %s\n", i.valueString())
+}
+
+// valueString returns the value of non-grouped IE in human-readable form.
+func (i *IE) valueString() string {
+	v, err := i.value()
+	switch {
+	case err != nil:
+		return fmt.Sprintf("<malformed: %v> %#x", err, i.Payload)
+	case v == nil:
+		return fmt.Sprintf("%#x", i.Payload)
+	default:
+		return formatValue(i.Type, v)
+	}
+}
+
+// formatValue returns the decoded value of an IE in human-readable form.
+func formatValue(t IEType, v any) string {
+	switch t {
+	case Cause:
+		c := v.(uint8)
+		return fmt.Sprintf("%s (%d)", causeName(c), c)
+	case SourceInterface:
+		s := v.(uint8)
+		return fmt.Sprintf("%s (%d)", lookupName(srcInterfaceNames, s), s)
+	case DestinationInterface:
+		d := v.(uint8)
+		return fmt.Sprintf("%s (%d)", lookupName(dstInterfaceNames, d), d)
+	}
+
+	switch x := v.(type) {
+	case *FTEIDFields:
+		return formatFTEID(x)
+	case *FSEIDFields:
+		return joinFields(
+			fmt.Sprintf("SEID=%#x", x.SEID),
+			formatIP("v4", x.IPv4Address),
+			formatIP("v6", x.IPv6Address),
+		)
+	case *UEIPAddressFields:
+		return formatUEIPAddress(x)
+	case []string:
+		if len(x) == 0 {
+			return "(none)"
+		}
+		return strings.Join(x, "|")
+	case string:
+		return fmt.Sprintf("%q", x)
+	}
+
+	return formatReflect(reflect.ValueOf(v))
+}
+
+func formatFTEID(f *FTEIDFields) string {
+	var s []string
+	if f.HasCh() {
+		s = append(s, "CH")
+		if f.HasChID() {
+			s = append(s, fmt.Sprintf("CHID=%d", f.ChooseID))
+		}
+		if f.HasIPv4() {
+			s = append(s, "v4")
+		}
+		if f.HasIPv6() {
+			s = append(s, "v6")
+		}
+		return joinFields(s...)
+	}
+
+	return joinFields(
+		fmt.Sprintf("TEID=%#x", f.TEID),
+		formatIP("v4", f.IPv4Address),
+		formatIP("v6", f.IPv6Address),
+	)
+}
+
+func formatUEIPAddress(f *UEIPAddressFields) string {
+	var s []string
+	if has3rdBit(f.Flags) {
+		s = append(s, "D")
+	} else {
+		s = append(s, "S")
+	}
+	if has5thBit(f.Flags) {
+		s = append(s, "CHV4")
+	}
+	if has6thBit(f.Flags) {
+		s = append(s, "CHV6")
+	}
+	s = append(s, formatIP("v4", f.IPv4Address), formatIP("v6", f.IPv6Address))
+	if has4thBit(f.Flags) {
+		s = append(s, fmt.Sprintf("v6PD=%d", f.IPv6PrefixDelegationBits))
+	}
+	if has7thBit(f.Flags) {
+		s = append(s, fmt.Sprintf("v6PL=%d", f.IPv6PrefixLength))
+	}
+	return joinFields(s...)
+}
+
+func formatIP(key string, ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return key + "=" + ip.String()
+}
+
+// joinFields joins non-empty strings with spaces.
+func joinFields(s ...string) string {
+	var fields []string
+	for _, f := range s {
+		if f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// formatReflect formats any value in human-readable form.
+//
+// Structs are shown as "Name=value" pairs of the fields with non-zero values.
+func formatReflect(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.UTC().Format(time.RFC3339)
+	case time.Duration:
+		return x.String()
+	case net.IP:
+		return x.String()
+	case net.HardwareAddr:
+		return x.String()
+	case IEType:
+		return x.String()
+	case []byte:
+		return fmt.Sprintf("%#x", x)
+	case fmt.Stringer:
+		return x.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return formatReflect(v.Elem())
+	case reflect.Struct:
+		var s []string
+		for n := 0; n < v.NumField(); n++ {
+			f := v.Type().Field(n)
+			if !f.IsExported() || v.Field(n).IsZero() {
+				continue
+			}
+			s = append(s, f.Name+"="+formatReflect(v.Field(n)))
+		}
+		return strings.Join(s, " ")
+	case reflect.Slice, reflect.Array:
+		s := make([]string, v.Len())
+		for n := range s {
+			s[n] = formatReflect(v.Index(n))
+		}
+		return "[" + strings.Join(s, ",") + "]"
+	case reflect.String:
+		return fmt.Sprintf("%q", v.String())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+var (
+	causeNames = map[uint8]string{
+		CauseRequestAccepted:                 "RequestAccepted",
+		CauseRequestRejected:                 "RequestRejected",
+		CauseSessionContextNotFound:          "SessionContextNotFound",
+		CauseMandatoryIEMissing:              "MandatoryIEMissing",
+		CauseConditionalIEMissing:            "ConditionalIEMissing",
+		CauseInvalidLength:                   "InvalidLength",
+		CauseMandatoryIEIncorrect:            "MandatoryIEIncorrect",
+		CauseInvalidForwardingPolicy:         "InvalidForwardingPolicy",
+		CauseInvalidFTEIDAllocationOption:    "InvalidFTEIDAllocationOption",
+		CauseNoEstablishedPFCPAssociation:    "NoEstablishedPFCPAssociation",
+		CauseRuleCreationModificationFailure: "RuleCreationModificationFailure",
+		CausePFCPEntityInCongestion:          "PFCPEntityInCongestion",
+		CauseNoResourcesAvailable:            "NoResourcesAvailable",
+		CauseServiceNotSupported:             "ServiceNotSupported",
+		CauseSystemFailure:                   "SystemFailure",
+		CauseRedirectionRequested:            "RedirectionRequested",
+	}
+	srcInterfaceNames = map[uint8]string{
+		SrcInterfaceAccess:       "Access",
+		SrcInterfaceCore:         "Core",
+		SrcInterfaceSGiLANN6LAN:  "SGi-LAN/N6-LAN",
+		SrcInterfaceCPFunction:   "CP-Function",
+		SrcInterface5GVNInternal: "5G VN Internal",
+	}
+	dstInterfaceNames = map[uint8]string{
+		DstInterfaceAccess:       "Access",
+		DstInterfaceCore:         "Core",
+		DstInterfaceSGiLANN6LAN:  "SGi-LAN/N6-LAN",
+		DstInterfaceCPFunction:   "CP-Function",
+		DstInterfaceLIFunction:   "LI Function",
+		DstInterface5GVNInternal: "5G VN Internal",
+	}
+)
+
+// causeName returns the name of the Cause value.
+func causeName(c uint8) string {
+	if n, ok := causeNames[c]; ok {
+		return n
+	}
+	if c == 0 {
+		return "Reserved"
+	}
+	if c >= 2 && c <= 63 {
+		return "Unknown (Acceptance)"
+	}
+	return "Unknown (Rejection)"
+}
+
+func lookupName(names map[uint8]string, v uint8) string {
+	if n, ok := names[v]; ok {
+		return n
+	}
+	return "Unknown"
+}
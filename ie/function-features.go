@@ -0,0 +1,455 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// UPFeature is a feature in UP Function Features IE, represented as the
+// position of the bit counted from bit 1 of the 5th octet.
+type UPFeature uint8
+
+// UPFeature definitions, as of 3GPP TS 29.244 V18.
+const (
+	// 5th octet
+	UPFeatureBUCP UPFeature = iota
+	UPFeatureDDND
+	UPFeatureDLBD
+	UPFeatureTRST
+	UPFeatureFTUP
+	UPFeaturePFDM
+	UPFeatureHEEU
+	UPFeatureTREU
+
+	// 6th octet
+	UPFeatureEMPU
+	UPFeaturePDIU
+	UPFeatureUDBC
+	UPFeatureQUOAC
+	UPFeatureTRACE
+	UPFeatureFRRT
+	UPFeaturePFDE
+	UPFeatureEPFAR
+
+	// 7th octet
+	UPFeatureDPDRA
+	UPFeatureADPDP
+	UPFeatureUEIP
+	UPFeatureSSET
+	UPFeatureMNOP
+	UPFeatureMTE
+	UPFeatureBUNDL
+	UPFeatureGCOM
+
+	// 8th octet
+	UPFeatureMPAS
+	UPFeatureRTTL
+	UPFeatureVTIME
+	UPFeatureNORP
+	UPFeatureIPTV
+	UPFeatureIP6PL
+	UPFeatureTSCU
+	UPFeatureMPTCP
+
+	// 9th octet
+	UPFeatureATSSSLL
+	UPFeatureQFQM
+	UPFeatureGPQM
+	UPFeatureMTEDT
+	UPFeatureCIOT
+	UPFeatureETHAR
+	UPFeatureDDDS
+	UPFeatureRDS
+
+	// 10th octet
+	UPFeatureRTTWP
+	UPFeatureQUASF
+	UPFeatureNSPOC
+	UPFeatureL2TP
+	UPFeatureUPBER
+	UPFeatureRESPS
+	UPFeatureIPREP
+	UPFeatureDNSTS
+
+	// 11th octet
+	UPFeatureDRQOS
+	UPFeatureMBSN4
+	UPFeaturePSUPRM
+	UPFeatureEPPPI
+	UPFeatureRATP
+	UPFeatureUPIDP
+	UPFeatureUPSAR
+	UPFeatureN6JF
+)
+
+var upFeatureNames = []string{
+	"BUCP", "DDND", "DLBD", "TRST", "FTUP", "PFDM", "HEEU", "TREU",
+	"EMPU", "PDIU", "UDBC", "QUOAC", "TRACE", "FRRT", "PFDE", "EPFAR",
+	"DPDRA", "ADPDP", "UEIP", "SSET", "MNOP", "MTE", "BUNDL", "GCOM",
+	"MPAS", "RTTL", "VTIME", "NORP", "IPTV", "IP6PL", "TSCU", "MPTCP",
+	"ATSSS-LL", "QFQM", "GPQM", "MT-EDT", "CIOT", "ETHAR", "DDDS", "RDS",
+	"RTTWP", "QUASF", "NSPOC", "L2TP", "UPBER", "RESPS", "IPREP", "DNSTS",
+	"DRQOS", "MBSN4", "PSUPRM", "EPPPI", "RATP", "UPIDP", "UPSAR", "N6JF",
+}
+
+// String returns the name of the feature as in the spec, e.g., "FTUP".
+func (f UPFeature) String() string {
+	return featureName(upFeatureNames, uint8(f))
+}
+
+// CPFeature is a feature in CP Function Features IE, represented as the
+// position of the bit counted from bit 1 of the 5th octet.
+type CPFeature uint8
+
+// CPFeature definitions, as of 3GPP TS 29.244 V18.
+const (
+	// 5th octet
+	CPFeatureLOAD CPFeature = iota
+	CPFeatureOVRL
+	CPFeatureEPFAR
+	CPFeatureSSET
+	CPFeatureBUNDL
+	CPFeatureMPAS
+	CPFeatureARDR
+	CPFeatureUIAUR
+
+	// 6th octet
+	CPFeaturePSUCC
+	CPFeatureRPGUR
+)
+
+var cpFeatureNames = []string{
+	"LOAD", "OVRL", "EPFAR", "SSET", "BUNDL", "MPAS", "ARDR", "UIAUR",
+	"PSUCC", "RPGUR",
+}
+
+// String returns the name of the feature as in the spec, e.g., "LOAD".
+func (f CPFeature) String() string {
+	return featureName(cpFeatureNames, uint8(f))
+}
+
+// UPFeatures is the set of features in UP Function Features IE.
+//
+// The zero value is the empty set. The bits that are not defined in this
+// package are kept as they are, so that the set can be encoded into the IE
+// with the same value it was decoded from.
+type UPFeatures uint64
+
+// NewUPFeatures creates a new UPFeatures with the given features.
+func NewUPFeatures(features ...UPFeature) UPFeatures {
+	return UPFeatures(0).Set(features...)
+}
+
+// ParseUPFeatures decodes the value of UP Function Features IE into UPFeatures.
+// The octets beyond the 8th of the value are ignored.
+func ParseUPFeatures(b []byte) UPFeatures {
+	return UPFeatures(decodeFeatures(b))
+}
+
+// Has reports whether the set has all the given features.
+func (s UPFeatures) Has(features ...UPFeature) bool {
+	for _, f := range features {
+		if s&(1<<f) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Set returns the set with the given features added.
+func (s UPFeatures) Set(features ...UPFeature) UPFeatures {
+	for _, f := range features {
+		s |= 1 << f
+	}
+	return s
+}
+
+// Clear returns the set with the given features removed.
+func (s UPFeatures) Clear(features ...UPFeature) UPFeatures {
+	for _, f := range features {
+		s &^= 1 << f
+	}
+	return s
+}
+
+// Union returns the set of features in either s or t.
+func (s UPFeatures) Union(t UPFeatures) UPFeatures {
+	return s | t
+}
+
+// Intersect returns the set of features in both s and t.
+func (s UPFeatures) Intersect(t UPFeatures) UPFeatures {
+	return s & t
+}
+
+// Difference returns the set of features in s but not in t.
+func (s UPFeatures) Difference(t UPFeatures) UPFeatures {
+	return s &^ t
+}
+
+// Features returns the features in the set in the order of the bit position.
+func (s UPFeatures) Features() []UPFeature {
+	var fs []UPFeature
+	for _, n := range featureBits(uint64(s)) {
+		fs = append(fs, UPFeature(n))
+	}
+	return fs
+}
+
+// Bytes returns the value of UP Function Features IE. It has at least 2 octets
+// and is trimmed at the last octet that has any feature set.
+func (s UPFeatures) Bytes() []byte {
+	return encodeFeatures(uint64(s), 2)
+}
+
+// String returns the names of the features in the set separated by "|", e.g.,
+// "FTUP|PFDM", or "none" if the set is empty.
+func (s UPFeatures) String() string {
+	return joinFeatures(upFeatureNames, uint64(s))
+}
+
+// MarshalJSON encodes the set as the array of feature names.
+func (s UPFeatures) MarshalJSON() ([]byte, error) {
+	return marshalFeatures(upFeatureNames, uint64(s))
+}
+
+// UnmarshalJSON decodes the array of feature names into the set.
+func (s *UPFeatures) UnmarshalJSON(b []byte) error {
+	v, err := unmarshalFeatures(upFeatureNames, b)
+	if err != nil {
+		return err
+	}
+	*s = UPFeatures(v)
+	return nil
+}
+
+// CPFeatures is the set of features in CP Function Features IE.
+//
+// The zero value is the empty set. The bits that are not defined in this
+// package are kept as they are, so that the set can be encoded into the IE
+// with the same value it was decoded from.
+type CPFeatures uint64
+
+// NewCPFeatures creates a new CPFeatures with the given features.
+func NewCPFeatures(features ...CPFeature) CPFeatures {
+	return CPFeatures(0).Set(features...)
+}
+
+// ParseCPFeatures decodes the value of CP Function Features IE into CPFeatures.
+// The octets beyond the 8th of the value are ignored.
+func ParseCPFeatures(b []byte) CPFeatures {
+	return CPFeatures(decodeFeatures(b))
+}
+
+// Has reports whether the set has all the given features.
+func (s CPFeatures) Has(features ...CPFeature) bool {
+	for _, f := range features {
+		if s&(1<<f) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Set returns the set with the given features added.
+func (s CPFeatures) Set(features ...CPFeature) CPFeatures {
+	for _, f := range features {
+		s |= 1 << f
+	}
+	return s
+}
+
+// Clear returns the set with the given features removed.
+func (s CPFeatures) Clear(features ...CPFeature) CPFeatures {
+	for _, f := range features {
+		s &^= 1 << f
+	}
+	return s
+}
+
+// Union returns the set of features in either s or t.
+func (s CPFeatures) Union(t CPFeatures) CPFeatures {
+	return s | t
+}
+
+// Intersect returns the set of features in both s and t.
+func (s CPFeatures) Intersect(t CPFeatures) CPFeatures {
+	return s & t
+}
+
+// Difference returns the set of features in s but not in t.
+func (s CPFeatures) Difference(t CPFeatures) CPFeatures {
+	return s &^ t
+}
+
+// Features returns the features in the set in the order of the bit position.
+func (s CPFeatures) Features() []CPFeature {
+	var fs []CPFeature
+	for _, n := range featureBits(uint64(s)) {
+		fs = append(fs, CPFeature(n))
+	}
+	return fs
+}
+
+// Bytes returns the value of CP Function Features IE. It has at least 1 octet
+// and is trimmed at the last octet that has any feature set.
+func (s CPFeatures) Bytes() []byte {
+	return encodeFeatures(uint64(s), 1)
+}
+
+// String returns the names of the features in the set separated by "|", e.g.,
+// "LOAD|OVRL", or "none" if the set is empty.
+func (s CPFeatures) String() string {
+	return joinFeatures(cpFeatureNames, uint64(s))
+}
+
+// MarshalJSON encodes the set as the array of feature names.
+func (s CPFeatures) MarshalJSON() ([]byte, error) {
+	return marshalFeatures(cpFeatureNames, uint64(s))
+}
+
+// UnmarshalJSON decodes the array of feature names into the set.
+func (s *CPFeatures) UnmarshalJSON(b []byte) error {
+	v, err := unmarshalFeatures(cpFeatureNames, b)
+	if err != nil {
+		return err
+	}
+	*s = CPFeatures(v)
+	return nil
+}
+
+// NewUPFunctionFeaturesWithFeatures creates a new UPFunctionFeatures IE from UPFeatures.
+func NewUPFunctionFeaturesWithFeatures(s UPFeatures) *IE {
+	return New(UPFunctionFeatures, s.Bytes())
+}
+
+// NewCPFunctionFeaturesWithFeatures creates a new CPFunctionFeatures IE from CPFeatures.
+func NewCPFunctionFeaturesWithFeatures(s CPFeatures) *IE {
+	return New(CPFunctionFeatures, s.Bytes())
+}
+
+// UPFeatures returns the UPFeatures in UPFunctionFeatures IE.
+func (i *IE) UPFeatures() (UPFeatures, error) {
+	b, err := i.UPFunctionFeatures()
+	if err != nil {
+		return 0, err
+	}
+	return ParseUPFeatures(b), nil
+}
+
+// CPFeatures returns the CPFeatures in CPFunctionFeatures IE.
+func (i *IE) CPFeatures() (CPFeatures, error) {
+	b, err := i.CPFunctionFeatures()
+	if err != nil {
+		return 0, err
+	}
+	return ParseCPFeatures(b), nil
+}
+
+func decodeFeatures(b []byte) uint64 {
+	var v uint64
+	for n, x := range b {
+		if n >= 8 {
+			break
+		}
+		v |= uint64(x) << (8 * n)
+	}
+	return v
+}
+
+func encodeFeatures(v uint64, minLen int) []byte {
+	l := (bits.Len64(v) + 7) / 8
+	if l < minLen {
+		l = minLen
+	}
+
+	b := make([]byte, l)
+	for n := range b {
+		b[n] = uint8(v >> (8 * n))
+	}
+	return b
+}
+
+func featureBits(v uint64) []uint8 {
+	var ns []uint8
+	for v != 0 {
+		n := bits.TrailingZeros64(v)
+		ns = append(ns, uint8(n))
+		v &^= 1 << n
+	}
+	return ns
+}
+
+// featureName returns the name of the n-th feature, or the position in the form
+// of "Octet5Bit1" if it is not defined.
+func featureName(names []string, n uint8) string {
+	if int(n) < len(names) {
+		return names[n]
+	}
+	return fmt.Sprintf("Octet%dBit%d", n/8+5, n%8+1)
+}
+
+func joinFeatures(names []string, v uint64) string {
+	if v == 0 {
+		return "none"
+	}
+
+	var s []string
+	for _, n := range featureBits(v) {
+		s = append(s, featureName(names, n))
+	}
+	return strings.Join(s, "|")
+}
+
+func marshalFeatures(names []string, v uint64) ([]byte, error) {
+	s := []string{}
+	for _, n := range featureBits(v) {
+		s = append(s, featureName(names, n))
+	}
+	return json.Marshal(s)
+}
+
+func unmarshalFeatures(names []string, b []byte) (uint64, error) {
+	var s []string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0, err
+	}
+
+	var v uint64
+	for _, name := range s {
+		n, ok := featureByName(names, name)
+		if !ok {
+			return 0, fmt.Errorf("unknown feature %q", name)
+		}
+		v |= 1 << n
+	}
+	return v, nil
+}
+
+func featureByName(names []string, name string) (uint8, bool) {
+	for n, x := range names {
+		if x == name {
+			return uint8(n), true
+		}
+	}
+
+	var octet, bit uint8
+	if _, err := fmt.Sscanf(name, "Octet%dBit%d", &octet, &bit); err != nil {
+		return 0, false
+	}
+	if octet < 5 || octet > 12 || bit < 1 || bit > 8 {
+		return 0, false
+	}
+
+	n := (octet-5)*8 + bit - 1
+	if featureName(names, n) != name {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,47 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// The typed fields of grouped IEs, e.g., CreatePDRFields, are generated from
+// internal/gen/spec/grouped.json. Run go generate after editing the spec.
+//
+// Each grouped IE type X in defaultGroupedIEMap has XFields struct, which has
+// the values of the child IEs in the plain Go types returned by the accessors.
+// Mandatory child IEs are held as values, optional ones as pointers, and the ones
+// that can appear multiple times as slices. Child IEs not known to the struct are
+// kept as they are in IEs field.
+//
+// ParseXFields decodes the payload of X IE into the struct, and ToIE builds X IE
+// from the struct.
+
+// groupedFields is implemented by the generated XFields structs.
+type groupedFields interface {
+	unmarshalIEs(ies []*IE) error
+}
+
+// unmarshalGroupedFields decodes the child IEs of i into f.
+func unmarshalGroupedFields(i *IE, f groupedFields) error {
+	ies, err := i.ValueAsGrouped()
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+// newFieldsIE creates a new IE of type t with the payload marshaled from f.
+// It returns nil if failed to marshal, which is ignored by newGroupedIE.
+func newFieldsIE(t IEType, f interface{ Marshal() ([]byte, error) }) *IE {
+	b, err := f.Marshal()
+	if err != nil {
+		return nil
+	}
+	return New(t, b)
+}
+
+// valueAsBytes returns the payload as it is, for the IEs that have no typed accessor
+// that keeps all the information in the payload.
+func (i *IE) valueAsBytes() ([]byte, error) {
+	return i.Payload, nil
+}
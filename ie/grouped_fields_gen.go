@@ -0,0 +1,8930 @@
+// Code generated by internal/gen from spec/grouped.json; DO NOT EDIT.
+
+package ie
+
+import (
+	"net"
+	"time"
+)
+
+// CreatePDRFields represents the fields in CreatePDR IE.
+type CreatePDRFields struct {
+	PDRID                                           uint16
+	Precedence                                      uint32
+	PDI                                             *PDIFields
+	OuterHeaderRemoval                              []byte
+	FARID                                           *uint32
+	URRIDs                                          []uint32
+	QERIDs                                          []uint32
+	ActivatePredefinedRules                         []string
+	ActivationTime                                  *time.Time
+	DeactivationTime                                *time.Time
+	MARID                                           *uint16
+	PacketReplicationAndDetectionCarryOnInformation *uint8
+	IPMulticastAddressingInfos                      []*IPMulticastAddressingInfoFields
+	UEIPAddressPoolIdentities                       [][]byte
+	MPTCPApplicableIndication                       *uint8
+	TransportDelayReporting                         *TransportDelayReportingFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreatePDRFields parses b into CreatePDRFields.
+func ParseCreatePDRFields(b []byte) (*CreatePDRFields, error) {
+	f := &CreatePDRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreatePDRFields.
+func (f *CreatePDRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreatePDRFields) unmarshalIEs(ies []*IE) error {
+	var hasPDRID bool
+	var hasPrecedence bool
+	var hasPDI bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.PDRID = v
+			hasPDRID = true
+		case Precedence:
+			v, err := i.Precedence()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.Precedence = v
+			hasPrecedence = true
+		case PDI:
+			v := &PDIFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.PDI = v
+			hasPDI = true
+		case OuterHeaderRemoval:
+			v, err := i.OuterHeaderRemoval()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderRemoval = v
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.FARID = &v
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.URRIDs = append(f.URRIDs, v)
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.QERIDs = append(f.QERIDs, v)
+		case ActivatePredefinedRules:
+			v, err := i.ActivatePredefinedRules()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.ActivatePredefinedRules = append(f.ActivatePredefinedRules, v)
+		case ActivationTime:
+			v, err := i.ActivationTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.ActivationTime = &v
+		case DeactivationTime:
+			v, err := i.DeactivationTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.DeactivationTime = &v
+		case MARID:
+			v, err := i.MARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.MARID = &v
+		case PacketReplicationAndDetectionCarryOnInformation:
+			v, err := i.PacketReplicationAndDetectionCarryOnInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.PacketReplicationAndDetectionCarryOnInformation = &v
+		case IPMulticastAddressingInfo:
+			v := &IPMulticastAddressingInfoFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.IPMulticastAddressingInfos = append(f.IPMulticastAddressingInfos, v)
+		case UEIPAddressPoolIdentity:
+			v, err := i.UEIPAddressPoolIdentity()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.UEIPAddressPoolIdentities = append(f.UEIPAddressPoolIdentities, v)
+		case MPTCPApplicableIndication:
+			v, err := i.MPTCPApplicableIndication()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.MPTCPApplicableIndication = &v
+		case TransportDelayReporting:
+			v := &TransportDelayReportingFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreatePDR, Type: i.Type, Err: err}
+			}
+			f.TransportDelayReporting = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPDRID {
+		return &GroupedFieldError{Parent: CreatePDR, Type: PDRID, Err: ErrIENotFound}
+	}
+	if !hasPrecedence {
+		return &GroupedFieldError{Parent: CreatePDR, Type: Precedence, Err: ErrIENotFound}
+	}
+	if !hasPDI {
+		return &GroupedFieldError{Parent: CreatePDR, Type: PDI, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreatePDR IE built from the fields.
+func (f *CreatePDRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewPDRID(f.PDRID))
+	ies = append(ies, NewPrecedence(f.Precedence))
+	if f.PDI != nil {
+		ies = append(ies, f.PDI.ToIE())
+	}
+	if f.OuterHeaderRemoval != nil {
+		ies = append(ies, New(OuterHeaderRemoval, f.OuterHeaderRemoval))
+	}
+	if f.FARID != nil {
+		ies = append(ies, NewFARID(*f.FARID))
+	}
+	for _, v := range f.URRIDs {
+		ies = append(ies, NewURRID(v))
+	}
+	for _, v := range f.QERIDs {
+		ies = append(ies, NewQERID(v))
+	}
+	for _, v := range f.ActivatePredefinedRules {
+		ies = append(ies, NewActivatePredefinedRules(v))
+	}
+	if f.ActivationTime != nil {
+		ies = append(ies, NewActivationTime(*f.ActivationTime))
+	}
+	if f.DeactivationTime != nil {
+		ies = append(ies, NewDeactivationTime(*f.DeactivationTime))
+	}
+	if f.MARID != nil {
+		ies = append(ies, NewMARID(*f.MARID))
+	}
+	if f.PacketReplicationAndDetectionCarryOnInformation != nil {
+		ies = append(ies, NewPacketReplicationAndDetectionCarryOnInformation(*f.PacketReplicationAndDetectionCarryOnInformation))
+	}
+	for _, v := range f.IPMulticastAddressingInfos {
+		ies = append(ies, v.ToIE())
+	}
+	for _, v := range f.UEIPAddressPoolIdentities {
+		ies = append(ies, New(UEIPAddressPoolIdentity, v))
+	}
+	if f.MPTCPApplicableIndication != nil {
+		ies = append(ies, NewMPTCPApplicableIndication(*f.MPTCPApplicableIndication))
+	}
+	if f.TransportDelayReporting != nil {
+		ies = append(ies, f.TransportDelayReporting.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreatePDR, 0, ies...)
+}
+
+// PDIFields represents the fields in PDI IE.
+type PDIFields struct {
+	SourceInterface                 uint8
+	FTEID                           *FTEIDFields
+	NetworkInstance                 *string
+	RedundantTransmissionParameters *RedundantTransmissionParametersFields
+	UEIPAddresses                   []*UEIPAddressFields
+	TrafficEndpointIDs              []uint8
+	SDFFilters                      []*SDFFilterFields
+	ApplicationID                   *string
+	EthernetPDUSessionInformation   *uint8
+	EthernetPacketFilters           []*EthernetPacketFilterFields
+	QFIs                            []uint8
+	FramedRoutes                    []string
+	FramedRouting                   *uint32
+	FramedIPv6Routes                []string
+	SourceInterfaceType             *uint8
+	IPMulticastAddressingInfos      []*IPMulticastAddressingInfoFields
+	DataNetworkAccessIdentifier     *string
+	AreaSessionID                   *uint16
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParsePDIFields parses b into PDIFields.
+func ParsePDIFields(b []byte) (*PDIFields, error) {
+	f := &PDIFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into PDIFields.
+func (f *PDIFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *PDIFields) unmarshalIEs(ies []*IE) error {
+	var hasSourceInterface bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SourceInterface:
+			v, err := i.SourceInterface()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.SourceInterface = v
+			hasSourceInterface = true
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.FTEID = v
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case RedundantTransmissionParameters:
+			v := &RedundantTransmissionParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.RedundantTransmissionParameters = v
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.UEIPAddresses = append(f.UEIPAddresses, v)
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.TrafficEndpointIDs = append(f.TrafficEndpointIDs, v)
+		case SDFFilter:
+			v, err := i.SDFFilter()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.SDFFilters = append(f.SDFFilters, v)
+		case ApplicationID:
+			v, err := i.ApplicationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.ApplicationID = &v
+		case EthernetPDUSessionInformation:
+			v, err := i.EthernetPDUSessionInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.EthernetPDUSessionInformation = &v
+		case EthernetPacketFilter:
+			v := &EthernetPacketFilterFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.EthernetPacketFilters = append(f.EthernetPacketFilters, v)
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.QFIs = append(f.QFIs, v)
+		case FramedRoute:
+			v, err := i.FramedRoute()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.FramedRoutes = append(f.FramedRoutes, v)
+		case FramedRouting:
+			v, err := i.FramedRouting()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.FramedRouting = &v
+		case FramedIPv6Route:
+			v, err := i.FramedIPv6Route()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.FramedIPv6Routes = append(f.FramedIPv6Routes, v)
+		case TGPPInterfaceType:
+			v, err := i.TGPPInterfaceType()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.SourceInterfaceType = &v
+		case IPMulticastAddressingInfo:
+			v := &IPMulticastAddressingInfoFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.IPMulticastAddressingInfos = append(f.IPMulticastAddressingInfos, v)
+		case DataNetworkAccessIdentifier:
+			v, err := i.DataNetworkAccessIdentifier()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.DataNetworkAccessIdentifier = &v
+		case AreaSessionID:
+			v, err := i.AreaSessionID()
+			if err != nil {
+				return &GroupedFieldError{Parent: PDI, Type: i.Type, Err: err}
+			}
+			f.AreaSessionID = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSourceInterface {
+		return &GroupedFieldError{Parent: PDI, Type: SourceInterface, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns PDI IE built from the fields.
+func (f *PDIFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSourceInterface(f.SourceInterface))
+	if f.FTEID != nil {
+		ies = append(ies, newFieldsIE(FTEID, f.FTEID))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	if f.RedundantTransmissionParameters != nil {
+		ies = append(ies, f.RedundantTransmissionParameters.ToIE())
+	}
+	for _, v := range f.UEIPAddresses {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	for _, v := range f.TrafficEndpointIDs {
+		ies = append(ies, NewTrafficEndpointID(v))
+	}
+	for _, v := range f.SDFFilters {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+	if f.ApplicationID != nil {
+		ies = append(ies, NewApplicationID(*f.ApplicationID))
+	}
+	if f.EthernetPDUSessionInformation != nil {
+		ies = append(ies, NewEthernetPDUSessionInformation(*f.EthernetPDUSessionInformation))
+	}
+	for _, v := range f.EthernetPacketFilters {
+		ies = append(ies, v.ToIE())
+	}
+	for _, v := range f.QFIs {
+		ies = append(ies, NewQFI(v))
+	}
+	for _, v := range f.FramedRoutes {
+		ies = append(ies, NewFramedRoute(v))
+	}
+	if f.FramedRouting != nil {
+		ies = append(ies, NewFramedRouting(*f.FramedRouting))
+	}
+	for _, v := range f.FramedIPv6Routes {
+		ies = append(ies, NewFramedIPv6Route(v))
+	}
+	if f.SourceInterfaceType != nil {
+		ies = append(ies, NewTGPPInterfaceType(*f.SourceInterfaceType))
+	}
+	for _, v := range f.IPMulticastAddressingInfos {
+		ies = append(ies, v.ToIE())
+	}
+	if f.DataNetworkAccessIdentifier != nil {
+		ies = append(ies, NewDataNetworkAccessIdentifier(*f.DataNetworkAccessIdentifier))
+	}
+	if f.AreaSessionID != nil {
+		ies = append(ies, NewAreaSessionID(*f.AreaSessionID))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(PDI, 0, ies...)
+}
+
+// CreateFARFields represents the fields in CreateFAR IE.
+type CreateFARFields struct {
+	FARID                                     uint32
+	ApplyAction                               []byte
+	ForwardingParameters                      *ForwardingParametersFields
+	DuplicatingParameters                     []*DuplicatingParametersFields
+	BARID                                     *uint8
+	RedundantTransmissionForwardingParameters *RedundantTransmissionForwardingParametersFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateFARFields parses b into CreateFARFields.
+func ParseCreateFARFields(b []byte) (*CreateFARFields, error) {
+	f := &CreateFARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateFARFields.
+func (f *CreateFARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateFARFields) unmarshalIEs(ies []*IE) error {
+	var hasFARID bool
+	var hasApplyAction bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateFAR, Type: i.Type, Err: err}
+			}
+			f.FARID = v
+			hasFARID = true
+		case ApplyAction:
+			v, err := i.ApplyAction()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateFAR, Type: i.Type, Err: err}
+			}
+			f.ApplyAction = v
+			hasApplyAction = true
+		case ForwardingParameters:
+			v := &ForwardingParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateFAR, Type: i.Type, Err: err}
+			}
+			f.ForwardingParameters = v
+		case DuplicatingParameters:
+			v := &DuplicatingParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateFAR, Type: i.Type, Err: err}
+			}
+			f.DuplicatingParameters = append(f.DuplicatingParameters, v)
+		case BARID:
+			v, err := i.BARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateFAR, Type: i.Type, Err: err}
+			}
+			f.BARID = &v
+		case RedundantTransmissionForwardingParameters:
+			v := &RedundantTransmissionForwardingParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateFAR, Type: i.Type, Err: err}
+			}
+			f.RedundantTransmissionForwardingParameters = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasFARID {
+		return &GroupedFieldError{Parent: CreateFAR, Type: FARID, Err: ErrIENotFound}
+	}
+	if !hasApplyAction {
+		return &GroupedFieldError{Parent: CreateFAR, Type: ApplyAction, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateFAR IE built from the fields.
+func (f *CreateFARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewFARID(f.FARID))
+	if f.ApplyAction != nil {
+		ies = append(ies, New(ApplyAction, f.ApplyAction))
+	}
+	if f.ForwardingParameters != nil {
+		ies = append(ies, f.ForwardingParameters.ToIE())
+	}
+	for _, v := range f.DuplicatingParameters {
+		ies = append(ies, v.ToIE())
+	}
+	if f.BARID != nil {
+		ies = append(ies, NewBARID(*f.BARID))
+	}
+	if f.RedundantTransmissionForwardingParameters != nil {
+		ies = append(ies, f.RedundantTransmissionForwardingParameters.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateFAR, 0, ies...)
+}
+
+// ForwardingParametersFields represents the fields in ForwardingParameters IE.
+type ForwardingParametersFields struct {
+	DestinationInterface        uint8
+	NetworkInstance             *string
+	RedirectInformation         *RedirectInformationFields
+	OuterHeaderCreation         *OuterHeaderCreationFields
+	TransportLevelMarking       *uint16
+	ForwardingPolicy            []byte
+	HeaderEnrichment            *HeaderEnrichmentFields
+	LinkedTrafficEndpointID     *uint8
+	Proxying                    *uint8
+	DestinationInterfaceType    *uint8
+	DataNetworkAccessIdentifier *string
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseForwardingParametersFields parses b into ForwardingParametersFields.
+func ParseForwardingParametersFields(b []byte) (*ForwardingParametersFields, error) {
+	f := &ForwardingParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ForwardingParametersFields.
+func (f *ForwardingParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ForwardingParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasDestinationInterface bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case DestinationInterface:
+			v, err := i.DestinationInterface()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.DestinationInterface = v
+			hasDestinationInterface = true
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case RedirectInformation:
+			v, err := i.RedirectInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.RedirectInformation = v
+		case OuterHeaderCreation:
+			v, err := i.OuterHeaderCreation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderCreation = v
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		case ForwardingPolicy:
+			v, err := i.ForwardingPolicy()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.ForwardingPolicy = v
+		case HeaderEnrichment:
+			v, err := i.HeaderEnrichment()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.HeaderEnrichment = v
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.LinkedTrafficEndpointID = &v
+		case Proxying:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.Proxying = &v
+		case TGPPInterfaceType:
+			v, err := i.TGPPInterfaceType()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.DestinationInterfaceType = &v
+		case DataNetworkAccessIdentifier:
+			v, err := i.DataNetworkAccessIdentifier()
+			if err != nil {
+				return &GroupedFieldError{Parent: ForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.DataNetworkAccessIdentifier = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasDestinationInterface {
+		return &GroupedFieldError{Parent: ForwardingParameters, Type: DestinationInterface, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ForwardingParameters IE built from the fields.
+func (f *ForwardingParametersFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewDestinationInterface(f.DestinationInterface))
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	if f.RedirectInformation != nil {
+		ies = append(ies, newFieldsIE(RedirectInformation, f.RedirectInformation))
+	}
+	if f.OuterHeaderCreation != nil {
+		ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	}
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	if f.ForwardingPolicy != nil {
+		ies = append(ies, New(ForwardingPolicy, f.ForwardingPolicy))
+	}
+	if f.HeaderEnrichment != nil {
+		ies = append(ies, newFieldsIE(HeaderEnrichment, f.HeaderEnrichment))
+	}
+	if f.LinkedTrafficEndpointID != nil {
+		ies = append(ies, NewTrafficEndpointID(*f.LinkedTrafficEndpointID))
+	}
+	if f.Proxying != nil {
+		ies = append(ies, newUint8ValIE(Proxying, *f.Proxying))
+	}
+	if f.DestinationInterfaceType != nil {
+		ies = append(ies, NewTGPPInterfaceType(*f.DestinationInterfaceType))
+	}
+	if f.DataNetworkAccessIdentifier != nil {
+		ies = append(ies, NewDataNetworkAccessIdentifier(*f.DataNetworkAccessIdentifier))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ForwardingParameters, 0, ies...)
+}
+
+// DuplicatingParametersFields represents the fields in DuplicatingParameters IE.
+type DuplicatingParametersFields struct {
+	DestinationInterface  uint8
+	OuterHeaderCreation   *OuterHeaderCreationFields
+	TransportLevelMarking *uint16
+	ForwardingPolicy      []byte
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseDuplicatingParametersFields parses b into DuplicatingParametersFields.
+func ParseDuplicatingParametersFields(b []byte) (*DuplicatingParametersFields, error) {
+	f := &DuplicatingParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into DuplicatingParametersFields.
+func (f *DuplicatingParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *DuplicatingParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasDestinationInterface bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case DestinationInterface:
+			v, err := i.DestinationInterface()
+			if err != nil {
+				return &GroupedFieldError{Parent: DuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.DestinationInterface = v
+			hasDestinationInterface = true
+		case OuterHeaderCreation:
+			v, err := i.OuterHeaderCreation()
+			if err != nil {
+				return &GroupedFieldError{Parent: DuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderCreation = v
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: DuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		case ForwardingPolicy:
+			v, err := i.ForwardingPolicy()
+			if err != nil {
+				return &GroupedFieldError{Parent: DuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.ForwardingPolicy = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasDestinationInterface {
+		return &GroupedFieldError{Parent: DuplicatingParameters, Type: DestinationInterface, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns DuplicatingParameters IE built from the fields.
+func (f *DuplicatingParametersFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewDestinationInterface(f.DestinationInterface))
+	if f.OuterHeaderCreation != nil {
+		ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	}
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	if f.ForwardingPolicy != nil {
+		ies = append(ies, New(ForwardingPolicy, f.ForwardingPolicy))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(DuplicatingParameters, 0, ies...)
+}
+
+// CreateURRFields represents the fields in CreateURR IE.
+type CreateURRFields struct {
+	URRID                     uint32
+	MeasurementMethod         uint8
+	ReportingTriggers         []byte
+	MeasurementPeriod         *time.Duration
+	VolumeThreshold           *VolumeThresholdFields
+	VolumeQuota               *VolumeQuotaFields
+	EventThreshold            *uint32
+	EventQuota                *uint32
+	TimeThreshold             *time.Duration
+	TimeQuota                 *time.Duration
+	QuotaHoldingTime          *time.Duration
+	DroppedDLTrafficThreshold []byte
+	QuotaValidityTime         *time.Duration
+	MonitoringTime            *time.Time
+	SubsequentVolumeThreshold *SubsequentVolumeThresholdFields
+	SubsequentTimeThreshold   *time.Duration
+	SubsequentVolumeQuota     *SubsequentVolumeQuotaFields
+	SubsequentTimeQuota       *time.Duration
+	SubsequentEventThreshold  *uint32
+	SubsequentEventQuota      *uint32
+	InactivityDetectionTime   *uint32
+	LinkedURRIDs              []uint32
+	MeasurementInformation    *uint8
+	TimeQuotaMechanism        []byte
+	AggregatedURRs            []*AggregatedURRsFields
+	FARIDForQuotaAction       *uint32
+	EthernetInactivityTimer   *time.Duration
+	AdditionalMonitoringTimes []*AdditionalMonitoringTimeFields
+	NumberOfReports           *uint16
+	ExemptedApplicationIDs    []string
+	ExemptedSDFFilters        []*SDFFilterFields
+	UserPlaneInactivityTimer  *time.Duration
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateURRFields parses b into CreateURRFields.
+func ParseCreateURRFields(b []byte) (*CreateURRFields, error) {
+	f := &CreateURRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateURRFields.
+func (f *CreateURRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateURRFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	var hasMeasurementMethod bool
+	var hasReportingTriggers bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		case MeasurementMethod:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.MeasurementMethod = v
+			hasMeasurementMethod = true
+		case ReportingTriggers:
+			v, err := i.ReportingTriggers()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.ReportingTriggers = v
+			hasReportingTriggers = true
+		case MeasurementPeriod:
+			v, err := i.MeasurementPeriod()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.MeasurementPeriod = &v
+		case VolumeThreshold:
+			v, err := i.VolumeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.VolumeThreshold = v
+		case VolumeQuota:
+			v, err := i.VolumeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.VolumeQuota = v
+		case EventThreshold:
+			v, err := i.EventThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.EventThreshold = &v
+		case EventQuota:
+			v, err := i.EventQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.EventQuota = &v
+		case TimeThreshold:
+			v, err := i.TimeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.TimeThreshold = &v
+		case TimeQuota:
+			v, err := i.TimeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.TimeQuota = &v
+		case QuotaHoldingTime:
+			v, err := i.QuotaHoldingTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.QuotaHoldingTime = &v
+		case DroppedDLTrafficThreshold:
+			v, err := i.valueAsBytes()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.DroppedDLTrafficThreshold = v
+		case QuotaValidityTime:
+			v, err := i.QuotaValidityTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.QuotaValidityTime = &v
+		case MonitoringTime:
+			v, err := i.MonitoringTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.MonitoringTime = &v
+		case SubsequentVolumeThreshold:
+			v, err := i.SubsequentVolumeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentVolumeThreshold = v
+		case SubsequentTimeThreshold:
+			v, err := i.SubsequentTimeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentTimeThreshold = &v
+		case SubsequentVolumeQuota:
+			v, err := i.SubsequentVolumeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentVolumeQuota = v
+		case SubsequentTimeQuota:
+			v, err := i.SubsequentTimeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentTimeQuota = &v
+		case SubsequentEventThreshold:
+			v, err := i.SubsequentEventThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentEventThreshold = &v
+		case SubsequentEventQuota:
+			v, err := i.SubsequentEventQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentEventQuota = &v
+		case InactivityDetectionTime:
+			v, err := i.InactivityDetectionTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.InactivityDetectionTime = &v
+		case LinkedURRID:
+			v, err := i.LinkedURRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.LinkedURRIDs = append(f.LinkedURRIDs, v)
+		case MeasurementInformation:
+			v, err := i.MeasurementInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.MeasurementInformation = &v
+		case TimeQuotaMechanism:
+			v, err := i.TimeQuotaMechanism()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.TimeQuotaMechanism = v
+		case AggregatedURRs:
+			v := &AggregatedURRsFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.AggregatedURRs = append(f.AggregatedURRs, v)
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.FARIDForQuotaAction = &v
+		case EthernetInactivityTimer:
+			v, err := i.EthernetInactivityTimer()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.EthernetInactivityTimer = &v
+		case AdditionalMonitoringTime:
+			v := &AdditionalMonitoringTimeFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.AdditionalMonitoringTimes = append(f.AdditionalMonitoringTimes, v)
+		case NumberOfReports:
+			v, err := i.NumberOfReports()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.NumberOfReports = &v
+		case ApplicationID:
+			v, err := i.ApplicationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.ExemptedApplicationIDs = append(f.ExemptedApplicationIDs, v)
+		case SDFFilter:
+			v, err := i.SDFFilter()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.ExemptedSDFFilters = append(f.ExemptedSDFFilters, v)
+		case UserPlaneInactivityTimer:
+			v, err := i.UserPlaneInactivityTimer()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateURR, Type: i.Type, Err: err}
+			}
+			f.UserPlaneInactivityTimer = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: CreateURR, Type: URRID, Err: ErrIENotFound}
+	}
+	if !hasMeasurementMethod {
+		return &GroupedFieldError{Parent: CreateURR, Type: MeasurementMethod, Err: ErrIENotFound}
+	}
+	if !hasReportingTriggers {
+		return &GroupedFieldError{Parent: CreateURR, Type: ReportingTriggers, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateURR IE built from the fields.
+func (f *CreateURRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	ies = append(ies, newUint8ValIE(MeasurementMethod, f.MeasurementMethod))
+	if f.ReportingTriggers != nil {
+		ies = append(ies, New(ReportingTriggers, f.ReportingTriggers))
+	}
+	if f.MeasurementPeriod != nil {
+		ies = append(ies, NewMeasurementPeriod(*f.MeasurementPeriod))
+	}
+	if f.VolumeThreshold != nil {
+		ies = append(ies, newFieldsIE(VolumeThreshold, f.VolumeThreshold))
+	}
+	if f.VolumeQuota != nil {
+		ies = append(ies, newFieldsIE(VolumeQuota, f.VolumeQuota))
+	}
+	if f.EventThreshold != nil {
+		ies = append(ies, NewEventThreshold(*f.EventThreshold))
+	}
+	if f.EventQuota != nil {
+		ies = append(ies, NewEventQuota(*f.EventQuota))
+	}
+	if f.TimeThreshold != nil {
+		ies = append(ies, NewTimeThreshold(*f.TimeThreshold))
+	}
+	if f.TimeQuota != nil {
+		ies = append(ies, NewTimeQuota(*f.TimeQuota))
+	}
+	if f.QuotaHoldingTime != nil {
+		ies = append(ies, NewQuotaHoldingTime(*f.QuotaHoldingTime))
+	}
+	if f.DroppedDLTrafficThreshold != nil {
+		ies = append(ies, New(DroppedDLTrafficThreshold, f.DroppedDLTrafficThreshold))
+	}
+	if f.QuotaValidityTime != nil {
+		ies = append(ies, NewQuotaValidityTime(*f.QuotaValidityTime))
+	}
+	if f.MonitoringTime != nil {
+		ies = append(ies, NewMonitoringTime(*f.MonitoringTime))
+	}
+	if f.SubsequentVolumeThreshold != nil {
+		ies = append(ies, newFieldsIE(SubsequentVolumeThreshold, f.SubsequentVolumeThreshold))
+	}
+	if f.SubsequentTimeThreshold != nil {
+		ies = append(ies, NewSubsequentTimeThreshold(*f.SubsequentTimeThreshold))
+	}
+	if f.SubsequentVolumeQuota != nil {
+		ies = append(ies, newFieldsIE(SubsequentVolumeQuota, f.SubsequentVolumeQuota))
+	}
+	if f.SubsequentTimeQuota != nil {
+		ies = append(ies, NewSubsequentTimeQuota(*f.SubsequentTimeQuota))
+	}
+	if f.SubsequentEventThreshold != nil {
+		ies = append(ies, NewSubsequentEventThreshold(*f.SubsequentEventThreshold))
+	}
+	if f.SubsequentEventQuota != nil {
+		ies = append(ies, NewSubsequentEventQuota(*f.SubsequentEventQuota))
+	}
+	if f.InactivityDetectionTime != nil {
+		ies = append(ies, NewInactivityDetectionTime(*f.InactivityDetectionTime))
+	}
+	for _, v := range f.LinkedURRIDs {
+		ies = append(ies, NewLinkedURRID(v))
+	}
+	if f.MeasurementInformation != nil {
+		ies = append(ies, NewMeasurementInformation(*f.MeasurementInformation))
+	}
+	if f.TimeQuotaMechanism != nil {
+		ies = append(ies, New(TimeQuotaMechanism, f.TimeQuotaMechanism))
+	}
+	for _, v := range f.AggregatedURRs {
+		ies = append(ies, v.ToIE())
+	}
+	if f.FARIDForQuotaAction != nil {
+		ies = append(ies, NewFARID(*f.FARIDForQuotaAction))
+	}
+	if f.EthernetInactivityTimer != nil {
+		ies = append(ies, NewEthernetInactivityTimer(*f.EthernetInactivityTimer))
+	}
+	for _, v := range f.AdditionalMonitoringTimes {
+		ies = append(ies, v.ToIE())
+	}
+	if f.NumberOfReports != nil {
+		ies = append(ies, NewNumberOfReports(*f.NumberOfReports))
+	}
+	for _, v := range f.ExemptedApplicationIDs {
+		ies = append(ies, NewApplicationID(v))
+	}
+	for _, v := range f.ExemptedSDFFilters {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+	if f.UserPlaneInactivityTimer != nil {
+		ies = append(ies, NewUserPlaneInactivityTimer(*f.UserPlaneInactivityTimer))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateURR, 0, ies...)
+}
+
+// CreateQERFields represents the fields in CreateQER IE.
+type CreateQERFields struct {
+	QERID                 uint32
+	QERCorrelationID      *uint32
+	GateStatus            uint8
+	MBR                   []byte
+	GBR                   []byte
+	PacketRates           []*PacketRateFields
+	PacketRateStatus      *PacketRateStatusFields
+	DLFlowLevelMarking    *DLFlowLevelMarkingFields
+	QFI                   *uint8
+	RQI                   *uint8
+	PagingPolicyIndicator *uint8
+	AveragingWindow       *uint32
+	QERControlIndications *uint8
+	QERIndications        *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateQERFields parses b into CreateQERFields.
+func ParseCreateQERFields(b []byte) (*CreateQERFields, error) {
+	f := &CreateQERFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateQERFields.
+func (f *CreateQERFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateQERFields) unmarshalIEs(ies []*IE) error {
+	var hasQERID bool
+	var hasGateStatus bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.QERID = v
+			hasQERID = true
+		case QERCorrelationID:
+			v, err := i.QERCorrelationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.QERCorrelationID = &v
+		case GateStatus:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.GateStatus = v
+			hasGateStatus = true
+		case MBR:
+			v, err := i.MBR()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.MBR = v
+		case GBR:
+			v, err := i.GBR()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.GBR = v
+		case PacketRate:
+			v, err := i.PacketRate()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.PacketRates = append(f.PacketRates, v)
+		case PacketRateStatus:
+			v, err := i.PacketRateStatus()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.PacketRateStatus = v
+		case DLFlowLevelMarking:
+			v, err := i.DLFlowLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.DLFlowLevelMarking = v
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.QFI = &v
+		case RQI:
+			v, err := i.RQI()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.RQI = &v
+		case PagingPolicyIndicator:
+			v, err := i.PagingPolicyIndicator()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.PagingPolicyIndicator = &v
+		case AveragingWindow:
+			v, err := i.AveragingWindow()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.AveragingWindow = &v
+		case QERControlIndications:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.QERControlIndications = &v
+		case QERIndications:
+			v, err := i.QERIndications()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateQER, Type: i.Type, Err: err}
+			}
+			f.QERIndications = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQERID {
+		return &GroupedFieldError{Parent: CreateQER, Type: QERID, Err: ErrIENotFound}
+	}
+	if !hasGateStatus {
+		return &GroupedFieldError{Parent: CreateQER, Type: GateStatus, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateQER IE built from the fields.
+func (f *CreateQERFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQERID(f.QERID))
+	if f.QERCorrelationID != nil {
+		ies = append(ies, NewQERCorrelationID(*f.QERCorrelationID))
+	}
+	ies = append(ies, newUint8ValIE(GateStatus, f.GateStatus))
+	if f.MBR != nil {
+		ies = append(ies, New(MBR, f.MBR))
+	}
+	if f.GBR != nil {
+		ies = append(ies, New(GBR, f.GBR))
+	}
+	for _, v := range f.PacketRates {
+		ies = append(ies, newFieldsIE(PacketRate, v))
+	}
+	if f.PacketRateStatus != nil {
+		ies = append(ies, newFieldsIE(PacketRateStatus, f.PacketRateStatus))
+	}
+	if f.DLFlowLevelMarking != nil {
+		ies = append(ies, newFieldsIE(DLFlowLevelMarking, f.DLFlowLevelMarking))
+	}
+	if f.QFI != nil {
+		ies = append(ies, NewQFI(*f.QFI))
+	}
+	if f.RQI != nil {
+		ies = append(ies, NewRQI(*f.RQI))
+	}
+	if f.PagingPolicyIndicator != nil {
+		ies = append(ies, NewPagingPolicyIndicator(*f.PagingPolicyIndicator))
+	}
+	if f.AveragingWindow != nil {
+		ies = append(ies, NewAveragingWindow(*f.AveragingWindow))
+	}
+	if f.QERControlIndications != nil {
+		ies = append(ies, newUint8ValIE(QERControlIndications, *f.QERControlIndications))
+	}
+	if f.QERIndications != nil {
+		ies = append(ies, NewQERIndications(*f.QERIndications))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateQER, 0, ies...)
+}
+
+// CreatedPDRFields represents the fields in CreatedPDR IE.
+type CreatedPDRFields struct {
+	PDRID         uint16
+	LocalFTEIDs   []*FTEIDFields
+	UEIPAddresses []*UEIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreatedPDRFields parses b into CreatedPDRFields.
+func ParseCreatedPDRFields(b []byte) (*CreatedPDRFields, error) {
+	f := &CreatedPDRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreatedPDRFields.
+func (f *CreatedPDRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreatedPDRFields) unmarshalIEs(ies []*IE) error {
+	var hasPDRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedPDR, Type: i.Type, Err: err}
+			}
+			f.PDRID = v
+			hasPDRID = true
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedPDR, Type: i.Type, Err: err}
+			}
+			f.LocalFTEIDs = append(f.LocalFTEIDs, v)
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedPDR, Type: i.Type, Err: err}
+			}
+			f.UEIPAddresses = append(f.UEIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPDRID {
+		return &GroupedFieldError{Parent: CreatedPDR, Type: PDRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreatedPDR IE built from the fields.
+func (f *CreatedPDRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewPDRID(f.PDRID))
+	for _, v := range f.LocalFTEIDs {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	for _, v := range f.UEIPAddresses {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreatedPDR, 0, ies...)
+}
+
+// UpdatePDRFields represents the fields in UpdatePDR IE.
+type UpdatePDRFields struct {
+	PDRID                      uint16
+	OuterHeaderRemoval         []byte
+	Precedence                 *uint32
+	PDI                        *PDIFields
+	FARID                      *uint32
+	URRIDs                     []uint32
+	QERIDs                     []uint32
+	ActivatePredefinedRules    []string
+	DeactivatePredefinedRules  []string
+	ActivationTime             *time.Time
+	DeactivationTime           *time.Time
+	IPMulticastAddressingInfos []*IPMulticastAddressingInfoFields
+	TransportDelayReporting    *TransportDelayReportingFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdatePDRFields parses b into UpdatePDRFields.
+func ParseUpdatePDRFields(b []byte) (*UpdatePDRFields, error) {
+	f := &UpdatePDRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdatePDRFields.
+func (f *UpdatePDRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdatePDRFields) unmarshalIEs(ies []*IE) error {
+	var hasPDRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.PDRID = v
+			hasPDRID = true
+		case OuterHeaderRemoval:
+			v, err := i.OuterHeaderRemoval()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderRemoval = v
+		case Precedence:
+			v, err := i.Precedence()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.Precedence = &v
+		case PDI:
+			v := &PDIFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.PDI = v
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.FARID = &v
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.URRIDs = append(f.URRIDs, v)
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.QERIDs = append(f.QERIDs, v)
+		case ActivatePredefinedRules:
+			v, err := i.ActivatePredefinedRules()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.ActivatePredefinedRules = append(f.ActivatePredefinedRules, v)
+		case DeactivatePredefinedRules:
+			v, err := i.DeactivatePredefinedRules()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.DeactivatePredefinedRules = append(f.DeactivatePredefinedRules, v)
+		case ActivationTime:
+			v, err := i.ActivationTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.ActivationTime = &v
+		case DeactivationTime:
+			v, err := i.DeactivationTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.DeactivationTime = &v
+		case IPMulticastAddressingInfo:
+			v := &IPMulticastAddressingInfoFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.IPMulticastAddressingInfos = append(f.IPMulticastAddressingInfos, v)
+		case TransportDelayReporting:
+			v := &TransportDelayReportingFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdatePDR, Type: i.Type, Err: err}
+			}
+			f.TransportDelayReporting = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPDRID {
+		return &GroupedFieldError{Parent: UpdatePDR, Type: PDRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdatePDR IE built from the fields.
+func (f *UpdatePDRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewPDRID(f.PDRID))
+	if f.OuterHeaderRemoval != nil {
+		ies = append(ies, New(OuterHeaderRemoval, f.OuterHeaderRemoval))
+	}
+	if f.Precedence != nil {
+		ies = append(ies, NewPrecedence(*f.Precedence))
+	}
+	if f.PDI != nil {
+		ies = append(ies, f.PDI.ToIE())
+	}
+	if f.FARID != nil {
+		ies = append(ies, NewFARID(*f.FARID))
+	}
+	for _, v := range f.URRIDs {
+		ies = append(ies, NewURRID(v))
+	}
+	for _, v := range f.QERIDs {
+		ies = append(ies, NewQERID(v))
+	}
+	for _, v := range f.ActivatePredefinedRules {
+		ies = append(ies, NewActivatePredefinedRules(v))
+	}
+	for _, v := range f.DeactivatePredefinedRules {
+		ies = append(ies, NewDeactivatePredefinedRules(v))
+	}
+	if f.ActivationTime != nil {
+		ies = append(ies, NewActivationTime(*f.ActivationTime))
+	}
+	if f.DeactivationTime != nil {
+		ies = append(ies, NewDeactivationTime(*f.DeactivationTime))
+	}
+	for _, v := range f.IPMulticastAddressingInfos {
+		ies = append(ies, v.ToIE())
+	}
+	if f.TransportDelayReporting != nil {
+		ies = append(ies, f.TransportDelayReporting.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdatePDR, 0, ies...)
+}
+
+// UpdateFARFields represents the fields in UpdateFAR IE.
+type UpdateFARFields struct {
+	FARID                                     uint32
+	ApplyAction                               []byte
+	UpdateForwardingParameters                *UpdateForwardingParametersFields
+	UpdateDuplicatingParameters               []*UpdateDuplicatingParametersFields
+	RedundantTransmissionForwardingParameters *RedundantTransmissionForwardingParametersFields
+	BARID                                     *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateFARFields parses b into UpdateFARFields.
+func ParseUpdateFARFields(b []byte) (*UpdateFARFields, error) {
+	f := &UpdateFARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateFARFields.
+func (f *UpdateFARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateFARFields) unmarshalIEs(ies []*IE) error {
+	var hasFARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateFAR, Type: i.Type, Err: err}
+			}
+			f.FARID = v
+			hasFARID = true
+		case ApplyAction:
+			v, err := i.ApplyAction()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateFAR, Type: i.Type, Err: err}
+			}
+			f.ApplyAction = v
+		case UpdateForwardingParameters:
+			v := &UpdateForwardingParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateFAR, Type: i.Type, Err: err}
+			}
+			f.UpdateForwardingParameters = v
+		case UpdateDuplicatingParameters:
+			v := &UpdateDuplicatingParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateFAR, Type: i.Type, Err: err}
+			}
+			f.UpdateDuplicatingParameters = append(f.UpdateDuplicatingParameters, v)
+		case RedundantTransmissionForwardingParameters:
+			v := &RedundantTransmissionForwardingParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateFAR, Type: i.Type, Err: err}
+			}
+			f.RedundantTransmissionForwardingParameters = v
+		case BARID:
+			v, err := i.BARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateFAR, Type: i.Type, Err: err}
+			}
+			f.BARID = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasFARID {
+		return &GroupedFieldError{Parent: UpdateFAR, Type: FARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateFAR IE built from the fields.
+func (f *UpdateFARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewFARID(f.FARID))
+	if f.ApplyAction != nil {
+		ies = append(ies, New(ApplyAction, f.ApplyAction))
+	}
+	if f.UpdateForwardingParameters != nil {
+		ies = append(ies, f.UpdateForwardingParameters.ToIE())
+	}
+	for _, v := range f.UpdateDuplicatingParameters {
+		ies = append(ies, v.ToIE())
+	}
+	if f.RedundantTransmissionForwardingParameters != nil {
+		ies = append(ies, f.RedundantTransmissionForwardingParameters.ToIE())
+	}
+	if f.BARID != nil {
+		ies = append(ies, NewBARID(*f.BARID))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateFAR, 0, ies...)
+}
+
+// UpdateForwardingParametersFields represents the fields in UpdateForwardingParameters IE.
+type UpdateForwardingParametersFields struct {
+	DestinationInterface        *uint8
+	NetworkInstance             *string
+	RedirectInformation         *RedirectInformationFields
+	OuterHeaderCreation         *OuterHeaderCreationFields
+	TransportLevelMarking       *uint16
+	ForwardingPolicy            []byte
+	HeaderEnrichment            *HeaderEnrichmentFields
+	PFCPSMReqFlags              *uint8
+	LinkedTrafficEndpointID     *uint8
+	DestinationInterfaceType    *uint8
+	DataNetworkAccessIdentifier *string
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateForwardingParametersFields parses b into UpdateForwardingParametersFields.
+func ParseUpdateForwardingParametersFields(b []byte) (*UpdateForwardingParametersFields, error) {
+	f := &UpdateForwardingParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateForwardingParametersFields.
+func (f *UpdateForwardingParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateForwardingParametersFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case DestinationInterface:
+			v, err := i.DestinationInterface()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.DestinationInterface = &v
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case RedirectInformation:
+			v, err := i.RedirectInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.RedirectInformation = v
+		case OuterHeaderCreation:
+			v, err := i.OuterHeaderCreation()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderCreation = v
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		case ForwardingPolicy:
+			v, err := i.ForwardingPolicy()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.ForwardingPolicy = v
+		case HeaderEnrichment:
+			v, err := i.HeaderEnrichment()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.HeaderEnrichment = v
+		case PFCPSMReqFlags:
+			v, err := i.PFCPSMReqFlags()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.PFCPSMReqFlags = &v
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.LinkedTrafficEndpointID = &v
+		case TGPPInterfaceType:
+			v, err := i.TGPPInterfaceType()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.DestinationInterfaceType = &v
+		case DataNetworkAccessIdentifier:
+			v, err := i.DataNetworkAccessIdentifier()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.DataNetworkAccessIdentifier = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns UpdateForwardingParameters IE built from the fields.
+func (f *UpdateForwardingParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.DestinationInterface != nil {
+		ies = append(ies, NewDestinationInterface(*f.DestinationInterface))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	if f.RedirectInformation != nil {
+		ies = append(ies, newFieldsIE(RedirectInformation, f.RedirectInformation))
+	}
+	if f.OuterHeaderCreation != nil {
+		ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	}
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	if f.ForwardingPolicy != nil {
+		ies = append(ies, New(ForwardingPolicy, f.ForwardingPolicy))
+	}
+	if f.HeaderEnrichment != nil {
+		ies = append(ies, newFieldsIE(HeaderEnrichment, f.HeaderEnrichment))
+	}
+	if f.PFCPSMReqFlags != nil {
+		ies = append(ies, NewPFCPSMReqFlags(*f.PFCPSMReqFlags))
+	}
+	if f.LinkedTrafficEndpointID != nil {
+		ies = append(ies, NewTrafficEndpointID(*f.LinkedTrafficEndpointID))
+	}
+	if f.DestinationInterfaceType != nil {
+		ies = append(ies, NewTGPPInterfaceType(*f.DestinationInterfaceType))
+	}
+	if f.DataNetworkAccessIdentifier != nil {
+		ies = append(ies, NewDataNetworkAccessIdentifier(*f.DataNetworkAccessIdentifier))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateForwardingParameters, 0, ies...)
+}
+
+// UpdateBARWithinSessionReportResponseFields represents the fields in UpdateBARWithinSessionReportResponse IE.
+type UpdateBARWithinSessionReportResponseFields struct {
+	BARID                           uint8
+	DownlinkDataNotificationDelay   *time.Duration
+	DLBufferingDuration             *time.Duration
+	DLBufferingSuggestedPacketCount *uint16
+	SuggestedBufferingPacketsCount  *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateBARWithinSessionReportResponseFields parses b into UpdateBARWithinSessionReportResponseFields.
+func ParseUpdateBARWithinSessionReportResponseFields(b []byte) (*UpdateBARWithinSessionReportResponseFields, error) {
+	f := &UpdateBARWithinSessionReportResponseFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateBARWithinSessionReportResponseFields.
+func (f *UpdateBARWithinSessionReportResponseFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateBARWithinSessionReportResponseFields) unmarshalIEs(ies []*IE) error {
+	var hasBARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case BARID:
+			v, err := i.BARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionReportResponse, Type: i.Type, Err: err}
+			}
+			f.BARID = v
+			hasBARID = true
+		case DownlinkDataNotificationDelay:
+			v, err := i.DownlinkDataNotificationDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionReportResponse, Type: i.Type, Err: err}
+			}
+			f.DownlinkDataNotificationDelay = &v
+		case DLBufferingDuration:
+			v, err := i.DLBufferingDuration()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionReportResponse, Type: i.Type, Err: err}
+			}
+			f.DLBufferingDuration = &v
+		case DLBufferingSuggestedPacketCount:
+			v, err := i.DLBufferingSuggestedPacketCount()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionReportResponse, Type: i.Type, Err: err}
+			}
+			f.DLBufferingSuggestedPacketCount = &v
+		case SuggestedBufferingPacketsCount:
+			v, err := i.SuggestedBufferingPacketsCount()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionReportResponse, Type: i.Type, Err: err}
+			}
+			f.SuggestedBufferingPacketsCount = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasBARID {
+		return &GroupedFieldError{Parent: UpdateBARWithinSessionReportResponse, Type: BARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateBARWithinSessionReportResponse IE built from the fields.
+func (f *UpdateBARWithinSessionReportResponseFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewBARID(f.BARID))
+	if f.DownlinkDataNotificationDelay != nil {
+		ies = append(ies, NewDownlinkDataNotificationDelay(*f.DownlinkDataNotificationDelay))
+	}
+	if f.DLBufferingDuration != nil {
+		ies = append(ies, NewDLBufferingDuration(*f.DLBufferingDuration))
+	}
+	if f.DLBufferingSuggestedPacketCount != nil {
+		ies = append(ies, NewDLBufferingSuggestedPacketCount(*f.DLBufferingSuggestedPacketCount))
+	}
+	if f.SuggestedBufferingPacketsCount != nil {
+		ies = append(ies, NewSuggestedBufferingPacketsCount(*f.SuggestedBufferingPacketsCount))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateBARWithinSessionReportResponse, 0, ies...)
+}
+
+// UpdateURRFields represents the fields in UpdateURR IE.
+type UpdateURRFields struct {
+	URRID                     uint32
+	MeasurementMethod         *uint8
+	ReportingTriggers         []byte
+	MeasurementPeriod         *time.Duration
+	VolumeThreshold           *VolumeThresholdFields
+	VolumeQuota               *VolumeQuotaFields
+	TimeThreshold             *time.Duration
+	TimeQuota                 *time.Duration
+	EventThreshold            *uint32
+	EventQuota                *uint32
+	QuotaHoldingTime          *time.Duration
+	DroppedDLTrafficThreshold []byte
+	QuotaValidityTime         *time.Duration
+	MonitoringTime            *time.Time
+	SubsequentVolumeThreshold *SubsequentVolumeThresholdFields
+	SubsequentTimeThreshold   *time.Duration
+	SubsequentVolumeQuota     *SubsequentVolumeQuotaFields
+	SubsequentTimeQuota       *time.Duration
+	SubsequentEventThreshold  *uint32
+	SubsequentEventQuota      *uint32
+	InactivityDetectionTime   *uint32
+	LinkedURRIDs              []uint32
+	MeasurementInformation    *uint8
+	TimeQuotaMechanism        []byte
+	AggregatedURRs            []*AggregatedURRsFields
+	FARIDForQuotaAction       *uint32
+	EthernetInactivityTimer   *time.Duration
+	AdditionalMonitoringTimes []*AdditionalMonitoringTimeFields
+	NumberOfReports           *uint16
+	ExemptedApplicationIDs    []string
+	ExemptedSDFFilters        []*SDFFilterFields
+	UserPlaneInactivityTimer  *time.Duration
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateURRFields parses b into UpdateURRFields.
+func ParseUpdateURRFields(b []byte) (*UpdateURRFields, error) {
+	f := &UpdateURRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateURRFields.
+func (f *UpdateURRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateURRFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		case MeasurementMethod:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.MeasurementMethod = &v
+		case ReportingTriggers:
+			v, err := i.ReportingTriggers()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.ReportingTriggers = v
+		case MeasurementPeriod:
+			v, err := i.MeasurementPeriod()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.MeasurementPeriod = &v
+		case VolumeThreshold:
+			v, err := i.VolumeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.VolumeThreshold = v
+		case VolumeQuota:
+			v, err := i.VolumeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.VolumeQuota = v
+		case TimeThreshold:
+			v, err := i.TimeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.TimeThreshold = &v
+		case TimeQuota:
+			v, err := i.TimeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.TimeQuota = &v
+		case EventThreshold:
+			v, err := i.EventThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.EventThreshold = &v
+		case EventQuota:
+			v, err := i.EventQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.EventQuota = &v
+		case QuotaHoldingTime:
+			v, err := i.QuotaHoldingTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.QuotaHoldingTime = &v
+		case DroppedDLTrafficThreshold:
+			v, err := i.valueAsBytes()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.DroppedDLTrafficThreshold = v
+		case QuotaValidityTime:
+			v, err := i.QuotaValidityTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.QuotaValidityTime = &v
+		case MonitoringTime:
+			v, err := i.MonitoringTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.MonitoringTime = &v
+		case SubsequentVolumeThreshold:
+			v, err := i.SubsequentVolumeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentVolumeThreshold = v
+		case SubsequentTimeThreshold:
+			v, err := i.SubsequentTimeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentTimeThreshold = &v
+		case SubsequentVolumeQuota:
+			v, err := i.SubsequentVolumeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentVolumeQuota = v
+		case SubsequentTimeQuota:
+			v, err := i.SubsequentTimeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentTimeQuota = &v
+		case SubsequentEventThreshold:
+			v, err := i.SubsequentEventThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentEventThreshold = &v
+		case SubsequentEventQuota:
+			v, err := i.SubsequentEventQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.SubsequentEventQuota = &v
+		case InactivityDetectionTime:
+			v, err := i.InactivityDetectionTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.InactivityDetectionTime = &v
+		case LinkedURRID:
+			v, err := i.LinkedURRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.LinkedURRIDs = append(f.LinkedURRIDs, v)
+		case MeasurementInformation:
+			v, err := i.MeasurementInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.MeasurementInformation = &v
+		case TimeQuotaMechanism:
+			v, err := i.TimeQuotaMechanism()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.TimeQuotaMechanism = v
+		case AggregatedURRs:
+			v := &AggregatedURRsFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.AggregatedURRs = append(f.AggregatedURRs, v)
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.FARIDForQuotaAction = &v
+		case EthernetInactivityTimer:
+			v, err := i.EthernetInactivityTimer()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.EthernetInactivityTimer = &v
+		case AdditionalMonitoringTime:
+			v := &AdditionalMonitoringTimeFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.AdditionalMonitoringTimes = append(f.AdditionalMonitoringTimes, v)
+		case NumberOfReports:
+			v, err := i.NumberOfReports()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.NumberOfReports = &v
+		case ApplicationID:
+			v, err := i.ApplicationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.ExemptedApplicationIDs = append(f.ExemptedApplicationIDs, v)
+		case SDFFilter:
+			v, err := i.SDFFilter()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.ExemptedSDFFilters = append(f.ExemptedSDFFilters, v)
+		case UserPlaneInactivityTimer:
+			v, err := i.UserPlaneInactivityTimer()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateURR, Type: i.Type, Err: err}
+			}
+			f.UserPlaneInactivityTimer = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: UpdateURR, Type: URRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateURR IE built from the fields.
+func (f *UpdateURRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	if f.MeasurementMethod != nil {
+		ies = append(ies, newUint8ValIE(MeasurementMethod, *f.MeasurementMethod))
+	}
+	if f.ReportingTriggers != nil {
+		ies = append(ies, New(ReportingTriggers, f.ReportingTriggers))
+	}
+	if f.MeasurementPeriod != nil {
+		ies = append(ies, NewMeasurementPeriod(*f.MeasurementPeriod))
+	}
+	if f.VolumeThreshold != nil {
+		ies = append(ies, newFieldsIE(VolumeThreshold, f.VolumeThreshold))
+	}
+	if f.VolumeQuota != nil {
+		ies = append(ies, newFieldsIE(VolumeQuota, f.VolumeQuota))
+	}
+	if f.TimeThreshold != nil {
+		ies = append(ies, NewTimeThreshold(*f.TimeThreshold))
+	}
+	if f.TimeQuota != nil {
+		ies = append(ies, NewTimeQuota(*f.TimeQuota))
+	}
+	if f.EventThreshold != nil {
+		ies = append(ies, NewEventThreshold(*f.EventThreshold))
+	}
+	if f.EventQuota != nil {
+		ies = append(ies, NewEventQuota(*f.EventQuota))
+	}
+	if f.QuotaHoldingTime != nil {
+		ies = append(ies, NewQuotaHoldingTime(*f.QuotaHoldingTime))
+	}
+	if f.DroppedDLTrafficThreshold != nil {
+		ies = append(ies, New(DroppedDLTrafficThreshold, f.DroppedDLTrafficThreshold))
+	}
+	if f.QuotaValidityTime != nil {
+		ies = append(ies, NewQuotaValidityTime(*f.QuotaValidityTime))
+	}
+	if f.MonitoringTime != nil {
+		ies = append(ies, NewMonitoringTime(*f.MonitoringTime))
+	}
+	if f.SubsequentVolumeThreshold != nil {
+		ies = append(ies, newFieldsIE(SubsequentVolumeThreshold, f.SubsequentVolumeThreshold))
+	}
+	if f.SubsequentTimeThreshold != nil {
+		ies = append(ies, NewSubsequentTimeThreshold(*f.SubsequentTimeThreshold))
+	}
+	if f.SubsequentVolumeQuota != nil {
+		ies = append(ies, newFieldsIE(SubsequentVolumeQuota, f.SubsequentVolumeQuota))
+	}
+	if f.SubsequentTimeQuota != nil {
+		ies = append(ies, NewSubsequentTimeQuota(*f.SubsequentTimeQuota))
+	}
+	if f.SubsequentEventThreshold != nil {
+		ies = append(ies, NewSubsequentEventThreshold(*f.SubsequentEventThreshold))
+	}
+	if f.SubsequentEventQuota != nil {
+		ies = append(ies, NewSubsequentEventQuota(*f.SubsequentEventQuota))
+	}
+	if f.InactivityDetectionTime != nil {
+		ies = append(ies, NewInactivityDetectionTime(*f.InactivityDetectionTime))
+	}
+	for _, v := range f.LinkedURRIDs {
+		ies = append(ies, NewLinkedURRID(v))
+	}
+	if f.MeasurementInformation != nil {
+		ies = append(ies, NewMeasurementInformation(*f.MeasurementInformation))
+	}
+	if f.TimeQuotaMechanism != nil {
+		ies = append(ies, New(TimeQuotaMechanism, f.TimeQuotaMechanism))
+	}
+	for _, v := range f.AggregatedURRs {
+		ies = append(ies, v.ToIE())
+	}
+	if f.FARIDForQuotaAction != nil {
+		ies = append(ies, NewFARID(*f.FARIDForQuotaAction))
+	}
+	if f.EthernetInactivityTimer != nil {
+		ies = append(ies, NewEthernetInactivityTimer(*f.EthernetInactivityTimer))
+	}
+	for _, v := range f.AdditionalMonitoringTimes {
+		ies = append(ies, v.ToIE())
+	}
+	if f.NumberOfReports != nil {
+		ies = append(ies, NewNumberOfReports(*f.NumberOfReports))
+	}
+	for _, v := range f.ExemptedApplicationIDs {
+		ies = append(ies, NewApplicationID(v))
+	}
+	for _, v := range f.ExemptedSDFFilters {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+	if f.UserPlaneInactivityTimer != nil {
+		ies = append(ies, NewUserPlaneInactivityTimer(*f.UserPlaneInactivityTimer))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateURR, 0, ies...)
+}
+
+// UpdateQERFields represents the fields in UpdateQER IE.
+type UpdateQERFields struct {
+	QERID                 uint32
+	QERCorrelationID      *uint32
+	GateStatus            *uint8
+	MBR                   []byte
+	GBR                   []byte
+	PacketRates           []*PacketRateFields
+	DLFlowLevelMarking    *DLFlowLevelMarkingFields
+	QFI                   *uint8
+	RQI                   *uint8
+	PagingPolicyIndicator *uint8
+	AveragingWindow       *uint32
+	QERControlIndications *uint8
+	QERIndications        *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateQERFields parses b into UpdateQERFields.
+func ParseUpdateQERFields(b []byte) (*UpdateQERFields, error) {
+	f := &UpdateQERFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateQERFields.
+func (f *UpdateQERFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateQERFields) unmarshalIEs(ies []*IE) error {
+	var hasQERID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.QERID = v
+			hasQERID = true
+		case QERCorrelationID:
+			v, err := i.QERCorrelationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.QERCorrelationID = &v
+		case GateStatus:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.GateStatus = &v
+		case MBR:
+			v, err := i.MBR()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.MBR = v
+		case GBR:
+			v, err := i.GBR()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.GBR = v
+		case PacketRate:
+			v, err := i.PacketRate()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.PacketRates = append(f.PacketRates, v)
+		case DLFlowLevelMarking:
+			v, err := i.DLFlowLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.DLFlowLevelMarking = v
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.QFI = &v
+		case RQI:
+			v, err := i.RQI()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.RQI = &v
+		case PagingPolicyIndicator:
+			v, err := i.PagingPolicyIndicator()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.PagingPolicyIndicator = &v
+		case AveragingWindow:
+			v, err := i.AveragingWindow()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.AveragingWindow = &v
+		case QERControlIndications:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.QERControlIndications = &v
+		case QERIndications:
+			v, err := i.QERIndications()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateQER, Type: i.Type, Err: err}
+			}
+			f.QERIndications = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQERID {
+		return &GroupedFieldError{Parent: UpdateQER, Type: QERID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateQER IE built from the fields.
+func (f *UpdateQERFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQERID(f.QERID))
+	if f.QERCorrelationID != nil {
+		ies = append(ies, NewQERCorrelationID(*f.QERCorrelationID))
+	}
+	if f.GateStatus != nil {
+		ies = append(ies, newUint8ValIE(GateStatus, *f.GateStatus))
+	}
+	if f.MBR != nil {
+		ies = append(ies, New(MBR, f.MBR))
+	}
+	if f.GBR != nil {
+		ies = append(ies, New(GBR, f.GBR))
+	}
+	for _, v := range f.PacketRates {
+		ies = append(ies, newFieldsIE(PacketRate, v))
+	}
+	if f.DLFlowLevelMarking != nil {
+		ies = append(ies, newFieldsIE(DLFlowLevelMarking, f.DLFlowLevelMarking))
+	}
+	if f.QFI != nil {
+		ies = append(ies, NewQFI(*f.QFI))
+	}
+	if f.RQI != nil {
+		ies = append(ies, NewRQI(*f.RQI))
+	}
+	if f.PagingPolicyIndicator != nil {
+		ies = append(ies, NewPagingPolicyIndicator(*f.PagingPolicyIndicator))
+	}
+	if f.AveragingWindow != nil {
+		ies = append(ies, NewAveragingWindow(*f.AveragingWindow))
+	}
+	if f.QERControlIndications != nil {
+		ies = append(ies, newUint8ValIE(QERControlIndications, *f.QERControlIndications))
+	}
+	if f.QERIndications != nil {
+		ies = append(ies, NewQERIndications(*f.QERIndications))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateQER, 0, ies...)
+}
+
+// RemovePDRFields represents the fields in RemovePDR IE.
+type RemovePDRFields struct {
+	PDRID uint16
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemovePDRFields parses b into RemovePDRFields.
+func ParseRemovePDRFields(b []byte) (*RemovePDRFields, error) {
+	f := &RemovePDRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemovePDRFields.
+func (f *RemovePDRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemovePDRFields) unmarshalIEs(ies []*IE) error {
+	var hasPDRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemovePDR, Type: i.Type, Err: err}
+			}
+			f.PDRID = v
+			hasPDRID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPDRID {
+		return &GroupedFieldError{Parent: RemovePDR, Type: PDRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemovePDR IE built from the fields.
+func (f *RemovePDRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewPDRID(f.PDRID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemovePDR, 0, ies...)
+}
+
+// RemoveFARFields represents the fields in RemoveFAR IE.
+type RemoveFARFields struct {
+	FARID uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveFARFields parses b into RemoveFARFields.
+func ParseRemoveFARFields(b []byte) (*RemoveFARFields, error) {
+	f := &RemoveFARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveFARFields.
+func (f *RemoveFARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveFARFields) unmarshalIEs(ies []*IE) error {
+	var hasFARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveFAR, Type: i.Type, Err: err}
+			}
+			f.FARID = v
+			hasFARID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasFARID {
+		return &GroupedFieldError{Parent: RemoveFAR, Type: FARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveFAR IE built from the fields.
+func (f *RemoveFARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewFARID(f.FARID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveFAR, 0, ies...)
+}
+
+// RemoveURRFields represents the fields in RemoveURR IE.
+type RemoveURRFields struct {
+	URRID uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveURRFields parses b into RemoveURRFields.
+func ParseRemoveURRFields(b []byte) (*RemoveURRFields, error) {
+	f := &RemoveURRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveURRFields.
+func (f *RemoveURRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveURRFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveURR, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: RemoveURR, Type: URRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveURR IE built from the fields.
+func (f *RemoveURRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveURR, 0, ies...)
+}
+
+// RemoveQERFields represents the fields in RemoveQER IE.
+type RemoveQERFields struct {
+	QERID uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveQERFields parses b into RemoveQERFields.
+func ParseRemoveQERFields(b []byte) (*RemoveQERFields, error) {
+	f := &RemoveQERFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveQERFields.
+func (f *RemoveQERFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveQERFields) unmarshalIEs(ies []*IE) error {
+	var hasQERID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveQER, Type: i.Type, Err: err}
+			}
+			f.QERID = v
+			hasQERID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQERID {
+		return &GroupedFieldError{Parent: RemoveQER, Type: QERID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveQER IE built from the fields.
+func (f *RemoveQERFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQERID(f.QERID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveQER, 0, ies...)
+}
+
+// LoadControlInformationFields represents the fields in LoadControlInformation IE.
+type LoadControlInformationFields struct {
+	SequenceNumber uint32
+	Metric         uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseLoadControlInformationFields parses b into LoadControlInformationFields.
+func ParseLoadControlInformationFields(b []byte) (*LoadControlInformationFields, error) {
+	f := &LoadControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into LoadControlInformationFields.
+func (f *LoadControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *LoadControlInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasSequenceNumber bool
+	var hasMetric bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SequenceNumber:
+			v, err := i.SequenceNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: LoadControlInformation, Type: i.Type, Err: err}
+			}
+			f.SequenceNumber = v
+			hasSequenceNumber = true
+		case Metric:
+			v, err := i.Metric()
+			if err != nil {
+				return &GroupedFieldError{Parent: LoadControlInformation, Type: i.Type, Err: err}
+			}
+			f.Metric = v
+			hasMetric = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSequenceNumber {
+		return &GroupedFieldError{Parent: LoadControlInformation, Type: SequenceNumber, Err: ErrIENotFound}
+	}
+	if !hasMetric {
+		return &GroupedFieldError{Parent: LoadControlInformation, Type: Metric, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns LoadControlInformation IE built from the fields.
+func (f *LoadControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSequenceNumber(f.SequenceNumber))
+	ies = append(ies, NewMetric(f.Metric))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(LoadControlInformation, 0, ies...)
+}
+
+// OverloadControlInformationFields represents the fields in OverloadControlInformation IE.
+type OverloadControlInformationFields struct {
+	SequenceNumber uint32
+	Metric         uint8
+	Timer          time.Duration
+	OCIFlags       *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseOverloadControlInformationFields parses b into OverloadControlInformationFields.
+func ParseOverloadControlInformationFields(b []byte) (*OverloadControlInformationFields, error) {
+	f := &OverloadControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into OverloadControlInformationFields.
+func (f *OverloadControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *OverloadControlInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasSequenceNumber bool
+	var hasMetric bool
+	var hasTimer bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SequenceNumber:
+			v, err := i.SequenceNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: OverloadControlInformation, Type: i.Type, Err: err}
+			}
+			f.SequenceNumber = v
+			hasSequenceNumber = true
+		case Metric:
+			v, err := i.Metric()
+			if err != nil {
+				return &GroupedFieldError{Parent: OverloadControlInformation, Type: i.Type, Err: err}
+			}
+			f.Metric = v
+			hasMetric = true
+		case Timer:
+			v, err := i.Timer()
+			if err != nil {
+				return &GroupedFieldError{Parent: OverloadControlInformation, Type: i.Type, Err: err}
+			}
+			f.Timer = v
+			hasTimer = true
+		case OCIFlags:
+			v, err := i.OCIFlags()
+			if err != nil {
+				return &GroupedFieldError{Parent: OverloadControlInformation, Type: i.Type, Err: err}
+			}
+			f.OCIFlags = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSequenceNumber {
+		return &GroupedFieldError{Parent: OverloadControlInformation, Type: SequenceNumber, Err: ErrIENotFound}
+	}
+	if !hasMetric {
+		return &GroupedFieldError{Parent: OverloadControlInformation, Type: Metric, Err: ErrIENotFound}
+	}
+	if !hasTimer {
+		return &GroupedFieldError{Parent: OverloadControlInformation, Type: Timer, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns OverloadControlInformation IE built from the fields.
+func (f *OverloadControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSequenceNumber(f.SequenceNumber))
+	ies = append(ies, NewMetric(f.Metric))
+	ies = append(ies, NewTimer(f.Timer))
+	if f.OCIFlags != nil {
+		ies = append(ies, NewOCIFlags(*f.OCIFlags))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(OverloadControlInformation, 0, ies...)
+}
+
+// ApplicationIDsPFDsFields represents the fields in ApplicationIDsPFDs IE.
+type ApplicationIDsPFDsFields struct {
+	ApplicationID string
+	PFDContexts   []*PFDContextFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseApplicationIDsPFDsFields parses b into ApplicationIDsPFDsFields.
+func ParseApplicationIDsPFDsFields(b []byte) (*ApplicationIDsPFDsFields, error) {
+	f := &ApplicationIDsPFDsFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ApplicationIDsPFDsFields.
+func (f *ApplicationIDsPFDsFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ApplicationIDsPFDsFields) unmarshalIEs(ies []*IE) error {
+	var hasApplicationID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case ApplicationID:
+			v, err := i.ApplicationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: ApplicationIDsPFDs, Type: i.Type, Err: err}
+			}
+			f.ApplicationID = v
+			hasApplicationID = true
+		case PFDContext:
+			v := &PFDContextFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: ApplicationIDsPFDs, Type: i.Type, Err: err}
+			}
+			f.PFDContexts = append(f.PFDContexts, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasApplicationID {
+		return &GroupedFieldError{Parent: ApplicationIDsPFDs, Type: ApplicationID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ApplicationIDsPFDs IE built from the fields.
+func (f *ApplicationIDsPFDsFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewApplicationID(f.ApplicationID))
+	for _, v := range f.PFDContexts {
+		ies = append(ies, v.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ApplicationIDsPFDs, 0, ies...)
+}
+
+// PFDContextFields represents the fields in PFDContext IE.
+type PFDContextFields struct {
+	PFDContents []*PFDContentsFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParsePFDContextFields parses b into PFDContextFields.
+func ParsePFDContextFields(b []byte) (*PFDContextFields, error) {
+	f := &PFDContextFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into PFDContextFields.
+func (f *PFDContextFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *PFDContextFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PFDContents:
+			v, err := i.PFDContents()
+			if err != nil {
+				return &GroupedFieldError{Parent: PFDContext, Type: i.Type, Err: err}
+			}
+			f.PFDContents = append(f.PFDContents, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns PFDContext IE built from the fields.
+func (f *PFDContextFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.PFDContents {
+		ies = append(ies, newFieldsIE(PFDContents, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(PFDContext, 0, ies...)
+}
+
+// ApplicationDetectionInformationFields represents the fields in ApplicationDetectionInformation IE.
+type ApplicationDetectionInformationFields struct {
+	ApplicationID         string
+	ApplicationInstanceID *string
+	FlowInformation       []byte
+	PDRID                 *uint16
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseApplicationDetectionInformationFields parses b into ApplicationDetectionInformationFields.
+func ParseApplicationDetectionInformationFields(b []byte) (*ApplicationDetectionInformationFields, error) {
+	f := &ApplicationDetectionInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ApplicationDetectionInformationFields.
+func (f *ApplicationDetectionInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ApplicationDetectionInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasApplicationID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case ApplicationID:
+			v, err := i.ApplicationID()
+			if err != nil {
+				return &GroupedFieldError{Parent: ApplicationDetectionInformation, Type: i.Type, Err: err}
+			}
+			f.ApplicationID = v
+			hasApplicationID = true
+		case ApplicationInstanceID:
+			v, err := i.ApplicationInstanceID()
+			if err != nil {
+				return &GroupedFieldError{Parent: ApplicationDetectionInformation, Type: i.Type, Err: err}
+			}
+			f.ApplicationInstanceID = &v
+		case FlowInformation:
+			v, err := i.FlowInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ApplicationDetectionInformation, Type: i.Type, Err: err}
+			}
+			f.FlowInformation = v
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: ApplicationDetectionInformation, Type: i.Type, Err: err}
+			}
+			f.PDRID = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasApplicationID {
+		return &GroupedFieldError{Parent: ApplicationDetectionInformation, Type: ApplicationID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ApplicationDetectionInformation IE built from the fields.
+func (f *ApplicationDetectionInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewApplicationID(f.ApplicationID))
+	if f.ApplicationInstanceID != nil {
+		ies = append(ies, NewApplicationInstanceID(*f.ApplicationInstanceID))
+	}
+	if f.FlowInformation != nil {
+		ies = append(ies, New(FlowInformation, f.FlowInformation))
+	}
+	if f.PDRID != nil {
+		ies = append(ies, NewPDRID(*f.PDRID))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ApplicationDetectionInformation, 0, ies...)
+}
+
+// QueryURRFields represents the fields in QueryURR IE.
+type QueryURRFields struct {
+	URRID uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseQueryURRFields parses b into QueryURRFields.
+func ParseQueryURRFields(b []byte) (*QueryURRFields, error) {
+	f := &QueryURRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into QueryURRFields.
+func (f *QueryURRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *QueryURRFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: QueryURR, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: QueryURR, Type: URRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns QueryURR IE built from the fields.
+func (f *QueryURRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(QueryURR, 0, ies...)
+}
+
+// UsageReportWithinSessionModificationResponseFields represents the fields in UsageReportWithinSessionModificationResponse IE.
+type UsageReportWithinSessionModificationResponseFields struct {
+	URRID                      uint32
+	URSEQN                     uint32
+	UsageReportTrigger         []byte
+	StartTime                  *time.Time
+	EndTime                    *time.Time
+	VolumeMeasurement          *VolumeMeasurementFields
+	DurationMeasurement        *time.Duration
+	TimeOfFirstPacket          *time.Time
+	TimeOfLastPacket           *time.Time
+	UsageInformation           *uint8
+	QueryURRReference          *uint32
+	EthernetTrafficInformation *EthernetTrafficInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUsageReportWithinSessionModificationResponseFields parses b into UsageReportWithinSessionModificationResponseFields.
+func ParseUsageReportWithinSessionModificationResponseFields(b []byte) (*UsageReportWithinSessionModificationResponseFields, error) {
+	f := &UsageReportWithinSessionModificationResponseFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UsageReportWithinSessionModificationResponseFields.
+func (f *UsageReportWithinSessionModificationResponseFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UsageReportWithinSessionModificationResponseFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	var hasURSEQN bool
+	var hasUsageReportTrigger bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		case URSEQN:
+			v, err := i.URSEQN()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.URSEQN = v
+			hasURSEQN = true
+		case UsageReportTrigger:
+			v, err := i.UsageReportTrigger()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.UsageReportTrigger = v
+			hasUsageReportTrigger = true
+		case StartTime:
+			v, err := i.StartTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.StartTime = &v
+		case EndTime:
+			v, err := i.EndTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.EndTime = &v
+		case VolumeMeasurement:
+			v, err := i.VolumeMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.VolumeMeasurement = v
+		case DurationMeasurement:
+			v, err := i.DurationMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.DurationMeasurement = &v
+		case TimeOfFirstPacket:
+			v, err := i.TimeOfFirstPacket()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.TimeOfFirstPacket = &v
+		case TimeOfLastPacket:
+			v, err := i.TimeOfLastPacket()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.TimeOfLastPacket = &v
+		case UsageInformation:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.UsageInformation = &v
+		case QueryURRReference:
+			v, err := i.QueryURRReference()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.QueryURRReference = &v
+		case EthernetTrafficInformation:
+			v := &EthernetTrafficInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.EthernetTrafficInformation = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: URRID, Err: ErrIENotFound}
+	}
+	if !hasURSEQN {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: URSEQN, Err: ErrIENotFound}
+	}
+	if !hasUsageReportTrigger {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionModificationResponse, Type: UsageReportTrigger, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UsageReportWithinSessionModificationResponse IE built from the fields.
+func (f *UsageReportWithinSessionModificationResponseFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	ies = append(ies, NewURSEQN(f.URSEQN))
+	if f.UsageReportTrigger != nil {
+		ies = append(ies, New(UsageReportTrigger, f.UsageReportTrigger))
+	}
+	if f.StartTime != nil {
+		ies = append(ies, NewStartTime(*f.StartTime))
+	}
+	if f.EndTime != nil {
+		ies = append(ies, NewEndTime(*f.EndTime))
+	}
+	if f.VolumeMeasurement != nil {
+		ies = append(ies, newFieldsIE(VolumeMeasurement, f.VolumeMeasurement))
+	}
+	if f.DurationMeasurement != nil {
+		ies = append(ies, NewDurationMeasurement(*f.DurationMeasurement))
+	}
+	if f.TimeOfFirstPacket != nil {
+		ies = append(ies, NewTimeOfFirstPacket(*f.TimeOfFirstPacket))
+	}
+	if f.TimeOfLastPacket != nil {
+		ies = append(ies, NewTimeOfLastPacket(*f.TimeOfLastPacket))
+	}
+	if f.UsageInformation != nil {
+		ies = append(ies, newUint8ValIE(UsageInformation, *f.UsageInformation))
+	}
+	if f.QueryURRReference != nil {
+		ies = append(ies, NewQueryURRReference(*f.QueryURRReference))
+	}
+	if f.EthernetTrafficInformation != nil {
+		ies = append(ies, f.EthernetTrafficInformation.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UsageReportWithinSessionModificationResponse, 0, ies...)
+}
+
+// UsageReportWithinSessionDeletionResponseFields represents the fields in UsageReportWithinSessionDeletionResponse IE.
+type UsageReportWithinSessionDeletionResponseFields struct {
+	URRID                      uint32
+	URSEQN                     uint32
+	UsageReportTrigger         []byte
+	StartTime                  *time.Time
+	EndTime                    *time.Time
+	VolumeMeasurement          *VolumeMeasurementFields
+	DurationMeasurement        *time.Duration
+	TimeOfFirstPacket          *time.Time
+	TimeOfLastPacket           *time.Time
+	UsageInformation           *uint8
+	EthernetTrafficInformation *EthernetTrafficInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUsageReportWithinSessionDeletionResponseFields parses b into UsageReportWithinSessionDeletionResponseFields.
+func ParseUsageReportWithinSessionDeletionResponseFields(b []byte) (*UsageReportWithinSessionDeletionResponseFields, error) {
+	f := &UsageReportWithinSessionDeletionResponseFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UsageReportWithinSessionDeletionResponseFields.
+func (f *UsageReportWithinSessionDeletionResponseFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UsageReportWithinSessionDeletionResponseFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	var hasURSEQN bool
+	var hasUsageReportTrigger bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		case URSEQN:
+			v, err := i.URSEQN()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.URSEQN = v
+			hasURSEQN = true
+		case UsageReportTrigger:
+			v, err := i.UsageReportTrigger()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.UsageReportTrigger = v
+			hasUsageReportTrigger = true
+		case StartTime:
+			v, err := i.StartTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.StartTime = &v
+		case EndTime:
+			v, err := i.EndTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.EndTime = &v
+		case VolumeMeasurement:
+			v, err := i.VolumeMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.VolumeMeasurement = v
+		case DurationMeasurement:
+			v, err := i.DurationMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.DurationMeasurement = &v
+		case TimeOfFirstPacket:
+			v, err := i.TimeOfFirstPacket()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.TimeOfFirstPacket = &v
+		case TimeOfLastPacket:
+			v, err := i.TimeOfLastPacket()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.TimeOfLastPacket = &v
+		case UsageInformation:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.UsageInformation = &v
+		case EthernetTrafficInformation:
+			v := &EthernetTrafficInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: i.Type, Err: err}
+			}
+			f.EthernetTrafficInformation = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: URRID, Err: ErrIENotFound}
+	}
+	if !hasURSEQN {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: URSEQN, Err: ErrIENotFound}
+	}
+	if !hasUsageReportTrigger {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionDeletionResponse, Type: UsageReportTrigger, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UsageReportWithinSessionDeletionResponse IE built from the fields.
+func (f *UsageReportWithinSessionDeletionResponseFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	ies = append(ies, NewURSEQN(f.URSEQN))
+	if f.UsageReportTrigger != nil {
+		ies = append(ies, New(UsageReportTrigger, f.UsageReportTrigger))
+	}
+	if f.StartTime != nil {
+		ies = append(ies, NewStartTime(*f.StartTime))
+	}
+	if f.EndTime != nil {
+		ies = append(ies, NewEndTime(*f.EndTime))
+	}
+	if f.VolumeMeasurement != nil {
+		ies = append(ies, newFieldsIE(VolumeMeasurement, f.VolumeMeasurement))
+	}
+	if f.DurationMeasurement != nil {
+		ies = append(ies, NewDurationMeasurement(*f.DurationMeasurement))
+	}
+	if f.TimeOfFirstPacket != nil {
+		ies = append(ies, NewTimeOfFirstPacket(*f.TimeOfFirstPacket))
+	}
+	if f.TimeOfLastPacket != nil {
+		ies = append(ies, NewTimeOfLastPacket(*f.TimeOfLastPacket))
+	}
+	if f.UsageInformation != nil {
+		ies = append(ies, newUint8ValIE(UsageInformation, *f.UsageInformation))
+	}
+	if f.EthernetTrafficInformation != nil {
+		ies = append(ies, f.EthernetTrafficInformation.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UsageReportWithinSessionDeletionResponse, 0, ies...)
+}
+
+// UsageReportWithinSessionReportRequestFields represents the fields in UsageReportWithinSessionReportRequest IE.
+type UsageReportWithinSessionReportRequestFields struct {
+	URRID                           uint32
+	URSEQN                          uint32
+	UsageReportTrigger              []byte
+	StartTime                       *time.Time
+	EndTime                         *time.Time
+	VolumeMeasurement               *VolumeMeasurementFields
+	DurationMeasurement             *time.Duration
+	ApplicationDetectionInformation *ApplicationDetectionInformationFields
+	UEIPAddress                     *UEIPAddressFields
+	NetworkInstance                 *string
+	TimeOfFirstPacket               *time.Time
+	TimeOfLastPacket                *time.Time
+	UsageInformation                *uint8
+	QueryURRReference               *uint32
+	EventTimeStamps                 []time.Time
+	EthernetTrafficInformation      *EthernetTrafficInformationFields
+	JoinIPMulticastInformation      []*JoinIPMulticastInformationWithinUsageReportFields
+	LeaveIPMulticastInformation     []*LeaveIPMulticastInformationWithinUsageReportFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUsageReportWithinSessionReportRequestFields parses b into UsageReportWithinSessionReportRequestFields.
+func ParseUsageReportWithinSessionReportRequestFields(b []byte) (*UsageReportWithinSessionReportRequestFields, error) {
+	f := &UsageReportWithinSessionReportRequestFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UsageReportWithinSessionReportRequestFields.
+func (f *UsageReportWithinSessionReportRequestFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UsageReportWithinSessionReportRequestFields) unmarshalIEs(ies []*IE) error {
+	var hasURRID bool
+	var hasURSEQN bool
+	var hasUsageReportTrigger bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.URRID = v
+			hasURRID = true
+		case URSEQN:
+			v, err := i.URSEQN()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.URSEQN = v
+			hasURSEQN = true
+		case UsageReportTrigger:
+			v, err := i.UsageReportTrigger()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.UsageReportTrigger = v
+			hasUsageReportTrigger = true
+		case StartTime:
+			v, err := i.StartTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.StartTime = &v
+		case EndTime:
+			v, err := i.EndTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.EndTime = &v
+		case VolumeMeasurement:
+			v, err := i.VolumeMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.VolumeMeasurement = v
+		case DurationMeasurement:
+			v, err := i.DurationMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.DurationMeasurement = &v
+		case ApplicationDetectionInformation:
+			v := &ApplicationDetectionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.ApplicationDetectionInformation = v
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.UEIPAddress = v
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case TimeOfFirstPacket:
+			v, err := i.TimeOfFirstPacket()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.TimeOfFirstPacket = &v
+		case TimeOfLastPacket:
+			v, err := i.TimeOfLastPacket()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.TimeOfLastPacket = &v
+		case UsageInformation:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.UsageInformation = &v
+		case QueryURRReference:
+			v, err := i.QueryURRReference()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.QueryURRReference = &v
+		case EventTimeStamp:
+			v, err := i.EventTimeStamp()
+			if err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.EventTimeStamps = append(f.EventTimeStamps, v)
+		case EthernetTrafficInformation:
+			v := &EthernetTrafficInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.EthernetTrafficInformation = v
+		case JoinIPMulticastInformationWithinUsageReport:
+			v := &JoinIPMulticastInformationWithinUsageReportFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.JoinIPMulticastInformation = append(f.JoinIPMulticastInformation, v)
+		case LeaveIPMulticastInformationWithinUsageReport:
+			v := &LeaveIPMulticastInformationWithinUsageReportFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.LeaveIPMulticastInformation = append(f.LeaveIPMulticastInformation, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasURRID {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: URRID, Err: ErrIENotFound}
+	}
+	if !hasURSEQN {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: URSEQN, Err: ErrIENotFound}
+	}
+	if !hasUsageReportTrigger {
+		return &GroupedFieldError{Parent: UsageReportWithinSessionReportRequest, Type: UsageReportTrigger, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UsageReportWithinSessionReportRequest IE built from the fields.
+func (f *UsageReportWithinSessionReportRequestFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewURRID(f.URRID))
+	ies = append(ies, NewURSEQN(f.URSEQN))
+	if f.UsageReportTrigger != nil {
+		ies = append(ies, New(UsageReportTrigger, f.UsageReportTrigger))
+	}
+	if f.StartTime != nil {
+		ies = append(ies, NewStartTime(*f.StartTime))
+	}
+	if f.EndTime != nil {
+		ies = append(ies, NewEndTime(*f.EndTime))
+	}
+	if f.VolumeMeasurement != nil {
+		ies = append(ies, newFieldsIE(VolumeMeasurement, f.VolumeMeasurement))
+	}
+	if f.DurationMeasurement != nil {
+		ies = append(ies, NewDurationMeasurement(*f.DurationMeasurement))
+	}
+	if f.ApplicationDetectionInformation != nil {
+		ies = append(ies, f.ApplicationDetectionInformation.ToIE())
+	}
+	if f.UEIPAddress != nil {
+		ies = append(ies, newFieldsIE(UEIPAddress, f.UEIPAddress))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	if f.TimeOfFirstPacket != nil {
+		ies = append(ies, NewTimeOfFirstPacket(*f.TimeOfFirstPacket))
+	}
+	if f.TimeOfLastPacket != nil {
+		ies = append(ies, NewTimeOfLastPacket(*f.TimeOfLastPacket))
+	}
+	if f.UsageInformation != nil {
+		ies = append(ies, newUint8ValIE(UsageInformation, *f.UsageInformation))
+	}
+	if f.QueryURRReference != nil {
+		ies = append(ies, NewQueryURRReference(*f.QueryURRReference))
+	}
+	for _, v := range f.EventTimeStamps {
+		ies = append(ies, NewEventTimeStamp(v))
+	}
+	if f.EthernetTrafficInformation != nil {
+		ies = append(ies, f.EthernetTrafficInformation.ToIE())
+	}
+	for _, v := range f.JoinIPMulticastInformation {
+		ies = append(ies, v.ToIE())
+	}
+	for _, v := range f.LeaveIPMulticastInformation {
+		ies = append(ies, v.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UsageReportWithinSessionReportRequest, 0, ies...)
+}
+
+// DownlinkDataReportFields represents the fields in DownlinkDataReport IE.
+type DownlinkDataReportFields struct {
+	PDRIDs                         []uint16
+	DownlinkDataServiceInformation [][]byte
+	DLDataPacketsSize              *uint16
+	DataStatus                     *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseDownlinkDataReportFields parses b into DownlinkDataReportFields.
+func ParseDownlinkDataReportFields(b []byte) (*DownlinkDataReportFields, error) {
+	f := &DownlinkDataReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into DownlinkDataReportFields.
+func (f *DownlinkDataReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *DownlinkDataReportFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: DownlinkDataReport, Type: i.Type, Err: err}
+			}
+			f.PDRIDs = append(f.PDRIDs, v)
+		case DownlinkDataServiceInformation:
+			v, err := i.DownlinkDataServiceInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: DownlinkDataReport, Type: i.Type, Err: err}
+			}
+			f.DownlinkDataServiceInformation = append(f.DownlinkDataServiceInformation, v)
+		case DLDataPacketsSize:
+			v, err := i.DLDataPacketsSize()
+			if err != nil {
+				return &GroupedFieldError{Parent: DownlinkDataReport, Type: i.Type, Err: err}
+			}
+			f.DLDataPacketsSize = &v
+		case DataStatus:
+			v, err := i.DataStatus()
+			if err != nil {
+				return &GroupedFieldError{Parent: DownlinkDataReport, Type: i.Type, Err: err}
+			}
+			f.DataStatus = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if len(f.PDRIDs) == 0 {
+		return &GroupedFieldError{Parent: DownlinkDataReport, Type: PDRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns DownlinkDataReport IE built from the fields.
+func (f *DownlinkDataReportFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.PDRIDs {
+		ies = append(ies, NewPDRID(v))
+	}
+	for _, v := range f.DownlinkDataServiceInformation {
+		ies = append(ies, New(DownlinkDataServiceInformation, v))
+	}
+	if f.DLDataPacketsSize != nil {
+		ies = append(ies, NewDLDataPacketsSize(*f.DLDataPacketsSize))
+	}
+	if f.DataStatus != nil {
+		ies = append(ies, NewDataStatus(*f.DataStatus))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(DownlinkDataReport, 0, ies...)
+}
+
+// CreateBARFields represents the fields in CreateBAR IE.
+type CreateBARFields struct {
+	BARID                          uint8
+	DownlinkDataNotificationDelay  *time.Duration
+	SuggestedBufferingPacketsCount *uint8
+	MTEDTControlInformation        *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateBARFields parses b into CreateBARFields.
+func ParseCreateBARFields(b []byte) (*CreateBARFields, error) {
+	f := &CreateBARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateBARFields.
+func (f *CreateBARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateBARFields) unmarshalIEs(ies []*IE) error {
+	var hasBARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case BARID:
+			v, err := i.BARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateBAR, Type: i.Type, Err: err}
+			}
+			f.BARID = v
+			hasBARID = true
+		case DownlinkDataNotificationDelay:
+			v, err := i.DownlinkDataNotificationDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateBAR, Type: i.Type, Err: err}
+			}
+			f.DownlinkDataNotificationDelay = &v
+		case SuggestedBufferingPacketsCount:
+			v, err := i.SuggestedBufferingPacketsCount()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateBAR, Type: i.Type, Err: err}
+			}
+			f.SuggestedBufferingPacketsCount = &v
+		case MTEDTControlInformation:
+			v, err := i.MTEDTControlInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateBAR, Type: i.Type, Err: err}
+			}
+			f.MTEDTControlInformation = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasBARID {
+		return &GroupedFieldError{Parent: CreateBAR, Type: BARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateBAR IE built from the fields.
+func (f *CreateBARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewBARID(f.BARID))
+	if f.DownlinkDataNotificationDelay != nil {
+		ies = append(ies, NewDownlinkDataNotificationDelay(*f.DownlinkDataNotificationDelay))
+	}
+	if f.SuggestedBufferingPacketsCount != nil {
+		ies = append(ies, NewSuggestedBufferingPacketsCount(*f.SuggestedBufferingPacketsCount))
+	}
+	if f.MTEDTControlInformation != nil {
+		ies = append(ies, NewMTEDTControlInformation(*f.MTEDTControlInformation))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateBAR, 0, ies...)
+}
+
+// UpdateBARWithinSessionModificationRequestFields represents the fields in UpdateBARWithinSessionModificationRequest IE.
+type UpdateBARWithinSessionModificationRequestFields struct {
+	BARID                          uint8
+	DownlinkDataNotificationDelay  *time.Duration
+	SuggestedBufferingPacketsCount *uint8
+	MTEDTControlInformation        *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateBARWithinSessionModificationRequestFields parses b into UpdateBARWithinSessionModificationRequestFields.
+func ParseUpdateBARWithinSessionModificationRequestFields(b []byte) (*UpdateBARWithinSessionModificationRequestFields, error) {
+	f := &UpdateBARWithinSessionModificationRequestFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateBARWithinSessionModificationRequestFields.
+func (f *UpdateBARWithinSessionModificationRequestFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateBARWithinSessionModificationRequestFields) unmarshalIEs(ies []*IE) error {
+	var hasBARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case BARID:
+			v, err := i.BARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.BARID = v
+			hasBARID = true
+		case DownlinkDataNotificationDelay:
+			v, err := i.DownlinkDataNotificationDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.DownlinkDataNotificationDelay = &v
+		case SuggestedBufferingPacketsCount:
+			v, err := i.SuggestedBufferingPacketsCount()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.SuggestedBufferingPacketsCount = &v
+		case MTEDTControlInformation:
+			v, err := i.MTEDTControlInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateBARWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.MTEDTControlInformation = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasBARID {
+		return &GroupedFieldError{Parent: UpdateBARWithinSessionModificationRequest, Type: BARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateBARWithinSessionModificationRequest IE built from the fields.
+func (f *UpdateBARWithinSessionModificationRequestFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewBARID(f.BARID))
+	if f.DownlinkDataNotificationDelay != nil {
+		ies = append(ies, NewDownlinkDataNotificationDelay(*f.DownlinkDataNotificationDelay))
+	}
+	if f.SuggestedBufferingPacketsCount != nil {
+		ies = append(ies, NewSuggestedBufferingPacketsCount(*f.SuggestedBufferingPacketsCount))
+	}
+	if f.MTEDTControlInformation != nil {
+		ies = append(ies, NewMTEDTControlInformation(*f.MTEDTControlInformation))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateBARWithinSessionModificationRequest, 0, ies...)
+}
+
+// RemoveBARFields represents the fields in RemoveBAR IE.
+type RemoveBARFields struct {
+	BARID uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveBARFields parses b into RemoveBARFields.
+func ParseRemoveBARFields(b []byte) (*RemoveBARFields, error) {
+	f := &RemoveBARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveBARFields.
+func (f *RemoveBARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveBARFields) unmarshalIEs(ies []*IE) error {
+	var hasBARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case BARID:
+			v, err := i.BARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveBAR, Type: i.Type, Err: err}
+			}
+			f.BARID = v
+			hasBARID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasBARID {
+		return &GroupedFieldError{Parent: RemoveBAR, Type: BARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveBAR IE built from the fields.
+func (f *RemoveBARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewBARID(f.BARID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveBAR, 0, ies...)
+}
+
+// ErrorIndicationReportFields represents the fields in ErrorIndicationReport IE.
+type ErrorIndicationReportFields struct {
+	RemoteFTEIDs []*FTEIDFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseErrorIndicationReportFields parses b into ErrorIndicationReportFields.
+func ParseErrorIndicationReportFields(b []byte) (*ErrorIndicationReportFields, error) {
+	f := &ErrorIndicationReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ErrorIndicationReportFields.
+func (f *ErrorIndicationReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ErrorIndicationReportFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: ErrorIndicationReport, Type: i.Type, Err: err}
+			}
+			f.RemoteFTEIDs = append(f.RemoteFTEIDs, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if len(f.RemoteFTEIDs) == 0 {
+		return &GroupedFieldError{Parent: ErrorIndicationReport, Type: FTEID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ErrorIndicationReport IE built from the fields.
+func (f *ErrorIndicationReportFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.RemoteFTEIDs {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ErrorIndicationReport, 0, ies...)
+}
+
+// UserPlanePathFailureReportFields represents the fields in UserPlanePathFailureReport IE.
+type UserPlanePathFailureReportFields struct {
+	RemoteGTPUPeers []*RemoteGTPUPeerFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUserPlanePathFailureReportFields parses b into UserPlanePathFailureReportFields.
+func ParseUserPlanePathFailureReportFields(b []byte) (*UserPlanePathFailureReportFields, error) {
+	f := &UserPlanePathFailureReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UserPlanePathFailureReportFields.
+func (f *UserPlanePathFailureReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UserPlanePathFailureReportFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RemoteGTPUPeer:
+			v, err := i.RemoteGTPUPeer()
+			if err != nil {
+				return &GroupedFieldError{Parent: UserPlanePathFailureReport, Type: i.Type, Err: err}
+			}
+			f.RemoteGTPUPeers = append(f.RemoteGTPUPeers, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if len(f.RemoteGTPUPeers) == 0 {
+		return &GroupedFieldError{Parent: UserPlanePathFailureReport, Type: RemoteGTPUPeer, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UserPlanePathFailureReport IE built from the fields.
+func (f *UserPlanePathFailureReportFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.RemoteGTPUPeers {
+		ies = append(ies, newFieldsIE(RemoteGTPUPeer, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UserPlanePathFailureReport, 0, ies...)
+}
+
+// UpdateDuplicatingParametersFields represents the fields in UpdateDuplicatingParameters IE.
+type UpdateDuplicatingParametersFields struct {
+	DestinationInterface  *uint8
+	OuterHeaderCreation   *OuterHeaderCreationFields
+	TransportLevelMarking *uint16
+	ForwardingPolicy      []byte
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateDuplicatingParametersFields parses b into UpdateDuplicatingParametersFields.
+func ParseUpdateDuplicatingParametersFields(b []byte) (*UpdateDuplicatingParametersFields, error) {
+	f := &UpdateDuplicatingParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateDuplicatingParametersFields.
+func (f *UpdateDuplicatingParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateDuplicatingParametersFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case DestinationInterface:
+			v, err := i.DestinationInterface()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateDuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.DestinationInterface = &v
+		case OuterHeaderCreation:
+			v, err := i.OuterHeaderCreation()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateDuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderCreation = v
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateDuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		case ForwardingPolicy:
+			v, err := i.ForwardingPolicy()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateDuplicatingParameters, Type: i.Type, Err: err}
+			}
+			f.ForwardingPolicy = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns UpdateDuplicatingParameters IE built from the fields.
+func (f *UpdateDuplicatingParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.DestinationInterface != nil {
+		ies = append(ies, NewDestinationInterface(*f.DestinationInterface))
+	}
+	if f.OuterHeaderCreation != nil {
+		ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	}
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	if f.ForwardingPolicy != nil {
+		ies = append(ies, New(ForwardingPolicy, f.ForwardingPolicy))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateDuplicatingParameters, 0, ies...)
+}
+
+// AggregatedURRsFields represents the fields in AggregatedURRs IE.
+type AggregatedURRsFields struct {
+	AggregatedURRID uint32
+	Multiplier      []byte
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseAggregatedURRsFields parses b into AggregatedURRsFields.
+func ParseAggregatedURRsFields(b []byte) (*AggregatedURRsFields, error) {
+	f := &AggregatedURRsFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into AggregatedURRsFields.
+func (f *AggregatedURRsFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *AggregatedURRsFields) unmarshalIEs(ies []*IE) error {
+	var hasAggregatedURRID bool
+	var hasMultiplier bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case AggregatedURRID:
+			v, err := i.AggregatedURRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: AggregatedURRs, Type: i.Type, Err: err}
+			}
+			f.AggregatedURRID = v
+			hasAggregatedURRID = true
+		case Multiplier:
+			v, err := i.Multiplier()
+			if err != nil {
+				return &GroupedFieldError{Parent: AggregatedURRs, Type: i.Type, Err: err}
+			}
+			f.Multiplier = v
+			hasMultiplier = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasAggregatedURRID {
+		return &GroupedFieldError{Parent: AggregatedURRs, Type: AggregatedURRID, Err: ErrIENotFound}
+	}
+	if !hasMultiplier {
+		return &GroupedFieldError{Parent: AggregatedURRs, Type: Multiplier, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns AggregatedURRs IE built from the fields.
+func (f *AggregatedURRsFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewAggregatedURRID(f.AggregatedURRID))
+	if f.Multiplier != nil {
+		ies = append(ies, New(Multiplier, f.Multiplier))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(AggregatedURRs, 0, ies...)
+}
+
+// CreateTrafficEndpointFields represents the fields in CreateTrafficEndpoint IE.
+type CreateTrafficEndpointFields struct {
+	TrafficEndpointID               uint8
+	FTEID                           *FTEIDFields
+	NetworkInstance                 *string
+	RedundantTransmissionParameters *RedundantTransmissionParametersFields
+	UEIPAddresses                   []*UEIPAddressFields
+	EthernetPDUSessionInformation   *uint8
+	FramedRoutes                    []string
+	FramedRouting                   *uint32
+	FramedIPv6Routes                []string
+	QFIs                            []uint8
+	SourceInterfaceType             *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateTrafficEndpointFields parses b into CreateTrafficEndpointFields.
+func ParseCreateTrafficEndpointFields(b []byte) (*CreateTrafficEndpointFields, error) {
+	f := &CreateTrafficEndpointFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateTrafficEndpointFields.
+func (f *CreateTrafficEndpointFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateTrafficEndpointFields) unmarshalIEs(ies []*IE) error {
+	var hasTrafficEndpointID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.TrafficEndpointID = v
+			hasTrafficEndpointID = true
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FTEID = v
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case RedundantTransmissionParameters:
+			v := &RedundantTransmissionParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.RedundantTransmissionParameters = v
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.UEIPAddresses = append(f.UEIPAddresses, v)
+		case EthernetPDUSessionInformation:
+			v, err := i.EthernetPDUSessionInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.EthernetPDUSessionInformation = &v
+		case FramedRoute:
+			v, err := i.FramedRoute()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FramedRoutes = append(f.FramedRoutes, v)
+		case FramedRouting:
+			v, err := i.FramedRouting()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FramedRouting = &v
+		case FramedIPv6Route:
+			v, err := i.FramedIPv6Route()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FramedIPv6Routes = append(f.FramedIPv6Routes, v)
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.QFIs = append(f.QFIs, v)
+		case TGPPInterfaceType:
+			v, err := i.TGPPInterfaceType()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.SourceInterfaceType = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasTrafficEndpointID {
+		return &GroupedFieldError{Parent: CreateTrafficEndpoint, Type: TrafficEndpointID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateTrafficEndpoint IE built from the fields.
+func (f *CreateTrafficEndpointFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewTrafficEndpointID(f.TrafficEndpointID))
+	if f.FTEID != nil {
+		ies = append(ies, newFieldsIE(FTEID, f.FTEID))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	if f.RedundantTransmissionParameters != nil {
+		ies = append(ies, f.RedundantTransmissionParameters.ToIE())
+	}
+	for _, v := range f.UEIPAddresses {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	if f.EthernetPDUSessionInformation != nil {
+		ies = append(ies, NewEthernetPDUSessionInformation(*f.EthernetPDUSessionInformation))
+	}
+	for _, v := range f.FramedRoutes {
+		ies = append(ies, NewFramedRoute(v))
+	}
+	if f.FramedRouting != nil {
+		ies = append(ies, NewFramedRouting(*f.FramedRouting))
+	}
+	for _, v := range f.FramedIPv6Routes {
+		ies = append(ies, NewFramedIPv6Route(v))
+	}
+	for _, v := range f.QFIs {
+		ies = append(ies, NewQFI(v))
+	}
+	if f.SourceInterfaceType != nil {
+		ies = append(ies, NewTGPPInterfaceType(*f.SourceInterfaceType))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateTrafficEndpoint, 0, ies...)
+}
+
+// CreatedTrafficEndpointFields represents the fields in CreatedTrafficEndpoint IE.
+type CreatedTrafficEndpointFields struct {
+	TrafficEndpointID uint8
+	LocalFTEIDs       []*FTEIDFields
+	UEIPAddresses     []*UEIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreatedTrafficEndpointFields parses b into CreatedTrafficEndpointFields.
+func ParseCreatedTrafficEndpointFields(b []byte) (*CreatedTrafficEndpointFields, error) {
+	f := &CreatedTrafficEndpointFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreatedTrafficEndpointFields.
+func (f *CreatedTrafficEndpointFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreatedTrafficEndpointFields) unmarshalIEs(ies []*IE) error {
+	var hasTrafficEndpointID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.TrafficEndpointID = v
+			hasTrafficEndpointID = true
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.LocalFTEIDs = append(f.LocalFTEIDs, v)
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.UEIPAddresses = append(f.UEIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasTrafficEndpointID {
+		return &GroupedFieldError{Parent: CreatedTrafficEndpoint, Type: TrafficEndpointID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreatedTrafficEndpoint IE built from the fields.
+func (f *CreatedTrafficEndpointFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewTrafficEndpointID(f.TrafficEndpointID))
+	for _, v := range f.LocalFTEIDs {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	for _, v := range f.UEIPAddresses {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreatedTrafficEndpoint, 0, ies...)
+}
+
+// UpdateTrafficEndpointFields represents the fields in UpdateTrafficEndpoint IE.
+type UpdateTrafficEndpointFields struct {
+	TrafficEndpointID               uint8
+	FTEID                           *FTEIDFields
+	NetworkInstance                 *string
+	RedundantTransmissionParameters *RedundantTransmissionParametersFields
+	UEIPAddresses                   []*UEIPAddressFields
+	FramedRoutes                    []string
+	FramedRouting                   *uint32
+	FramedIPv6Routes                []string
+	QFIs                            []uint8
+	SourceInterfaceType             *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateTrafficEndpointFields parses b into UpdateTrafficEndpointFields.
+func ParseUpdateTrafficEndpointFields(b []byte) (*UpdateTrafficEndpointFields, error) {
+	f := &UpdateTrafficEndpointFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateTrafficEndpointFields.
+func (f *UpdateTrafficEndpointFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateTrafficEndpointFields) unmarshalIEs(ies []*IE) error {
+	var hasTrafficEndpointID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.TrafficEndpointID = v
+			hasTrafficEndpointID = true
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FTEID = v
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case RedundantTransmissionParameters:
+			v := &RedundantTransmissionParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.RedundantTransmissionParameters = v
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.UEIPAddresses = append(f.UEIPAddresses, v)
+		case FramedRoute:
+			v, err := i.FramedRoute()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FramedRoutes = append(f.FramedRoutes, v)
+		case FramedRouting:
+			v, err := i.FramedRouting()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FramedRouting = &v
+		case FramedIPv6Route:
+			v, err := i.FramedIPv6Route()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.FramedIPv6Routes = append(f.FramedIPv6Routes, v)
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.QFIs = append(f.QFIs, v)
+		case TGPPInterfaceType:
+			v, err := i.TGPPInterfaceType()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.SourceInterfaceType = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasTrafficEndpointID {
+		return &GroupedFieldError{Parent: UpdateTrafficEndpoint, Type: TrafficEndpointID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateTrafficEndpoint IE built from the fields.
+func (f *UpdateTrafficEndpointFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewTrafficEndpointID(f.TrafficEndpointID))
+	if f.FTEID != nil {
+		ies = append(ies, newFieldsIE(FTEID, f.FTEID))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	if f.RedundantTransmissionParameters != nil {
+		ies = append(ies, f.RedundantTransmissionParameters.ToIE())
+	}
+	for _, v := range f.UEIPAddresses {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	for _, v := range f.FramedRoutes {
+		ies = append(ies, NewFramedRoute(v))
+	}
+	if f.FramedRouting != nil {
+		ies = append(ies, NewFramedRouting(*f.FramedRouting))
+	}
+	for _, v := range f.FramedIPv6Routes {
+		ies = append(ies, NewFramedIPv6Route(v))
+	}
+	for _, v := range f.QFIs {
+		ies = append(ies, NewQFI(v))
+	}
+	if f.SourceInterfaceType != nil {
+		ies = append(ies, NewTGPPInterfaceType(*f.SourceInterfaceType))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateTrafficEndpoint, 0, ies...)
+}
+
+// RemoveTrafficEndpointFields represents the fields in RemoveTrafficEndpoint IE.
+type RemoveTrafficEndpointFields struct {
+	TrafficEndpointID uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveTrafficEndpointFields parses b into RemoveTrafficEndpointFields.
+func ParseRemoveTrafficEndpointFields(b []byte) (*RemoveTrafficEndpointFields, error) {
+	f := &RemoveTrafficEndpointFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveTrafficEndpointFields.
+func (f *RemoveTrafficEndpointFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveTrafficEndpointFields) unmarshalIEs(ies []*IE) error {
+	var hasTrafficEndpointID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case TrafficEndpointID:
+			v, err := i.TrafficEndpointID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveTrafficEndpoint, Type: i.Type, Err: err}
+			}
+			f.TrafficEndpointID = v
+			hasTrafficEndpointID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasTrafficEndpointID {
+		return &GroupedFieldError{Parent: RemoveTrafficEndpoint, Type: TrafficEndpointID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveTrafficEndpoint IE built from the fields.
+func (f *RemoveTrafficEndpointFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewTrafficEndpointID(f.TrafficEndpointID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveTrafficEndpoint, 0, ies...)
+}
+
+// EthernetPacketFilterFields represents the fields in EthernetPacketFilter IE.
+type EthernetPacketFilterFields struct {
+	EthernetFilterID         *uint32
+	EthernetFilterProperties *uint8
+	MACAddresses             []*MACAddressFields
+	Ethertype                *uint16
+	CTAG                     *CTAGFields
+	STAG                     *STAGFields
+	SDFFilters               []*SDFFilterFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseEthernetPacketFilterFields parses b into EthernetPacketFilterFields.
+func ParseEthernetPacketFilterFields(b []byte) (*EthernetPacketFilterFields, error) {
+	f := &EthernetPacketFilterFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into EthernetPacketFilterFields.
+func (f *EthernetPacketFilterFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *EthernetPacketFilterFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case EthernetFilterID:
+			v, err := i.EthernetFilterID()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.EthernetFilterID = &v
+		case EthernetFilterProperties:
+			v, err := i.EthernetFilterProperties()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.EthernetFilterProperties = &v
+		case MACAddress:
+			v, err := i.MACAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.MACAddresses = append(f.MACAddresses, v)
+		case Ethertype:
+			v, err := i.Ethertype()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.Ethertype = &v
+		case CTAG:
+			v, err := i.CTAG()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.CTAG = v
+		case STAG:
+			v, err := i.STAG()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.STAG = v
+		case SDFFilter:
+			v, err := i.SDFFilter()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetPacketFilter, Type: i.Type, Err: err}
+			}
+			f.SDFFilters = append(f.SDFFilters, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns EthernetPacketFilter IE built from the fields.
+func (f *EthernetPacketFilterFields) ToIE() *IE {
+	var ies []*IE
+	if f.EthernetFilterID != nil {
+		ies = append(ies, NewEthernetFilterID(*f.EthernetFilterID))
+	}
+	if f.EthernetFilterProperties != nil {
+		ies = append(ies, NewEthernetFilterProperties(*f.EthernetFilterProperties))
+	}
+	for _, v := range f.MACAddresses {
+		ies = append(ies, newFieldsIE(MACAddress, v))
+	}
+	if f.Ethertype != nil {
+		ies = append(ies, NewEthertype(*f.Ethertype))
+	}
+	if f.CTAG != nil {
+		ies = append(ies, newFieldsIE(CTAG, f.CTAG))
+	}
+	if f.STAG != nil {
+		ies = append(ies, newFieldsIE(STAG, f.STAG))
+	}
+	for _, v := range f.SDFFilters {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(EthernetPacketFilter, 0, ies...)
+}
+
+// EthernetTrafficInformationFields represents the fields in EthernetTrafficInformation IE.
+type EthernetTrafficInformationFields struct {
+	MACAddressesDetected []*MACAddressesDetectedFields
+	MACAddressesRemoved  []*MACAddressesRemovedFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseEthernetTrafficInformationFields parses b into EthernetTrafficInformationFields.
+func ParseEthernetTrafficInformationFields(b []byte) (*EthernetTrafficInformationFields, error) {
+	f := &EthernetTrafficInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into EthernetTrafficInformationFields.
+func (f *EthernetTrafficInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *EthernetTrafficInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MACAddressesDetected:
+			v, err := i.MACAddressesDetected()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetTrafficInformation, Type: i.Type, Err: err}
+			}
+			f.MACAddressesDetected = append(f.MACAddressesDetected, v)
+		case MACAddressesRemoved:
+			v, err := i.MACAddressesRemoved()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetTrafficInformation, Type: i.Type, Err: err}
+			}
+			f.MACAddressesRemoved = append(f.MACAddressesRemoved, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns EthernetTrafficInformation IE built from the fields.
+func (f *EthernetTrafficInformationFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.MACAddressesDetected {
+		ies = append(ies, newFieldsIE(MACAddressesDetected, v))
+	}
+	for _, v := range f.MACAddressesRemoved {
+		ies = append(ies, newFieldsIE(MACAddressesRemoved, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(EthernetTrafficInformation, 0, ies...)
+}
+
+// AdditionalMonitoringTimeFields represents the fields in AdditionalMonitoringTime IE.
+type AdditionalMonitoringTimeFields struct {
+	MonitoringTime            time.Time
+	SubsequentVolumeThreshold *SubsequentVolumeThresholdFields
+	SubsequentTimeThreshold   *time.Duration
+	SubsequentVolumeQuota     *SubsequentVolumeQuotaFields
+	SubsequentTimeQuota       *time.Duration
+	SubsequentEventThreshold  *uint32
+	SubsequentEventQuota      *uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseAdditionalMonitoringTimeFields parses b into AdditionalMonitoringTimeFields.
+func ParseAdditionalMonitoringTimeFields(b []byte) (*AdditionalMonitoringTimeFields, error) {
+	f := &AdditionalMonitoringTimeFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into AdditionalMonitoringTimeFields.
+func (f *AdditionalMonitoringTimeFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *AdditionalMonitoringTimeFields) unmarshalIEs(ies []*IE) error {
+	var hasMonitoringTime bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MonitoringTime:
+			v, err := i.MonitoringTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.MonitoringTime = v
+			hasMonitoringTime = true
+		case SubsequentVolumeThreshold:
+			v, err := i.SubsequentVolumeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.SubsequentVolumeThreshold = v
+		case SubsequentTimeThreshold:
+			v, err := i.SubsequentTimeThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.SubsequentTimeThreshold = &v
+		case SubsequentVolumeQuota:
+			v, err := i.SubsequentVolumeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.SubsequentVolumeQuota = v
+		case SubsequentTimeQuota:
+			v, err := i.SubsequentTimeQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.SubsequentTimeQuota = &v
+		case SubsequentEventThreshold:
+			v, err := i.SubsequentEventThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.SubsequentEventThreshold = &v
+		case SubsequentEventQuota:
+			v, err := i.SubsequentEventQuota()
+			if err != nil {
+				return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: i.Type, Err: err}
+			}
+			f.SubsequentEventQuota = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasMonitoringTime {
+		return &GroupedFieldError{Parent: AdditionalMonitoringTime, Type: MonitoringTime, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns AdditionalMonitoringTime IE built from the fields.
+func (f *AdditionalMonitoringTimeFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewMonitoringTime(f.MonitoringTime))
+	if f.SubsequentVolumeThreshold != nil {
+		ies = append(ies, newFieldsIE(SubsequentVolumeThreshold, f.SubsequentVolumeThreshold))
+	}
+	if f.SubsequentTimeThreshold != nil {
+		ies = append(ies, NewSubsequentTimeThreshold(*f.SubsequentTimeThreshold))
+	}
+	if f.SubsequentVolumeQuota != nil {
+		ies = append(ies, newFieldsIE(SubsequentVolumeQuota, f.SubsequentVolumeQuota))
+	}
+	if f.SubsequentTimeQuota != nil {
+		ies = append(ies, NewSubsequentTimeQuota(*f.SubsequentTimeQuota))
+	}
+	if f.SubsequentEventThreshold != nil {
+		ies = append(ies, NewSubsequentEventThreshold(*f.SubsequentEventThreshold))
+	}
+	if f.SubsequentEventQuota != nil {
+		ies = append(ies, NewSubsequentEventQuota(*f.SubsequentEventQuota))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(AdditionalMonitoringTime, 0, ies...)
+}
+
+// CreateMARFields represents the fields in CreateMAR IE.
+type CreateMARFields struct {
+	MARID                                    uint16
+	SteeringFunctionality                    uint8
+	SteeringMode                             uint8
+	TGPPAccessForwardingActionInformation    *TGPPAccessForwardingActionInformationFields
+	NonTGPPAccessForwardingActionInformation *NonTGPPAccessForwardingActionInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateMARFields parses b into CreateMARFields.
+func ParseCreateMARFields(b []byte) (*CreateMARFields, error) {
+	f := &CreateMARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateMARFields.
+func (f *CreateMARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateMARFields) unmarshalIEs(ies []*IE) error {
+	var hasMARID bool
+	var hasSteeringFunctionality bool
+	var hasSteeringMode bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MARID:
+			v, err := i.MARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateMAR, Type: i.Type, Err: err}
+			}
+			f.MARID = v
+			hasMARID = true
+		case SteeringFunctionality:
+			v, err := i.SteeringFunctionality()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateMAR, Type: i.Type, Err: err}
+			}
+			f.SteeringFunctionality = v
+			hasSteeringFunctionality = true
+		case SteeringMode:
+			v, err := i.SteeringMode()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateMAR, Type: i.Type, Err: err}
+			}
+			f.SteeringMode = v
+			hasSteeringMode = true
+		case TGPPAccessForwardingActionInformation:
+			v := &TGPPAccessForwardingActionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateMAR, Type: i.Type, Err: err}
+			}
+			f.TGPPAccessForwardingActionInformation = v
+		case NonTGPPAccessForwardingActionInformation:
+			v := &NonTGPPAccessForwardingActionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateMAR, Type: i.Type, Err: err}
+			}
+			f.NonTGPPAccessForwardingActionInformation = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasMARID {
+		return &GroupedFieldError{Parent: CreateMAR, Type: MARID, Err: ErrIENotFound}
+	}
+	if !hasSteeringFunctionality {
+		return &GroupedFieldError{Parent: CreateMAR, Type: SteeringFunctionality, Err: ErrIENotFound}
+	}
+	if !hasSteeringMode {
+		return &GroupedFieldError{Parent: CreateMAR, Type: SteeringMode, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateMAR IE built from the fields.
+func (f *CreateMARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewMARID(f.MARID))
+	ies = append(ies, NewSteeringFunctionality(f.SteeringFunctionality))
+	ies = append(ies, NewSteeringMode(f.SteeringMode))
+	if f.TGPPAccessForwardingActionInformation != nil {
+		ies = append(ies, f.TGPPAccessForwardingActionInformation.ToIE())
+	}
+	if f.NonTGPPAccessForwardingActionInformation != nil {
+		ies = append(ies, f.NonTGPPAccessForwardingActionInformation.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateMAR, 0, ies...)
+}
+
+// TGPPAccessForwardingActionInformationFields represents the fields in TGPPAccessForwardingActionInformation IE.
+type TGPPAccessForwardingActionInformationFields struct {
+	FARID    uint32
+	Weight   *uint8
+	Priority *uint8
+	URRIDs   []uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseTGPPAccessForwardingActionInformationFields parses b into TGPPAccessForwardingActionInformationFields.
+func ParseTGPPAccessForwardingActionInformationFields(b []byte) (*TGPPAccessForwardingActionInformationFields, error) {
+	f := &TGPPAccessForwardingActionInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into TGPPAccessForwardingActionInformationFields.
+func (f *TGPPAccessForwardingActionInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *TGPPAccessForwardingActionInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasFARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: TGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.FARID = v
+			hasFARID = true
+		case Weight:
+			v, err := i.Weight()
+			if err != nil {
+				return &GroupedFieldError{Parent: TGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Weight = &v
+		case Priority:
+			v, err := i.Priority()
+			if err != nil {
+				return &GroupedFieldError{Parent: TGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Priority = &v
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: TGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.URRIDs = append(f.URRIDs, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasFARID {
+		return &GroupedFieldError{Parent: TGPPAccessForwardingActionInformation, Type: FARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns TGPPAccessForwardingActionInformation IE built from the fields.
+func (f *TGPPAccessForwardingActionInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewFARID(f.FARID))
+	if f.Weight != nil {
+		ies = append(ies, NewWeight(*f.Weight))
+	}
+	if f.Priority != nil {
+		ies = append(ies, NewPriority(*f.Priority))
+	}
+	for _, v := range f.URRIDs {
+		ies = append(ies, NewURRID(v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(TGPPAccessForwardingActionInformation, 0, ies...)
+}
+
+// NonTGPPAccessForwardingActionInformationFields represents the fields in NonTGPPAccessForwardingActionInformation IE.
+type NonTGPPAccessForwardingActionInformationFields struct {
+	FARID    uint32
+	Weight   *uint8
+	Priority *uint8
+	URRIDs   []uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseNonTGPPAccessForwardingActionInformationFields parses b into NonTGPPAccessForwardingActionInformationFields.
+func ParseNonTGPPAccessForwardingActionInformationFields(b []byte) (*NonTGPPAccessForwardingActionInformationFields, error) {
+	f := &NonTGPPAccessForwardingActionInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into NonTGPPAccessForwardingActionInformationFields.
+func (f *NonTGPPAccessForwardingActionInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *NonTGPPAccessForwardingActionInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasFARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: NonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.FARID = v
+			hasFARID = true
+		case Weight:
+			v, err := i.Weight()
+			if err != nil {
+				return &GroupedFieldError{Parent: NonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Weight = &v
+		case Priority:
+			v, err := i.Priority()
+			if err != nil {
+				return &GroupedFieldError{Parent: NonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Priority = &v
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: NonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.URRIDs = append(f.URRIDs, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasFARID {
+		return &GroupedFieldError{Parent: NonTGPPAccessForwardingActionInformation, Type: FARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns NonTGPPAccessForwardingActionInformation IE built from the fields.
+func (f *NonTGPPAccessForwardingActionInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewFARID(f.FARID))
+	if f.Weight != nil {
+		ies = append(ies, NewWeight(*f.Weight))
+	}
+	if f.Priority != nil {
+		ies = append(ies, NewPriority(*f.Priority))
+	}
+	for _, v := range f.URRIDs {
+		ies = append(ies, NewURRID(v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(NonTGPPAccessForwardingActionInformation, 0, ies...)
+}
+
+// RemoveMARFields represents the fields in RemoveMAR IE.
+type RemoveMARFields struct {
+	MARID uint16
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveMARFields parses b into RemoveMARFields.
+func ParseRemoveMARFields(b []byte) (*RemoveMARFields, error) {
+	f := &RemoveMARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveMARFields.
+func (f *RemoveMARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveMARFields) unmarshalIEs(ies []*IE) error {
+	var hasMARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MARID:
+			v, err := i.MARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveMAR, Type: i.Type, Err: err}
+			}
+			f.MARID = v
+			hasMARID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasMARID {
+		return &GroupedFieldError{Parent: RemoveMAR, Type: MARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveMAR IE built from the fields.
+func (f *RemoveMARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewMARID(f.MARID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveMAR, 0, ies...)
+}
+
+// UpdateMARFields represents the fields in UpdateMAR IE.
+type UpdateMARFields struct {
+	MARID                                          uint16
+	SteeringFunctionality                          *uint8
+	SteeringMode                                   *uint8
+	UpdateTGPPAccessForwardingActionInformation    *UpdateTGPPAccessForwardingActionInformationFields
+	UpdateNonTGPPAccessForwardingActionInformation *UpdateNonTGPPAccessForwardingActionInformationFields
+	TGPPAccessForwardingActionInformation          *TGPPAccessForwardingActionInformationFields
+	NonTGPPAccessForwardingActionInformation       *NonTGPPAccessForwardingActionInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateMARFields parses b into UpdateMARFields.
+func ParseUpdateMARFields(b []byte) (*UpdateMARFields, error) {
+	f := &UpdateMARFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateMARFields.
+func (f *UpdateMARFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateMARFields) unmarshalIEs(ies []*IE) error {
+	var hasMARID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MARID:
+			v, err := i.MARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.MARID = v
+			hasMARID = true
+		case SteeringFunctionality:
+			v, err := i.SteeringFunctionality()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.SteeringFunctionality = &v
+		case SteeringMode:
+			v, err := i.SteeringMode()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.SteeringMode = &v
+		case UpdateTGPPAccessForwardingActionInformation:
+			v := &UpdateTGPPAccessForwardingActionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.UpdateTGPPAccessForwardingActionInformation = v
+		case UpdateNonTGPPAccessForwardingActionInformation:
+			v := &UpdateNonTGPPAccessForwardingActionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.UpdateNonTGPPAccessForwardingActionInformation = v
+		case TGPPAccessForwardingActionInformation:
+			v := &TGPPAccessForwardingActionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.TGPPAccessForwardingActionInformation = v
+		case NonTGPPAccessForwardingActionInformation:
+			v := &NonTGPPAccessForwardingActionInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateMAR, Type: i.Type, Err: err}
+			}
+			f.NonTGPPAccessForwardingActionInformation = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasMARID {
+		return &GroupedFieldError{Parent: UpdateMAR, Type: MARID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateMAR IE built from the fields.
+func (f *UpdateMARFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewMARID(f.MARID))
+	if f.SteeringFunctionality != nil {
+		ies = append(ies, NewSteeringFunctionality(*f.SteeringFunctionality))
+	}
+	if f.SteeringMode != nil {
+		ies = append(ies, NewSteeringMode(*f.SteeringMode))
+	}
+	if f.UpdateTGPPAccessForwardingActionInformation != nil {
+		ies = append(ies, f.UpdateTGPPAccessForwardingActionInformation.ToIE())
+	}
+	if f.UpdateNonTGPPAccessForwardingActionInformation != nil {
+		ies = append(ies, f.UpdateNonTGPPAccessForwardingActionInformation.ToIE())
+	}
+	if f.TGPPAccessForwardingActionInformation != nil {
+		ies = append(ies, f.TGPPAccessForwardingActionInformation.ToIE())
+	}
+	if f.NonTGPPAccessForwardingActionInformation != nil {
+		ies = append(ies, f.NonTGPPAccessForwardingActionInformation.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateMAR, 0, ies...)
+}
+
+// UpdateTGPPAccessForwardingActionInformationFields represents the fields in UpdateTGPPAccessForwardingActionInformation IE.
+type UpdateTGPPAccessForwardingActionInformationFields struct {
+	FARID    *uint32
+	Weight   *uint8
+	Priority *uint8
+	URRIDs   []uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateTGPPAccessForwardingActionInformationFields parses b into UpdateTGPPAccessForwardingActionInformationFields.
+func ParseUpdateTGPPAccessForwardingActionInformationFields(b []byte) (*UpdateTGPPAccessForwardingActionInformationFields, error) {
+	f := &UpdateTGPPAccessForwardingActionInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateTGPPAccessForwardingActionInformationFields.
+func (f *UpdateTGPPAccessForwardingActionInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateTGPPAccessForwardingActionInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.FARID = &v
+		case Weight:
+			v, err := i.Weight()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Weight = &v
+		case Priority:
+			v, err := i.Priority()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Priority = &v
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.URRIDs = append(f.URRIDs, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns UpdateTGPPAccessForwardingActionInformation IE built from the fields.
+func (f *UpdateTGPPAccessForwardingActionInformationFields) ToIE() *IE {
+	var ies []*IE
+	if f.FARID != nil {
+		ies = append(ies, NewFARID(*f.FARID))
+	}
+	if f.Weight != nil {
+		ies = append(ies, NewWeight(*f.Weight))
+	}
+	if f.Priority != nil {
+		ies = append(ies, NewPriority(*f.Priority))
+	}
+	for _, v := range f.URRIDs {
+		ies = append(ies, NewURRID(v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateTGPPAccessForwardingActionInformation, 0, ies...)
+}
+
+// UpdateNonTGPPAccessForwardingActionInformationFields represents the fields in UpdateNonTGPPAccessForwardingActionInformation IE.
+type UpdateNonTGPPAccessForwardingActionInformationFields struct {
+	FARID    *uint32
+	Weight   *uint8
+	Priority *uint8
+	URRIDs   []uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateNonTGPPAccessForwardingActionInformationFields parses b into UpdateNonTGPPAccessForwardingActionInformationFields.
+func ParseUpdateNonTGPPAccessForwardingActionInformationFields(b []byte) (*UpdateNonTGPPAccessForwardingActionInformationFields, error) {
+	f := &UpdateNonTGPPAccessForwardingActionInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateNonTGPPAccessForwardingActionInformationFields.
+func (f *UpdateNonTGPPAccessForwardingActionInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateNonTGPPAccessForwardingActionInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FARID:
+			v, err := i.FARID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateNonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.FARID = &v
+		case Weight:
+			v, err := i.Weight()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateNonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Weight = &v
+		case Priority:
+			v, err := i.Priority()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateNonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.Priority = &v
+		case URRID:
+			v, err := i.URRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateNonTGPPAccessForwardingActionInformation, Type: i.Type, Err: err}
+			}
+			f.URRIDs = append(f.URRIDs, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns UpdateNonTGPPAccessForwardingActionInformation IE built from the fields.
+func (f *UpdateNonTGPPAccessForwardingActionInformationFields) ToIE() *IE {
+	var ies []*IE
+	if f.FARID != nil {
+		ies = append(ies, NewFARID(*f.FARID))
+	}
+	if f.Weight != nil {
+		ies = append(ies, NewWeight(*f.Weight))
+	}
+	if f.Priority != nil {
+		ies = append(ies, NewPriority(*f.Priority))
+	}
+	for _, v := range f.URRIDs {
+		ies = append(ies, NewURRID(v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateNonTGPPAccessForwardingActionInformation, 0, ies...)
+}
+
+// PFCPSessionRetentionInformationFields represents the fields in PFCPSessionRetentionInformation IE.
+type PFCPSessionRetentionInformationFields struct {
+	CPPFCPEntityIPAddresses []*CPPFCPEntityIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParsePFCPSessionRetentionInformationFields parses b into PFCPSessionRetentionInformationFields.
+func ParsePFCPSessionRetentionInformationFields(b []byte) (*PFCPSessionRetentionInformationFields, error) {
+	f := &PFCPSessionRetentionInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into PFCPSessionRetentionInformationFields.
+func (f *PFCPSessionRetentionInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *PFCPSessionRetentionInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case CPPFCPEntityIPAddress:
+			v, err := i.CPPFCPEntityIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: PFCPSessionRetentionInformation, Type: i.Type, Err: err}
+			}
+			f.CPPFCPEntityIPAddresses = append(f.CPPFCPEntityIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns PFCPSessionRetentionInformation IE built from the fields.
+func (f *PFCPSessionRetentionInformationFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.CPPFCPEntityIPAddresses {
+		ies = append(ies, newFieldsIE(CPPFCPEntityIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(PFCPSessionRetentionInformation, 0, ies...)
+}
+
+// UserPlanePathRecoveryReportFields represents the fields in UserPlanePathRecoveryReport IE.
+type UserPlanePathRecoveryReportFields struct {
+	RemoteGTPUPeer *RemoteGTPUPeerFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUserPlanePathRecoveryReportFields parses b into UserPlanePathRecoveryReportFields.
+func ParseUserPlanePathRecoveryReportFields(b []byte) (*UserPlanePathRecoveryReportFields, error) {
+	f := &UserPlanePathRecoveryReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UserPlanePathRecoveryReportFields.
+func (f *UserPlanePathRecoveryReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UserPlanePathRecoveryReportFields) unmarshalIEs(ies []*IE) error {
+	var hasRemoteGTPUPeer bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RemoteGTPUPeer:
+			v, err := i.RemoteGTPUPeer()
+			if err != nil {
+				return &GroupedFieldError{Parent: UserPlanePathRecoveryReport, Type: i.Type, Err: err}
+			}
+			f.RemoteGTPUPeer = v
+			hasRemoteGTPUPeer = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasRemoteGTPUPeer {
+		return &GroupedFieldError{Parent: UserPlanePathRecoveryReport, Type: RemoteGTPUPeer, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UserPlanePathRecoveryReport IE built from the fields.
+func (f *UserPlanePathRecoveryReportFields) ToIE() *IE {
+	var ies []*IE
+	if f.RemoteGTPUPeer != nil {
+		ies = append(ies, newFieldsIE(RemoteGTPUPeer, f.RemoteGTPUPeer))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UserPlanePathRecoveryReport, 0, ies...)
+}
+
+// IPMulticastAddressingInfoFields represents the fields in IPMulticastAddressingInfo IE.
+type IPMulticastAddressingInfoFields struct {
+	IPMulticastAddress *IPMulticastAddressFields
+	SourceIPAddresses  []*SourceIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseIPMulticastAddressingInfoFields parses b into IPMulticastAddressingInfoFields.
+func ParseIPMulticastAddressingInfoFields(b []byte) (*IPMulticastAddressingInfoFields, error) {
+	f := &IPMulticastAddressingInfoFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IPMulticastAddressingInfoFields.
+func (f *IPMulticastAddressingInfoFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *IPMulticastAddressingInfoFields) unmarshalIEs(ies []*IE) error {
+	var hasIPMulticastAddress bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case IPMulticastAddress:
+			v, err := i.IPMulticastAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: IPMulticastAddressingInfo, Type: i.Type, Err: err}
+			}
+			f.IPMulticastAddress = v
+			hasIPMulticastAddress = true
+		case SourceIPAddress:
+			v, err := i.SourceIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: IPMulticastAddressingInfo, Type: i.Type, Err: err}
+			}
+			f.SourceIPAddresses = append(f.SourceIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasIPMulticastAddress {
+		return &GroupedFieldError{Parent: IPMulticastAddressingInfo, Type: IPMulticastAddress, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns IPMulticastAddressingInfo IE built from the fields.
+func (f *IPMulticastAddressingInfoFields) ToIE() *IE {
+	var ies []*IE
+	if f.IPMulticastAddress != nil {
+		ies = append(ies, newFieldsIE(IPMulticastAddress, f.IPMulticastAddress))
+	}
+	for _, v := range f.SourceIPAddresses {
+		ies = append(ies, newFieldsIE(SourceIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(IPMulticastAddressingInfo, 0, ies...)
+}
+
+// JoinIPMulticastInformationWithinUsageReportFields represents the fields in JoinIPMulticastInformationWithinUsageReport IE.
+type JoinIPMulticastInformationWithinUsageReportFields struct {
+	IPMulticastAddress *IPMulticastAddressFields
+	SourceIPAddresses  []*SourceIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseJoinIPMulticastInformationWithinUsageReportFields parses b into JoinIPMulticastInformationWithinUsageReportFields.
+func ParseJoinIPMulticastInformationWithinUsageReportFields(b []byte) (*JoinIPMulticastInformationWithinUsageReportFields, error) {
+	f := &JoinIPMulticastInformationWithinUsageReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into JoinIPMulticastInformationWithinUsageReportFields.
+func (f *JoinIPMulticastInformationWithinUsageReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *JoinIPMulticastInformationWithinUsageReportFields) unmarshalIEs(ies []*IE) error {
+	var hasIPMulticastAddress bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case IPMulticastAddress:
+			v, err := i.IPMulticastAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: JoinIPMulticastInformationWithinUsageReport, Type: i.Type, Err: err}
+			}
+			f.IPMulticastAddress = v
+			hasIPMulticastAddress = true
+		case SourceIPAddress:
+			v, err := i.SourceIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: JoinIPMulticastInformationWithinUsageReport, Type: i.Type, Err: err}
+			}
+			f.SourceIPAddresses = append(f.SourceIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasIPMulticastAddress {
+		return &GroupedFieldError{Parent: JoinIPMulticastInformationWithinUsageReport, Type: IPMulticastAddress, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns JoinIPMulticastInformationWithinUsageReport IE built from the fields.
+func (f *JoinIPMulticastInformationWithinUsageReportFields) ToIE() *IE {
+	var ies []*IE
+	if f.IPMulticastAddress != nil {
+		ies = append(ies, newFieldsIE(IPMulticastAddress, f.IPMulticastAddress))
+	}
+	for _, v := range f.SourceIPAddresses {
+		ies = append(ies, newFieldsIE(SourceIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(JoinIPMulticastInformationWithinUsageReport, 0, ies...)
+}
+
+// LeaveIPMulticastInformationWithinUsageReportFields represents the fields in LeaveIPMulticastInformationWithinUsageReport IE.
+type LeaveIPMulticastInformationWithinUsageReportFields struct {
+	IPMulticastAddress *IPMulticastAddressFields
+	SourceIPAddresses  []*SourceIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseLeaveIPMulticastInformationWithinUsageReportFields parses b into LeaveIPMulticastInformationWithinUsageReportFields.
+func ParseLeaveIPMulticastInformationWithinUsageReportFields(b []byte) (*LeaveIPMulticastInformationWithinUsageReportFields, error) {
+	f := &LeaveIPMulticastInformationWithinUsageReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into LeaveIPMulticastInformationWithinUsageReportFields.
+func (f *LeaveIPMulticastInformationWithinUsageReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *LeaveIPMulticastInformationWithinUsageReportFields) unmarshalIEs(ies []*IE) error {
+	var hasIPMulticastAddress bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case IPMulticastAddress:
+			v, err := i.IPMulticastAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: LeaveIPMulticastInformationWithinUsageReport, Type: i.Type, Err: err}
+			}
+			f.IPMulticastAddress = v
+			hasIPMulticastAddress = true
+		case SourceIPAddress:
+			v, err := i.SourceIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: LeaveIPMulticastInformationWithinUsageReport, Type: i.Type, Err: err}
+			}
+			f.SourceIPAddresses = append(f.SourceIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasIPMulticastAddress {
+		return &GroupedFieldError{Parent: LeaveIPMulticastInformationWithinUsageReport, Type: IPMulticastAddress, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns LeaveIPMulticastInformationWithinUsageReport IE built from the fields.
+func (f *LeaveIPMulticastInformationWithinUsageReportFields) ToIE() *IE {
+	var ies []*IE
+	if f.IPMulticastAddress != nil {
+		ies = append(ies, newFieldsIE(IPMulticastAddress, f.IPMulticastAddress))
+	}
+	for _, v := range f.SourceIPAddresses {
+		ies = append(ies, newFieldsIE(SourceIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(LeaveIPMulticastInformationWithinUsageReport, 0, ies...)
+}
+
+// CreatedBridgeInfoForTSCFields represents the fields in CreatedBridgeInfoForTSC IE.
+type CreatedBridgeInfoForTSCFields struct {
+	DSTTPortNumber *uint32
+	TSNBridgeID    net.HardwareAddr
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreatedBridgeInfoForTSCFields parses b into CreatedBridgeInfoForTSCFields.
+func ParseCreatedBridgeInfoForTSCFields(b []byte) (*CreatedBridgeInfoForTSCFields, error) {
+	f := &CreatedBridgeInfoForTSCFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreatedBridgeInfoForTSCFields.
+func (f *CreatedBridgeInfoForTSCFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreatedBridgeInfoForTSCFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case DSTTPortNumber:
+			v, err := i.DSTTPortNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedBridgeInfoForTSC, Type: i.Type, Err: err}
+			}
+			f.DSTTPortNumber = &v
+		case TSNBridgeID:
+			v, err := i.TSNBridgeID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreatedBridgeInfoForTSC, Type: i.Type, Err: err}
+			}
+			f.TSNBridgeID = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns CreatedBridgeInfoForTSC IE built from the fields.
+func (f *CreatedBridgeInfoForTSCFields) ToIE() *IE {
+	var ies []*IE
+	if f.DSTTPortNumber != nil {
+		ies = append(ies, NewDSTTPortNumber(*f.DSTTPortNumber))
+	}
+	if f.TSNBridgeID != nil {
+		ies = append(ies, NewTSNBridgeID(f.TSNBridgeID))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreatedBridgeInfoForTSC, 0, ies...)
+}
+
+// TSCManagementInformationWithinSessionModificationRequestFields represents the fields in TSCManagementInformationWithinSessionModificationRequest IE.
+type TSCManagementInformationWithinSessionModificationRequestFields struct {
+	PortManagementInformationContainer   *string
+	BridgeManagementInformationContainer *string
+	NWTTPortNumber                       *uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseTSCManagementInformationWithinSessionModificationRequestFields parses b into TSCManagementInformationWithinSessionModificationRequestFields.
+func ParseTSCManagementInformationWithinSessionModificationRequestFields(b []byte) (*TSCManagementInformationWithinSessionModificationRequestFields, error) {
+	f := &TSCManagementInformationWithinSessionModificationRequestFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into TSCManagementInformationWithinSessionModificationRequestFields.
+func (f *TSCManagementInformationWithinSessionModificationRequestFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *TSCManagementInformationWithinSessionModificationRequestFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PortManagementInformationContainer:
+			v, err := i.PortManagementInformationContainer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.PortManagementInformationContainer = &v
+		case BridgeManagementInformationContainer:
+			v, err := i.BridgeManagementInformationContainer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.BridgeManagementInformationContainer = &v
+		case NWTTPortNumber:
+			v, err := i.NWTTPortNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.NWTTPortNumber = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns TSCManagementInformationWithinSessionModificationRequest IE built from the fields.
+func (f *TSCManagementInformationWithinSessionModificationRequestFields) ToIE() *IE {
+	var ies []*IE
+	if f.PortManagementInformationContainer != nil {
+		ies = append(ies, NewPortManagementInformationContainer(*f.PortManagementInformationContainer))
+	}
+	if f.BridgeManagementInformationContainer != nil {
+		ies = append(ies, NewBridgeManagementInformationContainer(*f.BridgeManagementInformationContainer))
+	}
+	if f.NWTTPortNumber != nil {
+		ies = append(ies, NewNWTTPortNumber(*f.NWTTPortNumber))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(TSCManagementInformationWithinSessionModificationRequest, 0, ies...)
+}
+
+// TSCManagementInformationWithinSessionModificationResponseFields represents the fields in TSCManagementInformationWithinSessionModificationResponse IE.
+type TSCManagementInformationWithinSessionModificationResponseFields struct {
+	PortManagementInformationContainer   *string
+	BridgeManagementInformationContainer *string
+	NWTTPortNumber                       *uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseTSCManagementInformationWithinSessionModificationResponseFields parses b into TSCManagementInformationWithinSessionModificationResponseFields.
+func ParseTSCManagementInformationWithinSessionModificationResponseFields(b []byte) (*TSCManagementInformationWithinSessionModificationResponseFields, error) {
+	f := &TSCManagementInformationWithinSessionModificationResponseFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into TSCManagementInformationWithinSessionModificationResponseFields.
+func (f *TSCManagementInformationWithinSessionModificationResponseFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *TSCManagementInformationWithinSessionModificationResponseFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PortManagementInformationContainer:
+			v, err := i.PortManagementInformationContainer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.PortManagementInformationContainer = &v
+		case BridgeManagementInformationContainer:
+			v, err := i.BridgeManagementInformationContainer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.BridgeManagementInformationContainer = &v
+		case NWTTPortNumber:
+			v, err := i.NWTTPortNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.NWTTPortNumber = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns TSCManagementInformationWithinSessionModificationResponse IE built from the fields.
+func (f *TSCManagementInformationWithinSessionModificationResponseFields) ToIE() *IE {
+	var ies []*IE
+	if f.PortManagementInformationContainer != nil {
+		ies = append(ies, NewPortManagementInformationContainer(*f.PortManagementInformationContainer))
+	}
+	if f.BridgeManagementInformationContainer != nil {
+		ies = append(ies, NewBridgeManagementInformationContainer(*f.BridgeManagementInformationContainer))
+	}
+	if f.NWTTPortNumber != nil {
+		ies = append(ies, NewNWTTPortNumber(*f.NWTTPortNumber))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(TSCManagementInformationWithinSessionModificationResponse, 0, ies...)
+}
+
+// TSCManagementInformationWithinSessionReportRequestFields represents the fields in TSCManagementInformationWithinSessionReportRequest IE.
+type TSCManagementInformationWithinSessionReportRequestFields struct {
+	PortManagementInformationContainer   *string
+	BridgeManagementInformationContainer *string
+	NWTTPortNumber                       *uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseTSCManagementInformationWithinSessionReportRequestFields parses b into TSCManagementInformationWithinSessionReportRequestFields.
+func ParseTSCManagementInformationWithinSessionReportRequestFields(b []byte) (*TSCManagementInformationWithinSessionReportRequestFields, error) {
+	f := &TSCManagementInformationWithinSessionReportRequestFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into TSCManagementInformationWithinSessionReportRequestFields.
+func (f *TSCManagementInformationWithinSessionReportRequestFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *TSCManagementInformationWithinSessionReportRequestFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PortManagementInformationContainer:
+			v, err := i.PortManagementInformationContainer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.PortManagementInformationContainer = &v
+		case BridgeManagementInformationContainer:
+			v, err := i.BridgeManagementInformationContainer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.BridgeManagementInformationContainer = &v
+		case NWTTPortNumber:
+			v, err := i.NWTTPortNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: TSCManagementInformationWithinSessionReportRequest, Type: i.Type, Err: err}
+			}
+			f.NWTTPortNumber = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns TSCManagementInformationWithinSessionReportRequest IE built from the fields.
+func (f *TSCManagementInformationWithinSessionReportRequestFields) ToIE() *IE {
+	var ies []*IE
+	if f.PortManagementInformationContainer != nil {
+		ies = append(ies, NewPortManagementInformationContainer(*f.PortManagementInformationContainer))
+	}
+	if f.BridgeManagementInformationContainer != nil {
+		ies = append(ies, NewBridgeManagementInformationContainer(*f.BridgeManagementInformationContainer))
+	}
+	if f.NWTTPortNumber != nil {
+		ies = append(ies, NewNWTTPortNumber(*f.NWTTPortNumber))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(TSCManagementInformationWithinSessionReportRequest, 0, ies...)
+}
+
+// ClockDriftControlInformationFields represents the fields in ClockDriftControlInformation IE.
+type ClockDriftControlInformationFields struct {
+	RequestedClockDriftInformation uint8
+	TSNTimeDomainNumbers           []uint8
+	TimeOffsetThreshold            *time.Duration
+	CumulativeRateRatioThreshold   *uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseClockDriftControlInformationFields parses b into ClockDriftControlInformationFields.
+func ParseClockDriftControlInformationFields(b []byte) (*ClockDriftControlInformationFields, error) {
+	f := &ClockDriftControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ClockDriftControlInformationFields.
+func (f *ClockDriftControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ClockDriftControlInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasRequestedClockDriftInformation bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RequestedClockDriftInformation:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftControlInformation, Type: i.Type, Err: err}
+			}
+			f.RequestedClockDriftInformation = v
+			hasRequestedClockDriftInformation = true
+		case TSNTimeDomainNumber:
+			v, err := i.TSNTimeDomainNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftControlInformation, Type: i.Type, Err: err}
+			}
+			f.TSNTimeDomainNumbers = append(f.TSNTimeDomainNumbers, v)
+		case TimeOffsetThreshold:
+			v, err := i.TimeOffsetThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftControlInformation, Type: i.Type, Err: err}
+			}
+			f.TimeOffsetThreshold = &v
+		case CumulativeRateRatioThreshold:
+			v, err := i.CumulativeRateRatioThreshold()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftControlInformation, Type: i.Type, Err: err}
+			}
+			f.CumulativeRateRatioThreshold = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasRequestedClockDriftInformation {
+		return &GroupedFieldError{Parent: ClockDriftControlInformation, Type: RequestedClockDriftInformation, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ClockDriftControlInformation IE built from the fields.
+func (f *ClockDriftControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, newUint8ValIE(RequestedClockDriftInformation, f.RequestedClockDriftInformation))
+	for _, v := range f.TSNTimeDomainNumbers {
+		ies = append(ies, NewTSNTimeDomainNumber(v))
+	}
+	if f.TimeOffsetThreshold != nil {
+		ies = append(ies, NewTimeOffsetThreshold(*f.TimeOffsetThreshold))
+	}
+	if f.CumulativeRateRatioThreshold != nil {
+		ies = append(ies, NewCumulativeRateRatioThreshold(*f.CumulativeRateRatioThreshold))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ClockDriftControlInformation, 0, ies...)
+}
+
+// ClockDriftReportFields represents the fields in ClockDriftReport IE.
+type ClockDriftReportFields struct {
+	TSNTimeDomainNumber            uint8
+	TimeOffsetMeasurement          *time.Duration
+	CumulativeRateRatioMeasurement *uint32
+	EventTimeStamp                 *time.Time
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseClockDriftReportFields parses b into ClockDriftReportFields.
+func ParseClockDriftReportFields(b []byte) (*ClockDriftReportFields, error) {
+	f := &ClockDriftReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ClockDriftReportFields.
+func (f *ClockDriftReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ClockDriftReportFields) unmarshalIEs(ies []*IE) error {
+	var hasTSNTimeDomainNumber bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case TSNTimeDomainNumber:
+			v, err := i.TSNTimeDomainNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftReport, Type: i.Type, Err: err}
+			}
+			f.TSNTimeDomainNumber = v
+			hasTSNTimeDomainNumber = true
+		case TimeOffsetMeasurement:
+			v, err := i.TimeOffsetMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftReport, Type: i.Type, Err: err}
+			}
+			f.TimeOffsetMeasurement = &v
+		case CumulativeRateRatioMeasurement:
+			v, err := i.CumulativeRateRatioMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftReport, Type: i.Type, Err: err}
+			}
+			f.CumulativeRateRatioMeasurement = &v
+		case EventTimeStamp:
+			v, err := i.EventTimeStamp()
+			if err != nil {
+				return &GroupedFieldError{Parent: ClockDriftReport, Type: i.Type, Err: err}
+			}
+			f.EventTimeStamp = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasTSNTimeDomainNumber {
+		return &GroupedFieldError{Parent: ClockDriftReport, Type: TSNTimeDomainNumber, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ClockDriftReport IE built from the fields.
+func (f *ClockDriftReportFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewTSNTimeDomainNumber(f.TSNTimeDomainNumber))
+	if f.TimeOffsetMeasurement != nil {
+		ies = append(ies, NewTimeOffsetMeasurement(*f.TimeOffsetMeasurement))
+	}
+	if f.CumulativeRateRatioMeasurement != nil {
+		ies = append(ies, NewCumulativeRateRatioMeasurement(*f.CumulativeRateRatioMeasurement))
+	}
+	if f.EventTimeStamp != nil {
+		ies = append(ies, NewEventTimeStamp(*f.EventTimeStamp))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ClockDriftReport, 0, ies...)
+}
+
+// RemoveSRRFields represents the fields in RemoveSRR IE.
+type RemoveSRRFields struct {
+	SRRID uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRemoveSRRFields parses b into RemoveSRRFields.
+func ParseRemoveSRRFields(b []byte) (*RemoveSRRFields, error) {
+	f := &RemoveSRRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RemoveSRRFields.
+func (f *RemoveSRRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RemoveSRRFields) unmarshalIEs(ies []*IE) error {
+	var hasSRRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SRRID:
+			v, err := i.SRRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RemoveSRR, Type: i.Type, Err: err}
+			}
+			f.SRRID = v
+			hasSRRID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSRRID {
+		return &GroupedFieldError{Parent: RemoveSRR, Type: SRRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RemoveSRR IE built from the fields.
+func (f *RemoveSRRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSRRID(f.SRRID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RemoveSRR, 0, ies...)
+}
+
+// CreateSRRFields represents the fields in CreateSRR IE.
+type CreateSRRFields struct {
+	SRRID                                     uint8
+	AccessAvailabilityControlInformation      *AccessAvailabilityControlInformationFields
+	QoSMonitoringPerQoSFlowControlInformation []*QoSMonitoringPerQoSFlowControlInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseCreateSRRFields parses b into CreateSRRFields.
+func ParseCreateSRRFields(b []byte) (*CreateSRRFields, error) {
+	f := &CreateSRRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into CreateSRRFields.
+func (f *CreateSRRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *CreateSRRFields) unmarshalIEs(ies []*IE) error {
+	var hasSRRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SRRID:
+			v, err := i.SRRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: CreateSRR, Type: i.Type, Err: err}
+			}
+			f.SRRID = v
+			hasSRRID = true
+		case AccessAvailabilityControlInformation:
+			v := &AccessAvailabilityControlInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateSRR, Type: i.Type, Err: err}
+			}
+			f.AccessAvailabilityControlInformation = v
+		case QoSMonitoringPerQoSFlowControlInformation:
+			v := &QoSMonitoringPerQoSFlowControlInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: CreateSRR, Type: i.Type, Err: err}
+			}
+			f.QoSMonitoringPerQoSFlowControlInformation = append(f.QoSMonitoringPerQoSFlowControlInformation, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSRRID {
+		return &GroupedFieldError{Parent: CreateSRR, Type: SRRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns CreateSRR IE built from the fields.
+func (f *CreateSRRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSRRID(f.SRRID))
+	if f.AccessAvailabilityControlInformation != nil {
+		ies = append(ies, f.AccessAvailabilityControlInformation.ToIE())
+	}
+	for _, v := range f.QoSMonitoringPerQoSFlowControlInformation {
+		ies = append(ies, v.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(CreateSRR, 0, ies...)
+}
+
+// UpdateSRRFields represents the fields in UpdateSRR IE.
+type UpdateSRRFields struct {
+	SRRID                                     uint8
+	AccessAvailabilityControlInformation      *AccessAvailabilityControlInformationFields
+	QoSMonitoringPerQoSFlowControlInformation []*QoSMonitoringPerQoSFlowControlInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdateSRRFields parses b into UpdateSRRFields.
+func ParseUpdateSRRFields(b []byte) (*UpdateSRRFields, error) {
+	f := &UpdateSRRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdateSRRFields.
+func (f *UpdateSRRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdateSRRFields) unmarshalIEs(ies []*IE) error {
+	var hasSRRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SRRID:
+			v, err := i.SRRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdateSRR, Type: i.Type, Err: err}
+			}
+			f.SRRID = v
+			hasSRRID = true
+		case AccessAvailabilityControlInformation:
+			v := &AccessAvailabilityControlInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateSRR, Type: i.Type, Err: err}
+			}
+			f.AccessAvailabilityControlInformation = v
+		case QoSMonitoringPerQoSFlowControlInformation:
+			v := &QoSMonitoringPerQoSFlowControlInformationFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: UpdateSRR, Type: i.Type, Err: err}
+			}
+			f.QoSMonitoringPerQoSFlowControlInformation = append(f.QoSMonitoringPerQoSFlowControlInformation, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSRRID {
+		return &GroupedFieldError{Parent: UpdateSRR, Type: SRRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdateSRR IE built from the fields.
+func (f *UpdateSRRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSRRID(f.SRRID))
+	if f.AccessAvailabilityControlInformation != nil {
+		ies = append(ies, f.AccessAvailabilityControlInformation.ToIE())
+	}
+	for _, v := range f.QoSMonitoringPerQoSFlowControlInformation {
+		ies = append(ies, v.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdateSRR, 0, ies...)
+}
+
+// SessionReportFields represents the fields in SessionReport IE.
+type SessionReportFields struct {
+	SRRID                    uint8
+	AccessAvailabilityReport *AccessAvailabilityReportFields
+	QoSMonitoringReports     []*QoSMonitoringReportFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseSessionReportFields parses b into SessionReportFields.
+func ParseSessionReportFields(b []byte) (*SessionReportFields, error) {
+	f := &SessionReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into SessionReportFields.
+func (f *SessionReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *SessionReportFields) unmarshalIEs(ies []*IE) error {
+	var hasSRRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SRRID:
+			v, err := i.SRRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: SessionReport, Type: i.Type, Err: err}
+			}
+			f.SRRID = v
+			hasSRRID = true
+		case AccessAvailabilityReport:
+			v := &AccessAvailabilityReportFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: SessionReport, Type: i.Type, Err: err}
+			}
+			f.AccessAvailabilityReport = v
+		case QoSMonitoringReport:
+			v := &QoSMonitoringReportFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: SessionReport, Type: i.Type, Err: err}
+			}
+			f.QoSMonitoringReports = append(f.QoSMonitoringReports, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSRRID {
+		return &GroupedFieldError{Parent: SessionReport, Type: SRRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns SessionReport IE built from the fields.
+func (f *SessionReportFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSRRID(f.SRRID))
+	if f.AccessAvailabilityReport != nil {
+		ies = append(ies, f.AccessAvailabilityReport.ToIE())
+	}
+	for _, v := range f.QoSMonitoringReports {
+		ies = append(ies, v.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(SessionReport, 0, ies...)
+}
+
+// AccessAvailabilityControlInformationFields represents the fields in AccessAvailabilityControlInformation IE.
+type AccessAvailabilityControlInformationFields struct {
+	RequestedAccessAvailabilityInformation uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseAccessAvailabilityControlInformationFields parses b into AccessAvailabilityControlInformationFields.
+func ParseAccessAvailabilityControlInformationFields(b []byte) (*AccessAvailabilityControlInformationFields, error) {
+	f := &AccessAvailabilityControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into AccessAvailabilityControlInformationFields.
+func (f *AccessAvailabilityControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *AccessAvailabilityControlInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasRequestedAccessAvailabilityInformation bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RequestedAccessAvailabilityInformation:
+			v, err := i.RequestedAccessAvailabilityInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: AccessAvailabilityControlInformation, Type: i.Type, Err: err}
+			}
+			f.RequestedAccessAvailabilityInformation = v
+			hasRequestedAccessAvailabilityInformation = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasRequestedAccessAvailabilityInformation {
+		return &GroupedFieldError{Parent: AccessAvailabilityControlInformation, Type: RequestedAccessAvailabilityInformation, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns AccessAvailabilityControlInformation IE built from the fields.
+func (f *AccessAvailabilityControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewRequestedAccessAvailabilityInformation(f.RequestedAccessAvailabilityInformation))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(AccessAvailabilityControlInformation, 0, ies...)
+}
+
+// AccessAvailabilityReportFields represents the fields in AccessAvailabilityReport IE.
+type AccessAvailabilityReportFields struct {
+	AccessAvailabilityInformation uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseAccessAvailabilityReportFields parses b into AccessAvailabilityReportFields.
+func ParseAccessAvailabilityReportFields(b []byte) (*AccessAvailabilityReportFields, error) {
+	f := &AccessAvailabilityReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into AccessAvailabilityReportFields.
+func (f *AccessAvailabilityReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *AccessAvailabilityReportFields) unmarshalIEs(ies []*IE) error {
+	var hasAccessAvailabilityInformation bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case AccessAvailabilityInformation:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: AccessAvailabilityReport, Type: i.Type, Err: err}
+			}
+			f.AccessAvailabilityInformation = v
+			hasAccessAvailabilityInformation = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasAccessAvailabilityInformation {
+		return &GroupedFieldError{Parent: AccessAvailabilityReport, Type: AccessAvailabilityInformation, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns AccessAvailabilityReport IE built from the fields.
+func (f *AccessAvailabilityReportFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, newUint8ValIE(AccessAvailabilityInformation, f.AccessAvailabilityInformation))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(AccessAvailabilityReport, 0, ies...)
+}
+
+// ProvideATSSSControlInformationFields represents the fields in ProvideATSSSControlInformation IE.
+type ProvideATSSSControlInformationFields struct {
+	MPTCPControlInformation   *uint8
+	ATSSSLLControlInformation *uint8
+	PMFControlInformation     *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseProvideATSSSControlInformationFields parses b into ProvideATSSSControlInformationFields.
+func ParseProvideATSSSControlInformationFields(b []byte) (*ProvideATSSSControlInformationFields, error) {
+	f := &ProvideATSSSControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ProvideATSSSControlInformationFields.
+func (f *ProvideATSSSControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ProvideATSSSControlInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MPTCPControlInformation:
+			v, err := i.MPTCPControlInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ProvideATSSSControlInformation, Type: i.Type, Err: err}
+			}
+			f.MPTCPControlInformation = &v
+		case ATSSSLLControlInformation:
+			v, err := i.ATSSSLLControlInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ProvideATSSSControlInformation, Type: i.Type, Err: err}
+			}
+			f.ATSSSLLControlInformation = &v
+		case PMFControlInformation:
+			v, err := i.PMFControlInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ProvideATSSSControlInformation, Type: i.Type, Err: err}
+			}
+			f.PMFControlInformation = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns ProvideATSSSControlInformation IE built from the fields.
+func (f *ProvideATSSSControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	if f.MPTCPControlInformation != nil {
+		ies = append(ies, NewMPTCPControlInformation(*f.MPTCPControlInformation))
+	}
+	if f.ATSSSLLControlInformation != nil {
+		ies = append(ies, NewATSSSLLControlInformation(*f.ATSSSLLControlInformation))
+	}
+	if f.PMFControlInformation != nil {
+		ies = append(ies, NewPMFControlInformation(*f.PMFControlInformation))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ProvideATSSSControlInformation, 0, ies...)
+}
+
+// ATSSSControlParametersFields represents the fields in ATSSSControlParameters IE.
+type ATSSSControlParametersFields struct {
+	MPTCPParameters   *MPTCPParametersFields
+	ATSSSLLParameters *ATSSSLLParametersFields
+	PMFParameters     *PMFParametersFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseATSSSControlParametersFields parses b into ATSSSControlParametersFields.
+func ParseATSSSControlParametersFields(b []byte) (*ATSSSControlParametersFields, error) {
+	f := &ATSSSControlParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ATSSSControlParametersFields.
+func (f *ATSSSControlParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ATSSSControlParametersFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MPTCPParameters:
+			v := &MPTCPParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: ATSSSControlParameters, Type: i.Type, Err: err}
+			}
+			f.MPTCPParameters = v
+		case ATSSSLLParameters:
+			v := &ATSSSLLParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: ATSSSControlParameters, Type: i.Type, Err: err}
+			}
+			f.ATSSSLLParameters = v
+		case PMFParameters:
+			v := &PMFParametersFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: ATSSSControlParameters, Type: i.Type, Err: err}
+			}
+			f.PMFParameters = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns ATSSSControlParameters IE built from the fields.
+func (f *ATSSSControlParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.MPTCPParameters != nil {
+		ies = append(ies, f.MPTCPParameters.ToIE())
+	}
+	if f.ATSSSLLParameters != nil {
+		ies = append(ies, f.ATSSSLLParameters.ToIE())
+	}
+	if f.PMFParameters != nil {
+		ies = append(ies, f.PMFParameters.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ATSSSControlParameters, 0, ies...)
+}
+
+// MPTCPParametersFields represents the fields in MPTCPParameters IE.
+type MPTCPParametersFields struct {
+	MPTCPAddressInformation *MPTCPAddressInformationFields
+	UELinkSpecificIPAddress *UELinkSpecificIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseMPTCPParametersFields parses b into MPTCPParametersFields.
+func ParseMPTCPParametersFields(b []byte) (*MPTCPParametersFields, error) {
+	f := &MPTCPParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into MPTCPParametersFields.
+func (f *MPTCPParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *MPTCPParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasMPTCPAddressInformation bool
+	var hasUELinkSpecificIPAddress bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MPTCPAddressInformation:
+			v, err := i.MPTCPAddressInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: MPTCPParameters, Type: i.Type, Err: err}
+			}
+			f.MPTCPAddressInformation = v
+			hasMPTCPAddressInformation = true
+		case UELinkSpecificIPAddress:
+			v, err := i.UELinkSpecificIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: MPTCPParameters, Type: i.Type, Err: err}
+			}
+			f.UELinkSpecificIPAddress = v
+			hasUELinkSpecificIPAddress = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasMPTCPAddressInformation {
+		return &GroupedFieldError{Parent: MPTCPParameters, Type: MPTCPAddressInformation, Err: ErrIENotFound}
+	}
+	if !hasUELinkSpecificIPAddress {
+		return &GroupedFieldError{Parent: MPTCPParameters, Type: UELinkSpecificIPAddress, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns MPTCPParameters IE built from the fields.
+func (f *MPTCPParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.MPTCPAddressInformation != nil {
+		ies = append(ies, newFieldsIE(MPTCPAddressInformation, f.MPTCPAddressInformation))
+	}
+	if f.UELinkSpecificIPAddress != nil {
+		ies = append(ies, newFieldsIE(UELinkSpecificIPAddress, f.UELinkSpecificIPAddress))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(MPTCPParameters, 0, ies...)
+}
+
+// ATSSSLLParametersFields represents the fields in ATSSSLLParameters IE.
+type ATSSSLLParametersFields struct {
+	ATSSSLLInformation uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseATSSSLLParametersFields parses b into ATSSSLLParametersFields.
+func ParseATSSSLLParametersFields(b []byte) (*ATSSSLLParametersFields, error) {
+	f := &ATSSSLLParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ATSSSLLParametersFields.
+func (f *ATSSSLLParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ATSSSLLParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasATSSSLLInformation bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case ATSSSLLInformation:
+			v, err := i.ATSSSLLInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ATSSSLLParameters, Type: i.Type, Err: err}
+			}
+			f.ATSSSLLInformation = v
+			hasATSSSLLInformation = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasATSSSLLInformation {
+		return &GroupedFieldError{Parent: ATSSSLLParameters, Type: ATSSSLLInformation, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns ATSSSLLParameters IE built from the fields.
+func (f *ATSSSLLParametersFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewATSSSLLInformation(f.ATSSSLLInformation))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ATSSSLLParameters, 0, ies...)
+}
+
+// PMFParametersFields represents the fields in PMFParameters IE.
+type PMFParametersFields struct {
+	PMFAddressInformation *PMFAddressInformationFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParsePMFParametersFields parses b into PMFParametersFields.
+func ParsePMFParametersFields(b []byte) (*PMFParametersFields, error) {
+	f := &PMFParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into PMFParametersFields.
+func (f *PMFParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *PMFParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasPMFAddressInformation bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PMFAddressInformation:
+			v, err := i.PMFAddressInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: PMFParameters, Type: i.Type, Err: err}
+			}
+			f.PMFAddressInformation = v
+			hasPMFAddressInformation = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPMFAddressInformation {
+		return &GroupedFieldError{Parent: PMFParameters, Type: PMFAddressInformation, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns PMFParameters IE built from the fields.
+func (f *PMFParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.PMFAddressInformation != nil {
+		ies = append(ies, newFieldsIE(PMFAddressInformation, f.PMFAddressInformation))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(PMFParameters, 0, ies...)
+}
+
+// UEIPAddressPoolInformationFields represents the fields in UEIPAddressPoolInformation IE.
+type UEIPAddressPoolInformationFields struct {
+	UEIPAddressPoolIdentities [][]byte
+	NetworkInstance           *string
+	SNSSAIs                   [][]byte
+	IPVersion                 *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUEIPAddressPoolInformationFields parses b into UEIPAddressPoolInformationFields.
+func ParseUEIPAddressPoolInformationFields(b []byte) (*UEIPAddressPoolInformationFields, error) {
+	f := &UEIPAddressPoolInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UEIPAddressPoolInformationFields.
+func (f *UEIPAddressPoolInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UEIPAddressPoolInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case UEIPAddressPoolIdentity:
+			v, err := i.UEIPAddressPoolIdentity()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressPoolInformation, Type: i.Type, Err: err}
+			}
+			f.UEIPAddressPoolIdentities = append(f.UEIPAddressPoolIdentities, v)
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressPoolInformation, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		case SNSSAI:
+			v, err := i.SNSSAI()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressPoolInformation, Type: i.Type, Err: err}
+			}
+			f.SNSSAIs = append(f.SNSSAIs, v)
+		case IPVersion:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressPoolInformation, Type: i.Type, Err: err}
+			}
+			f.IPVersion = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if len(f.UEIPAddressPoolIdentities) == 0 {
+		return &GroupedFieldError{Parent: UEIPAddressPoolInformation, Type: UEIPAddressPoolIdentity, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UEIPAddressPoolInformation IE built from the fields.
+func (f *UEIPAddressPoolInformationFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.UEIPAddressPoolIdentities {
+		ies = append(ies, New(UEIPAddressPoolIdentity, v))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	for _, v := range f.SNSSAIs {
+		ies = append(ies, New(SNSSAI, v))
+	}
+	if f.IPVersion != nil {
+		ies = append(ies, newUint8ValIE(IPVersion, *f.IPVersion))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UEIPAddressPoolInformation, 0, ies...)
+}
+
+// GTPUPathQoSControlInformationFields represents the fields in GTPUPathQoSControlInformation IE.
+type GTPUPathQoSControlInformationFields struct {
+	RemoteGTPUPeers       []*RemoteGTPUPeerFields
+	GTPUPathInterfaceType *uint8
+	QoSReportTrigger      uint8
+	TransportLevelMarking *uint16
+	MeasurementPeriod     *time.Duration
+	AveragePacketDelay    *time.Duration
+	MinimumPacketDelay    *time.Duration
+	MaximumPacketDelay    *time.Duration
+	MinimumWaitTime       *time.Duration
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseGTPUPathQoSControlInformationFields parses b into GTPUPathQoSControlInformationFields.
+func ParseGTPUPathQoSControlInformationFields(b []byte) (*GTPUPathQoSControlInformationFields, error) {
+	f := &GTPUPathQoSControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into GTPUPathQoSControlInformationFields.
+func (f *GTPUPathQoSControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *GTPUPathQoSControlInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasQoSReportTrigger bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RemoteGTPUPeer:
+			v, err := i.RemoteGTPUPeer()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.RemoteGTPUPeers = append(f.RemoteGTPUPeers, v)
+		case GTPUPathInterfaceType:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.GTPUPathInterfaceType = &v
+		case QoSReportTrigger:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.QoSReportTrigger = v
+			hasQoSReportTrigger = true
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		case MeasurementPeriod:
+			v, err := i.MeasurementPeriod()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.MeasurementPeriod = &v
+		case AveragePacketDelay:
+			v, err := i.AveragePacketDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.AveragePacketDelay = &v
+		case MinimumPacketDelay:
+			v, err := i.MinimumPacketDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.MinimumPacketDelay = &v
+		case MaximumPacketDelay:
+			v, err := i.MaximumPacketDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.MaximumPacketDelay = &v
+		case MinimumWaitTime:
+			v, err := i.MinimumWaitTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: i.Type, Err: err}
+			}
+			f.MinimumWaitTime = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQoSReportTrigger {
+		return &GroupedFieldError{Parent: GTPUPathQoSControlInformation, Type: QoSReportTrigger, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns GTPUPathQoSControlInformation IE built from the fields.
+func (f *GTPUPathQoSControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.RemoteGTPUPeers {
+		ies = append(ies, newFieldsIE(RemoteGTPUPeer, v))
+	}
+	if f.GTPUPathInterfaceType != nil {
+		ies = append(ies, newUint8ValIE(GTPUPathInterfaceType, *f.GTPUPathInterfaceType))
+	}
+	ies = append(ies, newUint8ValIE(QoSReportTrigger, f.QoSReportTrigger))
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	if f.MeasurementPeriod != nil {
+		ies = append(ies, NewMeasurementPeriod(*f.MeasurementPeriod))
+	}
+	if f.AveragePacketDelay != nil {
+		ies = append(ies, NewAveragePacketDelay(*f.AveragePacketDelay))
+	}
+	if f.MinimumPacketDelay != nil {
+		ies = append(ies, NewMinimumPacketDelay(*f.MinimumPacketDelay))
+	}
+	if f.MaximumPacketDelay != nil {
+		ies = append(ies, NewMaximumPacketDelay(*f.MaximumPacketDelay))
+	}
+	if f.MinimumWaitTime != nil {
+		ies = append(ies, NewMinimumWaitTime(*f.MinimumWaitTime))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(GTPUPathQoSControlInformation, 0, ies...)
+}
+
+// GTPUPathQoSReportFields represents the fields in GTPUPathQoSReport IE.
+type GTPUPathQoSReportFields struct {
+	RemoteGTPUPeer                     *RemoteGTPUPeerFields
+	GTPUPathInterfaceType              *uint8
+	QoSReportTrigger                   uint8
+	EventTimeStamp                     time.Time
+	StartTime                          *time.Time
+	QoSInformationInGTPUPathQoSReports []*QoSInformationInGTPUPathQoSReportFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseGTPUPathQoSReportFields parses b into GTPUPathQoSReportFields.
+func ParseGTPUPathQoSReportFields(b []byte) (*GTPUPathQoSReportFields, error) {
+	f := &GTPUPathQoSReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into GTPUPathQoSReportFields.
+func (f *GTPUPathQoSReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *GTPUPathQoSReportFields) unmarshalIEs(ies []*IE) error {
+	var hasRemoteGTPUPeer bool
+	var hasQoSReportTrigger bool
+	var hasEventTimeStamp bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RemoteGTPUPeer:
+			v, err := i.RemoteGTPUPeer()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.RemoteGTPUPeer = v
+			hasRemoteGTPUPeer = true
+		case GTPUPathInterfaceType:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.GTPUPathInterfaceType = &v
+		case QoSReportTrigger:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.QoSReportTrigger = v
+			hasQoSReportTrigger = true
+		case EventTimeStamp:
+			v, err := i.EventTimeStamp()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.EventTimeStamp = v
+			hasEventTimeStamp = true
+		case StartTime:
+			v, err := i.StartTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.StartTime = &v
+		case QoSInformationInGTPUPathQoSReport:
+			v := &QoSInformationInGTPUPathQoSReportFields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.QoSInformationInGTPUPathQoSReports = append(f.QoSInformationInGTPUPathQoSReports, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasRemoteGTPUPeer {
+		return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: RemoteGTPUPeer, Err: ErrIENotFound}
+	}
+	if !hasQoSReportTrigger {
+		return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: QoSReportTrigger, Err: ErrIENotFound}
+	}
+	if !hasEventTimeStamp {
+		return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: EventTimeStamp, Err: ErrIENotFound}
+	}
+	if len(f.QoSInformationInGTPUPathQoSReports) == 0 {
+		return &GroupedFieldError{Parent: GTPUPathQoSReport, Type: QoSInformationInGTPUPathQoSReport, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns GTPUPathQoSReport IE built from the fields.
+func (f *GTPUPathQoSReportFields) ToIE() *IE {
+	var ies []*IE
+	if f.RemoteGTPUPeer != nil {
+		ies = append(ies, newFieldsIE(RemoteGTPUPeer, f.RemoteGTPUPeer))
+	}
+	if f.GTPUPathInterfaceType != nil {
+		ies = append(ies, newUint8ValIE(GTPUPathInterfaceType, *f.GTPUPathInterfaceType))
+	}
+	ies = append(ies, newUint8ValIE(QoSReportTrigger, f.QoSReportTrigger))
+	ies = append(ies, NewEventTimeStamp(f.EventTimeStamp))
+	if f.StartTime != nil {
+		ies = append(ies, NewStartTime(*f.StartTime))
+	}
+	for _, v := range f.QoSInformationInGTPUPathQoSReports {
+		ies = append(ies, v.ToIE())
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(GTPUPathQoSReport, 0, ies...)
+}
+
+// QoSInformationInGTPUPathQoSReportFields represents the fields in QoSInformationInGTPUPathQoSReport IE.
+type QoSInformationInGTPUPathQoSReportFields struct {
+	AveragePacketDelay    time.Duration
+	MinimumPacketDelay    *time.Duration
+	MaximumPacketDelay    *time.Duration
+	TransportLevelMarking *uint16
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseQoSInformationInGTPUPathQoSReportFields parses b into QoSInformationInGTPUPathQoSReportFields.
+func ParseQoSInformationInGTPUPathQoSReportFields(b []byte) (*QoSInformationInGTPUPathQoSReportFields, error) {
+	f := &QoSInformationInGTPUPathQoSReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into QoSInformationInGTPUPathQoSReportFields.
+func (f *QoSInformationInGTPUPathQoSReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *QoSInformationInGTPUPathQoSReportFields) unmarshalIEs(ies []*IE) error {
+	var hasAveragePacketDelay bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case AveragePacketDelay:
+			v, err := i.AveragePacketDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSInformationInGTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.AveragePacketDelay = v
+			hasAveragePacketDelay = true
+		case MinimumPacketDelay:
+			v, err := i.MinimumPacketDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSInformationInGTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.MinimumPacketDelay = &v
+		case MaximumPacketDelay:
+			v, err := i.MaximumPacketDelay()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSInformationInGTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.MaximumPacketDelay = &v
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSInformationInGTPUPathQoSReport, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasAveragePacketDelay {
+		return &GroupedFieldError{Parent: QoSInformationInGTPUPathQoSReport, Type: AveragePacketDelay, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns QoSInformationInGTPUPathQoSReport IE built from the fields.
+func (f *QoSInformationInGTPUPathQoSReportFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewAveragePacketDelay(f.AveragePacketDelay))
+	if f.MinimumPacketDelay != nil {
+		ies = append(ies, NewMinimumPacketDelay(*f.MinimumPacketDelay))
+	}
+	if f.MaximumPacketDelay != nil {
+		ies = append(ies, NewMaximumPacketDelay(*f.MaximumPacketDelay))
+	}
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(QoSInformationInGTPUPathQoSReport, 0, ies...)
+}
+
+// QoSMonitoringPerQoSFlowControlInformationFields represents the fields in QoSMonitoringPerQoSFlowControlInformation IE.
+type QoSMonitoringPerQoSFlowControlInformationFields struct {
+	QFIs                   []uint8
+	RequestedQoSMonitoring uint8
+	ReportingFrequency     uint8
+	PacketDelayThresholds  *PacketDelayThresholdsFields
+	MinimumWaitTime        *time.Duration
+	MeasurementPeriod      *time.Duration
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseQoSMonitoringPerQoSFlowControlInformationFields parses b into QoSMonitoringPerQoSFlowControlInformationFields.
+func ParseQoSMonitoringPerQoSFlowControlInformationFields(b []byte) (*QoSMonitoringPerQoSFlowControlInformationFields, error) {
+	f := &QoSMonitoringPerQoSFlowControlInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into QoSMonitoringPerQoSFlowControlInformationFields.
+func (f *QoSMonitoringPerQoSFlowControlInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *QoSMonitoringPerQoSFlowControlInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasRequestedQoSMonitoring bool
+	var hasReportingFrequency bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: i.Type, Err: err}
+			}
+			f.QFIs = append(f.QFIs, v)
+		case RequestedQoSMonitoring:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: i.Type, Err: err}
+			}
+			f.RequestedQoSMonitoring = v
+			hasRequestedQoSMonitoring = true
+		case ReportingFrequency:
+			v, err := i.ValueAsUint8()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: i.Type, Err: err}
+			}
+			f.ReportingFrequency = v
+			hasReportingFrequency = true
+		case PacketDelayThresholds:
+			v, err := i.PacketDelayThresholds()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: i.Type, Err: err}
+			}
+			f.PacketDelayThresholds = v
+		case MinimumWaitTime:
+			v, err := i.MinimumWaitTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: i.Type, Err: err}
+			}
+			f.MinimumWaitTime = &v
+		case MeasurementPeriod:
+			v, err := i.MeasurementPeriod()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: i.Type, Err: err}
+			}
+			f.MeasurementPeriod = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if len(f.QFIs) == 0 {
+		return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: QFI, Err: ErrIENotFound}
+	}
+	if !hasRequestedQoSMonitoring {
+		return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: RequestedQoSMonitoring, Err: ErrIENotFound}
+	}
+	if !hasReportingFrequency {
+		return &GroupedFieldError{Parent: QoSMonitoringPerQoSFlowControlInformation, Type: ReportingFrequency, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns QoSMonitoringPerQoSFlowControlInformation IE built from the fields.
+func (f *QoSMonitoringPerQoSFlowControlInformationFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.QFIs {
+		ies = append(ies, NewQFI(v))
+	}
+	ies = append(ies, newUint8ValIE(RequestedQoSMonitoring, f.RequestedQoSMonitoring))
+	ies = append(ies, newUint8ValIE(ReportingFrequency, f.ReportingFrequency))
+	if f.PacketDelayThresholds != nil {
+		ies = append(ies, newFieldsIE(PacketDelayThresholds, f.PacketDelayThresholds))
+	}
+	if f.MinimumWaitTime != nil {
+		ies = append(ies, NewMinimumWaitTime(*f.MinimumWaitTime))
+	}
+	if f.MeasurementPeriod != nil {
+		ies = append(ies, NewMeasurementPeriod(*f.MeasurementPeriod))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(QoSMonitoringPerQoSFlowControlInformation, 0, ies...)
+}
+
+// QoSMonitoringReportFields represents the fields in QoSMonitoringReport IE.
+type QoSMonitoringReportFields struct {
+	QFI                      uint8
+	QoSMonitoringMeasurement *QoSMonitoringMeasurementFields
+	EventTimeStamp           time.Time
+	StartTime                *time.Time
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseQoSMonitoringReportFields parses b into QoSMonitoringReportFields.
+func ParseQoSMonitoringReportFields(b []byte) (*QoSMonitoringReportFields, error) {
+	f := &QoSMonitoringReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into QoSMonitoringReportFields.
+func (f *QoSMonitoringReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *QoSMonitoringReportFields) unmarshalIEs(ies []*IE) error {
+	var hasQFI bool
+	var hasQoSMonitoringMeasurement bool
+	var hasEventTimeStamp bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QFI:
+			v, err := i.QFI()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringReport, Type: i.Type, Err: err}
+			}
+			f.QFI = v
+			hasQFI = true
+		case QoSMonitoringMeasurement:
+			v, err := i.QoSMonitoringMeasurement()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringReport, Type: i.Type, Err: err}
+			}
+			f.QoSMonitoringMeasurement = v
+			hasQoSMonitoringMeasurement = true
+		case EventTimeStamp:
+			v, err := i.EventTimeStamp()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringReport, Type: i.Type, Err: err}
+			}
+			f.EventTimeStamp = v
+			hasEventTimeStamp = true
+		case StartTime:
+			v, err := i.StartTime()
+			if err != nil {
+				return &GroupedFieldError{Parent: QoSMonitoringReport, Type: i.Type, Err: err}
+			}
+			f.StartTime = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQFI {
+		return &GroupedFieldError{Parent: QoSMonitoringReport, Type: QFI, Err: ErrIENotFound}
+	}
+	if !hasQoSMonitoringMeasurement {
+		return &GroupedFieldError{Parent: QoSMonitoringReport, Type: QoSMonitoringMeasurement, Err: ErrIENotFound}
+	}
+	if !hasEventTimeStamp {
+		return &GroupedFieldError{Parent: QoSMonitoringReport, Type: EventTimeStamp, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns QoSMonitoringReport IE built from the fields.
+func (f *QoSMonitoringReportFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQFI(f.QFI))
+	if f.QoSMonitoringMeasurement != nil {
+		ies = append(ies, newFieldsIE(QoSMonitoringMeasurement, f.QoSMonitoringMeasurement))
+	}
+	ies = append(ies, NewEventTimeStamp(f.EventTimeStamp))
+	if f.StartTime != nil {
+		ies = append(ies, NewStartTime(*f.StartTime))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(QoSMonitoringReport, 0, ies...)
+}
+
+// PacketRateStatusReportFields represents the fields in PacketRateStatusReport IE.
+type PacketRateStatusReportFields struct {
+	QERID            uint32
+	PacketRateStatus *PacketRateStatusFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParsePacketRateStatusReportFields parses b into PacketRateStatusReportFields.
+func ParsePacketRateStatusReportFields(b []byte) (*PacketRateStatusReportFields, error) {
+	f := &PacketRateStatusReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into PacketRateStatusReportFields.
+func (f *PacketRateStatusReportFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *PacketRateStatusReportFields) unmarshalIEs(ies []*IE) error {
+	var hasQERID bool
+	var hasPacketRateStatus bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: PacketRateStatusReport, Type: i.Type, Err: err}
+			}
+			f.QERID = v
+			hasQERID = true
+		case PacketRateStatus:
+			v, err := i.PacketRateStatus()
+			if err != nil {
+				return &GroupedFieldError{Parent: PacketRateStatusReport, Type: i.Type, Err: err}
+			}
+			f.PacketRateStatus = v
+			hasPacketRateStatus = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQERID {
+		return &GroupedFieldError{Parent: PacketRateStatusReport, Type: QERID, Err: ErrIENotFound}
+	}
+	if !hasPacketRateStatus {
+		return &GroupedFieldError{Parent: PacketRateStatusReport, Type: PacketRateStatus, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns PacketRateStatusReport IE built from the fields.
+func (f *PacketRateStatusReportFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQERID(f.QERID))
+	if f.PacketRateStatus != nil {
+		ies = append(ies, newFieldsIE(PacketRateStatus, f.PacketRateStatus))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(PacketRateStatusReport, 0, ies...)
+}
+
+// EthernetContextInformationFields represents the fields in EthernetContextInformation IE.
+type EthernetContextInformationFields struct {
+	MACAddressesDetected []*MACAddressesDetectedFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseEthernetContextInformationFields parses b into EthernetContextInformationFields.
+func ParseEthernetContextInformationFields(b []byte) (*EthernetContextInformationFields, error) {
+	f := &EthernetContextInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into EthernetContextInformationFields.
+func (f *EthernetContextInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *EthernetContextInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case MACAddressesDetected:
+			v, err := i.MACAddressesDetected()
+			if err != nil {
+				return &GroupedFieldError{Parent: EthernetContextInformation, Type: i.Type, Err: err}
+			}
+			f.MACAddressesDetected = append(f.MACAddressesDetected, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if len(f.MACAddressesDetected) == 0 {
+		return &GroupedFieldError{Parent: EthernetContextInformation, Type: MACAddressesDetected, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns EthernetContextInformation IE built from the fields.
+func (f *EthernetContextInformationFields) ToIE() *IE {
+	var ies []*IE
+	for _, v := range f.MACAddressesDetected {
+		ies = append(ies, newFieldsIE(MACAddressesDetected, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(EthernetContextInformation, 0, ies...)
+}
+
+// RedundantTransmissionParametersFields represents the fields in RedundantTransmissionParameters IE.
+type RedundantTransmissionParametersFields struct {
+	FTEID           *FTEIDFields
+	NetworkInstance *string
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRedundantTransmissionParametersFields parses b into RedundantTransmissionParametersFields.
+func ParseRedundantTransmissionParametersFields(b []byte) (*RedundantTransmissionParametersFields, error) {
+	f := &RedundantTransmissionParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RedundantTransmissionParametersFields.
+func (f *RedundantTransmissionParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RedundantTransmissionParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasFTEID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: RedundantTransmissionParameters, Type: i.Type, Err: err}
+			}
+			f.FTEID = v
+			hasFTEID = true
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: RedundantTransmissionParameters, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasFTEID {
+		return &GroupedFieldError{Parent: RedundantTransmissionParameters, Type: FTEID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RedundantTransmissionParameters IE built from the fields.
+func (f *RedundantTransmissionParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.FTEID != nil {
+		ies = append(ies, newFieldsIE(FTEID, f.FTEID))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RedundantTransmissionParameters, 0, ies...)
+}
+
+// UpdatedPDRFields represents the fields in UpdatedPDR IE.
+type UpdatedPDRFields struct {
+	PDRID         uint16
+	LocalFTEIDs   []*FTEIDFields
+	UEIPAddresses []*UEIPAddressFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUpdatedPDRFields parses b into UpdatedPDRFields.
+func ParseUpdatedPDRFields(b []byte) (*UpdatedPDRFields, error) {
+	f := &UpdatedPDRFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UpdatedPDRFields.
+func (f *UpdatedPDRFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UpdatedPDRFields) unmarshalIEs(ies []*IE) error {
+	var hasPDRID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case PDRID:
+			v, err := i.PDRID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatedPDR, Type: i.Type, Err: err}
+			}
+			f.PDRID = v
+			hasPDRID = true
+		case FTEID:
+			v, err := i.FTEID()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatedPDR, Type: i.Type, Err: err}
+			}
+			f.LocalFTEIDs = append(f.LocalFTEIDs, v)
+		case UEIPAddress:
+			v, err := i.UEIPAddress()
+			if err != nil {
+				return &GroupedFieldError{Parent: UpdatedPDR, Type: i.Type, Err: err}
+			}
+			f.UEIPAddresses = append(f.UEIPAddresses, v)
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPDRID {
+		return &GroupedFieldError{Parent: UpdatedPDR, Type: PDRID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UpdatedPDR IE built from the fields.
+func (f *UpdatedPDRFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewPDRID(f.PDRID))
+	for _, v := range f.LocalFTEIDs {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	for _, v := range f.UEIPAddresses {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UpdatedPDR, 0, ies...)
+}
+
+// ProvideRDSConfigurationInformationFields represents the fields in ProvideRDSConfigurationInformation IE.
+type ProvideRDSConfigurationInformationFields struct {
+	RDSConfigurationInformation *uint8
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseProvideRDSConfigurationInformationFields parses b into ProvideRDSConfigurationInformationFields.
+func ParseProvideRDSConfigurationInformationFields(b []byte) (*ProvideRDSConfigurationInformationFields, error) {
+	f := &ProvideRDSConfigurationInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into ProvideRDSConfigurationInformationFields.
+func (f *ProvideRDSConfigurationInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *ProvideRDSConfigurationInformationFields) unmarshalIEs(ies []*IE) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RDSConfigurationInformation:
+			v, err := i.RDSConfigurationInformation()
+			if err != nil {
+				return &GroupedFieldError{Parent: ProvideRDSConfigurationInformation, Type: i.Type, Err: err}
+			}
+			f.RDSConfigurationInformation = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	return nil
+}
+
+// ToIE returns ProvideRDSConfigurationInformation IE built from the fields.
+func (f *ProvideRDSConfigurationInformationFields) ToIE() *IE {
+	var ies []*IE
+	if f.RDSConfigurationInformation != nil {
+		ies = append(ies, NewRDSConfigurationInformation(*f.RDSConfigurationInformation))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(ProvideRDSConfigurationInformation, 0, ies...)
+}
+
+// QueryPacketRateStatusWithinSessionModificationRequestFields represents the fields in QueryPacketRateStatusWithinSessionModificationRequest IE.
+type QueryPacketRateStatusWithinSessionModificationRequestFields struct {
+	QERID uint32
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseQueryPacketRateStatusWithinSessionModificationRequestFields parses b into QueryPacketRateStatusWithinSessionModificationRequestFields.
+func ParseQueryPacketRateStatusWithinSessionModificationRequestFields(b []byte) (*QueryPacketRateStatusWithinSessionModificationRequestFields, error) {
+	f := &QueryPacketRateStatusWithinSessionModificationRequestFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into QueryPacketRateStatusWithinSessionModificationRequestFields.
+func (f *QueryPacketRateStatusWithinSessionModificationRequestFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *QueryPacketRateStatusWithinSessionModificationRequestFields) unmarshalIEs(ies []*IE) error {
+	var hasQERID bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: QueryPacketRateStatusWithinSessionModificationRequest, Type: i.Type, Err: err}
+			}
+			f.QERID = v
+			hasQERID = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQERID {
+		return &GroupedFieldError{Parent: QueryPacketRateStatusWithinSessionModificationRequest, Type: QERID, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns QueryPacketRateStatusWithinSessionModificationRequest IE built from the fields.
+func (f *QueryPacketRateStatusWithinSessionModificationRequestFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQERID(f.QERID))
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(QueryPacketRateStatusWithinSessionModificationRequest, 0, ies...)
+}
+
+// PacketRateStatusReportWithinSessionModificationResponseFields represents the fields in PacketRateStatusReportWithinSessionModificationResponse IE.
+type PacketRateStatusReportWithinSessionModificationResponseFields struct {
+	QERID            uint32
+	PacketRateStatus *PacketRateStatusFields
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParsePacketRateStatusReportWithinSessionModificationResponseFields parses b into PacketRateStatusReportWithinSessionModificationResponseFields.
+func ParsePacketRateStatusReportWithinSessionModificationResponseFields(b []byte) (*PacketRateStatusReportWithinSessionModificationResponseFields, error) {
+	f := &PacketRateStatusReportWithinSessionModificationResponseFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into PacketRateStatusReportWithinSessionModificationResponseFields.
+func (f *PacketRateStatusReportWithinSessionModificationResponseFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *PacketRateStatusReportWithinSessionModificationResponseFields) unmarshalIEs(ies []*IE) error {
+	var hasQERID bool
+	var hasPacketRateStatus bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case QERID:
+			v, err := i.QERID()
+			if err != nil {
+				return &GroupedFieldError{Parent: PacketRateStatusReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.QERID = v
+			hasQERID = true
+		case PacketRateStatus:
+			v, err := i.PacketRateStatus()
+			if err != nil {
+				return &GroupedFieldError{Parent: PacketRateStatusReportWithinSessionModificationResponse, Type: i.Type, Err: err}
+			}
+			f.PacketRateStatus = v
+			hasPacketRateStatus = true
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasQERID {
+		return &GroupedFieldError{Parent: PacketRateStatusReportWithinSessionModificationResponse, Type: QERID, Err: ErrIENotFound}
+	}
+	if !hasPacketRateStatus {
+		return &GroupedFieldError{Parent: PacketRateStatusReportWithinSessionModificationResponse, Type: PacketRateStatus, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns PacketRateStatusReportWithinSessionModificationResponse IE built from the fields.
+func (f *PacketRateStatusReportWithinSessionModificationResponseFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewQERID(f.QERID))
+	if f.PacketRateStatus != nil {
+		ies = append(ies, newFieldsIE(PacketRateStatus, f.PacketRateStatus))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(PacketRateStatusReportWithinSessionModificationResponse, 0, ies...)
+}
+
+// UEIPAddressUsageInformationFields represents the fields in UEIPAddressUsageInformation IE.
+type UEIPAddressUsageInformationFields struct {
+	SequenceNumber          uint32
+	Metric                  uint8
+	ValidityTimer           time.Duration
+	NumberOfUEIPAddresses   *NumberOfUEIPAddressesFields
+	NetworkInstance         string
+	UEIPAddressPoolIdentity []byte
+	SNSSAI                  []byte
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseUEIPAddressUsageInformationFields parses b into UEIPAddressUsageInformationFields.
+func ParseUEIPAddressUsageInformationFields(b []byte) (*UEIPAddressUsageInformationFields, error) {
+	f := &UEIPAddressUsageInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into UEIPAddressUsageInformationFields.
+func (f *UEIPAddressUsageInformationFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *UEIPAddressUsageInformationFields) unmarshalIEs(ies []*IE) error {
+	var hasSequenceNumber bool
+	var hasMetric bool
+	var hasValidityTimer bool
+	var hasNumberOfUEIPAddresses bool
+	var hasNetworkInstance bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case SequenceNumber:
+			v, err := i.SequenceNumber()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.SequenceNumber = v
+			hasSequenceNumber = true
+		case Metric:
+			v, err := i.Metric()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.Metric = v
+			hasMetric = true
+		case ValidityTimer:
+			v, err := i.ValidityTimer()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.ValidityTimer = v
+			hasValidityTimer = true
+		case NumberOfUEIPAddresses:
+			v, err := i.NumberOfUEIPAddresses()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.NumberOfUEIPAddresses = v
+			hasNumberOfUEIPAddresses = true
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = v
+			hasNetworkInstance = true
+		case UEIPAddressPoolIdentity:
+			v, err := i.UEIPAddressPoolIdentity()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.UEIPAddressPoolIdentity = v
+		case SNSSAI:
+			v, err := i.SNSSAI()
+			if err != nil {
+				return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: i.Type, Err: err}
+			}
+			f.SNSSAI = v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasSequenceNumber {
+		return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: SequenceNumber, Err: ErrIENotFound}
+	}
+	if !hasMetric {
+		return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: Metric, Err: ErrIENotFound}
+	}
+	if !hasValidityTimer {
+		return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: ValidityTimer, Err: ErrIENotFound}
+	}
+	if !hasNumberOfUEIPAddresses {
+		return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: NumberOfUEIPAddresses, Err: ErrIENotFound}
+	}
+	if !hasNetworkInstance {
+		return &GroupedFieldError{Parent: UEIPAddressUsageInformation, Type: NetworkInstance, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns UEIPAddressUsageInformation IE built from the fields.
+func (f *UEIPAddressUsageInformationFields) ToIE() *IE {
+	var ies []*IE
+	ies = append(ies, NewSequenceNumber(f.SequenceNumber))
+	ies = append(ies, NewMetric(f.Metric))
+	ies = append(ies, NewValidityTimer(f.ValidityTimer))
+	if f.NumberOfUEIPAddresses != nil {
+		ies = append(ies, newFieldsIE(NumberOfUEIPAddresses, f.NumberOfUEIPAddresses))
+	}
+	ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	if f.UEIPAddressPoolIdentity != nil {
+		ies = append(ies, New(UEIPAddressPoolIdentity, f.UEIPAddressPoolIdentity))
+	}
+	if f.SNSSAI != nil {
+		ies = append(ies, New(SNSSAI, f.SNSSAI))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(UEIPAddressUsageInformation, 0, ies...)
+}
+
+// RedundantTransmissionForwardingParametersFields represents the fields in RedundantTransmissionForwardingParameters IE.
+type RedundantTransmissionForwardingParametersFields struct {
+	OuterHeaderCreation *OuterHeaderCreationFields
+	NetworkInstance     *string
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseRedundantTransmissionForwardingParametersFields parses b into RedundantTransmissionForwardingParametersFields.
+func ParseRedundantTransmissionForwardingParametersFields(b []byte) (*RedundantTransmissionForwardingParametersFields, error) {
+	f := &RedundantTransmissionForwardingParametersFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into RedundantTransmissionForwardingParametersFields.
+func (f *RedundantTransmissionForwardingParametersFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *RedundantTransmissionForwardingParametersFields) unmarshalIEs(ies []*IE) error {
+	var hasOuterHeaderCreation bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case OuterHeaderCreation:
+			v, err := i.OuterHeaderCreation()
+			if err != nil {
+				return &GroupedFieldError{Parent: RedundantTransmissionForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.OuterHeaderCreation = v
+			hasOuterHeaderCreation = true
+		case NetworkInstance:
+			v, err := i.NetworkInstance()
+			if err != nil {
+				return &GroupedFieldError{Parent: RedundantTransmissionForwardingParameters, Type: i.Type, Err: err}
+			}
+			f.NetworkInstance = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasOuterHeaderCreation {
+		return &GroupedFieldError{Parent: RedundantTransmissionForwardingParameters, Type: OuterHeaderCreation, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns RedundantTransmissionForwardingParameters IE built from the fields.
+func (f *RedundantTransmissionForwardingParametersFields) ToIE() *IE {
+	var ies []*IE
+	if f.OuterHeaderCreation != nil {
+		ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	}
+	if f.NetworkInstance != nil {
+		ies = append(ies, NewNetworkInstance(*f.NetworkInstance))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(RedundantTransmissionForwardingParameters, 0, ies...)
+}
+
+// TransportDelayReportingFields represents the fields in TransportDelayReporting IE.
+type TransportDelayReportingFields struct {
+	PrecedingULGTPUPeer   *RemoteGTPUPeerFields
+	TransportLevelMarking *uint16
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// ParseTransportDelayReportingFields parses b into TransportDelayReportingFields.
+func ParseTransportDelayReportingFields(b []byte) (*TransportDelayReportingFields, error) {
+	f := &TransportDelayReportingFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into TransportDelayReportingFields.
+func (f *TransportDelayReportingFields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *TransportDelayReportingFields) unmarshalIEs(ies []*IE) error {
+	var hasPrecedingULGTPUPeer bool
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+		case RemoteGTPUPeer:
+			v, err := i.RemoteGTPUPeer()
+			if err != nil {
+				return &GroupedFieldError{Parent: TransportDelayReporting, Type: i.Type, Err: err}
+			}
+			f.PrecedingULGTPUPeer = v
+			hasPrecedingULGTPUPeer = true
+		case TransportLevelMarking:
+			v, err := i.TransportLevelMarking()
+			if err != nil {
+				return &GroupedFieldError{Parent: TransportDelayReporting, Type: i.Type, Err: err}
+			}
+			f.TransportLevelMarking = &v
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+
+	if !hasPrecedingULGTPUPeer {
+		return &GroupedFieldError{Parent: TransportDelayReporting, Type: RemoteGTPUPeer, Err: ErrIENotFound}
+	}
+	return nil
+}
+
+// ToIE returns TransportDelayReporting IE built from the fields.
+func (f *TransportDelayReportingFields) ToIE() *IE {
+	var ies []*IE
+	if f.PrecedingULGTPUPeer != nil {
+		ies = append(ies, newFieldsIE(RemoteGTPUPeer, f.PrecedingULGTPUPeer))
+	}
+	if f.TransportLevelMarking != nil {
+		ies = append(ies, NewTransportLevelMarking(*f.TransportLevelMarking))
+	}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE(TransportDelayReporting, 0, ies...)
+}
@@ -9,39 +9,6 @@ func NewGTPUPathInterfaceType(n3, n9 int) *IE {
 	return newUint8ValIE(GTPUPathInterfaceType, uint8((n3<<1)|n9))
 }
 
-// GTPUPathInterfaceType returns GTPUPathInterfaceType in uint8 if the type of IE matches.
-func (i *IE) GTPUPathInterfaceType() (uint8, error) {
-	switch i.Type {
-	case GTPUPathInterfaceType:
-		return i.ValueAsUint8()
-	case GTPUPathQoSControlInformation:
-		ies, err := i.GTPUPathQoSControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == GTPUPathInterfaceType {
-				return x.GTPUPathInterfaceType()
-			}
-		}
-		return 0, ErrIENotFound
-	case GTPUPathQoSReport:
-		ies, err := i.GTPUPathQoSReport()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == GTPUPathInterfaceType {
-				return x.GTPUPathInterfaceType()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // HasN3 reports whether an IE has N3 bit.
 func (i *IE) HasN3() bool {
 	v, err := i.GTPUPathInterfaceType()
@@ -13,287 +13,10 @@ import (
 	"github.com/wmnsk/go-pfcp/internal/utils"
 )
 
-//go:generate stringer -type=IEType
-type IEType uint16
+//go:generate go run ../internal/gen -spec ../internal/gen/spec ies grouped typed
 
-// IE Type definitions.
-const (
-	_                                                                IEType = 0
-	CreatePDR                                                        IEType = 1
-	PDI                                                              IEType = 2
-	CreateFAR                                                        IEType = 3
-	ForwardingParameters                                             IEType = 4
-	DuplicatingParameters                                            IEType = 5
-	CreateURR                                                        IEType = 6
-	CreateQER                                                        IEType = 7
-	CreatedPDR                                                       IEType = 8
-	UpdatePDR                                                        IEType = 9
-	UpdateFAR                                                        IEType = 10
-	UpdateForwardingParameters                                       IEType = 11
-	UpdateBARWithinSessionReportResponse                             IEType = 12
-	UpdateURR                                                        IEType = 13
-	UpdateQER                                                        IEType = 14
-	RemovePDR                                                        IEType = 15
-	RemoveFAR                                                        IEType = 16
-	RemoveURR                                                        IEType = 17
-	RemoveQER                                                        IEType = 18
-	Cause                                                            IEType = 19
-	SourceInterface                                                  IEType = 20
-	FTEID                                                            IEType = 21
-	NetworkInstance                                                  IEType = 22
-	SDFFilter                                                        IEType = 23
-	ApplicationID                                                    IEType = 24
-	GateStatus                                                       IEType = 25
-	MBR                                                              IEType = 26
-	GBR                                                              IEType = 27
-	QERCorrelationID                                                 IEType = 28
-	Precedence                                                       IEType = 29
-	TransportLevelMarking                                            IEType = 30
-	VolumeThreshold                                                  IEType = 31
-	TimeThreshold                                                    IEType = 32
-	MonitoringTime                                                   IEType = 33
-	SubsequentVolumeThreshold                                        IEType = 34
-	SubsequentTimeThreshold                                          IEType = 35
-	InactivityDetectionTime                                          IEType = 36
-	ReportingTriggers                                                IEType = 37
-	RedirectInformation                                              IEType = 38
-	ReportType                                                       IEType = 39
-	OffendingIE                                                      IEType = 40
-	ForwardingPolicy                                                 IEType = 41
-	DestinationInterface                                             IEType = 42
-	UPFunctionFeatures                                               IEType = 43
-	ApplyAction                                                      IEType = 44
-	DownlinkDataServiceInformation                                   IEType = 45
-	DownlinkDataNotificationDelay                                    IEType = 46
-	DLBufferingDuration                                              IEType = 47
-	DLBufferingSuggestedPacketCount                                  IEType = 48
-	PFCPSMReqFlags                                                   IEType = 49
-	PFCPSRRspFlags                                                   IEType = 50
-	LoadControlInformation                                           IEType = 51
-	SequenceNumber                                                   IEType = 52
-	Metric                                                           IEType = 53
-	OverloadControlInformation                                       IEType = 54
-	Timer                                                            IEType = 55
-	PDRID                                                            IEType = 56
-	FSEID                                                            IEType = 57
-	ApplicationIDsPFDs                                               IEType = 58
-	PFDContext                                                       IEType = 59
-	NodeID                                                           IEType = 60
-	PFDContents                                                      IEType = 61
-	MeasurementMethod                                                IEType = 62
-	UsageReportTrigger                                               IEType = 63
-	MeasurementPeriod                                                IEType = 64
-	FQCSID                                                           IEType = 65
-	VolumeMeasurement                                                IEType = 66
-	DurationMeasurement                                              IEType = 67
-	ApplicationDetectionInformation                                  IEType = 68
-	TimeOfFirstPacket                                                IEType = 69
-	TimeOfLastPacket                                                 IEType = 70
-	QuotaHoldingTime                                                 IEType = 71
-	DroppedDLTrafficThreshold                                        IEType = 72
-	VolumeQuota                                                      IEType = 73
-	TimeQuota                                                        IEType = 74
-	StartTime                                                        IEType = 75
-	EndTime                                                          IEType = 76
-	QueryURR                                                         IEType = 77
-	UsageReportWithinSessionModificationResponse                     IEType = 78
-	UsageReportWithinSessionDeletionResponse                         IEType = 79
-	UsageReportWithinSessionReportRequest                            IEType = 80
-	URRID                                                            IEType = 81
-	LinkedURRID                                                      IEType = 82
-	DownlinkDataReport                                               IEType = 83
-	OuterHeaderCreation                                              IEType = 84
-	CreateBAR                                                        IEType = 85
-	UpdateBARWithinSessionModificationRequest                        IEType = 86
-	RemoveBAR                                                        IEType = 87
-	BARID                                                            IEType = 88
-	CPFunctionFeatures                                               IEType = 89
-	UsageInformation                                                 IEType = 90
-	ApplicationInstanceID                                            IEType = 91
-	FlowInformation                                                  IEType = 92
-	UEIPAddress                                                      IEType = 93
-	PacketRate                                                       IEType = 94
-	OuterHeaderRemoval                                               IEType = 95
-	RecoveryTimeStamp                                                IEType = 96
-	DLFlowLevelMarking                                               IEType = 97
-	HeaderEnrichment                                                 IEType = 98
-	ErrorIndicationReport                                            IEType = 99
-	MeasurementInformation                                           IEType = 100
-	NodeReportType                                                   IEType = 101
-	UserPlanePathFailureReport                                       IEType = 102
-	RemoteGTPUPeer                                                   IEType = 103
-	URSEQN                                                           IEType = 104
-	UpdateDuplicatingParameters                                      IEType = 105
-	ActivatePredefinedRules                                          IEType = 106
-	DeactivatePredefinedRules                                        IEType = 107
-	FARID                                                            IEType = 108
-	QERID                                                            IEType = 109
-	OCIFlags                                                         IEType = 110
-	PFCPAssociationReleaseRequest                                    IEType = 111
-	GracefulReleasePeriod                                            IEType = 112
-	PDNType                                                          IEType = 113
-	FailedRuleID                                                     IEType = 114
-	TimeQuotaMechanism                                               IEType = 115
-	UserPlaneIPResourceInformation                                   IEType = 116
-	UserPlaneInactivityTimer                                         IEType = 117
-	AggregatedURRs                                                   IEType = 118
-	Multiplier                                                       IEType = 119
-	AggregatedURRID                                                  IEType = 120
-	SubsequentVolumeQuota                                            IEType = 121
-	SubsequentTimeQuota                                              IEType = 122
-	RQI                                                              IEType = 123
-	QFI                                                              IEType = 124
-	QueryURRReference                                                IEType = 125
-	AdditionalUsageReportsInformation                                IEType = 126
-	CreateTrafficEndpoint                                            IEType = 127
-	CreatedTrafficEndpoint                                           IEType = 128
-	UpdateTrafficEndpoint                                            IEType = 129
-	RemoveTrafficEndpoint                                            IEType = 130
-	TrafficEndpointID                                                IEType = 131
-	EthernetPacketFilter                                             IEType = 132
-	MACAddress                                                       IEType = 133
-	CTAG                                                             IEType = 134
-	STAG                                                             IEType = 135
-	Ethertype                                                        IEType = 136
-	Proxying                                                         IEType = 137
-	EthernetFilterID                                                 IEType = 138
-	EthernetFilterProperties                                         IEType = 139
-	SuggestedBufferingPacketsCount                                   IEType = 140
-	UserID                                                           IEType = 141
-	EthernetPDUSessionInformation                                    IEType = 142
-	EthernetTrafficInformation                                       IEType = 143
-	MACAddressesDetected                                             IEType = 144
-	MACAddressesRemoved                                              IEType = 145
-	EthernetInactivityTimer                                          IEType = 146
-	AdditionalMonitoringTime                                         IEType = 147
-	EventQuota                                                       IEType = 148
-	EventThreshold                                                   IEType = 149
-	SubsequentEventQuota                                             IEType = 150
-	SubsequentEventThreshold                                         IEType = 151
-	TraceInformation                                                 IEType = 152
-	FramedRoute                                                      IEType = 153
-	FramedRouting                                                    IEType = 154
-	FramedIPv6Route                                                  IEType = 155
-	EventTimeStamp                                                   IEType = 156
-	AveragingWindow                                                  IEType = 157
-	PagingPolicyIndicator                                            IEType = 158
-	APNDNN                                                           IEType = 159
-	TGPPInterfaceType                                                IEType = 160
-	PFCPSRReqFlags                                                   IEType = 161
-	PFCPAUReqFlags                                                   IEType = 162
-	ActivationTime                                                   IEType = 163
-	DeactivationTime                                                 IEType = 164
-	CreateMAR                                                        IEType = 165
-	TGPPAccessForwardingActionInformation                            IEType = 166
-	NonTGPPAccessForwardingActionInformation                         IEType = 167
-	RemoveMAR                                                        IEType = 168
-	UpdateMAR                                                        IEType = 169
-	MARID                                                            IEType = 170
-	SteeringFunctionality                                            IEType = 171
-	SteeringMode                                                     IEType = 172
-	Weight                                                           IEType = 173
-	Priority                                                         IEType = 174
-	UpdateTGPPAccessForwardingActionInformation                      IEType = 175
-	UpdateNonTGPPAccessForwardingActionInformation                   IEType = 176
-	UEIPAddressPoolIdentity                                          IEType = 177
-	AlternativeSMFIPAddress                                          IEType = 178
-	PacketReplicationAndDetectionCarryOnInformation                  IEType = 179
-	SMFSetID                                                         IEType = 180
-	QuotaValidityTime                                                IEType = 181
-	NumberOfReports                                                  IEType = 182
-	PFCPSessionRetentionInformation                                  IEType = 183
-	PFCPASRspFlags                                                   IEType = 184
-	CPPFCPEntityIPAddress                                            IEType = 185
-	PFCPSEReqFlags                                                   IEType = 186
-	UserPlanePathRecoveryReport                                      IEType = 187
-	IPMulticastAddressingInfo                                        IEType = 188
-	JoinIPMulticastInformationWithinUsageReport                      IEType = 189
-	LeaveIPMulticastInformationWithinUsageReport                     IEType = 190
-	IPMulticastAddress                                               IEType = 191
-	SourceIPAddress                                                  IEType = 192
-	PacketRateStatus                                                 IEType = 193
-	CreateBridgeInfoForTSC                                           IEType = 194
-	CreatedBridgeInfoForTSC                                          IEType = 195
-	DSTTPortNumber                                                   IEType = 196
-	NWTTPortNumber                                                   IEType = 197
-	TSNBridgeID                                                      IEType = 198
-	TSCManagementInformationWithinSessionModificationRequest         IEType = 199
-	TSCManagementInformationWithinSessionModificationResponse        IEType = 200
-	TSCManagementInformationWithinSessionReportRequest               IEType = 201
-	PortManagementInformationForTSCWithinSessionModificationRequest  IEType = 199 // Deprecated
-	PortManagementInformationForTSCWithinSessionModificationResponse IEType = 200 // Deprecated
-	PortManagementInformationForTSCWithinSessionReportRequest        IEType = 201 // Deprecated
-	PortManagementInformationContainer                               IEType = 202
-	ClockDriftControlInformation                                     IEType = 203
-	RequestedClockDriftInformation                                   IEType = 204
-	ClockDriftReport                                                 IEType = 205
-	TSNTimeDomainNumber                                              IEType = 206
-	TimeOffsetThreshold                                              IEType = 207
-	CumulativeRateRatioThreshold                                     IEType = 208
-	TimeOffsetMeasurement                                            IEType = 209
-	CumulativeRateRatioMeasurement                                   IEType = 210
-	RemoveSRR                                                        IEType = 211
-	CreateSRR                                                        IEType = 212
-	UpdateSRR                                                        IEType = 213
-	SessionReport                                                    IEType = 214
-	SRRID                                                            IEType = 215
-	AccessAvailabilityControlInformation                             IEType = 216
-	RequestedAccessAvailabilityInformation                           IEType = 217
-	AccessAvailabilityReport                                         IEType = 218
-	AccessAvailabilityInformation                                    IEType = 219
-	ProvideATSSSControlInformation                                   IEType = 220
-	ATSSSControlParameters                                           IEType = 221
-	MPTCPControlInformation                                          IEType = 222
-	ATSSSLLControlInformation                                        IEType = 223
-	PMFControlInformation                                            IEType = 224
-	MPTCPParameters                                                  IEType = 225
-	ATSSSLLParameters                                                IEType = 226
-	PMFParameters                                                    IEType = 227
-	MPTCPAddressInformation                                          IEType = 228
-	UELinkSpecificIPAddress                                          IEType = 229
-	PMFAddressInformation                                            IEType = 230
-	ATSSSLLInformation                                               IEType = 231
-	DataNetworkAccessIdentifier                                      IEType = 232
-	UEIPAddressPoolInformation                                       IEType = 233
-	AveragePacketDelay                                               IEType = 234
-	MinimumPacketDelay                                               IEType = 235
-	MaximumPacketDelay                                               IEType = 236
-	QoSReportTrigger                                                 IEType = 237
-	GTPUPathQoSControlInformation                                    IEType = 238
-	GTPUPathQoSReport                                                IEType = 239
-	QoSInformationInGTPUPathQoSReport                                IEType = 240
-	GTPUPathInterfaceType                                            IEType = 241
-	QoSMonitoringPerQoSFlowControlInformation                        IEType = 242
-	RequestedQoSMonitoring                                           IEType = 243
-	ReportingFrequency                                               IEType = 244
-	PacketDelayThresholds                                            IEType = 245
-	MinimumWaitTime                                                  IEType = 246
-	QoSMonitoringReport                                              IEType = 247
-	QoSMonitoringMeasurement                                         IEType = 248
-	MTEDTControlInformation                                          IEType = 249
-	DLDataPacketsSize                                                IEType = 250
-	QERControlIndications                                            IEType = 251
-	PacketRateStatusReport                                           IEType = 252
-	NFInstanceID                                                     IEType = 253
-	EthernetContextInformation                                       IEType = 254
-	RedundantTransmissionParameters                                  IEType = 255
-	UpdatedPDR                                                       IEType = 256
-	SNSSAI                                                           IEType = 257
-	IPVersion                                                        IEType = 258
-	PFCPASReqFlags                                                   IEType = 259
-	DataStatus                                                       IEType = 260
-	ProvideRDSConfigurationInformation                               IEType = 261
-	RDSConfigurationInformation                                      IEType = 262
-	QueryPacketRateStatusWithinSessionModificationRequest            IEType = 263
-	PacketRateStatusReportWithinSessionModificationResponse          IEType = 264
-	MPTCPApplicableIndication                                        IEType = 265
-	BridgeManagementInformationContainer                             IEType = 266
-	UEIPAddressUsageInformation                                      IEType = 267
-	NumberOfUEIPAddresses                                            IEType = 268
-	ValidityTimer                                                    IEType = 269
-	RedundantTransmissionForwardingParameters                        IEType = 270
-	TransportDelayReporting                                          IEType = 271
-)
+// IEType is the type of IE.
+type IEType uint16
 
 // IE represents an Information Element of PFCP messages.
 type IE struct {
@@ -438,9 +161,9 @@ func (i *IE) ValueAsFQDN() (string, error) {
 // This method returns the ChildIEs field if it is already parsed.
 // Otherwise, it parses the Payload field and returns the result.
 //
-// It is recommended to access the ChildIEs field directly if you know the payload is
-// already parsed and not modified. If you need to parse the payload anyway, use the
-// `<IE-Name>()` method instead, which disregards the ChildIEs field.
+// The `<IE-Name>()` methods of grouped IEs use this method, so they also read the
+// ChildIEs field when present, and the changes made to ChildIEs directly are
+// reflected without calling Refresh.
 //
 // For vendor-specific IE, this method tries to parse as grouped IE. If it fails, it
 // returns error.
@@ -565,14 +288,26 @@ func (i *IE) Marshal() ([]byte, error) {
 }
 
 // MarshalTo puts the byte sequence in the byte array given as b.
+//
+// For grouped IEs that have ChildIEs, the value and the Length field are built
+// from the current ChildIEs, so that the changes made to them directly are also
+// reflected. Payload is used only when ChildIEs is empty, which means that setting
+// ChildIEs to nil directly does not drop the children but falls back to the
+// Payload, which may be stale. Use Remove or RemoveAt to drop the children, which
+// rebuild the Payload as well.
 func (i *IE) MarshalTo(b []byte) error {
 	l := len(b)
 	if l < 4 {
 		return ErrInvalidLength
 	}
 
+	grouped := i.hasChildren()
 	binary.BigEndian.PutUint16(b[:2], uint16(i.Type))
-	binary.BigEndian.PutUint16(b[2:4], i.Length)
+	if grouped {
+		binary.BigEndian.PutUint16(b[2:4], uint16(i.MarshalLen()-4))
+	} else {
+		binary.BigEndian.PutUint16(b[2:4], i.Length)
+	}
 
 	offset := 4
 	if i.IsVendorSpecific() && l >= 6 {
@@ -580,8 +315,11 @@ func (i *IE) MarshalTo(b []byte) error {
 		offset += 2
 	}
 
-	if i.IsGrouped() {
+	if grouped {
 		for _, ie := range i.ChildIEs {
+			if ie == nil {
+				continue
+			}
 			if err := ie.MarshalTo(b[offset:]); err != nil {
 				return err
 			}
@@ -595,14 +333,20 @@ func (i *IE) MarshalTo(b []byte) error {
 }
 
 // MarshalLen returns field length in integer.
+//
+// As in MarshalTo, the length of grouped IEs is calculated from ChildIEs, or from
+// Payload if ChildIEs is empty.
 func (i *IE) MarshalLen() int {
 	l := 4
 	if i.IsVendorSpecific() {
 		l += 2
 	}
 
-	if i.IsGrouped() {
+	if i.hasChildren() {
 		for _, ie := range i.ChildIEs {
+			if ie == nil {
+				continue
+			}
 			l += ie.MarshalLen()
 		}
 		return l
@@ -612,7 +356,15 @@ func (i *IE) MarshalLen() int {
 }
 
 // SetLength sets the length in Length field.
+//
+// For grouped IEs that have ChildIEs, the length is calculated from the current
+// ChildIEs. Payload is not updated; use Refresh to update both.
 func (i *IE) SetLength() {
+	if i.hasChildren() {
+		i.Length = uint16(i.MarshalLen() - 4)
+		return
+	}
+
 	l := 0
 
 	if i.IsVendorSpecific() {
@@ -622,6 +374,12 @@ func (i *IE) SetLength() {
 	i.Length = uint16(l + len(i.Payload))
 }
 
+// hasChildren reports whether the IE is grouped and the value should be built
+// from ChildIEs rather than Payload.
+func (i *IE) hasChildren() bool {
+	return len(i.ChildIEs) != 0 && i.IsGrouped()
+}
+
 // IsVendorSpecific reports whether an IE is vendor-specific or defined by 3gpp.
 func (i *IE) IsVendorSpecific() bool {
 	// Spef: TS 29.244 8.1.1 Information Element Format
@@ -661,6 +419,13 @@ func newFQDNIE(t IEType, v string) *IE {
 	return New(t, utils.EncodeFQDN(v))
 }
 
+// new3GPPTimestampIE creates a new IE that has ts in the format of timestamp IEs.
+// See valueAs3GPPTimestamp for the details.
+func new3GPPTimestampIE(t IEType, ts time.Time) *IE {
+	u64sec := uint64(ts.Sub(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC))) / 1000000000
+	return newUint32ValIE(t, uint32(u64sec))
+}
+
 func newGroupedIE(itype IEType, eid uint16, ies ...*IE) *IE {
 	i := NewVendorSpecificIE(itype, eid, make([]byte, 0))
 
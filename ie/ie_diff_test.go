@@ -0,0 +1,83 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestDiff(t *testing.T) {
+	newPDR := func(id uint16, teid uint32, far uint32) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, teid, net.ParseIP("127.0.0.1"), nil, 0),
+			),
+			ie.NewFARID(far),
+		)
+	}
+
+	cases := []struct {
+		description string
+		a, b        []*ie.IE
+		diffs       []string
+	}{
+		{
+			"Same",
+			[]*ie.IE{newPDR(1, 1, 1), ie.NewCause(ie.CauseRequestAccepted)},
+			[]*ie.IE{newPDR(1, 1, 1), ie.NewCause(ie.CauseRequestAccepted)},
+			nil,
+		}, {
+			"Scalar",
+			[]*ie.IE{ie.NewCause(ie.CauseRequestAccepted)},
+			[]*ie.IE{ie.NewCause(ie.CauseRequestRejected)},
+			[]string{"~ Cause: RequestAccepted (1) -> RequestRejected (64)"},
+		}, {
+			"NestedField",
+			[]*ie.IE{newPDR(1, 1, 1), newPDR(2, 2, 2)},
+			[]*ie.IE{newPDR(2, 2, 2), newPDR(1, 0x10, 1)},
+			[]string{"~ CreatePDR[PDRID=1]/PDI/FTEID/TEID: 1 -> 16"},
+		}, {
+			"AddedRemoved",
+			[]*ie.IE{newPDR(1, 1, 1), newPDR(2, 2, 2)},
+			[]*ie.IE{newPDR(1, 1, 1), newPDR(3, 3, 3)},
+			[]string{
+				"- CreatePDR[PDRID=2]: {PDRID: 2, PDI: {SourceInterface: Access (0), FTEID: TEID=0x2 v4=127.0.0.1}, FARID: 2}",
+				"+ CreatePDR[PDRID=3]: {PDRID: 3, PDI: {SourceInterface: Access (0), FTEID: TEID=0x3 v4=127.0.0.1}, FARID: 3}",
+			},
+		}, {
+			"ChildAdded",
+			[]*ie.IE{ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(0x02))},
+			[]*ie.IE{ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(0x04), ie.NewBARID(1))},
+			[]string{
+				"~ CreateFAR[FARID=1]/ApplyAction: FORW -> BUFF",
+				"+ CreateFAR[FARID=1]/BARID: 1",
+			},
+		}, {
+			"RepeatedWithoutID",
+			[]*ie.IE{ie.NewPDI(ie.NewSDFFilter("permit out ip from any to any", "", "", "", 1), ie.NewSDFFilter("permit out udp from any to any", "", "", "", 2))},
+			[]*ie.IE{ie.NewPDI(ie.NewSDFFilter("permit out ip from any to any", "", "", "", 1), ie.NewSDFFilter("permit out tcp from any to any", "", "", "", 2))},
+			[]string{`~ PDI/SDFFilter[1]/FlowDescription: "permit out udp from any to any" -> "permit out tcp from any to any"`},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			var got []string
+			for _, d := range ie.DiffIEs(c.a, c.b) {
+				got = append(got, d.String())
+			}
+
+			if diff := cmp.Diff(got, c.diffs); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestParseFields(t *testing.T) {
+	cases := []struct {
+		description string
+		serialized  []byte
+		parse       func([]byte) (any, error)
+		structured  any
+		err         error
+	}{
+		{
+			description: "UserPlaneIPResourceInformation/NI/SI",
+			serialized:  ie.NewUserPlaneIPResourceInformation(0x71, 15, "127.0.0.1", "", "some.instance.example", ie.SrcInterfaceCore).Payload,
+			parse:       func(b []byte) (any, error) { return ie.ParseUserPlaneIPResourceInformationFields(b) },
+			structured:  ie.NewUserPlaneIPResourceInformationFields(0x71, 15, "127.0.0.1", "", "some.instance.example", ie.SrcInterfaceCore),
+		}, {
+			description: "OuterHeaderCreation/CTag/STag",
+			serialized:  ie.NewOuterHeaderCreation(0xc000, 0, "", "", 0, 0x123456, 0x654321).Payload,
+			parse:       func(b []byte) (any, error) { return ie.ParseOuterHeaderCreationFields(b) },
+			structured:  ie.NewOuterHeaderCreationFields(0xc000, 0, "", "", 0, 0x123456, 0x654321),
+		}, {
+			description: "OuterHeaderCreation/UDP/IPv4/CTag",
+			serialized:  ie.NewOuterHeaderCreation(0x4400, 0, "127.0.0.1", "", 2152, 0x123456, 0).Payload,
+			parse:       func(b []byte) (any, error) { return ie.ParseOuterHeaderCreationFields(b) },
+			structured:  ie.NewOuterHeaderCreationFields(0x4400, 0, "127.0.0.1", "", 2152, 0x123456, 0),
+		}, {
+			description: "MACAddressesDetected/NoCTAGLength",
+			serialized:  []byte{0x00, 0x12, 0x34, 0x56, 0x78, 0x90, 0x01},
+			parse:       func(b []byte) (any, error) { return ie.ParseMACAddressesDetectedFields(b) },
+			err:         io.ErrUnexpectedEOF,
+		}, {
+			description: "MACAddressesRemoved/NoCTAGLength",
+			serialized:  []byte{0x00, 0x12, 0x34, 0x56, 0x78, 0x90, 0x01},
+			parse:       func(b []byte) (any, error) { return ie.ParseMACAddressesRemovedFields(b) },
+			err:         io.ErrUnexpectedEOF,
+		}, {
+			description: "TraceInformation/NoTriggeringEventsLength",
+			serialized:  []byte{0x21, 0xf3, 0x54, 0x11, 0x11, 0x11},
+			parse:       func(b []byte) (any, error) { return ie.ParseTraceInformationFields(b) },
+			err:         io.ErrUnexpectedEOF,
+		}, {
+			description: "SourceIPAddress/NoMaskPrefixLength",
+			serialized:  ie.NewSourceIPAddress(net.ParseIP("127.0.0.1"), nil, 24).Payload[:5],
+			parse:       func(b []byte) (any, error) { return ie.ParseSourceIPAddressFields(b) },
+			err:         io.ErrUnexpectedEOF,
+		}, {
+			description: "PFDContents/TruncatedFlowDescription",
+			serialized:  ie.NewPFDContents("flow", "", "", "", "", nil, nil, nil).Payload[:6],
+			parse:       func(b []byte) (any, error) { return ie.ParsePFDContentsFields(b) },
+			err:         io.ErrUnexpectedEOF,
+		}, {
+			description: "SDFFilter/TruncatedFlowDescription",
+			serialized:  ie.NewSDFFilter("permit out ip from any to assigned", "", "", "", 0).Payload[:6],
+			parse:       func(b []byte) (any, error) { return ie.ParseSDFFilterFields(b) },
+			err:         io.ErrUnexpectedEOF,
+		}, {
+			description: "SubsequentTimeQuota/Truncated",
+			serialized:  []byte{0x00, 0x0a},
+			parse:       func(b []byte) (any, error) { return ie.New(ie.SubsequentTimeQuota, b).SubsequentTimeQuota() },
+			err:         io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.parse(c.serialized)
+			if err != c.err {
+				t.Fatalf("expected error %v but got %v", c.err, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, c.structured); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestApplyActionFlags(t *testing.T) {
+	f := ie.NewApplyActionFlags(ie.ApplyActionBUFF, ie.ApplyActionNOCP, ie.ApplyActionBDPN)
+	i := ie.NewApplyAction(f...)
+	if diff := cmp.Diff(i.Payload, []byte{0x0c, 0x02}); diff != "" {
+		t.Error(diff)
+	}
+	if !i.HasBUFF() || !i.HasNOCP() || !i.HasBDPN() || i.HasFORW() {
+		t.Error("inconsistent with the existing accessors")
+	}
+
+	got, err := i.ApplyActionFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := got.String(); s != "BUFF|NOCP|BDPN" {
+		t.Errorf("got %s", s)
+	}
+	if diff := cmp.Diff(got.Flags(), []ie.ApplyActionFlag{ie.ApplyActionBUFF, ie.ApplyActionNOCP, ie.ApplyActionBDPN}); diff != "" {
+		t.Error(diff)
+	}
+
+	cleared := got.Clear(ie.ApplyActionBDPN)
+	if cleared.Has(ie.ApplyActionBDPN) || !got.Has(ie.ApplyActionBDPN) {
+		t.Error("Clear should return the modified copy")
+	}
+	if s := (ie.ApplyActionFlags{0x00}).String(); s != "none" {
+		t.Errorf("got %s", s)
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		cases := []struct {
+			description string
+			flags       ie.ApplyActionFlags
+			valid       bool
+		}{
+			{"FORW", ie.NewApplyActionFlags(ie.ApplyActionFORW), true},
+			{"FORW/DUPL/DFRT", ie.NewApplyActionFlags(ie.ApplyActionFORW, ie.ApplyActionDUPL, ie.ApplyActionDFRT), true},
+			{"BUFF/NOCP/BDPN/DDPN", ie.NewApplyActionFlags(ie.ApplyActionBUFF, ie.ApplyActionNOCP, ie.ApplyActionBDPN, ie.ApplyActionDDPN), true},
+			{"NoAction", ie.NewApplyActionFlags(ie.ApplyActionDUPL), false},
+			{"DROP/FORW", ie.NewApplyActionFlags(ie.ApplyActionDROP, ie.ApplyActionFORW), false},
+			{"FORW/NOCP", ie.NewApplyActionFlags(ie.ApplyActionFORW, ie.ApplyActionNOCP), false},
+			{"IPMA/DUPL", ie.NewApplyActionFlags(ie.ApplyActionIPMA, ie.ApplyActionDUPL), false},
+			{"DROP/DFRT", ie.NewApplyActionFlags(ie.ApplyActionDROP, ie.ApplyActionDFRT), false},
+			{"FORW/DDPN", ie.NewApplyActionFlags(ie.ApplyActionFORW, ie.ApplyActionDDPN), false},
+			{"Empty", ie.ApplyActionFlags{}, false},
+		}
+
+		for _, c := range cases {
+			t.Run(c.description, func(t *testing.T) {
+				err := c.flags.Validate()
+				if c.valid {
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					return
+				}
+
+				var fe *ie.FlagsError
+				if !errors.As(err, &fe) || !errors.Is(err, ie.ErrMalformed) {
+					t.Errorf("got %v, want FlagsError", err)
+				}
+				if err := ie.NewApplyAction(c.flags...).ValidateApplyAction(); err != ie.ErrMalformed {
+					t.Errorf("got %v from ValidateApplyAction, want ErrMalformed", err)
+				}
+			})
+		}
+	})
+}
+
+func TestReportingTriggerFlags(t *testing.T) {
+	f := ie.NewReportingTriggerFlags(ie.ReportingTriggerPERIO, ie.ReportingTriggerVOLTH, ie.ReportingTriggerQUVTI)
+	i := ie.NewReportingTriggers(f...)
+	if diff := cmp.Diff(i.Payload, []byte{0x03, 0x80}); diff != "" {
+		t.Error(diff)
+	}
+	if !i.HasPERIO() || !i.HasVOLTH() || !i.HasQUVTI() {
+		t.Error("inconsistent with the existing accessors")
+	}
+
+	got, err := ie.NewCreateURR(ie.NewURRID(1), i).ReportingTriggerFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := got.Set(ie.ReportingTriggerUPINT).String(); s != "PERIO|VOLTH|QUVTI|UPINT" {
+		t.Errorf("got %s", s)
+	}
+
+	if err := f.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := (ie.ReportingTriggerFlags{0x01}).Validate(); !errors.Is(err, ie.ErrMalformed) {
+		t.Errorf("got %v, want ErrMalformed", err)
+	}
+}
+
+func TestUsageReportTriggerFlags(t *testing.T) {
+	f := ie.NewUsageReportTriggerFlags(ie.UsageReportTriggerIMMER, ie.UsageReportTriggerTERMR, ie.UsageReportTriggerEMRRE)
+	i := ie.NewUsageReportTrigger(f...)
+	if diff := cmp.Diff(i.Payload, []byte{0x80, 0x08, 0x10}); diff != "" {
+		t.Error(diff)
+	}
+	if !i.HasIMMER() || !i.HasTERMR() || !i.HasEMRRE() {
+		t.Error("inconsistent with the existing accessors")
+	}
+
+	got, err := i.UsageReportTriggerFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := got.String(); s != "IMMER|TERMR|EMRRE" {
+		t.Errorf("got %s", s)
+	}
+
+	if err := f.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ie.NewUsageReportTriggerFlags().Validate(); !errors.Is(err, ie.ErrMalformed) {
+		t.Errorf("got %v, want ErrMalformed", err)
+	}
+}
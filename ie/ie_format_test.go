@@ -0,0 +1,88 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		formatted   string
+	}{
+		{
+			"FTEID",
+			ie.NewFTEID(0x01, 0x01, net.ParseIP("10.0.0.1"), nil, 0),
+			"FTEID: TEID=0x1 v4=10.0.0.1",
+		}, {
+			"FTEID/CHID",
+			ie.NewFTEID(0x0d, 0, nil, nil, 5),
+			"FTEID: CH CHID=5 v4",
+		}, {
+			"Cause",
+			ie.NewCause(ie.CauseMandatoryIEMissing),
+			"Cause: MandatoryIEMissing (66)",
+		}, {
+			"RecoveryTimeStamp",
+			ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			"RecoveryTimeStamp: 2019-01-01T00:00:00Z",
+		}, {
+			"UserPlaneInactivityTimer",
+			ie.NewUserPlaneInactivityTimer(10 * time.Second),
+			"UserPlaneInactivityTimer: 10s",
+		}, {
+			"ApplyAction",
+			ie.NewApplyAction(0x12, 0x01),
+			"ApplyAction: FORW|DUPL|EDRT",
+		}, {
+			"MBR",
+			ie.NewMBR(0x11111111, 0x22222222),
+			"MBR: UL=286331153 DL=572662306",
+		}, {
+			"OuterHeaderCreation",
+			ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.1", "", 0, 0, 0),
+			"OuterHeaderCreation: OuterHeaderCreationDescription=256 TEID=286331153 IPv4Address=127.0.0.1",
+		}, {
+			"VendorSpecific",
+			ie.NewVendorSpecificIE(0xffff, 10415, []byte{0xde, 0xad, 0xbe, 0xef}),
+			"IEType(65535) [EnterpriseID=10415]: 0xdeadbeef",
+		}, {
+			"Malformed",
+			ie.New(ie.FTEID, []byte{0x01, 0x00}),
+			"FTEID: <malformed: unexpected EOF> 0x0100",
+		}, {
+			"CreateFAR",
+			ie.NewCreateFAR(
+				ie.NewFARID(1),
+				ie.NewApplyAction(0x02),
+				ie.NewForwardingParameters(
+					ie.NewDestinationInterface(ie.DstInterfaceCore),
+					ie.NewNetworkInstance("internet"),
+				),
+			),
+			`CreateFAR:
+  FARID: 1
+  ApplyAction: FORW
+  ForwardingParameters:
+    DestinationInterface: Core (1)
+    NetworkInstance: "internet"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if diff := cmp.Diff(c.structured.String(), c.formatted); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
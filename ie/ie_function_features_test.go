@@ -0,0 +1,132 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestUPFeatures(t *testing.T) {
+	i := ie.NewUPFunctionFeatures(0x30, 0x00, 0x04, 0x01)
+	s, err := i.UPFeatures()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ie.UPFeature{ie.UPFeatureFTUP, ie.UPFeaturePFDM, ie.UPFeatureUEIP, ie.UPFeatureMPAS}
+	if diff := cmp.Diff(s.Features(), want); diff != "" {
+		t.Error(diff)
+	}
+	if got := s.String(); got != "FTUP|PFDM|UEIP|MPAS" {
+		t.Errorf("got %s", got)
+	}
+	if !s.Has(ie.UPFeatureFTUP, ie.UPFeatureUEIP) || s.Has(ie.UPFeatureFTUP, ie.UPFeatureBUCP) {
+		t.Error("unexpected Has result")
+	}
+	if s.Has(ie.UPFeatureFTUP) != i.HasFTUP() || s.Has(ie.UPFeatureMPAS) != i.HasMPAS() {
+		t.Error("inconsistent with the existing accessors")
+	}
+
+	t.Run("SetOperations", func(t *testing.T) {
+		other := ie.NewUPFeatures(ie.UPFeatureFTUP, ie.UPFeatureN6JF)
+
+		if got := s.Union(other).String(); got != "FTUP|PFDM|UEIP|MPAS|N6JF" {
+			t.Errorf("Union: got %s", got)
+		}
+		if got := s.Intersect(other).String(); got != "FTUP" {
+			t.Errorf("Intersect: got %s", got)
+		}
+		if got := s.Difference(other).String(); got != "PFDM|UEIP|MPAS" {
+			t.Errorf("Difference: got %s", got)
+		}
+		if got := s.Clear(ie.UPFeaturePFDM).Set(ie.UPFeatureBUCP).String(); got != "BUCP|FTUP|UEIP|MPAS" {
+			t.Errorf("Clear/Set: got %s", got)
+		}
+		if got := ie.UPFeatures(0).String(); got != "none" {
+			t.Errorf("empty: got %s", got)
+		}
+	})
+
+	t.Run("Bytes", func(t *testing.T) {
+		if diff := cmp.Diff(s.Bytes(), []byte{0x30, 0x00, 0x04, 0x01}); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(ie.NewUPFeatures(ie.UPFeatureBUCP).Bytes(), []byte{0x01, 0x00}); diff != "" {
+			t.Error(diff)
+		}
+		got := ie.NewUPFunctionFeaturesWithFeatures(ie.NewUPFeatures(ie.UPFeatureN6JF))
+		if diff := cmp.Diff(got.Payload, []byte{0, 0, 0, 0, 0, 0, 0x80}); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		s := s.Set(ie.UPFeature(60))
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(b), `["FTUP","PFDM","UEIP","MPAS","Octet12Bit5"]`; got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+
+		var decoded ie.UPFeatures
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != s {
+			t.Errorf("got %s, want %s", decoded, s)
+		}
+
+		if err := json.Unmarshal([]byte(`["FOO"]`), &decoded); err == nil {
+			t.Error("unknown feature accepted")
+		}
+	})
+}
+
+func TestCPFeatures(t *testing.T) {
+	s, err := ie.NewCPFunctionFeatures(0x3f, 0x01).CPFeatures()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.String(); got != "LOAD|OVRL|EPFAR|SSET|BUNDL|MPAS|PSUCC" {
+		t.Errorf("got %s", got)
+	}
+
+	got := ie.NewCPFunctionFeaturesWithFeatures(ie.NewCPFeatures(ie.CPFeatureLOAD))
+	if diff := cmp.Diff(got.Payload, []byte{0x01}); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := ie.NewUPFunctionFeatures(0x01).CPFeatures(); err == nil {
+		t.Error("expected error for wrong IE type")
+	}
+}
+
+func TestNegotiateFeatures(t *testing.T) {
+	cp := ie.NewCPFeatures(ie.CPFeatureLOAD, ie.CPFeatureBUNDL, ie.CPFeatureSSET)
+	up := ie.NewUPFeatures(ie.UPFeatureFTUP, ie.UPFeaturePFDM, ie.UPFeatureBUNDL, ie.UPFeatureEPFAR)
+
+	n := ie.NegotiateFeatures(cp, up)
+	want := []ie.Procedure{
+		ie.ProcedureUPFTEIDAllocation,
+		ie.ProcedurePFDManagement,
+		ie.ProcedureMessageBundling,
+		ie.ProcedureLoadControl,
+	}
+	if diff := cmp.Diff(n.Procedures(), want); diff != "" {
+		t.Error(diff)
+	}
+
+	for _, p := range []ie.Procedure{ie.ProcedureUPUEIPAllocation, ie.ProcedureSMFSet, ie.ProcedureEndMarkerOnFARRemoval} {
+		if n.Supports(p) {
+			t.Errorf("%s should not be supported", p)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package ie_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/wmnsk/go-pfcp/ie"
@@ -52,3 +53,12 @@ func FuzzValueAs(f *testing.F) {
 		}
 	})
 }
+
+func FuzzMarshalJSON(f *testing.F) {
+	f.Fuzz(func(t *testing.T, typ uint16, b []byte) {
+		i := ie.New(ie.IEType(typ), b)
+		if _, err := json.Marshal(i); err != nil {
+			t.Skip()
+		}
+	})
+}
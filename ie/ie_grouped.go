@@ -1,101 +1,25 @@
 package ie
 
-import "sync"
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/internal/logger"
+)
 
 // We're using map to avoid iterating over a list.
 // The value `true` is not actually used.
 // TODO: consider using a slice with utils in slices package introduced in Go 1.21.
 var (
 	mu                  sync.RWMutex
-	defaultGroupedIEMap = map[IEType]bool{
-		CreatePDR:                            true,
-		PDI:                                  true,
-		CreateFAR:                            true,
-		ForwardingParameters:                 true,
-		DuplicatingParameters:                true,
-		CreateURR:                            true,
-		CreateQER:                            true,
-		CreatedPDR:                           true,
-		UpdatePDR:                            true,
-		UpdateFAR:                            true,
-		UpdateForwardingParameters:           true,
-		UpdateBARWithinSessionReportResponse: true,
-		UpdateURR:                            true,
-		UpdateQER:                            true,
-		RemovePDR:                            true,
-		RemoveFAR:                            true,
-		RemoveURR:                            true,
-		RemoveQER:                            true,
-		LoadControlInformation:               true,
-		OverloadControlInformation:           true,
-		ApplicationIDsPFDs:                   true,
-		PFDContext:                           true,
-		ApplicationDetectionInformation:      true,
-		QueryURR:                             true,
-		UsageReportWithinSessionModificationResponse: true,
-		UsageReportWithinSessionDeletionResponse:     true,
-		UsageReportWithinSessionReportRequest:        true,
-		DownlinkDataReport:                           true,
-		CreateBAR:                                    true,
-		UpdateBARWithinSessionModificationRequest:    true,
-		RemoveBAR:                                                 true,
-		ErrorIndicationReport:                                     true,
-		UserPlanePathFailureReport:                                true,
-		UpdateDuplicatingParameters:                               true,
-		AggregatedURRs:                                            true,
-		CreateTrafficEndpoint:                                     true,
-		CreatedTrafficEndpoint:                                    true,
-		UpdateTrafficEndpoint:                                     true,
-		RemoveTrafficEndpoint:                                     true,
-		EthernetPacketFilter:                                      true,
-		EthernetTrafficInformation:                                true,
-		AdditionalMonitoringTime:                                  true,
-		CreateMAR:                                                 true,
-		TGPPAccessForwardingActionInformation:                     true,
-		NonTGPPAccessForwardingActionInformation:                  true,
-		RemoveMAR:                                                 true,
-		UpdateMAR:                                                 true,
-		UpdateTGPPAccessForwardingActionInformation:               true,
-		UpdateNonTGPPAccessForwardingActionInformation:            true,
-		PFCPSessionRetentionInformation:                           true,
-		UserPlanePathRecoveryReport:                               true,
-		IPMulticastAddressingInfo:                                 true,
-		JoinIPMulticastInformationWithinUsageReport:               true,
-		LeaveIPMulticastInformationWithinUsageReport:              true,
-		CreatedBridgeInfoForTSC:                                   true,
-		TSCManagementInformationWithinSessionModificationRequest:  true,
-		TSCManagementInformationWithinSessionModificationResponse: true,
-		TSCManagementInformationWithinSessionReportRequest:        true,
-		ClockDriftControlInformation:                              true,
-		ClockDriftReport:                                          true,
-		RemoveSRR:                                                 true,
-		CreateSRR:                                                 true,
-		UpdateSRR:                                                 true,
-		SessionReport:                                             true,
-		AccessAvailabilityControlInformation:                      true,
-		AccessAvailabilityReport:                                  true,
-		ProvideATSSSControlInformation:                            true,
-		ATSSSControlParameters:                                    true,
-		MPTCPParameters:                                           true,
-		ATSSSLLParameters:                                         true,
-		PMFParameters:                                             true,
-		UEIPAddressPoolInformation:                                true,
-		GTPUPathQoSControlInformation:                             true,
-		GTPUPathQoSReport:                                         true,
-		QoSInformationInGTPUPathQoSReport:                         true,
-		QoSMonitoringPerQoSFlowControlInformation:                 true,
-		QoSMonitoringReport:                                       true,
-		PacketRateStatusReport:                                    true,
-		EthernetContextInformation:                                true,
-		RedundantTransmissionParameters:                           true,
-		UpdatedPDR:                                                true,
-		ProvideRDSConfigurationInformation:                        true,
-		QueryPacketRateStatusWithinSessionModificationRequest:     true,
-		PacketRateStatusReportWithinSessionModificationResponse:   true,
-		UEIPAddressUsageInformation:                               true,
-		RedundantTransmissionForwardingParameters:                 true,
-		TransportDelayReporting:                                   true,
-	}
+	defaultGroupedIEMap = func() map[IEType]bool {
+		m := make(map[IEType]bool, len(defaultGroupedIETypes))
+		for _, t := range defaultGroupedIETypes {
+			m[t] = true
+		}
+		return m
+	}()
 	isGroupedFun = func(t IEType) bool {
 		mu.RLock()
 		defer mu.RUnlock()
@@ -128,54 +52,283 @@ func AddGroupedIEType(ts ...IEType) {
 // if the IE type is in the defaultGroupedIEMap.
 // You can change this entire behavior by calling SetIsGroupedFun(), or you can add
 // new IE types to the defaultGroupedIEMap by calling AddGroupedIEType().
+//
+// For vendor-specific IEs registered with RegisterVendorIE(), the registered one
+// for the pair of the EnterpriseID and the type is used instead.
 func (i *IE) IsGrouped() bool {
+	if v, ok := i.vendorIE(); ok {
+		return v.Grouped
+	}
 	return isGroupedFun(i.Type)
 }
 
 // Add adds variable number of IEs to a IE if the IE is grouped type and update length.
 // Otherwise, this does nothing (no errors).
+//
+// The nil IEs are ignored. Use Insert instead if the errors should be handled.
 func (i *IE) Add(ies ...*IE) {
 	if !i.IsGrouped() {
 		return
 	}
+	if err := i.insert(-1, ies); err != nil {
+		logger.Logf("Add() failed to add IEs to %s: %v", i.Type, err)
+	}
+}
 
-	i.Payload = nil
-	i.ChildIEs = append(i.ChildIEs, ies...)
-	for _, ie := range i.ChildIEs {
-		serialized, err := ie.Marshal()
-		if err != nil {
+// Remove removes all the IEs of the given type from the children of a grouped IE
+// and updates Payload and Length. Otherwise, this does nothing (no errors).
+//
+// Use RemoveAt instead if the errors should be handled.
+func (i *IE) Remove(typ IEType) {
+	children, err := i.groupedChildren()
+	if err != nil {
+		return
+	}
+
+	children = slices.DeleteFunc(slices.Clone(children), func(c *IE) bool {
+		return c == nil || c.Type == typ
+	})
+	if err := i.setChildren(children); err != nil {
+		logger.Logf("Remove() failed to remove %s from %s: %v", typ, i.Type, err)
+	}
+}
+
+// Insert inserts IEs into the children of a grouped IE at the position n, which
+// is the index in ChildIEs, and updates Payload and Length. n can be equal to
+// len(ChildIEs) to append them. The nil IEs are ignored.
+//
+// *InvalidTypeError is returned if the IE is not grouped, and ErrIENotFound if n
+// is out of range.
+func (i *IE) Insert(n int, ies ...*IE) error {
+	if n < 0 {
+		return fmt.Errorf("%w: index %d out of range in %s", ErrIENotFound, n, i.Type)
+	}
+	return i.insert(n, ies)
+}
+
+// insert inserts IEs at n, or appends them if n is negative.
+func (i *IE) insert(n int, ies []*IE) error {
+	children, err := i.groupedChildren()
+	if err != nil {
+		return err
+	}
+	if n < 0 {
+		n = len(children)
+	}
+	if n > len(children) {
+		return fmt.Errorf("%w: index %d out of range in %s", ErrIENotFound, n, i.Type)
+	}
+
+	ies = slices.DeleteFunc(slices.Clone(ies), func(c *IE) bool { return c == nil })
+	return i.setChildren(slices.Insert(slices.Clone(children), n, ies...))
+}
+
+// Replace replaces the n-th (0-based) child IE of the given type in a grouped IE
+// with the new one, and updates Payload and Length.
+//
+// *InvalidTypeError is returned if the IE is not grouped, and ErrIENotFound if
+// there are not enough IEs of the type.
+func (i *IE) Replace(typ IEType, n int, ie *IE) error {
+	if ie == nil {
+		return i.RemoveAt(typ, n)
+	}
+
+	children, idx, err := i.childIndex(typ, n)
+	if err != nil {
+		return err
+	}
+	children = slices.Clone(children)
+	children[idx] = ie
+	return i.setChildren(children)
+}
+
+// RemoveAt removes the n-th (0-based) child IE of the given type from a grouped IE
+// and updates Payload and Length.
+//
+// *InvalidTypeError is returned if the IE is not grouped, and ErrIENotFound if
+// there are not enough IEs of the type.
+func (i *IE) RemoveAt(typ IEType, n int) error {
+	children, idx, err := i.childIndex(typ, n)
+	if err != nil {
+		return err
+	}
+	return i.setChildren(slices.Delete(slices.Clone(children), idx, idx+1))
+}
+
+// UpdateChild calls fn with the n-th (0-based) child IE of the given type in a
+// grouped IE, and updates Payload and Length of both the child and the IE after
+// fn modifies it. fn can modify the child in place, including the mutation of its
+// own children with the methods like Replace, to update the nested IEs.
+//
+// If fn returns an error, it is returned as it is and the IE is kept unchanged
+// except for the modification made by fn.
+func (i *IE) UpdateChild(typ IEType, n int, fn func(child *IE) error) error {
+	children, idx, err := i.childIndex(typ, n)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(children[idx]); err != nil {
+		return err
+	}
+	if err := children[idx].Refresh(); err != nil {
+		return err
+	}
+	return i.setChildren(children)
+}
+
+// Update calls fn with each of the descendants of the IE that match the path
+// expression q (see Query for the syntax), and then updates Payload and Length
+// of the IE and all its descendants with Refresh.
+//
+//	err := createFAR.Update("ForwardingParameters/OuterHeaderCreation", func(ohc *ie.IE) error {
+//		*ohc = *ie.NewOuterHeaderCreation(0x0100, teid, "192.168.0.1", "", 0, 0, 0)
+//		return nil
+//	})
+func (i *IE) Update(q string, fn func(target *IE) error) error {
+	if err := i.populateChildren(); err != nil {
+		return err
+	}
+
+	targets, err := i.Query(q)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return i.Refresh()
+}
+
+// UpdateIEs is the same as Update but for the list of IEs, e.g., the ones in
+// a message. The IEs that contain the modified ones are refreshed.
+func UpdateIEs(ies []*IE, q string, fn func(target *IE) error) error {
+	for _, i := range ies {
+		if err := i.populateChildren(); err != nil {
+			return err
+		}
+	}
+
+	targets, err := QueryIEs(ies, q)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+
+	for _, i := range ies {
+		if err := i.Refresh(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Refresh updates Payload and Length of the IE and all its descendants to
+// reflect the current ChildIEs. This is needed only when ChildIEs are modified
+// directly and Payload is used afterwards, as the methods to mutate the grouped
+// IEs in this package and the marshaling methods take care of it.
+func (i *IE) Refresh() error {
+	if i == nil {
+		return nil
+	}
+	if !i.hasChildren() {
+		i.SetLength()
+		return nil
+	}
+
+	for _, c := range i.ChildIEs {
+		if c == nil {
 			continue
 		}
-		i.Payload = append(i.Payload, serialized...)
+		if err := c.Refresh(); err != nil {
+			return err
+		}
 	}
-	i.SetLength()
+	return i.setChildren(i.ChildIEs)
 }
 
-// Remove removes an IE looked up by type.
-func (i *IE) Remove(typ IEType) {
+// groupedChildren returns the children of grouped IE, parsing Payload if the
+// ChildIEs is not populated yet.
+func (i *IE) groupedChildren() ([]*IE, error) {
 	if !i.IsGrouped() {
-		return
+		return nil, &InvalidTypeError{Type: i.Type}
 	}
+	return i.ValueAsGrouped()
+}
 
-	i.Payload = nil
-	newChildren := make([]*IE, len(i.ChildIEs))
-	idx := 0
-	for _, ie := range i.ChildIEs {
-		if ie.Type == typ {
-			newChildren = newChildren[:len(newChildren)-1]
+// childIndex returns the children of grouped IE and the index of the n-th child
+// of the given type in it.
+func (i *IE) childIndex(typ IEType, n int) ([]*IE, int, error) {
+	children, err := i.groupedChildren()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count := 0
+	for idx, c := range children {
+		if c == nil || c.Type != typ {
 			continue
 		}
-		newChildren[idx] = ie
-		idx++
+		if count == n {
+			return children, idx, nil
+		}
+		count++
+	}
+	return nil, 0, fmt.Errorf("%w: %s[%d] in %s", ErrIENotFound, typ, n, i.Type)
+}
 
-		serialized, err := ie.Marshal()
-		if err != nil {
+// setChildren sets the children of grouped IE and rebuilds Payload and Length
+// from them, so that Payload is emptied when no children are left. The IE is not
+// modified if any of the children fails to marshal.
+func (i *IE) setChildren(children []*IE) error {
+	payload := make([]byte, 0, len(i.Payload))
+	for _, c := range children {
+		if c == nil {
 			continue
 		}
-		i.Payload = append(i.Payload, serialized...)
+		b, err := c.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s in %s: %w", c.Type, i.Type, err)
+		}
+		payload = append(payload, b...)
 	}
-	i.ChildIEs = newChildren
+
+	i.ChildIEs = children
+	i.Payload = payload
 	i.SetLength()
+	return nil
+}
+
+// populateChildren parses Payload into ChildIEs for the grouped IE and all its
+// grouped descendants that are not parsed yet, so that they can be modified in
+// place.
+func (i *IE) populateChildren() error {
+	if i == nil || !i.IsGrouped() {
+		return nil
+	}
+	if len(i.ChildIEs) == 0 && len(i.Payload) != 0 {
+		children, err := ParseMultiIEs(i.Payload)
+		if err != nil {
+			return err
+		}
+		i.ChildIEs = children
+	}
+
+	for _, c := range i.ChildIEs {
+		if c == nil {
+			continue
+		}
+		if err := c.populateChildren(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // FindByType returns IE looked up by type.
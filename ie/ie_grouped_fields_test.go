@@ -0,0 +1,147 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func uint32Ptr(v uint32) *uint32 { return &v }
+
+func stringPtr(v string) *string { return &v }
+
+func TestGroupedFields(t *testing.T) {
+	activation := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	vendor := ie.NewVendorSpecificIE(0xffff, 10415, []byte{0xde, 0xad, 0xbe, 0xef})
+
+	t.Run("CreatePDR", func(t *testing.T) {
+		i := ie.NewCreatePDR(
+			ie.NewPDRID(0xffff),
+			ie.NewPrecedence(0x11111111),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				ie.NewNetworkInstance("some.instance.example"),
+				ie.NewSDFFilter("aaaaaaaa", "bb", "cccc", "ddd", 0xffffffff),
+				ie.NewSDFFilter("permit out ip from any to any", "", "", "", 0),
+				ie.NewQFI(0x01),
+			),
+			ie.NewFARID(0xffffffff),
+			ie.NewURRID(0x11111111),
+			ie.NewURRID(0x22222222),
+			ie.NewQERID(0xffffffff),
+			ie.NewActivatePredefinedRules("go-pfcp"),
+			ie.NewActivationTime(activation),
+			vendor,
+		)
+
+		got, err := ie.ParseCreatePDRFields(i.Payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &ie.CreatePDRFields{
+			PDRID:      0xffff,
+			Precedence: 0x11111111,
+			PDI: &ie.PDIFields{
+				SourceInterface: ie.SrcInterfaceAccess,
+				FTEID:           ie.NewFTEIDFields(0x01, 0x11111111, net.ParseIP("127.0.0.1").To4(), nil, 0),
+				NetworkInstance: stringPtr("some.instance.example"),
+				SDFFilters: []*ie.SDFFilterFields{
+					ie.NewSDFFilterFields("aaaaaaaa", "bb", "cccc", "ddd", 0xffffffff),
+					ie.NewSDFFilterFields("permit out ip from any to any", "", "", "", 0),
+				},
+				QFIs: []uint8{0x01},
+			},
+			FARID:                   uint32Ptr(0xffffffff),
+			URRIDs:                  []uint32{0x11111111, 0x22222222},
+			QERIDs:                  []uint32{0xffffffff},
+			ActivatePredefinedRules: []string{"go-pfcp"},
+			ActivationTime:          &activation,
+			IEs:                     []*ie.IE{vendor},
+		}
+		if diff := cmp.Diff(got, want, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+			t.Error(diff)
+		}
+
+		b, err := got.ToIE().Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want2, err := i.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, want2); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("CreateURR", func(t *testing.T) {
+		i := ie.NewCreateURR(
+			ie.NewURRID(1),
+			ie.NewMeasurementMethod(0, 1, 1),
+			ie.NewReportingTriggers(0x01, 0x02),
+			ie.NewVolumeThreshold(0x07, 1000, 600, 400),
+			ie.NewTimeThreshold(30*time.Second),
+			ie.NewDroppedDLTrafficThreshold(true, false, 10, 0),
+			ie.NewLinkedURRID(2),
+			ie.NewAggregatedURRs(
+				ie.NewAggregatedURRID(3),
+				ie.NewMultiplier(1, 2),
+			),
+		)
+
+		got, err := ie.ParseCreateURRFields(i.Payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.MeasurementMethod != 0x03 {
+			t.Errorf("unexpected MeasurementMethod: %#x", got.MeasurementMethod)
+		}
+		if got.TimeThreshold == nil || *got.TimeThreshold != 30*time.Second {
+			t.Errorf("unexpected TimeThreshold: %v", got.TimeThreshold)
+		}
+		if len(got.AggregatedURRs) != 1 || got.AggregatedURRs[0].AggregatedURRID != 3 {
+			t.Errorf("unexpected AggregatedURRs: %v", got.AggregatedURRs)
+		}
+
+		b, err := got.ToIE().Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := i.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("MissingMandatory", func(t *testing.T) {
+		i := ie.NewCreateFAR(
+			ie.NewFARID(1),
+			ie.NewForwardingParameters(
+				ie.NewNetworkInstance("some.instance.example"),
+			),
+		)
+
+		_, err := ie.ParseCreateFARFields(i.Payload)
+		var fe *ie.GroupedFieldError
+		if !errors.As(err, &fe) || !errors.Is(err, ie.ErrIENotFound) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fe.Parent != ie.CreateFAR || fe.Type != ie.ForwardingParameters {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func newTestCreateFAR(applyAction uint8, ohcTEID uint32) *ie.IE {
+	return ie.NewCreateFAR(
+		ie.NewFARID(1),
+		ie.NewApplyAction(applyAction),
+		ie.NewForwardingParameters(
+			ie.NewDestinationInterface(ie.DstInterfaceAccess),
+			ie.NewOuterHeaderCreation(0x0100, ohcTEID, "127.0.0.1", "", 0, 0, 0),
+		),
+	)
+}
+
+// assertSameIE checks if got is encoded and decoded in the same way as want.
+func assertSameIE(t *testing.T, got, want *ie.IE) {
+	t.Helper()
+
+	b, err := got.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := want.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, w); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(got.Payload, want.Payload); diff != "" {
+		t.Errorf("Payload is stale: %s", diff)
+	}
+	if got.Length != want.Length {
+		t.Errorf("Length: got %d, want %d", got.Length, want.Length)
+	}
+}
+
+func TestGroupedIEDirectModification(t *testing.T) {
+	b, err := newTestCreateFAR(0x02, 0x11111111).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	far, err := ie.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// modify the received IE in place without using the methods.
+	far.ChildIEs[1] = ie.NewApplyAction(0x0c)
+	far.ChildIEs[2].ChildIEs[1] = ie.NewOuterHeaderCreation(0x0100, 0x22222222, "127.0.0.1", "", 0, 0, 0)
+	far.ChildIEs = append(far.ChildIEs, ie.NewBARID(1))
+
+	got, err := far.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := newTestCreateFAR(0x0c, 0x22222222)
+	want.Add(ie.NewBARID(1))
+	w, err := want.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, w); diff != "" {
+		t.Error(diff)
+	}
+
+	aa, err := far.FindByType(ie.ApplyAction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aa.HasBUFF() {
+		t.Error("FindByType should reflect the current ChildIEs")
+	}
+	children, err := far.CreateFAR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 4 {
+		t.Errorf("accessor should reflect the current ChildIEs, got %d IEs", len(children))
+	}
+	ohc, err := far.ChildIEs[2].OuterHeaderCreation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ohc.TEID != 0x22222222 {
+		t.Errorf("got TEID %#x", ohc.TEID)
+	}
+
+	if err := far.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	assertSameIE(t, far, want)
+}
+
+func TestGroupedIEMutation(t *testing.T) {
+	t.Run("Insert", func(t *testing.T) {
+		far := ie.NewCreateFAR(ie.NewFARID(1), ie.NewForwardingParameters(
+			ie.NewDestinationInterface(ie.DstInterfaceAccess),
+			ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.1", "", 0, 0, 0),
+		))
+		if err := far.Insert(1, ie.NewApplyAction(0x02), nil); err != nil {
+			t.Fatal(err)
+		}
+		assertSameIE(t, far, newTestCreateFAR(0x02, 0x11111111))
+
+		if err := far.Insert(5, ie.NewBARID(1)); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v, want ErrIENotFound", err)
+		}
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		far := newTestCreateFAR(0x02, 0x11111111)
+		if err := far.Replace(ie.ApplyAction, 0, ie.NewApplyAction(0x0c)); err != nil {
+			t.Fatal(err)
+		}
+		assertSameIE(t, far, newTestCreateFAR(0x0c, 0x11111111))
+
+		if err := far.Replace(ie.ApplyAction, 1, ie.NewApplyAction(0x0c)); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v, want ErrIENotFound", err)
+		}
+	})
+
+	t.Run("RemoveAt", func(t *testing.T) {
+		pdr := ie.NewCreatePDR(ie.NewPDRID(1), ie.NewURRID(1), ie.NewURRID(2), ie.NewURRID(3))
+		if err := pdr.RemoveAt(ie.URRID, 1); err != nil {
+			t.Fatal(err)
+		}
+		assertSameIE(t, pdr, ie.NewCreatePDR(ie.NewPDRID(1), ie.NewURRID(1), ie.NewURRID(3)))
+
+		pdr.Remove(ie.URRID)
+		assertSameIE(t, pdr, ie.NewCreatePDR(ie.NewPDRID(1)))
+
+		// removing the last child drops the Payload as well.
+		pdr.Remove(ie.PDRID)
+		b, err := pdr.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, []byte{0x00, 0x01, 0x00, 0x00}); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("UpdateChild", func(t *testing.T) {
+		far := newTestCreateFAR(0x02, 0x11111111)
+		err := far.UpdateChild(ie.ForwardingParameters, 0, func(fp *ie.IE) error {
+			return fp.Replace(ie.OuterHeaderCreation, 0, ie.NewOuterHeaderCreation(0x0100, 0x22222222, "127.0.0.1", "", 0, 0, 0))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameIE(t, far, newTestCreateFAR(0x02, 0x22222222))
+
+		errFn := errors.New("error in fn")
+		if err := far.UpdateChild(ie.ForwardingParameters, 0, func(_ *ie.IE) error { return errFn }); !errors.Is(err, errFn) {
+			t.Errorf("got %v, want the error from fn", err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		// the IE created from the payload only, whose children are not parsed yet.
+		src := newTestCreateFAR(0x02, 0x11111111)
+		far := ie.New(ie.CreateFAR, src.Payload)
+
+		err := far.Update("ForwardingParameters/OuterHeaderCreation", func(ohc *ie.IE) error {
+			*ohc = *ie.NewOuterHeaderCreation(0x0100, 0x33333333, "127.0.0.1", "", 0, 0, 0)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameIE(t, far, newTestCreateFAR(0x02, 0x33333333))
+	})
+
+	t.Run("AddToPayloadOnly", func(t *testing.T) {
+		src := ie.NewCreatePDR(ie.NewPDRID(1))
+		pdr := ie.New(ie.CreatePDR, src.Payload)
+		pdr.Add(ie.NewFARID(1))
+		assertSameIE(t, pdr, ie.NewCreatePDR(ie.NewPDRID(1), ie.NewFARID(1)))
+	})
+
+	t.Run("NotGrouped", func(t *testing.T) {
+		var te *ie.InvalidTypeError
+		if err := ie.NewFARID(1).Insert(0, ie.NewFARID(2)); !errors.As(err, &te) {
+			t.Errorf("got %v, want InvalidTypeError", err)
+		}
+		if err := ie.NewFARID(1).RemoveAt(ie.FARID, 0); !errors.As(err, &te) {
+			t.Errorf("got %v, want InvalidTypeError", err)
+		}
+	})
+}
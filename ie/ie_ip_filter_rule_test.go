@@ -0,0 +1,201 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func mustCIDR(s string) *net.IPNet {
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	n.IP = ip
+	return n
+}
+
+func TestParseIPFilterRule(t *testing.T) {
+	cases := []struct {
+		description string
+		in          string
+		want        *ie.IPFilterRule
+		canonical   string
+	}{
+		{
+			"Any",
+			"permit out ip from any to any",
+			&ie.IPFilterRule{},
+			"permit out ip from any to any",
+		}, {
+			"Assigned/PortRange",
+			"permit out 17 from 10.0.0.1 to assigned 80-443",
+			&ie.IPFilterRule{
+				Protocol:    17,
+				Source:      ie.IPFilterEndpoint{Prefix: mustCIDR("10.0.0.1/32")},
+				Destination: ie.IPFilterEndpoint{Assigned: true, Ports: []ie.PortRange{{80, 443}}},
+			},
+			"permit out 17 from 10.0.0.1 to assigned 80-443",
+		}, {
+			"ProtocolName/PortList",
+			"permit out  tcp from 172.16.61.0/24 8000,8080-8088 to 10.62.0.7",
+			&ie.IPFilterRule{
+				Protocol: 6,
+				Source: ie.IPFilterEndpoint{
+					Prefix: mustCIDR("172.16.61.0/24"),
+					Ports:  []ie.PortRange{{8000, 8000}, {8080, 8088}},
+				},
+				Destination: ie.IPFilterEndpoint{Prefix: mustCIDR("10.62.0.7/32")},
+			},
+			"permit out 6 from 172.16.61.0/24 8000,8080-8088 to 10.62.0.7",
+		}, {
+			"IPv6",
+			"permit out 58 from 2001:db8::/32 to 2001:db8::1/128 0-65535",
+			&ie.IPFilterRule{
+				Protocol:    58,
+				Source:      ie.IPFilterEndpoint{Prefix: mustCIDR("2001:db8::/32")},
+				Destination: ie.IPFilterEndpoint{Prefix: mustCIDR("2001:db8::1/128"), Ports: []ie.PortRange{{0, 65535}}},
+			},
+			"permit out 58 from 2001:db8::/32 to 2001:db8::1 0-65535",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := ie.ParseIPFilterRule(c.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.want); diff != "" {
+				t.Error(diff)
+			}
+			if err := got.Validate(); err != nil {
+				t.Error(err)
+			}
+			if s := got.String(); s != c.canonical {
+				t.Errorf("got %q, want %q", s, c.canonical)
+			}
+
+			again, err := ie.ParseIPFilterRule(got.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(again, c.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestParseIPFilterRuleError(t *testing.T) {
+	cases := []struct {
+		in    string
+		field string
+		value string
+	}{
+		{"", "action", ""},
+		{"allow out ip from any to any", "action", "allow"},
+		{"deny out ip from any to any", "action", "deny"},
+		{"permit both ip from any to any", "direction", "both"},
+		{"permit in ip from any to any", "direction", "in"},
+		{"permit out", "protocol", ""},
+		{"permit out 256 from any to any", "protocol", "256"},
+		{"permit out 0 from any to any", "protocol", "0"},
+		{"permit out ip to any", `"from"`, "to"},
+		{"permit out ip from 10.0.0.256 to any", "source address", "10.0.0.256"},
+		{"permit out ip from 10.0.0.0/33 to any", "source address", "10.0.0.0/33"},
+		{"permit out ip from any 80-x to any", "source port", "80-x"},
+		{"permit out ip from any 443-80 to any", "source port", "443-80"},
+		{"permit out ip from any", `"to"`, ""},
+		{"permit out ip from any to", "destination address", ""},
+		{"permit out ip from any to any 70000", "destination port", "70000"},
+		{"permit out ip from any to any frag", "option", "frag"},
+		{"permit out ip from any to any 80 established", "option", "established"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			_, err := ie.ParseIPFilterRule(c.in)
+			var e *ie.IPFilterRuleError
+			if !errors.As(err, &e) {
+				t.Fatalf("got %v, want *IPFilterRuleError", err)
+			}
+			if e.Field != c.field || e.Value != c.value {
+				t.Errorf("got %s %q, want %s %q", e.Field, e.Value, c.field, c.value)
+			}
+		})
+	}
+}
+
+func TestIPFilterRuleIE(t *testing.T) {
+	rule := &ie.IPFilterRule{
+		Protocol:    17,
+		Source:      ie.IPFilterEndpoint{Prefix: mustCIDR("10.0.0.0/8")},
+		Destination: ie.IPFilterEndpoint{Assigned: true, Ports: []ie.PortRange{{2152, 2152}}},
+	}
+
+	t.Run("SDFFilter", func(t *testing.T) {
+		i, err := ie.NewSDFFilterWithRule(rule, "", "", "", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := i.SDFFilter()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.FlowDescription != "permit out 17 from 10.0.0.0/8 to assigned 2152" {
+			t.Errorf("unexpected FlowDescription: %q", f.FlowDescription)
+		}
+
+		got, err := ie.NewPDI(i).IPFilterRule()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, rule); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("FlowInformation", func(t *testing.T) {
+		got, err := ie.NewFlowInformation(ie.FlowDirectionDownlink, rule.String()).IPFilterRule()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, rule); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("NoFlowDescription", func(t *testing.T) {
+		_, err := ie.NewSDFFilter("", "", "", "", 1).IPFilterRule()
+		if !errors.Is(err, ie.ErrElementNotFound) {
+			t.Errorf("got %v, want ErrElementNotFound", err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := &ie.IPFilterRule{
+			Source: ie.IPFilterEndpoint{Ports: []ie.PortRange{{443, 80}}},
+		}
+		if _, err := ie.NewSDFFilterWithRule(invalid, "", "", "", 1); err == nil {
+			t.Error("expected error")
+		}
+
+		for _, r := range []*ie.IPFilterRule{{Action: ie.IPFilterActionDeny}, {Direction: ie.IPFilterDirectionIn}} {
+			if err := r.Validate(); err == nil {
+				t.Errorf("expected error for %s", r)
+			}
+		}
+	})
+}
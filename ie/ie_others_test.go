@@ -24,3 +24,38 @@ func TestOffendingIE(t *testing.T) {
 		t.Error(diff)
 	}
 }
+
+func TestCSIDs(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		decoded     []uint16
+	}{
+		{
+			description: "IPv4/SingleCSID",
+			structured:  ie.NewFQCSID("127.0.0.1", 1),
+			decoded:     []uint16{1},
+		}, {
+			description: "IPv4/MultiCSIDs",
+			structured:  ie.NewFQCSID("127.0.0.1", 1, 2, 3),
+			decoded:     []uint16{1, 2, 3},
+		}, {
+			description: "IPv6/MultiCSIDs",
+			structured:  ie.NewFQCSID("2001::1", 1, 2),
+			decoded:     []uint16{1, 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.structured.CSIDs()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, c.decoded); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
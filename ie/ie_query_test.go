@@ -0,0 +1,216 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func newQueryTestIEs() []*ie.IE {
+	pdr := func(id uint16, teid uint32, ni string) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, teid, net.ParseIP("127.0.0.1"), nil, 0),
+				ie.NewNetworkInstance(ni),
+			),
+			ie.NewFARID(uint32(id)),
+		)
+	}
+	return []*ie.IE{
+		ie.NewNodeID("127.0.0.1", "", ""),
+		pdr(1, 0x11111111, "internet"),
+		pdr(3, 0x33333333, "ims"),
+		ie.NewCreateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewOuterHeaderCreation(0x0100, 0x44444444, "127.0.0.2", "", 0, 0, 0),
+			),
+		),
+		ie.NewCreateFAR(ie.NewFARID(3), ie.NewApplyAction(0x01)),
+	}
+}
+
+func teids(t *testing.T, ies []*ie.IE) []uint32 {
+	t.Helper()
+
+	var got []uint32
+	for _, i := range ies {
+		f, err := i.FTEID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, f.TEID)
+	}
+	return got
+}
+
+func TestWalk(t *testing.T) {
+	ies := newQueryTestIEs()
+
+	var paths []string
+	err := ie.WalkIEs(ies, func(parents []*ie.IE, i *ie.IE) error {
+		if i.Type == ie.CreateFAR {
+			return ie.SkipChildren
+		}
+		if i.Type != ie.FTEID {
+			return nil
+		}
+		var path string
+		for _, p := range parents {
+			path += p.Type.String() + "/"
+		}
+		paths = append(paths, path+i.Type.String())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 || paths[0] != "CreatePDR/PDI/FTEID" {
+		t.Errorf("got %v", paths)
+	}
+
+	stop := errors.New("stop")
+	var n int
+	err = ie.WalkIEs(ies, func(_ []*ie.IE, _ *ie.IE) error {
+		n++
+		if n == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || n != 3 {
+		t.Errorf("got %v after %d IEs", err, n)
+	}
+
+	malformed := ie.New(ie.CreatePDR, []byte{0x00, 0x38, 0x00})
+	if err := malformed.Walk(func(_ []*ie.IE, _ *ie.IE) error { return nil }); err == nil {
+		t.Error("expected error for malformed grouped IE")
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	ies := newQueryTestIEs()
+
+	if got := teids(t, ie.FindAllIEs(ies, ie.FTEID)); len(got) != 2 || got[1] != 0x33333333 {
+		t.Errorf("got %x", got)
+	}
+	if got := ies[3].FindAll(ie.OuterHeaderCreation); len(got) != 1 {
+		t.Errorf("got %d IEs", len(got))
+	}
+	if got := ies[1].FindAll(ie.CreatePDR); len(got) != 0 {
+		t.Error("FindAll should not include the IE itself")
+	}
+}
+
+func TestQuery(t *testing.T) {
+	ies := newQueryTestIEs()
+
+	cases := []struct {
+		query string
+		want  int
+		types []ie.IEType
+	}{
+		{"CreatePDR[PDRID=3]/PDI/FTEID", 1, []ie.IEType{ie.FTEID}},
+		{"CreatePDR[PDRID=0x3]/PDI/FTEID", 1, []ie.IEType{ie.FTEID}},
+		{"CreatePDR/PDI/FTEID", 2, []ie.IEType{ie.FTEID, ie.FTEID}},
+		{"CreatePDR[1]/PDRID", 1, []ie.IEType{ie.PDRID}},
+		{"CreatePDR[PDI]", 2, []ie.IEType{ie.CreatePDR, ie.CreatePDR}},
+		{"CreatePDR[PDRID=4]", 0, nil},
+		{"CreatePDR[5]", 0, nil},
+		{"**/FTEID", 2, []ie.IEType{ie.FTEID, ie.FTEID}},
+		{"**/OuterHeaderCreation", 1, []ie.IEType{ie.OuterHeaderCreation}},
+		{"*[FARID=1]", 2, []ie.IEType{ie.CreatePDR, ie.CreateFAR}},
+		{"CreateFAR[ApplyAction=FORW]/FARID", 1, []ie.IEType{ie.FARID}},
+		{"CreatePDR/PDI[NetworkInstance=ims]/FTEID", 1, []ie.IEType{ie.FTEID}},
+		{"CreatePDR/PDI[SourceInterface=Access]", 2, []ie.IEType{ie.PDI, ie.PDI}},
+		{"CreateFAR[FARID=1]/**", 5, nil},
+		{"NodeID", 1, []ie.IEType{ie.NodeID}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			got, err := ie.QueryIEs(ies, c.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != c.want {
+				t.Fatalf("got %d IEs, want %d", len(got), c.want)
+			}
+			for n, typ := range c.types {
+				if got[n].Type != typ {
+					t.Errorf("got %s at %d, want %s", got[n].Type, n, typ)
+				}
+			}
+		})
+	}
+
+	t.Run("Relative", func(t *testing.T) {
+		got, err := ies[2].Query("PDI/FTEID")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := teids(t, got); len(v) != 1 || v[0] != 0x33333333 {
+			t.Errorf("got %x", v)
+		}
+	})
+
+	for _, q := range []string{"", "CreatePDR//PDI", "CreatPDR", "CreatePDR[PDRID=1", "CreatePDR[Foo=1]", "**[0]", "CreatePDR[-1]"} {
+		t.Run("Invalid/"+q, func(t *testing.T) {
+			var qe *ie.QueryError
+			if _, err := ie.QueryIEs(ies, q); !errors.As(err, &qe) {
+				t.Errorf("got %v, want QueryError", err)
+			}
+		})
+	}
+}
+
+func TestQueryDiffPath(t *testing.T) {
+	a := newQueryTestIEs()
+	b := newQueryTestIEs()
+	b[1] = ie.NewCreatePDR(
+		ie.NewPDRID(1),
+		ie.NewPrecedence(200),
+		ie.NewPDI(
+			ie.NewSourceInterface(ie.SrcInterfaceAccess),
+			ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+			ie.NewNetworkInstance("internet"),
+		),
+		ie.NewFARID(1),
+		ie.NewURRID(1),
+		ie.NewURRID(2),
+	)
+	b[2] = ie.NewCreatePDR(ie.NewPDRID(9), ie.NewFARID(3))
+	b[4] = ie.NewCreateFAR(ie.NewFARID(3), ie.NewApplyAction(0x01), ie.NewBARID(1))
+
+	diffs := ie.DiffIEs(a, b)
+	if len(diffs) != 6 {
+		t.Fatalf("got %d differences: %v", len(diffs), diffs)
+	}
+	for _, d := range diffs {
+		t.Run(d.Path, func(t *testing.T) {
+			ies, want := a, d.A
+			if d.Kind == ie.DiffAdded {
+				ies, want = b, d.B
+			}
+
+			got, err := ie.QueryIEs(ies, d.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || got[0] != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
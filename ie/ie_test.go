@@ -5,6 +5,7 @@
 package ie_test
 
 import (
+	"encoding/json"
 	"io"
 	"net"
 	"testing"
@@ -1841,6 +1842,14 @@ func TestIEs(t *testing.T) {
 			"FailedRuleID/BAR",
 			ie.NewFailedRuleID(ie.RuleIDTypeBAR, 0xff),
 			[]byte{0x00, 0x72, 0x00, 0x02, 0x04, 0xff},
+		}, {
+			"FailedRuleID/MAR",
+			ie.NewFailedRuleID(ie.RuleIDTypeMAR, 0xffff),
+			[]byte{0x00, 0x72, 0x00, 0x03, 0x05, 0xff, 0xff},
+		}, {
+			"FailedRuleID/SRR",
+			ie.NewFailedRuleID(ie.RuleIDTypeSRR, 0xff),
+			[]byte{0x00, 0x72, 0x00, 0x02, 0x06, 0xff},
 		}, {
 			"TimeQuotaMechanism",
 			ie.NewTimeQuotaMechanism(ie.BTITCTP, 10*time.Second),
@@ -3612,6 +3621,29 @@ func TestIEs(t *testing.T) {
 			if diff := cmp.Diff(got, c.structured, opt); diff != "" {
 				t.Error(diff)
 			}
+			if err := got.Validate(); err != nil {
+				t.Errorf("valid IE rejected: %v", err)
+			}
+		})
+
+		t.Run("json/"+c.description, func(t *testing.T) {
+			b, err := json.Marshal(c.structured)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := &ie.IE{}
+			if err := json.Unmarshal(b, got); err != nil {
+				t.Fatal(err)
+			}
+
+			serialized, err := got.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(serialized, c.serialized); diff != "" {
+				t.Error(diff)
+			}
 		})
 	}
 }
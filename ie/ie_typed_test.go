@@ -0,0 +1,131 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// roundTrip marshals the IE created by the descriptor and gets the value back.
+func roundTrip[T any](t *testing.T, typ ie.Typed[T], v T) T {
+	t.Helper()
+
+	b, err := typ.New(v).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	i, err := ie.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !typ.Is(i) {
+		t.Fatalf("got %s, want %s", i.Type, typ)
+	}
+	got, err := typ.Get(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestTyped(t *testing.T) {
+	t.Run("Uint16", func(t *testing.T) {
+		if got := roundTrip(t, ie.PDRIDType, 0x1122); got != 0x1122 {
+			t.Errorf("got %#x", got)
+		}
+	})
+
+	t.Run("Codec", func(t *testing.T) {
+		if got := roundTrip(t, ie.GateStatusType, 0x05); got != 0x05 {
+			t.Errorf("got %#x", got)
+		}
+	})
+
+	t.Run("NodeID", func(t *testing.T) {
+		for _, want := range []string{"127.0.0.1", "2001::1", "go-pfcp.epc.3gppnetwork.org"} {
+			if got := roundTrip(t, ie.NodeIDType, want); got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		}
+	})
+
+	t.Run("Fields", func(t *testing.T) {
+		want, err := ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1").To4(), nil, 0).FTEID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(roundTrip(t, ie.FTEIDType, want), want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Grouped", func(t *testing.T) {
+		want := &ie.CreateFARFields{
+			FARID:       1,
+			ApplyAction: []uint8{0x02},
+			ForwardingParameters: &ie.ForwardingParametersFields{
+				DestinationInterface: ie.DstInterfaceCore,
+			},
+		}
+		if diff := cmp.Diff(roundTrip(t, ie.CreateFARType, want), want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		var te *ie.InvalidTypeError
+		if _, err := ie.PDRIDType.Get(ie.NewFARID(1)); !errors.As(err, &te) {
+			t.Errorf("got %v, want InvalidTypeError", err)
+		}
+		// the accessor looks into the child IEs, but the descriptor does not.
+		if _, err := ie.PDRIDType.Get(ie.NewCreatePDR(ie.NewPDRID(1))); !errors.As(err, &te) {
+			t.Errorf("got %v, want InvalidTypeError", err)
+		}
+		if _, err := ie.PDRIDType.Get(nil); !errors.Is(err, ie.ErrIENotFound) {
+			t.Errorf("got %v, want ErrIENotFound", err)
+		}
+	})
+}
+
+func TestTypedFindAndCollect(t *testing.T) {
+	ies := newQueryTestIEs()
+
+	if _, err := ie.FTEIDType.Find(ies); !errors.Is(err, ie.ErrIENotFound) {
+		t.Errorf("Find should not look into the child IEs: %v", err)
+	}
+	id, err := ie.NodeIDType.Find(ies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "127.0.0.1" {
+		t.Errorf("got %s", id)
+	}
+
+	fteids, err := ie.FTEIDType.Collect(ies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fteids) != 2 || fteids[1].TEID != 0x33333333 {
+		t.Errorf("got %v", fteids)
+	}
+
+	ids, err := ie.FARIDType.Collect(ies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(ids, []uint32{1, 3, 1, 3}); diff != "" {
+		t.Error(diff)
+	}
+
+	malformed := []*ie.IE{ie.New(ie.PDRID, []byte{0x01})}
+	if _, err := ie.PDRIDType.Collect(malformed); err == nil {
+		t.Error("expected error for malformed IE")
+	}
+}
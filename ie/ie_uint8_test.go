@@ -213,6 +213,11 @@ func TestUint8IEs(t *testing.T) {
 			structured:  ie.NewDownlinkDataServiceInformation(true, true, 1, 1),
 			decoded:     1,
 			decoderFunc: func(i *ie.IE) (uint8, error) { return i.QFI() },
+		}, {
+			description: "DownlinkDataServiceInformation/QFI/NoPPI",
+			structured:  ie.NewDownlinkDataServiceInformation(false, true, 0, 9),
+			decoded:     9,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.QFI() },
 		}, {
 			description: "EthernetFilterProperties",
 			structured:  ie.NewEthernetFilterProperties(0x01),
@@ -0,0 +1,183 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		description string
+		ie          *ie.IE
+		cause       uint8
+		parents     []ie.IEType
+	}{
+		{
+			"Cause/TooLong",
+			ie.New(ie.Cause, []byte{0x01, 0x00, 0x00}),
+			ie.CauseInvalidLength, nil,
+		}, {
+			"Cause/Reserved",
+			ie.NewCause(0),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"PDRID/TooShort",
+			ie.New(ie.PDRID, []byte{0x01}),
+			ie.CauseInvalidLength, nil,
+		}, {
+			"SourceInterface/Undefined",
+			ie.NewSourceInterface(0x0a),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"SourceInterface/SpareBits",
+			ie.New(ie.SourceInterface, []byte{0x10}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"FTEID/CH/WithTEID",
+			ie.New(ie.FTEID, []byte{0x05, 0x11, 0x11, 0x11, 0x11, 0x7f, 0x00, 0x00, 0x01}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"FTEID/CHIDWithoutCH",
+			ie.New(ie.FTEID, []byte{0x09, 0x11, 0x11, 0x11, 0x11, 0x7f, 0x00, 0x00, 0x01}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"FTEID/NoAddress",
+			ie.New(ie.FTEID, []byte{0x00, 0x11, 0x11, 0x11, 0x11}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"FTEID/TooShort",
+			ie.New(ie.FTEID, []byte{0x01, 0x11, 0x11, 0x11, 0x11, 0x7f}),
+			ie.CauseInvalidLength, nil,
+		}, {
+			"UEIPAddress/V4/TooShort",
+			ie.New(ie.UEIPAddress, []byte{0x02, 0x7f}),
+			ie.CauseInvalidLength, nil,
+		}, {
+			"UEIPAddress/IPv6DWithoutV6",
+			ie.New(ie.UEIPAddress, []byte{0x0a, 0x7f, 0x00, 0x00, 0x01, 0x04}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"FSEID/NoAddress",
+			ie.New(ie.FSEID, []byte{0x00, 0, 0, 0, 0, 0, 0, 0, 1}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"NodeID/IPv4/TooLong",
+			ie.New(ie.NodeID, []byte{0x00, 0x7f, 0x00, 0x00, 0x01, 0x00}),
+			ie.CauseInvalidLength, nil,
+		}, {
+			"NodeID/Undefined",
+			ie.New(ie.NodeID, []byte{0x03, 0x7f}),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"ApplyAction/DROPAndFORW",
+			ie.NewApplyAction(0x03),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"GateStatus/Undefined",
+			ie.NewGateStatus(ie.GateStatusOpen, 0x02),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"PDNType/Undefined",
+			ie.NewPDNType(0),
+			ie.CauseMandatoryIEIncorrect, nil,
+		}, {
+			"Grouped",
+			ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceAccess),
+					ie.New(ie.FTEID, []byte{0x05, 0x11, 0x11, 0x11, 0x11, 0x7f, 0x00, 0x00, 0x01}),
+				),
+			),
+			ie.CauseMandatoryIEIncorrect, []ie.IEType{ie.CreatePDR, ie.PDI},
+		}, {
+			"Grouped/Payload",
+			ie.New(ie.CreateFAR, []byte{0x00, 0x6c, 0x00}),
+			ie.CauseInvalidLength, nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			err := c.ie.Validate()
+
+			var ve *ie.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("got %v, want ValidationError", err)
+			}
+			if ve.Cause != c.cause {
+				t.Errorf("got cause %d, want %d: %v", ve.Cause, c.cause, err)
+			}
+			if diff := cmp.Diff(ve.Parents, c.parents); diff != "" {
+				t.Error(diff)
+			}
+
+			want := ie.ErrMalformed
+			if c.cause == ie.CauseInvalidLength {
+				want = ie.ErrInvalidLength
+			}
+			if !errors.Is(err, want) {
+				t.Errorf("got %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+func TestValidateValid(t *testing.T) {
+	ies := []*ie.IE{
+		ie.NewCause(ie.CauseRequestAccepted),
+		ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+		ie.NewFTEID(0x0d, 0, nil, nil, 1),
+		ie.NewUEIPAddress(0x02, "127.0.0.1", "", 0, 0),
+		ie.NewUEIPAddress(0x60, "", "", 0, 60),
+		ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+		ie.NewApplyAction(0x02, 0x00, 0x00),
+		ie.NewVendorSpecificIE(0xffff, 10415, []byte{0xde, 0xad}),
+		ie.NewCreateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.1", "", 0, 0, 0),
+			),
+		),
+	}
+
+	for _, i := range ies {
+		if err := i.Validate(); err != nil {
+			t.Errorf("%s: unexpected error: %v", i.Type, err)
+		}
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	b := []byte{0x00, 0x13, 0x00, 0x03, 0x01, 0x00, 0x00}
+	if _, err := ie.Parse(b); err != nil {
+		t.Fatalf("Parse should not validate: %v", err)
+	}
+
+	_, err := ie.ParseStrict(b)
+	var ve *ie.ValidationError
+	if !errors.As(err, &ve) || ve.Cause != ie.CauseInvalidLength {
+		t.Fatalf("got %v, want ValidationError with CauseInvalidLength", err)
+	}
+	if got, want := err.Error(), "invalid Cause: length 3 is longer than 1"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	ies, err := ie.ParseMultiIEsStrict([]byte{0x00, 0x13, 0x00, 0x01, 0x01, 0x00, 0x38, 0x00, 0x02, 0x00, 0x01})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ies) != 2 {
+		t.Errorf("got %d IEs, want 2", len(ies))
+	}
+}
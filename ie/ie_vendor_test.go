@@ -0,0 +1,150 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+const (
+	vendorA uint16 = 10415
+	vendorB uint16 = 32473
+
+	vendorType    ie.IEType = 0x8001
+	vendorSubType ie.IEType = 0x8002
+)
+
+func registerVendorIEs(t *testing.T) {
+	t.Helper()
+
+	decodeUint32 := func(b []byte) (any, error) {
+		if len(b) != 4 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return binary.BigEndian.Uint32(b), nil
+	}
+
+	defs := []struct {
+		eid uint16
+		typ ie.IEType
+		v   ie.VendorIE
+	}{
+		{vendorA, vendorType, ie.VendorIE{Name: "AcmeCounter", Decode: decodeUint32}},
+		{vendorB, vendorType, ie.VendorIE{Name: "ExampleContainer", Grouped: true}},
+		{vendorB, vendorSubType, ie.VendorIE{Name: "ExampleLabel", Decode: func(b []byte) (any, error) { return string(b), nil }}},
+	}
+	for _, d := range defs {
+		if err := ie.RegisterVendorIE(d.eid, d.typ, d.v); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { ie.UnregisterVendorIE(d.eid, d.typ) })
+	}
+}
+
+func TestVendorIERegistry(t *testing.T) {
+	registerVendorIEs(t)
+
+	a := ie.NewVendorSpecificIE(vendorType, vendorA, []byte{0x00, 0x00, 0x01, 0x00})
+	b := ie.NewVendorSpecificGroupedIE(vendorType, vendorB,
+		ie.NewVendorSpecificIE(vendorSubType, vendorB, []byte("foo")),
+	)
+	if a.IsGrouped() || !b.IsGrouped() {
+		t.Fatal("IsGrouped should depend on EnterpriseID")
+	}
+
+	var serialized []byte
+	for _, i := range []*ie.IE{a, b} {
+		x, err := i.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		serialized = append(serialized, x...)
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		ies, err := ie.ParseMultiIEsStrict(serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ies) != 2 {
+			t.Fatalf("got %d IEs, want 2", len(ies))
+		}
+
+		v, err := ies[0].VendorValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != uint32(256) {
+			t.Errorf("got %v, want 256", v)
+		}
+
+		if len(ies[1].ChildIEs) != 1 {
+			t.Fatalf("got %d children, want 1", len(ies[1].ChildIEs))
+		}
+		v, err = ies[1].ChildIEs[0].VendorValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != "foo" {
+			t.Errorf("got %v, want foo", v)
+		}
+		if _, err := ies[1].VendorValue(); err == nil {
+			t.Error("grouped IE has no value")
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		want := "AcmeCounter [EnterpriseID=10415]: 256"
+		if got := a.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+		want = "ExampleContainer [EnterpriseID=32473]:\n  ExampleLabel [EnterpriseID=32473]: \"foo\""
+		if got := b.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		j, err := json.Marshal(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := &ie.IE{}
+		if err := json.Unmarshal(j, got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, b); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		bad := ie.NewVendorSpecificIE(vendorType, vendorA, []byte{0x01})
+		if err := bad.Validate(); !errors.Is(err, ie.ErrInvalidLength) {
+			t.Errorf("got %v, want ErrInvalidLength", err)
+		}
+		unknown := ie.NewVendorSpecificIE(vendorType, 1, []byte{0x01})
+		if err := unknown.Validate(); err != nil {
+			t.Errorf("unregistered IE should be valid: %v", err)
+		}
+		if unknown.TypeName() != "IEType(32769)" {
+			t.Errorf("got %s", unknown.TypeName())
+		}
+	})
+
+	t.Run("NotVendorSpecific", func(t *testing.T) {
+		var te *ie.InvalidTypeError
+		if err := ie.RegisterVendorIE(vendorA, ie.Cause, ie.VendorIE{}); !errors.As(err, &te) {
+			t.Errorf("got %v, want InvalidTypeError", err)
+		}
+	})
+}
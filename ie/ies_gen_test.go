@@ -0,0 +1,3102 @@
+// Code generated by internal/gen from spec/ies.json and spec/grouped.json; DO NOT EDIT.
+
+package ie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestGeneratedIEs(t *testing.T) {
+	t.Run("Cause", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewCause(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.Cause()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SourceInterface", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewSourceInterface(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SourceInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewSourceInterface(want)).SourceInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("ApplicationID", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewApplicationID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.ApplicationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewApplicationID(want)).ApplicationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewApplicationID(want)).ApplicationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewApplicationID(want)).ApplicationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ApplicationIDsPFDs, ie.NewApplicationID(want)).ApplicationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ApplicationDetectionInformation, ie.NewApplicationID(want)).ApplicationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("QERCorrelationID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewQERCorrelationID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.QERCorrelationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateQER, ie.NewQERCorrelationID(want)).QERCorrelationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateQER, ie.NewQERCorrelationID(want)).QERCorrelationID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("Precedence", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewPrecedence(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.Precedence()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewPrecedence(want)).Precedence()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewPrecedence(want)).Precedence()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("TransportLevelMarking", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewTransportLevelMarking(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ForwardingParameters, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.DuplicatingParameters, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateForwardingParameters, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateDuplicatingParameters, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.GTPUPathQoSControlInformation, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.QoSInformationInGTPUPathQoSReport, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TransportDelayReporting, ie.NewTransportLevelMarking(want)).TransportLevelMarking()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("MonitoringTime", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewMonitoringTime(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.MonitoringTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewMonitoringTime(want)).MonitoringTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewMonitoringTime(want)).MonitoringTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.AdditionalMonitoringTime, ie.NewMonitoringTime(want)).MonitoringTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("InactivityDetectionTime", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewInactivityDetectionTime(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.InactivityDetectionTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewInactivityDetectionTime(want)).InactivityDetectionTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewInactivityDetectionTime(want)).InactivityDetectionTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DestinationInterface", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewDestinationInterface(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DestinationInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ForwardingParameters, ie.NewDestinationInterface(want)).DestinationInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.DuplicatingParameters, ie.NewDestinationInterface(want)).DestinationInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateForwardingParameters, ie.NewDestinationInterface(want)).DestinationInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateDuplicatingParameters, ie.NewDestinationInterface(want)).DestinationInterface()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPSMReqFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPSMReqFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPSMReqFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateForwardingParameters, ie.NewPFCPSMReqFlags(want)).PFCPSMReqFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPSRRspFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPSRRspFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPSRRspFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SequenceNumber", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewSequenceNumber(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SequenceNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.LoadControlInformation, ie.NewSequenceNumber(want)).SequenceNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.OverloadControlInformation, ie.NewSequenceNumber(want)).SequenceNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UEIPAddressUsageInformation, ie.NewSequenceNumber(want)).SequenceNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("Metric", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewMetric(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.Metric()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.LoadControlInformation, ie.NewMetric(want)).Metric()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.OverloadControlInformation, ie.NewMetric(want)).Metric()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UEIPAddressUsageInformation, ie.NewMetric(want)).Metric()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PDRID", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewPDRID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatedPDR, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemovePDR, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ApplicationDetectionInformation, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.DownlinkDataReport, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatedPDR, ie.NewPDRID(want)).PDRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("TimeOfFirstPacket", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewTimeOfFirstPacket(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.TimeOfFirstPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewTimeOfFirstPacket(want)).TimeOfFirstPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionDeletionResponse, ie.NewTimeOfFirstPacket(want)).TimeOfFirstPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewTimeOfFirstPacket(want)).TimeOfFirstPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("TimeOfLastPacket", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewTimeOfLastPacket(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.TimeOfLastPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewTimeOfLastPacket(want)).TimeOfLastPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionDeletionResponse, ie.NewTimeOfLastPacket(want)).TimeOfLastPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewTimeOfLastPacket(want)).TimeOfLastPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("StartTime", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewStartTime(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.StartTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewStartTime(want)).StartTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionDeletionResponse, ie.NewStartTime(want)).StartTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewStartTime(want)).StartTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.GTPUPathQoSReport, ie.NewStartTime(want)).StartTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.QoSMonitoringReport, ie.NewStartTime(want)).StartTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EndTime", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewEndTime(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EndTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewEndTime(want)).EndTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionDeletionResponse, ie.NewEndTime(want)).EndTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewEndTime(want)).EndTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("URRID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewURRID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveURR, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.QueryURR, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionDeletionResponse, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TGPPAccessForwardingActionInformation, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.NonTGPPAccessForwardingActionInformation, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTGPPAccessForwardingActionInformation, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateNonTGPPAccessForwardingActionInformation, ie.NewURRID(want)).URRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("LinkedURRID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewLinkedURRID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.LinkedURRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewLinkedURRID(want)).LinkedURRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewLinkedURRID(want)).LinkedURRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("BARID", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewBARID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateFAR, ie.NewBARID(want)).BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateFAR, ie.NewBARID(want)).BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateBARWithinSessionReportResponse, ie.NewBARID(want)).BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateBAR, ie.NewBARID(want)).BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateBARWithinSessionModificationRequest, ie.NewBARID(want)).BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveBAR, ie.NewBARID(want)).BARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("ApplicationInstanceID", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewApplicationInstanceID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.ApplicationInstanceID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ApplicationDetectionInformation, ie.NewApplicationInstanceID(want)).ApplicationInstanceID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("RecoveryTimeStamp", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewRecoveryTimeStamp(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.RecoveryTimeStamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("MeasurementInformation", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewMeasurementInformation(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.MeasurementInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewMeasurementInformation(want)).MeasurementInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewMeasurementInformation(want)).MeasurementInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("NodeReportType", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewNodeReportType(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.NodeReportType()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("URSEQN", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewURSEQN(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.URSEQN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewURSEQN(want)).URSEQN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionDeletionResponse, ie.NewURSEQN(want)).URSEQN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewURSEQN(want)).URSEQN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("ActivatePredefinedRules", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewActivatePredefinedRules(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.ActivatePredefinedRules()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewActivatePredefinedRules(want)).ActivatePredefinedRules()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewActivatePredefinedRules(want)).ActivatePredefinedRules()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DeactivatePredefinedRules", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewDeactivatePredefinedRules(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DeactivatePredefinedRules()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewDeactivatePredefinedRules(want)).DeactivatePredefinedRules()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("FARID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewFARID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateFAR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateFAR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveFAR, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TGPPAccessForwardingActionInformation, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.NonTGPPAccessForwardingActionInformation, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTGPPAccessForwardingActionInformation, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateNonTGPPAccessForwardingActionInformation, ie.NewFARID(want)).FARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("QERID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewQERID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateQER, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateQER, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveQER, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PacketRateStatusReport, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.QueryPacketRateStatusWithinSessionModificationRequest, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PacketRateStatusReportWithinSessionModificationResponse, ie.NewQERID(want)).QERID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("OCIFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewOCIFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.OCIFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.OverloadControlInformation, ie.NewOCIFlags(want)).OCIFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PDNType", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPDNType(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PDNType()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("AggregatedURRID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewAggregatedURRID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.AggregatedURRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.AggregatedURRs, ie.NewAggregatedURRID(want)).AggregatedURRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("RQI", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewRQI(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.RQI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateQER, ie.NewRQI(want)).RQI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateQER, ie.NewRQI(want)).RQI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("QueryURRReference", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewQueryURRReference(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.QueryURRReference()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionModificationResponse, ie.NewQueryURRReference(want)).QueryURRReference()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewQueryURRReference(want)).QueryURRReference()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("TrafficEndpointID", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewTrafficEndpointID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ForwardingParameters, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateForwardingParameters, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateTrafficEndpoint, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatedTrafficEndpoint, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTrafficEndpoint, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveTrafficEndpoint, ie.NewTrafficEndpointID(want)).TrafficEndpointID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("Ethertype", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewEthertype(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.Ethertype()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.EthernetPacketFilter, ie.NewEthertype(want)).Ethertype()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EthernetFilterID", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewEthernetFilterID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EthernetFilterID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.EthernetPacketFilter, ie.NewEthernetFilterID(want)).EthernetFilterID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EthernetFilterProperties", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewEthernetFilterProperties(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EthernetFilterProperties()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.EthernetPacketFilter, ie.NewEthernetFilterProperties(want)).EthernetFilterProperties()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SuggestedBufferingPacketsCount", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewSuggestedBufferingPacketsCount(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SuggestedBufferingPacketsCount()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateBARWithinSessionReportResponse, ie.NewSuggestedBufferingPacketsCount(want)).SuggestedBufferingPacketsCount()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateBAR, ie.NewSuggestedBufferingPacketsCount(want)).SuggestedBufferingPacketsCount()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateBARWithinSessionModificationRequest, ie.NewSuggestedBufferingPacketsCount(want)).SuggestedBufferingPacketsCount()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EthernetPDUSessionInformation", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewEthernetPDUSessionInformation(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EthernetPDUSessionInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewEthernetPDUSessionInformation(want)).EthernetPDUSessionInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateTrafficEndpoint, ie.NewEthernetPDUSessionInformation(want)).EthernetPDUSessionInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EventQuota", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewEventQuota(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewEventQuota(want)).EventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewEventQuota(want)).EventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EventThreshold", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewEventThreshold(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewEventThreshold(want)).EventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewEventThreshold(want)).EventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SubsequentEventQuota", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewSubsequentEventQuota(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SubsequentEventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewSubsequentEventQuota(want)).SubsequentEventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewSubsequentEventQuota(want)).SubsequentEventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.AdditionalMonitoringTime, ie.NewSubsequentEventQuota(want)).SubsequentEventQuota()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SubsequentEventThreshold", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewSubsequentEventThreshold(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SubsequentEventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewSubsequentEventThreshold(want)).SubsequentEventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewSubsequentEventThreshold(want)).SubsequentEventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.AdditionalMonitoringTime, ie.NewSubsequentEventThreshold(want)).SubsequentEventThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("FramedRoute", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewFramedRoute(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.FramedRoute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewFramedRoute(want)).FramedRoute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateTrafficEndpoint, ie.NewFramedRoute(want)).FramedRoute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTrafficEndpoint, ie.NewFramedRoute(want)).FramedRoute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("FramedRouting", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewFramedRouting(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.FramedRouting()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewFramedRouting(want)).FramedRouting()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateTrafficEndpoint, ie.NewFramedRouting(want)).FramedRouting()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTrafficEndpoint, ie.NewFramedRouting(want)).FramedRouting()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("FramedIPv6Route", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewFramedIPv6Route(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.FramedIPv6Route()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewFramedIPv6Route(want)).FramedIPv6Route()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateTrafficEndpoint, ie.NewFramedIPv6Route(want)).FramedIPv6Route()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTrafficEndpoint, ie.NewFramedIPv6Route(want)).FramedIPv6Route()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("EventTimeStamp", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewEventTimeStamp(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.EventTimeStamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UsageReportWithinSessionReportRequest, ie.NewEventTimeStamp(want)).EventTimeStamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ClockDriftReport, ie.NewEventTimeStamp(want)).EventTimeStamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.GTPUPathQoSReport, ie.NewEventTimeStamp(want)).EventTimeStamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.QoSMonitoringReport, ie.NewEventTimeStamp(want)).EventTimeStamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("AveragingWindow", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewAveragingWindow(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.AveragingWindow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateQER, ie.NewAveragingWindow(want)).AveragingWindow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateQER, ie.NewAveragingWindow(want)).AveragingWindow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("APNDNN", func(t *testing.T) {
+		want := "go-pfcp.epc.3gppnetwork.org"
+
+		b, err := ie.NewAPNDNN(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.APNDNN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPSRReqFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPSRReqFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPSRReqFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPAUReqFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPAUReqFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPAUReqFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("ActivationTime", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewActivationTime(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.ActivationTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewActivationTime(want)).ActivationTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewActivationTime(want)).ActivationTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DeactivationTime", func(t *testing.T) {
+		want := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		b, err := ie.NewDeactivationTime(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DeactivationTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewDeactivationTime(want)).DeactivationTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdatePDR, ie.NewDeactivationTime(want)).DeactivationTime()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("MARID", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewMARID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.MARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewMARID(want)).MARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateMAR, ie.NewMARID(want)).MARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveMAR, ie.NewMARID(want)).MARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateMAR, ie.NewMARID(want)).MARID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("Weight", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewWeight(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.Weight()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TGPPAccessForwardingActionInformation, ie.NewWeight(want)).Weight()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.NonTGPPAccessForwardingActionInformation, ie.NewWeight(want)).Weight()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateTGPPAccessForwardingActionInformation, ie.NewWeight(want)).Weight()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateNonTGPPAccessForwardingActionInformation, ie.NewWeight(want)).Weight()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PacketReplicationAndDetectionCarryOnInformation", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPacketReplicationAndDetectionCarryOnInformation(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PacketReplicationAndDetectionCarryOnInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewPacketReplicationAndDetectionCarryOnInformation(want)).PacketReplicationAndDetectionCarryOnInformation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SMFSetID", func(t *testing.T) {
+		want := "go-pfcp.epc.3gppnetwork.org"
+
+		b, err := ie.NewSMFSetID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SMFSetID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("NumberOfReports", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewNumberOfReports(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.NumberOfReports()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateURR, ie.NewNumberOfReports(want)).NumberOfReports()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateURR, ie.NewNumberOfReports(want)).NumberOfReports()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPASRspFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPASRspFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPASRspFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPSEReqFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPSEReqFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPSEReqFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DSTTPortNumber", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewDSTTPortNumber(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DSTTPortNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatedBridgeInfoForTSC, ie.NewDSTTPortNumber(want)).DSTTPortNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("NWTTPortNumber", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewNWTTPortNumber(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.NWTTPortNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionModificationRequest, ie.NewNWTTPortNumber(want)).NWTTPortNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionModificationResponse, ie.NewNWTTPortNumber(want)).NWTTPortNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionReportRequest, ie.NewNWTTPortNumber(want)).NWTTPortNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PortManagementInformationContainer", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewPortManagementInformationContainer(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PortManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionModificationRequest, ie.NewPortManagementInformationContainer(want)).PortManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionModificationResponse, ie.NewPortManagementInformationContainer(want)).PortManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionReportRequest, ie.NewPortManagementInformationContainer(want)).PortManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("TSNTimeDomainNumber", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewTSNTimeDomainNumber(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.TSNTimeDomainNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ClockDriftControlInformation, ie.NewTSNTimeDomainNumber(want)).TSNTimeDomainNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ClockDriftReport, ie.NewTSNTimeDomainNumber(want)).TSNTimeDomainNumber()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("CumulativeRateRatioThreshold", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewCumulativeRateRatioThreshold(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.CumulativeRateRatioThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ClockDriftControlInformation, ie.NewCumulativeRateRatioThreshold(want)).CumulativeRateRatioThreshold()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("CumulativeRateRatioMeasurement", func(t *testing.T) {
+		want := uint32(0x11223344)
+
+		b, err := ie.NewCumulativeRateRatioMeasurement(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.CumulativeRateRatioMeasurement()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ClockDriftReport, ie.NewCumulativeRateRatioMeasurement(want)).CumulativeRateRatioMeasurement()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("SRRID", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewSRRID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.SRRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.RemoveSRR, ie.NewSRRID(want)).SRRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateSRR, ie.NewSRRID(want)).SRRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateSRR, ie.NewSRRID(want)).SRRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.SessionReport, ie.NewSRRID(want)).SRRID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DataNetworkAccessIdentifier", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewDataNetworkAccessIdentifier(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DataNetworkAccessIdentifier()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewDataNetworkAccessIdentifier(want)).DataNetworkAccessIdentifier()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.ForwardingParameters, ie.NewDataNetworkAccessIdentifier(want)).DataNetworkAccessIdentifier()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateForwardingParameters, ie.NewDataNetworkAccessIdentifier(want)).DataNetworkAccessIdentifier()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DLDataPacketsSize", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewDLDataPacketsSize(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DLDataPacketsSize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.DownlinkDataReport, ie.NewDLDataPacketsSize(want)).DLDataPacketsSize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("PFCPASReqFlags", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewPFCPASReqFlags(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.PFCPASReqFlags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("DataStatus", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewDataStatus(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.DataStatus()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.DownlinkDataReport, ie.NewDataStatus(want)).DataStatus()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("MPTCPApplicableIndication", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewMPTCPApplicableIndication(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.MPTCPApplicableIndication()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreatePDR, ie.NewMPTCPApplicableIndication(want)).MPTCPApplicableIndication()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("BridgeManagementInformationContainer", func(t *testing.T) {
+		want := "go-pfcp"
+
+		b, err := ie.NewBridgeManagementInformationContainer(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.BridgeManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionModificationRequest, ie.NewBridgeManagementInformationContainer(want)).BridgeManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionModificationResponse, ie.NewBridgeManagementInformationContainer(want)).BridgeManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.TSCManagementInformationWithinSessionReportRequest, ie.NewBridgeManagementInformationContainer(want)).BridgeManagementInformationContainer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("RATType", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewRATType(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.RATType()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("AreaSessionID", func(t *testing.T) {
+		want := uint16(0x1122)
+
+		b, err := ie.NewAreaSessionID(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.AreaSessionID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.PDI, ie.NewAreaSessionID(want)).AreaSessionID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("QERIndications", func(t *testing.T) {
+		want := uint8(0x01)
+
+		b, err := ie.NewQERIndications(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.QERIndications()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.CreateQER, ie.NewQERIndications(want)).QERIndications()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err = ie.NewGroupedIE(ie.UpdateQER, ie.NewQERIndications(want)).QERIndications()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
@@ -1,297 +0,0 @@
-// Code generated by "stringer -type=IEType"; DO NOT EDIT.
-
-package ie
-
-import "strconv"
-
-func _() {
-	// An "invalid array index" compiler error signifies that the constant values have changed.
-	// Re-run the stringer command to generate them again.
-	var x [1]struct{}
-	_ = x[CreatePDR-1]
-	_ = x[PDI-2]
-	_ = x[CreateFAR-3]
-	_ = x[ForwardingParameters-4]
-	_ = x[DuplicatingParameters-5]
-	_ = x[CreateURR-6]
-	_ = x[CreateQER-7]
-	_ = x[CreatedPDR-8]
-	_ = x[UpdatePDR-9]
-	_ = x[UpdateFAR-10]
-	_ = x[UpdateForwardingParameters-11]
-	_ = x[UpdateBARWithinSessionReportResponse-12]
-	_ = x[UpdateURR-13]
-	_ = x[UpdateQER-14]
-	_ = x[RemovePDR-15]
-	_ = x[RemoveFAR-16]
-	_ = x[RemoveURR-17]
-	_ = x[RemoveQER-18]
-	_ = x[Cause-19]
-	_ = x[SourceInterface-20]
-	_ = x[FTEID-21]
-	_ = x[NetworkInstance-22]
-	_ = x[SDFFilter-23]
-	_ = x[ApplicationID-24]
-	_ = x[GateStatus-25]
-	_ = x[MBR-26]
-	_ = x[GBR-27]
-	_ = x[QERCorrelationID-28]
-	_ = x[Precedence-29]
-	_ = x[TransportLevelMarking-30]
-	_ = x[VolumeThreshold-31]
-	_ = x[TimeThreshold-32]
-	_ = x[MonitoringTime-33]
-	_ = x[SubsequentVolumeThreshold-34]
-	_ = x[SubsequentTimeThreshold-35]
-	_ = x[InactivityDetectionTime-36]
-	_ = x[ReportingTriggers-37]
-	_ = x[RedirectInformation-38]
-	_ = x[ReportType-39]
-	_ = x[OffendingIE-40]
-	_ = x[ForwardingPolicy-41]
-	_ = x[DestinationInterface-42]
-	_ = x[UPFunctionFeatures-43]
-	_ = x[ApplyAction-44]
-	_ = x[DownlinkDataServiceInformation-45]
-	_ = x[DownlinkDataNotificationDelay-46]
-	_ = x[DLBufferingDuration-47]
-	_ = x[DLBufferingSuggestedPacketCount-48]
-	_ = x[PFCPSMReqFlags-49]
-	_ = x[PFCPSRRspFlags-50]
-	_ = x[LoadControlInformation-51]
-	_ = x[SequenceNumber-52]
-	_ = x[Metric-53]
-	_ = x[OverloadControlInformation-54]
-	_ = x[Timer-55]
-	_ = x[PDRID-56]
-	_ = x[FSEID-57]
-	_ = x[ApplicationIDsPFDs-58]
-	_ = x[PFDContext-59]
-	_ = x[NodeID-60]
-	_ = x[PFDContents-61]
-	_ = x[MeasurementMethod-62]
-	_ = x[UsageReportTrigger-63]
-	_ = x[MeasurementPeriod-64]
-	_ = x[FQCSID-65]
-	_ = x[VolumeMeasurement-66]
-	_ = x[DurationMeasurement-67]
-	_ = x[ApplicationDetectionInformation-68]
-	_ = x[TimeOfFirstPacket-69]
-	_ = x[TimeOfLastPacket-70]
-	_ = x[QuotaHoldingTime-71]
-	_ = x[DroppedDLTrafficThreshold-72]
-	_ = x[VolumeQuota-73]
-	_ = x[TimeQuota-74]
-	_ = x[StartTime-75]
-	_ = x[EndTime-76]
-	_ = x[QueryURR-77]
-	_ = x[UsageReportWithinSessionModificationResponse-78]
-	_ = x[UsageReportWithinSessionDeletionResponse-79]
-	_ = x[UsageReportWithinSessionReportRequest-80]
-	_ = x[URRID-81]
-	_ = x[LinkedURRID-82]
-	_ = x[DownlinkDataReport-83]
-	_ = x[OuterHeaderCreation-84]
-	_ = x[CreateBAR-85]
-	_ = x[UpdateBARWithinSessionModificationRequest-86]
-	_ = x[RemoveBAR-87]
-	_ = x[BARID-88]
-	_ = x[CPFunctionFeatures-89]
-	_ = x[UsageInformation-90]
-	_ = x[ApplicationInstanceID-91]
-	_ = x[FlowInformation-92]
-	_ = x[UEIPAddress-93]
-	_ = x[PacketRate-94]
-	_ = x[OuterHeaderRemoval-95]
-	_ = x[RecoveryTimeStamp-96]
-	_ = x[DLFlowLevelMarking-97]
-	_ = x[HeaderEnrichment-98]
-	_ = x[ErrorIndicationReport-99]
-	_ = x[MeasurementInformation-100]
-	_ = x[NodeReportType-101]
-	_ = x[UserPlanePathFailureReport-102]
-	_ = x[RemoteGTPUPeer-103]
-	_ = x[URSEQN-104]
-	_ = x[UpdateDuplicatingParameters-105]
-	_ = x[ActivatePredefinedRules-106]
-	_ = x[DeactivatePredefinedRules-107]
-	_ = x[FARID-108]
-	_ = x[QERID-109]
-	_ = x[OCIFlags-110]
-	_ = x[PFCPAssociationReleaseRequest-111]
-	_ = x[GracefulReleasePeriod-112]
-	_ = x[PDNType-113]
-	_ = x[FailedRuleID-114]
-	_ = x[TimeQuotaMechanism-115]
-	_ = x[UserPlaneIPResourceInformation-116]
-	_ = x[UserPlaneInactivityTimer-117]
-	_ = x[AggregatedURRs-118]
-	_ = x[Multiplier-119]
-	_ = x[AggregatedURRID-120]
-	_ = x[SubsequentVolumeQuota-121]
-	_ = x[SubsequentTimeQuota-122]
-	_ = x[RQI-123]
-	_ = x[QFI-124]
-	_ = x[QueryURRReference-125]
-	_ = x[AdditionalUsageReportsInformation-126]
-	_ = x[CreateTrafficEndpoint-127]
-	_ = x[CreatedTrafficEndpoint-128]
-	_ = x[UpdateTrafficEndpoint-129]
-	_ = x[RemoveTrafficEndpoint-130]
-	_ = x[TrafficEndpointID-131]
-	_ = x[EthernetPacketFilter-132]
-	_ = x[MACAddress-133]
-	_ = x[CTAG-134]
-	_ = x[STAG-135]
-	_ = x[Ethertype-136]
-	_ = x[Proxying-137]
-	_ = x[EthernetFilterID-138]
-	_ = x[EthernetFilterProperties-139]
-	_ = x[SuggestedBufferingPacketsCount-140]
-	_ = x[UserID-141]
-	_ = x[EthernetPDUSessionInformation-142]
-	_ = x[EthernetTrafficInformation-143]
-	_ = x[MACAddressesDetected-144]
-	_ = x[MACAddressesRemoved-145]
-	_ = x[EthernetInactivityTimer-146]
-	_ = x[AdditionalMonitoringTime-147]
-	_ = x[EventQuota-148]
-	_ = x[EventThreshold-149]
-	_ = x[SubsequentEventQuota-150]
-	_ = x[SubsequentEventThreshold-151]
-	_ = x[TraceInformation-152]
-	_ = x[FramedRoute-153]
-	_ = x[FramedRouting-154]
-	_ = x[FramedIPv6Route-155]
-	_ = x[EventTimeStamp-156]
-	_ = x[AveragingWindow-157]
-	_ = x[PagingPolicyIndicator-158]
-	_ = x[APNDNN-159]
-	_ = x[TGPPInterfaceType-160]
-	_ = x[PFCPSRReqFlags-161]
-	_ = x[PFCPAUReqFlags-162]
-	_ = x[ActivationTime-163]
-	_ = x[DeactivationTime-164]
-	_ = x[CreateMAR-165]
-	_ = x[TGPPAccessForwardingActionInformation-166]
-	_ = x[NonTGPPAccessForwardingActionInformation-167]
-	_ = x[RemoveMAR-168]
-	_ = x[UpdateMAR-169]
-	_ = x[MARID-170]
-	_ = x[SteeringFunctionality-171]
-	_ = x[SteeringMode-172]
-	_ = x[Weight-173]
-	_ = x[Priority-174]
-	_ = x[UpdateTGPPAccessForwardingActionInformation-175]
-	_ = x[UpdateNonTGPPAccessForwardingActionInformation-176]
-	_ = x[UEIPAddressPoolIdentity-177]
-	_ = x[AlternativeSMFIPAddress-178]
-	_ = x[PacketReplicationAndDetectionCarryOnInformation-179]
-	_ = x[SMFSetID-180]
-	_ = x[QuotaValidityTime-181]
-	_ = x[NumberOfReports-182]
-	_ = x[PFCPSessionRetentionInformation-183]
-	_ = x[PFCPASRspFlags-184]
-	_ = x[CPPFCPEntityIPAddress-185]
-	_ = x[PFCPSEReqFlags-186]
-	_ = x[UserPlanePathRecoveryReport-187]
-	_ = x[IPMulticastAddressingInfo-188]
-	_ = x[JoinIPMulticastInformationWithinUsageReport-189]
-	_ = x[LeaveIPMulticastInformationWithinUsageReport-190]
-	_ = x[IPMulticastAddress-191]
-	_ = x[SourceIPAddress-192]
-	_ = x[PacketRateStatus-193]
-	_ = x[CreateBridgeInfoForTSC-194]
-	_ = x[CreatedBridgeInfoForTSC-195]
-	_ = x[DSTTPortNumber-196]
-	_ = x[NWTTPortNumber-197]
-	_ = x[TSNBridgeID-198]
-	_ = x[TSCManagementInformationWithinSessionModificationRequest-199]
-	_ = x[TSCManagementInformationWithinSessionModificationResponse-200]
-	_ = x[TSCManagementInformationWithinSessionReportRequest-201]
-	_ = x[PortManagementInformationForTSCWithinSessionModificationRequest-199]
-	_ = x[PortManagementInformationForTSCWithinSessionModificationResponse-200]
-	_ = x[PortManagementInformationForTSCWithinSessionReportRequest-201]
-	_ = x[PortManagementInformationContainer-202]
-	_ = x[ClockDriftControlInformation-203]
-	_ = x[RequestedClockDriftInformation-204]
-	_ = x[ClockDriftReport-205]
-	_ = x[TSNTimeDomainNumber-206]
-	_ = x[TimeOffsetThreshold-207]
-	_ = x[CumulativeRateRatioThreshold-208]
-	_ = x[TimeOffsetMeasurement-209]
-	_ = x[CumulativeRateRatioMeasurement-210]
-	_ = x[RemoveSRR-211]
-	_ = x[CreateSRR-212]
-	_ = x[UpdateSRR-213]
-	_ = x[SessionReport-214]
-	_ = x[SRRID-215]
-	_ = x[AccessAvailabilityControlInformation-216]
-	_ = x[RequestedAccessAvailabilityInformation-217]
-	_ = x[AccessAvailabilityReport-218]
-	_ = x[AccessAvailabilityInformation-219]
-	_ = x[ProvideATSSSControlInformation-220]
-	_ = x[ATSSSControlParameters-221]
-	_ = x[MPTCPControlInformation-222]
-	_ = x[ATSSSLLControlInformation-223]
-	_ = x[PMFControlInformation-224]
-	_ = x[MPTCPParameters-225]
-	_ = x[ATSSSLLParameters-226]
-	_ = x[PMFParameters-227]
-	_ = x[MPTCPAddressInformation-228]
-	_ = x[UELinkSpecificIPAddress-229]
-	_ = x[PMFAddressInformation-230]
-	_ = x[ATSSSLLInformation-231]
-	_ = x[DataNetworkAccessIdentifier-232]
-	_ = x[UEIPAddressPoolInformation-233]
-	_ = x[AveragePacketDelay-234]
-	_ = x[MinimumPacketDelay-235]
-	_ = x[MaximumPacketDelay-236]
-	_ = x[QoSReportTrigger-237]
-	_ = x[GTPUPathQoSControlInformation-238]
-	_ = x[GTPUPathQoSReport-239]
-	_ = x[QoSInformationInGTPUPathQoSReport-240]
-	_ = x[GTPUPathInterfaceType-241]
-	_ = x[QoSMonitoringPerQoSFlowControlInformation-242]
-	_ = x[RequestedQoSMonitoring-243]
-	_ = x[ReportingFrequency-244]
-	_ = x[PacketDelayThresholds-245]
-	_ = x[MinimumWaitTime-246]
-	_ = x[QoSMonitoringReport-247]
-	_ = x[QoSMonitoringMeasurement-248]
-	_ = x[MTEDTControlInformation-249]
-	_ = x[DLDataPacketsSize-250]
-	_ = x[QERControlIndications-251]
-	_ = x[PacketRateStatusReport-252]
-	_ = x[NFInstanceID-253]
-	_ = x[EthernetContextInformation-254]
-	_ = x[RedundantTransmissionParameters-255]
-	_ = x[UpdatedPDR-256]
-	_ = x[SNSSAI-257]
-	_ = x[IPVersion-258]
-	_ = x[PFCPASReqFlags-259]
-	_ = x[DataStatus-260]
-	_ = x[ProvideRDSConfigurationInformation-261]
-	_ = x[RDSConfigurationInformation-262]
-	_ = x[QueryPacketRateStatusWithinSessionModificationRequest-263]
-	_ = x[PacketRateStatusReportWithinSessionModificationResponse-264]
-	_ = x[MPTCPApplicableIndication-265]
-	_ = x[BridgeManagementInformationContainer-266]
-	_ = x[UEIPAddressUsageInformation-267]
-	_ = x[NumberOfUEIPAddresses-268]
-	_ = x[ValidityTimer-269]
-	_ = x[RedundantTransmissionForwardingParameters-270]
-	_ = x[TransportDelayReporting-271]
-}
-
-const _IEType_name = "CreatePDRPDICreateFARForwardingParametersDuplicatingParametersCreateURRCreateQERCreatedPDRUpdatePDRUpdateFARUpdateForwardingParametersUpdateBARWithinSessionReportResponseUpdateURRUpdateQERRemovePDRRemoveFARRemoveURRRemoveQERCauseSourceInterfaceFTEIDNetworkInstanceSDFFilterApplicationIDGateStatusMBRGBRQERCorrelationIDPrecedenceTransportLevelMarkingVolumeThresholdTimeThresholdMonitoringTimeSubsequentVolumeThresholdSubsequentTimeThresholdInactivityDetectionTimeReportingTriggersRedirectInformationReportTypeOffendingIEForwardingPolicyDestinationInterfaceUPFunctionFeaturesApplyActionDownlinkDataServiceInformationDownlinkDataNotificationDelayDLBufferingDurationDLBufferingSuggestedPacketCountPFCPSMReqFlagsPFCPSRRspFlagsLoadControlInformationSequenceNumberMetricOverloadControlInformationTimerPDRIDFSEIDApplicationIDsPFDsPFDContextNodeIDPFDContentsMeasurementMethodUsageReportTriggerMeasurementPeriodFQCSIDVolumeMeasurementDurationMeasurementApplicationDetectionInformationTimeOfFirstPacketTimeOfLastPacketQuotaHoldingTimeDroppedDLTrafficThresholdVolumeQuotaTimeQuotaStartTimeEndTimeQueryURRUsageReportWithinSessionModificationResponseUsageReportWithinSessionDeletionResponseUsageReportWithinSessionReportRequestURRIDLinkedURRIDDownlinkDataReportOuterHeaderCreationCreateBARUpdateBARWithinSessionModificationRequestRemoveBARBARIDCPFunctionFeaturesUsageInformationApplicationInstanceIDFlowInformationUEIPAddressPacketRateOuterHeaderRemovalRecoveryTimeStampDLFlowLevelMarkingHeaderEnrichmentErrorIndicationReportMeasurementInformationNodeReportTypeUserPlanePathFailureReportRemoteGTPUPeerURSEQNUpdateDuplicatingParametersActivatePredefinedRulesDeactivatePredefinedRulesFARIDQERIDOCIFlagsPFCPAssociationReleaseRequestGracefulReleasePeriodPDNTypeFailedRuleIDTimeQuotaMechanismUserPlaneIPResourceInformationUserPlaneInactivityTimerAggregatedURRsMultiplierAggregatedURRIDSubsequentVolumeQuotaSubsequentTimeQuotaRQIQFIQueryURRReferenceAdditionalUsageReportsInformationCreateTrafficEndpointCreatedTrafficEndpointUpdateTrafficEndpointRemoveTrafficEndpointTrafficEndpointIDEthernetPacketFilterMACAddressCTAGSTAGEthertypeProxyingEthernetFilterIDEthernetFilterPropertiesSuggestedBufferingPacketsCountUserIDEthernetPDUSessionInformationEthernetTrafficInformationMACAddressesDetectedMACAddressesRemovedEthernetInactivityTimerAdditionalMonitoringTimeEventQuotaEventThresholdSubsequentEventQuotaSubsequentEventThresholdTraceInformationFramedRouteFramedRoutingFramedIPv6RouteEventTimeStampAveragingWindowPagingPolicyIndicatorAPNDNNTGPPInterfaceTypePFCPSRReqFlagsPFCPAUReqFlagsActivationTimeDeactivationTimeCreateMARTGPPAccessForwardingActionInformationNonTGPPAccessForwardingActionInformationRemoveMARUpdateMARMARIDSteeringFunctionalitySteeringModeWeightPriorityUpdateTGPPAccessForwardingActionInformationUpdateNonTGPPAccessForwardingActionInformationUEIPAddressPoolIdentityAlternativeSMFIPAddressPacketReplicationAndDetectionCarryOnInformationSMFSetIDQuotaValidityTimeNumberOfReportsPFCPSessionRetentionInformationPFCPASRspFlagsCPPFCPEntityIPAddressPFCPSEReqFlagsUserPlanePathRecoveryReportIPMulticastAddressingInfoJoinIPMulticastInformationWithinUsageReportLeaveIPMulticastInformationWithinUsageReportIPMulticastAddressSourceIPAddressPacketRateStatusCreateBridgeInfoForTSCCreatedBridgeInfoForTSCDSTTPortNumberNWTTPortNumberTSNBridgeIDTSCManagementInformationWithinSessionModificationRequestTSCManagementInformationWithinSessionModificationResponseTSCManagementInformationWithinSessionReportRequestPortManagementInformationContainerClockDriftControlInformationRequestedClockDriftInformationClockDriftReportTSNTimeDomainNumberTimeOffsetThresholdCumulativeRateRatioThresholdTimeOffsetMeasurementCumulativeRateRatioMeasurementRemoveSRRCreateSRRUpdateSRRSessionReportSRRIDAccessAvailabilityControlInformationRequestedAccessAvailabilityInformationAccessAvailabilityReportAccessAvailabilityInformationProvideATSSSControlInformationATSSSControlParametersMPTCPControlInformationATSSSLLControlInformationPMFControlInformationMPTCPParametersATSSSLLParametersPMFParametersMPTCPAddressInformationUELinkSpecificIPAddressPMFAddressInformationATSSSLLInformationDataNetworkAccessIdentifierUEIPAddressPoolInformationAveragePacketDelayMinimumPacketDelayMaximumPacketDelayQoSReportTriggerGTPUPathQoSControlInformationGTPUPathQoSReportQoSInformationInGTPUPathQoSReportGTPUPathInterfaceTypeQoSMonitoringPerQoSFlowControlInformationRequestedQoSMonitoringReportingFrequencyPacketDelayThresholdsMinimumWaitTimeQoSMonitoringReportQoSMonitoringMeasurementMTEDTControlInformationDLDataPacketsSizeQERControlIndicationsPacketRateStatusReportNFInstanceIDEthernetContextInformationRedundantTransmissionParametersUpdatedPDRSNSSAIIPVersionPFCPASReqFlagsDataStatusProvideRDSConfigurationInformationRDSConfigurationInformationQueryPacketRateStatusWithinSessionModificationRequestPacketRateStatusReportWithinSessionModificationResponseMPTCPApplicableIndicationBridgeManagementInformationContainerUEIPAddressUsageInformationNumberOfUEIPAddressesValidityTimerRedundantTransmissionForwardingParametersTransportDelayReporting"
-
-var _IEType_index = [...]uint16{0, 9, 12, 21, 41, 62, 71, 80, 90, 99, 108, 134, 170, 179, 188, 197, 206, 215, 224, 229, 244, 249, 264, 273, 286, 296, 299, 302, 318, 328, 349, 364, 377, 391, 416, 439, 462, 479, 498, 508, 519, 535, 555, 573, 584, 614, 643, 662, 693, 707, 721, 743, 757, 763, 789, 794, 799, 804, 822, 832, 838, 849, 866, 884, 901, 907, 924, 943, 974, 991, 1007, 1023, 1048, 1059, 1068, 1077, 1084, 1092, 1136, 1176, 1213, 1218, 1229, 1247, 1266, 1275, 1316, 1325, 1330, 1348, 1364, 1385, 1400, 1411, 1421, 1439, 1456, 1474, 1490, 1511, 1533, 1547, 1573, 1587, 1593, 1620, 1643, 1668, 1673, 1678, 1686, 1715, 1736, 1743, 1755, 1773, 1803, 1827, 1841, 1851, 1866, 1887, 1906, 1909, 1912, 1929, 1962, 1983, 2005, 2026, 2047, 2064, 2084, 2094, 2098, 2102, 2111, 2119, 2135, 2159, 2189, 2195, 2224, 2250, 2270, 2289, 2312, 2336, 2346, 2360, 2380, 2404, 2420, 2431, 2444, 2459, 2473, 2488, 2509, 2515, 2532, 2546, 2560, 2574, 2590, 2599, 2636, 2676, 2685, 2694, 2699, 2720, 2732, 2738, 2746, 2789, 2835, 2858, 2881, 2928, 2936, 2953, 2968, 2999, 3013, 3034, 3048, 3075, 3100, 3143, 3187, 3205, 3220, 3236, 3258, 3281, 3295, 3309, 3320, 3376, 3433, 3483, 3517, 3545, 3575, 3591, 3610, 3629, 3657, 3678, 3708, 3717, 3726, 3735, 3748, 3753, 3789, 3827, 3851, 3880, 3910, 3932, 3955, 3980, 4001, 4016, 4033, 4046, 4069, 4092, 4113, 4131, 4158, 4184, 4202, 4220, 4238, 4254, 4283, 4300, 4333, 4354, 4395, 4417, 4435, 4456, 4471, 4490, 4514, 4537, 4554, 4575, 4597, 4609, 4635, 4666, 4676, 4682, 4691, 4705, 4715, 4749, 4776, 4829, 4884, 4909, 4945, 4972, 4993, 5006, 5047, 5070}
-
-func (i IEType) String() string {
-	idx := int(i) - 1
-	if i < 1 || idx >= len(_IEType_index)-1 {
-		return "IEType(" + strconv.FormatInt(int64(i), 10) + ")"
-	}
-	return _IEType_name[_IEType_index[idx]:_IEType_index[idx+1]]
-}
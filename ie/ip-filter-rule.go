@@ -0,0 +1,453 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// IPFilterAction is the action in IPFilterRule.
+//
+// IPFilterActionDeny is defined in RFC 6733 but not allowed in the Flow
+// Description, so ParseIPFilterRule and Validate reject it.
+type IPFilterAction uint8
+
+// IPFilterAction definitions.
+const (
+	IPFilterActionPermit IPFilterAction = iota
+	IPFilterActionDeny
+)
+
+// String returns the keyword of IPFilterAction.
+func (a IPFilterAction) String() string {
+	switch a {
+	case IPFilterActionPermit:
+		return "permit"
+	case IPFilterActionDeny:
+		return "deny"
+	default:
+		return "IPFilterAction(" + strconv.Itoa(int(a)) + ")"
+	}
+}
+
+// IPFilterDirection is the direction in IPFilterRule.
+//
+// IPFilterDirectionIn is defined in RFC 6733 but not allowed in the Flow
+// Description, so ParseIPFilterRule and Validate reject it.
+type IPFilterDirection uint8
+
+// IPFilterDirection definitions.
+const (
+	IPFilterDirectionOut IPFilterDirection = iota
+	IPFilterDirectionIn
+)
+
+// String returns the keyword of IPFilterDirection.
+func (d IPFilterDirection) String() string {
+	switch d {
+	case IPFilterDirectionOut:
+		return "out"
+	case IPFilterDirectionIn:
+		return "in"
+	default:
+		return "IPFilterDirection(" + strconv.Itoa(int(d)) + ")"
+	}
+}
+
+// IPFilterProtocolAny is the Protocol in IPFilterRule that matches any protocol,
+// which is represented by the keyword "ip".
+const IPFilterProtocolAny uint8 = 0
+
+// ipFilterProtocols is the protocol names accepted by ParseIPFilterRule in
+// addition to the numbers.
+var ipFilterProtocols = map[string]uint8{
+	"icmp":      1,
+	"tcp":       6,
+	"udp":       17,
+	"icmp6":     58,
+	"ipv6-icmp": 58,
+	"sctp":      132,
+}
+
+// PortRange is a range of ports in IPFilterRule. Start and End are inclusive,
+// and a single port has the same value in Start and End.
+type PortRange struct {
+	Start, End uint16
+}
+
+// String returns the PortRange in the form of "Start-End", or "Start" if it is
+// a single port.
+func (p PortRange) String() string {
+	if p.Start == p.End {
+		return strconv.Itoa(int(p.Start))
+	}
+	return strconv.Itoa(int(p.Start)) + "-" + strconv.Itoa(int(p.End))
+}
+
+// Contains reports whether the port is in the range.
+func (p PortRange) Contains(port uint16) bool {
+	return port >= p.Start && port <= p.End
+}
+
+// IPFilterEndpoint is the source or destination in IPFilterRule.
+//
+// Prefix is nil for the keyword "any", and Assigned is true for the keyword
+// "assigned", which refers to the address assigned to the UE. Ports is empty if
+// any port matches.
+type IPFilterEndpoint struct {
+	Assigned bool
+	Prefix   *net.IPNet
+	Ports    []PortRange
+}
+
+// IsAny reports whether the endpoint matches any address.
+func (e *IPFilterEndpoint) IsAny() bool {
+	return !e.Assigned && e.Prefix == nil
+}
+
+// String returns the endpoint in the form used in IPFilterRule.
+func (e *IPFilterEndpoint) String() string {
+	var s string
+	switch {
+	case e.Assigned:
+		s = "assigned"
+	case e.Prefix == nil:
+		s = "any"
+	default:
+		ones, bits := e.Prefix.Mask.Size()
+		if ones == bits {
+			s = e.Prefix.IP.String()
+		} else {
+			s = e.Prefix.IP.String() + "/" + strconv.Itoa(ones)
+		}
+	}
+
+	if len(e.Ports) == 0 {
+		return s
+	}
+
+	ports := make([]string, len(e.Ports))
+	for n, p := range e.Ports {
+		ports[n] = p.String()
+	}
+	return s + " " + strings.Join(ports, ",")
+}
+
+// IPFilterRule is the Flow Description in SDF Filter and Flow Information IE,
+// which is the IPFilterRule defined in RFC 6733 with the restrictions in
+// 3GPP TS 29.212 clause 5.4.2 referred from TS 29.244 clause 5.2.1A, e.g.,
+//
+//	permit out 17 from 10.0.0.1 to assigned 80-443
+//
+// The zero value is "permit out ip from any to any". As TS 29.212 requires, the
+// action must be "permit" and the direction must be "out", and the options in
+// RFC 6733 such as "frag" are not allowed.
+type IPFilterRule struct {
+	Action      IPFilterAction
+	Direction   IPFilterDirection
+	Protocol    uint8
+	Source      IPFilterEndpoint
+	Destination IPFilterEndpoint
+}
+
+// ParseIPFilterRule parses s as IPFilterRule.
+//
+// The protocol can be given as the number, the keyword "ip", or one of the
+// well-known names such as "udp". The addresses can be IPv4 or IPv6 address with
+// or without prefix length, or the keyword "any" or "assigned". The ports are the
+// list of ports or port ranges separated by commas, e.g., "80,8000-8080".
+//
+// The error returned is *IPFilterRuleError that tells which part of s is invalid.
+func ParseIPFilterRule(s string) (*IPFilterRule, error) {
+	p := &ipFilterRuleParser{tokens: strings.Fields(s)}
+	r := &IPFilterRule{}
+
+	var err error
+	if r.Action, err = p.action(); err != nil {
+		return nil, err
+	}
+	if r.Direction, err = p.direction(); err != nil {
+		return nil, err
+	}
+	if r.Protocol, err = p.protocol(); err != nil {
+		return nil, err
+	}
+	if err := p.keyword("from"); err != nil {
+		return nil, err
+	}
+	if err := p.endpoint("source", &r.Source, "to"); err != nil {
+		return nil, err
+	}
+	if err := p.keyword("to"); err != nil {
+		return nil, err
+	}
+	if err := p.endpoint("destination", &r.Destination, ""); err != nil {
+		return nil, err
+	}
+	if t, ok := p.next(); ok {
+		return nil, &IPFilterRuleError{Field: "option", Value: t, Reason: "options are not allowed"}
+	}
+
+	return r, nil
+}
+
+// Validate checks if the IPFilterRule can be represented in text with the
+// restrictions in TS 29.212. It is not necessary for the ones returned by
+// ParseIPFilterRule.
+func (r *IPFilterRule) Validate() error {
+	if r.Action != IPFilterActionPermit {
+		return &IPFilterRuleError{Field: "action", Value: r.Action.String(), Reason: `must be "permit"`}
+	}
+	if r.Direction != IPFilterDirectionOut {
+		return &IPFilterRuleError{Field: "direction", Value: r.Direction.String(), Reason: `must be "out"`}
+	}
+	if err := r.Source.validate("source"); err != nil {
+		return err
+	}
+	return r.Destination.validate("destination")
+}
+
+func (e *IPFilterEndpoint) validate(field string) error {
+	if e.Prefix != nil {
+		if e.Assigned {
+			return &IPFilterRuleError{Field: field + " address", Value: e.Prefix.String(), Reason: "cannot be used with assigned"}
+		}
+
+		ones, bits := e.Prefix.Mask.Size()
+		if bits == 0 || ones > bits {
+			return &IPFilterRuleError{Field: field + " address", Value: e.Prefix.String(), Reason: "invalid mask"}
+		}
+		isV4 := e.Prefix.IP.To4() != nil
+		if (bits == 32) != isV4 || (!isV4 && len(e.Prefix.IP) != net.IPv6len) {
+			return &IPFilterRuleError{Field: field + " address", Value: e.Prefix.String(), Reason: "address family does not match the mask"}
+		}
+	}
+
+	for _, p := range e.Ports {
+		if p.Start > p.End {
+			return &IPFilterRuleError{Field: field + " port", Value: p.String(), Reason: "start is larger than end"}
+		}
+	}
+	return nil
+}
+
+// String returns the IPFilterRule in the canonical form, in which the protocol
+// is the number or "ip", and the address has the prefix length only if it is
+// shorter than the address.
+func (r *IPFilterRule) String() string {
+	proto := "ip"
+	if r.Protocol != IPFilterProtocolAny {
+		proto = strconv.Itoa(int(r.Protocol))
+	}
+
+	return strings.Join([]string{
+		r.Action.String(),
+		r.Direction.String(),
+		proto,
+		"from",
+		r.Source.String(),
+		"to",
+		r.Destination.String(),
+	}, " ")
+}
+
+// ipFilterRuleParser is the state of ParseIPFilterRule.
+type ipFilterRuleParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *ipFilterRuleParser) next() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, true
+}
+
+func (p *ipFilterRuleParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *ipFilterRuleParser) action() (IPFilterAction, error) {
+	t, ok := p.next()
+	switch {
+	case !ok:
+		return 0, &IPFilterRuleError{Field: "action", Reason: "missing"}
+	case t == "permit":
+		return IPFilterActionPermit, nil
+	default:
+		return 0, &IPFilterRuleError{Field: "action", Value: t, Reason: `must be "permit"`}
+	}
+}
+
+func (p *ipFilterRuleParser) direction() (IPFilterDirection, error) {
+	t, ok := p.next()
+	switch {
+	case !ok:
+		return 0, &IPFilterRuleError{Field: "direction", Reason: "missing"}
+	case t == "out":
+		return IPFilterDirectionOut, nil
+	default:
+		return 0, &IPFilterRuleError{Field: "direction", Value: t, Reason: `must be "out"`}
+	}
+}
+
+func (p *ipFilterRuleParser) protocol() (uint8, error) {
+	t, ok := p.next()
+	if !ok {
+		return 0, &IPFilterRuleError{Field: "protocol", Reason: "missing"}
+	}
+	if t == "ip" {
+		return IPFilterProtocolAny, nil
+	}
+	if n, ok := ipFilterProtocols[t]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.ParseUint(t, 10, 8)
+	if err != nil {
+		return 0, &IPFilterRuleError{Field: "protocol", Value: t, Reason: "must be a number from 1 to 255 or ip"}
+	}
+	if n == 0 {
+		return 0, &IPFilterRuleError{Field: "protocol", Value: t, Reason: "use ip to match any protocol"}
+	}
+	return uint8(n), nil
+}
+
+func (p *ipFilterRuleParser) keyword(k string) error {
+	t, ok := p.next()
+	switch {
+	case !ok:
+		return &IPFilterRuleError{Field: `"` + k + `"`, Reason: "missing"}
+	case t != k:
+		return &IPFilterRuleError{Field: `"` + k + `"`, Value: t, Reason: "unexpected token"}
+	default:
+		return nil
+	}
+}
+
+// endpoint parses the address and the optional ports followed by the token end,
+// or the end of string if end is empty.
+func (p *ipFilterRuleParser) endpoint(field string, e *IPFilterEndpoint, end string) error {
+	t, ok := p.next()
+	if !ok {
+		return &IPFilterRuleError{Field: field + " address", Reason: "missing"}
+	}
+
+	switch t {
+	case "any":
+	case "assigned":
+		e.Assigned = true
+	default:
+		prefix, err := parseIPFilterPrefix(field, t)
+		if err != nil {
+			return err
+		}
+		e.Prefix = prefix
+	}
+
+	next := p.peek()
+	if next == "" || next == end || !isPortList(next) {
+		return nil
+	}
+	p.pos++
+
+	for _, s := range strings.Split(next, ",") {
+		r, err := parsePortRange(field, s)
+		if err != nil {
+			return err
+		}
+		e.Ports = append(e.Ports, r)
+	}
+	return nil
+}
+
+func parseIPFilterPrefix(field, s string) (*net.IPNet, error) {
+	addr, bitsStr, hasBits := strings.Cut(s, "/")
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil, &IPFilterRuleError{Field: field + " address", Value: s, Reason: "not an IP address"}
+	}
+
+	max := 128
+	if ip4 := ip.To4(); ip4 != nil && !strings.Contains(addr, ":") {
+		ip = ip4
+		max = 32
+	}
+
+	ones := max
+	if hasBits {
+		n, err := strconv.Atoi(bitsStr)
+		if err != nil || n < 0 || n > max || strings.HasPrefix(bitsStr, "+") {
+			return nil, &IPFilterRuleError{Field: field + " address", Value: s, Reason: "invalid prefix length"}
+		}
+		ones = n
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(ones, max)}, nil
+}
+
+// isPortList reports whether s looks like ports, to tell them from the options.
+func isPortList(s string) bool {
+	return s[0] >= '0' && s[0] <= '9'
+}
+
+func parsePortRange(field, s string) (PortRange, error) {
+	start, end, isRange := strings.Cut(s, "-")
+	a, ok := parsePort(start)
+	if !ok {
+		return PortRange{}, &IPFilterRuleError{Field: field + " port", Value: s, Reason: "not a port number"}
+	}
+	if !isRange {
+		return PortRange{a, a}, nil
+	}
+
+	b, ok := parsePort(end)
+	if !ok {
+		return PortRange{}, &IPFilterRuleError{Field: field + " port", Value: s, Reason: "not a port number"}
+	}
+	if a > b {
+		return PortRange{}, &IPFilterRuleError{Field: field + " port", Value: s, Reason: "start is larger than end"}
+	}
+	return PortRange{a, b}, nil
+}
+
+func parsePort(s string) (uint16, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, false
+	}
+	return uint16(n), true
+}
+
+// IPFilterRule returns the Flow Description in IPFilterRule if the type of IE
+// matches. It returns ErrElementNotFound if SDF Filter has no Flow Description.
+func (i *IE) IPFilterRule() (*IPFilterRule, error) {
+	switch i.Type {
+	case SDFFilter, CreatePDR, PDI, EthernetPacketFilter:
+		f, err := i.SDFFilter()
+		if err != nil {
+			return nil, err
+		}
+		return f.IPFilterRule()
+	case FlowInformation, ApplicationDetectionInformation:
+		fd, err := i.FlowDescription()
+		if err != nil {
+			return nil, err
+		}
+		return ParseIPFilterRule(fd)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -21,76 +21,6 @@ func NewIPMulticastAddress(sv4, sv6, ev4, ev6 net.IP) *IE {
 	return New(IPMulticastAddress, b)
 }
 
-// IPMulticastAddress returns IPMulticastAddress in structured format if the type of IE matches.
-func (i *IE) IPMulticastAddress() (*IPMulticastAddressFields, error) {
-	switch i.Type {
-	case IPMulticastAddress:
-		fields, err := ParseIPMulticastAddressFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == IPMulticastAddressingInfo {
-				return x.IPMulticastAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdatePDR:
-		ies, err := i.UpdatePDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == IPMulticastAddressingInfo {
-				return x.IPMulticastAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case IPMulticastAddressingInfo:
-		ies, err := i.IPMulticastAddressingInfo()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == IPMulticastAddress {
-				return x.IPMulticastAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case JoinIPMulticastInformationWithinUsageReport:
-		ies, err := i.JoinIPMulticastInformationWithinUsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == IPMulticastAddress {
-				return x.IPMulticastAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case LeaveIPMulticastInformationWithinUsageReport:
-		ies, err := i.LeaveIPMulticastInformationWithinUsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == IPMulticastAddress {
-				return x.IPMulticastAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // IPMulticastAddressFields represents a fields contained in IPMulticastAddress IE.
 type IPMulticastAddressFields struct {
 	Flags            uint8
@@ -182,7 +112,7 @@ func ParseIPMulticastAddressFields(b []byte) (*IPMulticastAddressFields, error)
 // UnmarshalBinary parses b into IE.
 func (f *IPMulticastAddressFields) UnmarshalBinary(b []byte) error {
 	l := len(b)
-	if l < 2 {
+	if l < 1 {
 		return io.ErrUnexpectedEOF
 	}
 
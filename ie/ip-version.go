@@ -15,24 +15,3 @@ func NewIPVersion(v4, v6 bool) *IE {
 	}
 	return i
 }
-
-// IPVersion returns IPVersion in uint8 if the type of IE matches.
-func (i *IE) IPVersion() (uint8, error) {
-	switch i.Type {
-	case IPVersion:
-		return i.ValueAsUint8()
-	case UEIPAddressPoolInformation:
-		ies, err := i.UEIPAddressPoolInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == IPVersion {
-				return x.IPVersion()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
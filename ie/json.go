@@ -0,0 +1,238 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ieJSON is the JSON representation of an IE.
+//
+// Value is only for humans and ignored when decoding. The IE is always reconstructed
+// from Payload, or from IEs for grouped IEs.
+type ieJSON struct {
+	Type         string `json:"type"`
+	TypeID       uint16 `json:"type_id"`
+	EnterpriseID uint16 `json:"enterprise_id,omitempty"`
+	Value        any    `json:"value,omitempty"`
+	DecodeError  string `json:"decode_error,omitempty"`
+	Payload      string `json:"payload,omitempty"`
+	IEs          []*IE  `json:"ies,omitempty"`
+}
+
+// MarshalJSON returns the JSON encoding of an IE.
+//
+// The type of IE is represented by its name, and the value is decoded into the typed
+// fields if this package knows how to decode it, e.g., F-TEID is shown with TEID and
+// addresses. The raw payload is always included in hex string so that the result can
+// be decoded into the same IE with UnmarshalJSON. Grouped IEs have their children in
+// "ies" instead of the payload.
+func (i *IE) MarshalJSON() ([]byte, error) {
+	v := &ieJSON{
+		Type:         i.TypeName(),
+		TypeID:       uint16(i.Type),
+		EnterpriseID: i.EnterpriseID,
+	}
+
+	if i.IsGrouped() {
+		children, err := i.ValueAsGrouped()
+		if err == nil {
+			v.IEs = children
+			return json.Marshal(v)
+		}
+		v.DecodeError = err.Error()
+	} else {
+		value, err := i.value()
+		if err != nil {
+			v.DecodeError = err.Error()
+		} else if value != nil {
+			v.Value = jsonValue(reflect.ValueOf(value))
+		}
+	}
+
+	v.Payload = hex.EncodeToString(i.Payload)
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes the JSON encoding of an IE generated by MarshalJSON.
+//
+// The type is looked up by "type_id" if present, otherwise by "type" which should be
+// the name of IE type. The "value" is not used; the IE is built from "payload", or
+// "ies" if the type is grouped.
+func (i *IE) UnmarshalJSON(b []byte) error {
+	var v ieJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	typ := IEType(v.TypeID)
+	if typ == 0 {
+		var ok bool
+		typ, ok = ieTypeByName(v.Type)
+		if !ok {
+			return fmt.Errorf("unknown IE type: %q", v.Type)
+		}
+	}
+
+	if v.IEs != nil {
+		g := newGroupedIE(typ, v.EnterpriseID, v.IEs...)
+		if g == nil {
+			return ErrMalformed
+		}
+		*i = *g
+		return nil
+	}
+
+	payload, err := hex.DecodeString(v.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode payload of %s: %w", typ, err)
+	}
+
+	n := NewVendorSpecificIE(typ, v.EnterpriseID, payload)
+	if n.IsGrouped() {
+		n.ChildIEs, err = ParseMultiIEs(payload)
+		if err != nil {
+			return err
+		}
+	}
+	*i = *n
+	return nil
+}
+
+var (
+	ieTypeNamesOnce sync.Once
+	ieTypeNames     map[string]IEType
+)
+
+// ieTypeByName returns the IEType that has the given name.
+// The name can also be in the form of "IEType(n)" which is used for unknown types.
+func ieTypeByName(name string) (IEType, bool) {
+	ieTypeNamesOnce.Do(func() {
+		ieTypeNames = make(map[string]IEType)
+		for t := IEType(1); t < 0x8000; t++ {
+			s := t.String()
+			if strings.HasPrefix(s, "IEType(") {
+				continue
+			}
+			ieTypeNames[s] = t
+		}
+	})
+
+	if t, ok := ieTypeNames[name]; ok {
+		return t, true
+	}
+
+	if s, ok := strings.CutPrefix(name, "IEType("); ok {
+		n, err := strconv.ParseUint(strings.TrimSuffix(s, ")"), 10, 16)
+		if err != nil {
+			return 0, false
+		}
+		return IEType(n), true
+	}
+	return 0, false
+}
+
+// jsonField is a key-value pair in jsonObject.
+type jsonField struct {
+	Key   string
+	Value any
+}
+
+// jsonObject is a JSON object that keeps the order of the keys.
+type jsonObject []jsonField
+
+// MarshalJSON returns the JSON encoding of the object with the keys in order.
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for n, f := range o {
+		if n > 0 {
+			b = append(b, ',')
+		}
+
+		k, err := json.Marshal(f.Key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, k...)
+		b = append(b, ':')
+		b = append(b, v...)
+	}
+	return append(b, '}'), nil
+}
+
+// jsonValue converts the decoded value of IE into human-readable form in JSON.
+//
+// Structs are converted into objects with the fields in order without nil fields,
+// byte slices into hex strings, and time-related values into strings.
+func jsonValue(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.UTC().Format(time.RFC3339)
+	case time.Duration:
+		return x.String()
+	case net.IP:
+		if x == nil {
+			return nil
+		}
+		return x.String()
+	case net.HardwareAddr:
+		return x.String()
+	case IEType:
+		return x.String()
+	case []byte:
+		return hex.EncodeToString(x)
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return jsonValue(v.Elem())
+	case reflect.Struct:
+		o := jsonObject{}
+		for n := 0; n < v.NumField(); n++ {
+			f := v.Type().Field(n)
+			if !f.IsExported() || isNilValue(v.Field(n)) {
+				continue
+			}
+			o = append(o, jsonField{Key: f.Name, Value: jsonValue(v.Field(n))})
+		}
+		return o
+	case reflect.Slice, reflect.Array:
+		l := make([]any, v.Len())
+		for n := range l {
+			l[n] = jsonValue(v.Index(n))
+		}
+		return l
+	default:
+		return v.Interface()
+	}
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
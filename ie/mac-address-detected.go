@@ -21,56 +21,6 @@ func NewMACAddressesDetected(ctag, stag *IE, macs ...net.HardwareAddr) *IE {
 	return New(MACAddressesDetected, b)
 }
 
-// MACAddressesDetected returns MACAddressesDetected in structured format if the type of IE matches.
-func (i *IE) MACAddressesDetected() (*MACAddressesDetectedFields, error) {
-	switch i.Type {
-	case MACAddressesDetected:
-		fields, err := ParseMACAddressesDetectedFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case EthernetTrafficInformation:
-		ies, err := i.EthernetTrafficInformation()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == MACAddressesDetected {
-				return x.MACAddressesDetected()
-			}
-		}
-		return nil, ErrIENotFound
-	case EthernetContextInformation:
-		ies, err := i.EthernetContextInformation()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == MACAddressesDetected {
-				return x.MACAddressesDetected()
-			}
-		}
-		return nil, ErrIENotFound
-	case UsageReportWithinSessionModificationResponse,
-		UsageReportWithinSessionDeletionResponse,
-		UsageReportWithinSessionReportRequest:
-		ies, err := i.UsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == EthernetTrafficInformation {
-				return x.MACAddressesDetected()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // MACAddressesDetectedFields represents a fields contained in MACAddressesDetected IE.
 type MACAddressesDetectedFields struct {
 	NumberOfMACAddresses uint8
@@ -113,7 +63,7 @@ func (f *MACAddressesDetectedFields) UnmarshalBinary(b []byte) error {
 	f.NumberOfMACAddresses = b[0]
 	offset := 1
 
-	for i := 0; i <= int(f.NumberOfMACAddresses); i++ {
+	for i := 0; i < int(f.NumberOfMACAddresses); i++ {
 		if l < offset+6 {
 			return io.ErrUnexpectedEOF
 		}
@@ -121,25 +71,28 @@ func (f *MACAddressesDetectedFields) UnmarshalBinary(b []byte) error {
 		offset += 6
 	}
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	f.CTAGLength = b[offset]
+	offset++
 
 	if l < offset+int(f.CTAGLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.CTAG, b[offset:offset+int(f.CTAGLength)])
+	f.CTAG = b[offset : offset+int(f.CTAGLength)]
+	offset += int(f.CTAGLength)
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	f.STAGLength = b[offset]
+	offset++
 
 	if l < offset+int(f.STAGLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.STAG, b[offset:offset+int(f.STAGLength)])
+	f.STAG = b[offset : offset+int(f.STAGLength)]
 
 	return nil
 }
@@ -21,43 +21,6 @@ func NewMACAddress(src, dst, upperSrc, upperDst net.HardwareAddr) *IE {
 	return New(MACAddress, b)
 }
 
-// MACAddress returns MACAddress in structured format if the type of IE matches.
-func (i *IE) MACAddress() (*MACAddressFields, error) {
-	switch i.Type {
-	case MACAddress:
-		fields, err := ParseMACAddressFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case PDI:
-		ies, err := i.PDI()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == EthernetPacketFilter {
-				return x.MACAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case EthernetPacketFilter:
-		ies, err := i.EthernetPacketFilter()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == MACAddress {
-				return x.MACAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // MACAddressFields represents a fields contained in MACAddress IE.
 type MACAddressFields struct {
 	Flags                      uint8
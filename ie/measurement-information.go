@@ -4,43 +4,6 @@
 
 package ie
 
-// NewMeasurementInformation creates a new MeasurementInformation IE.
-func NewMeasurementInformation(flags uint8) *IE {
-	return newUint8ValIE(MeasurementInformation, flags)
-}
-
-// MeasurementInformation returns MeasurementInformation in uint8 if the type of IE matches.
-func (i *IE) MeasurementInformation() (uint8, error) {
-	switch i.Type {
-	case MeasurementInformation:
-		return i.ValueAsUint8()
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MeasurementInformation {
-				return x.MeasurementInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MeasurementInformation {
-				return x.MeasurementInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasISTM reports whether an IE has ISTM bit.
 func (i *IE) HasISTM() bool {
 	if i.Type != MeasurementInformation {
@@ -9,49 +9,6 @@ func NewMeasurementMethod(event, volum, durat int) *IE {
 	return newUint8ValIE(MeasurementMethod, uint8((event<<2)|(volum<<1)|(durat)))
 }
 
-// MeasurementMethod returns MeasurementMethod in uint8 if the type of IE matches.
-func (i *IE) MeasurementMethod() (uint8, error) {
-	switch i.Type {
-	case MeasurementMethod:
-		return i.ValueAsUint8()
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MeasurementMethod {
-				return x.MeasurementMethod()
-			}
-		}
-		return 0, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MeasurementMethod {
-				return x.MeasurementMethod()
-			}
-		}
-		return 0, ErrIENotFound
-	case GTPUPathQoSControlInformation:
-		ies, err := i.GTPUPathQoSControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MeasurementMethod {
-				return x.MeasurementMethod()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasEVENT reports whether an IE has EVENT bit.
 func (i *IE) HasEVENT() bool {
 	v, err := i.MeasurementMethod()
@@ -27,43 +27,6 @@ func NewMPTCPAddressInformation(ptype uint8, port uint16, v4, v6 net.IP) *IE {
 	return New(MPTCPAddressInformation, b)
 }
 
-// MPTCPAddressInformation returns MPTCPAddressInformation in structured format if the type of IE matches.
-func (i *IE) MPTCPAddressInformation() (*MPTCPAddressInformationFields, error) {
-	switch i.Type {
-	case MPTCPAddressInformation:
-		fields, err := ParseMPTCPAddressInformationFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case ATSSSControlParameters:
-		ies, err := i.ATSSSControlParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == MPTCPParameters {
-				return x.MPTCPAddressInformation()
-			}
-		}
-		return nil, ErrIENotFound
-	case MPTCPParameters:
-		ies, err := i.MPTCPParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == MPTCPAddressInformation {
-				return x.MPTCPAddressInformation()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // MPTCPAddressInformationFields represents a fields contained in MPTCPAddressInformation IE.
 type MPTCPAddressInformationFields struct {
 	Flags            uint8
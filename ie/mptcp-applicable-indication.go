@@ -4,32 +4,6 @@
 
 package ie
 
-// NewMPTCPApplicableIndication creates a new MPTCPApplicableIndication IE.
-func NewMPTCPApplicableIndication(flag uint8) *IE {
-	return newUint8ValIE(MPTCPApplicableIndication, flag)
-}
-
-// MPTCPApplicableIndication returns MPTCPApplicableIndication in uint8 if the type of IE matches.
-func (i *IE) MPTCPApplicableIndication() (uint8, error) {
-	switch i.Type {
-	case MPTCPApplicableIndication:
-		return i.ValueAsUint8()
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MPTCPApplicableIndication {
-				return x.MPTCPApplicableIndication()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasMAI reports whether an IE has MAI bit.
 func (i *IE) HasMAI() bool {
 	switch i.Type {
@@ -9,27 +9,6 @@ func NewMPTCPControlInformation(tci uint8) *IE {
 	return newUint8ValIE(MPTCPControlInformation, tci&0x01)
 }
 
-// MPTCPControlInformation returns MPTCPControlInformation in uint8 if the type of IE matches.
-func (i *IE) MPTCPControlInformation() (uint8, error) {
-	switch i.Type {
-	case MPTCPControlInformation:
-		return i.ValueAsUint8()
-	case ProvideATSSSControlInformation:
-		ies, err := i.ProvideATSSSControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == MPTCPControlInformation {
-				return x.MPTCPControlInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasTCI reports whether an IE has TCI bit.
 func (i *IE) HasTCI() bool {
 	v, err := i.MPTCPControlInformation()
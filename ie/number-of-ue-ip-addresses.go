@@ -21,32 +21,6 @@ func NewNumberOfUEIPAddresses(flags uint8, v4, v6 uint32) *IE {
 	return New(NumberOfUEIPAddresses, b)
 }
 
-// NumberOfUEIPAddresses returns NumberOfUEIPAddresses in structured format if the type of IE matches.
-func (i *IE) NumberOfUEIPAddresses() (*NumberOfUEIPAddressesFields, error) {
-	switch i.Type {
-	case NumberOfUEIPAddresses:
-		fields, err := ParseNumberOfUEIPAddressesFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-		return fields, nil
-	case UEIPAddressUsageInformation:
-		ies, err := i.UEIPAddressUsageInformation()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == NumberOfUEIPAddresses {
-				return x.NumberOfUEIPAddresses()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // NumberOfUEIPAddressesFields represents a fields contained in NumberOfUEIPAddresses IE.
 type NumberOfUEIPAddressesFields struct {
 	Flags                   uint8
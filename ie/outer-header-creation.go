@@ -8,6 +8,8 @@ import (
 	"encoding/binary"
 	"io"
 	"net"
+
+	"github.com/wmnsk/go-pfcp/internal/utils"
 )
 
 // NewOuterHeaderCreation creates a new OuterHeaderCreation IE.
@@ -21,75 +23,6 @@ func NewOuterHeaderCreation(desc uint16, teid uint32, v4, v6 string, port uint16
 	return New(OuterHeaderCreation, b)
 }
 
-// OuterHeaderCreation returns OuterHeaderCreation in *OuterHeaderCreationFields if the type of IE matches.
-func (i *IE) OuterHeaderCreation() (*OuterHeaderCreationFields, error) {
-	switch i.Type {
-	case OuterHeaderCreation:
-		f, err := ParseOuterHeaderCreationFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-		return f, nil
-	case ForwardingParameters:
-		ies, err := i.ForwardingParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == OuterHeaderCreation {
-				return x.OuterHeaderCreation()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateForwardingParameters:
-		ies, err := i.UpdateForwardingParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == OuterHeaderCreation {
-				return x.OuterHeaderCreation()
-			}
-		}
-		return nil, ErrIENotFound
-	case DuplicatingParameters:
-		ies, err := i.DuplicatingParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == OuterHeaderCreation {
-				return x.OuterHeaderCreation()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateDuplicatingParameters:
-		ies, err := i.UpdateDuplicatingParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == OuterHeaderCreation {
-				return x.OuterHeaderCreation()
-			}
-		}
-		return nil, ErrIENotFound
-	case RedundantTransmissionParameters:
-		ies, err := i.RedundantTransmissionParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == OuterHeaderCreation {
-				return x.OuterHeaderCreation()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasTEID reports whether an IE has TEID bit.
 func (i *IE) HasTEID() bool {
 	if i.Type != OuterHeaderCreation {
@@ -394,19 +327,19 @@ func (f *OuterHeaderCreationFields) UnmarshalBinary(b []byte) error {
 		offset += 2
 	}
 
-	if has7thBit(uint8(f.OuterHeaderCreationDescription & 0xff)) {
+	if has7thBit(oct5) {
 		if l < offset+3 {
 			return io.ErrUnexpectedEOF
 		}
-		f.CTag = binary.BigEndian.Uint32(b[offset : offset+3])
+		f.CTag = utils.Uint24To32(b[offset : offset+3])
 		offset += 3
 	}
 
-	if has8thBit(uint8(f.OuterHeaderCreationDescription & 0xff)) {
+	if has8thBit(oct5) {
 		if l < offset+3 {
 			return io.ErrUnexpectedEOF
 		}
-		f.STag = binary.BigEndian.Uint32(b[offset : offset+3])
+		f.STag = utils.Uint24To32(b[offset : offset+3])
 	}
 
 	return nil
@@ -450,6 +383,7 @@ func (f *OuterHeaderCreationFields) MarshalTo(b []byte) error {
 
 	if has3rdBit(oct5) || has4thBit(oct5) {
 		binary.BigEndian.PutUint16(b[offset:offset+2], f.PortNumber)
+		offset += 2
 	}
 
 	if has7thBit(oct5) {
@@ -21,32 +21,6 @@ func NewPacketDelayThresholds(flags uint8, dl, ul, rp uint32) *IE {
 	return New(PacketDelayThresholds, b)
 }
 
-// PacketDelayThresholds returns PacketDelayThresholds in structured format if the type of IE matches.
-func (i *IE) PacketDelayThresholds() (*PacketDelayThresholdsFields, error) {
-	switch i.Type {
-	case PacketDelayThresholds:
-		fields, err := ParsePacketDelayThresholdsFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case QoSMonitoringPerQoSFlowControlInformation:
-		ies, err := i.QoSMonitoringPerQoSFlowControlInformation()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketDelayThresholds {
-				return x.PacketDelayThresholds()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // PacketDelayThresholdsFields represents a fields contained in PacketDelayThresholds IE.
 type PacketDelayThresholdsFields struct {
 	Flags                          uint8
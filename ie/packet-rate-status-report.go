@@ -18,7 +18,7 @@ func NewPacketRateStatusReportWithinSessionModificationResponse(ies ...*IE) *IE
 func (i *IE) PacketRateStatusReport() ([]*IE, error) {
 	switch i.Type {
 	case PacketRateStatusReport, PacketRateStatusReportWithinSessionModificationResponse:
-		return ParseMultiIEs(i.Payload)
+		return i.ValueAsGrouped()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -22,54 +22,6 @@ func NewPacketRateStatus(flags uint8, ul, aul, dl, adl uint16, vtime time.Time)
 	return New(PacketRateStatus, b)
 }
 
-// PacketRateStatus returns PacketRateStatus in structured format if the type of IE matches.
-func (i *IE) PacketRateStatus() (*PacketRateStatusFields, error) {
-	switch i.Type {
-	case PacketRateStatus:
-		f, err := ParsePacketRateStatusFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return f, nil
-	case CreateQER:
-		ies, err := i.CreateQER()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketRateStatus {
-				return x.PacketRateStatus()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateQER:
-		ies, err := i.UpdateQER()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketRateStatus {
-				return x.PacketRateStatus()
-			}
-		}
-		return nil, ErrIENotFound
-	case PacketRateStatusReport:
-		ies, err := i.PacketRateStatusReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketRateStatus {
-				return x.PacketRateStatus()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // PacketRateStatusFields represents a f contained in PacketRateStatus IE.
 type PacketRateStatusFields struct {
 	Flags                                             uint8
@@ -29,43 +29,6 @@ func NewPacketRate(flags uint8, ulunit uint8, ulpackets uint16, dlunit uint8, dl
 	return New(PacketRate, b)
 }
 
-// PacketRate returns PacketRate in *PacketRateFields if the type of IE matches.
-func (i *IE) PacketRate() (*PacketRateFields, error) {
-	switch i.Type {
-	case PacketRate:
-		f, err := ParsePacketRateFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-		return f, nil
-	case CreateQER:
-		ies, err := i.CreateQER()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketRate {
-				return x.PacketRate()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateQER:
-		ies, err := i.UpdateQER()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketRate {
-				return x.PacketRate()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // HasDLPR reports whether an IE has DLPR bit.
 func (i *IE) HasDLPR() bool {
 	if i.Type != PacketRate {
@@ -99,6 +62,22 @@ type PacketRateFields struct {
 	DownlinkPacketRate uint16
 }
 
+// HasULPR reports whether the fields have ULPR bit.
+func (f *PacketRateFields) HasULPR() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasDLPR reports whether the fields have DLPR bit.
+func (f *PacketRateFields) HasDLPR() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasAPRC reports whether the fields have APRC bit, which indicates the rate is
+// the Additional Packet Rate for APN Rate Control.
+func (f *PacketRateFields) HasAPRC() bool {
+	return has3rdBit(f.Flags)
+}
+
 // NewPacketRateFields creates a new PacketRateFields.
 func NewPacketRateFields(flags uint8, ulunit uint8, ulpackets uint16, dlunit uint8, dlpackets uint16) *PacketRateFields {
 	f := &PacketRateFields{Flags: flags}
@@ -4,32 +4,6 @@
 
 package ie
 
-// NewPacketReplicationAndDetectionCarryOnInformation creates a new PacketReplicationAndDetectionCarryOnInformation IE.
-func NewPacketReplicationAndDetectionCarryOnInformation(flag uint8) *IE {
-	return newUint8ValIE(PacketReplicationAndDetectionCarryOnInformation, flag)
-}
-
-// PacketReplicationAndDetectionCarryOnInformation returns PacketReplicationAndDetectionCarryOnInformation in uint8 if the type of IE matches.
-func (i *IE) PacketReplicationAndDetectionCarryOnInformation() (uint8, error) {
-	switch i.Type {
-	case PacketReplicationAndDetectionCarryOnInformation:
-		return i.ValueAsUint8()
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == PacketReplicationAndDetectionCarryOnInformation {
-				return x.PacketReplicationAndDetectionCarryOnInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasPRIUEAI reports whether an IE has PRIUEAI bit.
 func (i *IE) HasPRIUEAI() bool {
 	v, err := i.PacketReplicationAndDetectionCarryOnInformation()
@@ -13,17 +13,3 @@ const (
 	PDNTypeNonIP    uint8 = 4
 	PDNTypeEthernet uint8 = 5
 )
-
-// NewPDNType creates a new PDNType IE.
-func NewPDNType(typ uint8) *IE {
-	return newUint8ValIE(PDNType, typ)
-}
-
-// PDNType returns PDNType in uint8 if the type of IE matches.
-func (i *IE) PDNType() (uint8, error) {
-	if i.Type != PDNType {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
@@ -9,15 +9,6 @@ func NewPFCPAssociationReleaseRequest(sarr, urss int) *IE {
 	return newUint8ValIE(PFCPAssociationReleaseRequest, uint8((urss<<1)|(sarr)))
 }
 
-// PFCPAssociationReleaseRequest returns PFCPAssociationReleaseRequest in uint8 if the type of IE matches.
-func (i *IE) PFCPAssociationReleaseRequest() (uint8, error) {
-	if i.Type != PFCPAssociationReleaseRequest {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
-
 // HasURSS reports whether an IE has URSS bit.
 func (i *IE) HasURSS() bool {
 	v, err := i.PFCPAssociationReleaseRequest()
@@ -8,12 +8,3 @@ package ie
 func NewPFCPSessionRetentionInformation(cpIP *IE) *IE {
 	return newGroupedIE(PFCPSessionRetentionInformation, 0, cpIP)
 }
-
-// PFCPSessionRetentionInformation returns the IEs above PFCPSessionRetentionInformation if the type of IE matches.
-func (i *IE) PFCPSessionRetentionInformation() ([]*IE, error) {
-	if i.Type != PFCPSessionRetentionInformation {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -4,20 +4,6 @@
 
 package ie
 
-// NewPFCPASReqFlags creates a new PFCPASReqFlags IE.
-func NewPFCPASReqFlags(flag uint8) *IE {
-	return newUint8ValIE(PFCPASReqFlags, flag)
-}
-
-// PFCPASReqFlags returns PFCPASReqFlags in uint8 if the type of IE matches.
-func (i *IE) PFCPASReqFlags() (uint8, error) {
-	if i.Type != PFCPASReqFlags {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
-
 // HasUUPSI reports whether an IE has UUPSI bit.
 func (i *IE) HasUUPSI() bool {
 	v, err := i.PFCPASReqFlags()
@@ -4,20 +4,6 @@
 
 package ie
 
-// NewPFCPAUReqFlags creates a new PFCPAUReqFlags IE.
-func NewPFCPAUReqFlags(flag uint8) *IE {
-	return newUint8ValIE(PFCPAUReqFlags, flag)
-}
-
-// PFCPAUReqFlags returns PFCPAUReqFlags in uint8 if the type of IE matches.
-func (i *IE) PFCPAUReqFlags() (uint8, error) {
-	if i.Type != PFCPAUReqFlags {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
-
 // HasPARPS reports whether an IE has PARPS bit.
 func (i *IE) HasPARPS() bool {
 	v, err := i.PFCPAUReqFlags()
@@ -4,20 +4,6 @@
 
 package ie
 
-// NewPFCPSEReqFlags creates a new PFCPSEReqFlags IE.
-func NewPFCPSEReqFlags(flag uint8) *IE {
-	return newUint8ValIE(PFCPSEReqFlags, flag)
-}
-
-// PFCPSEReqFlags returns PFCPSEReqFlags in uint8 if the type of IE matches.
-func (i *IE) PFCPSEReqFlags() (uint8, error) {
-	if i.Type != PFCPSEReqFlags {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
-
 // HasRESTI reports whether an IE has RESTI bit.
 func (i *IE) HasRESTI() bool {
 	v, err := i.PFCPSEReqFlags()
@@ -4,43 +4,6 @@
 
 package ie
 
-// NewPFCPSMReqFlags creates a new PFCPSMReqFlags IE.
-func NewPFCPSMReqFlags(flag uint8) *IE {
-	return newUint8ValIE(PFCPSMReqFlags, flag)
-}
-
-// PFCPSMReqFlags returns PFCPSMReqFlags in uint8 if the type of IE matches.
-func (i *IE) PFCPSMReqFlags() (uint8, error) {
-	switch i.Type {
-	case PFCPSMReqFlags:
-		return i.ValueAsUint8()
-	case ForwardingParameters:
-		ies, err := i.ForwardingParameters()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == PFCPSMReqFlags {
-				return x.PFCPSMReqFlags()
-			}
-		}
-		return 0, ErrIENotFound
-	case UpdateForwardingParameters:
-		ies, err := i.UpdateForwardingParameters()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == PFCPSMReqFlags {
-				return x.PFCPSMReqFlags()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasDROBU reports whether an IE has DROBU bit.
 func (i *IE) HasDROBU() bool {
 	if len(i.Payload) < 1 {
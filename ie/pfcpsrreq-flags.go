@@ -4,20 +4,6 @@
 
 package ie
 
-// NewPFCPSRReqFlags creates a new PFCPSRReqFlags IE.
-func NewPFCPSRReqFlags(flag uint8) *IE {
-	return newUint8ValIE(PFCPSRReqFlags, flag)
-}
-
-// PFCPSRReqFlags returns PFCPSRReqFlags in uint8 if the type of IE matches.
-func (i *IE) PFCPSRReqFlags() (uint8, error) {
-	if i.Type != PFCPSRReqFlags {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
-
 // HasPSDBU reports whether an IE has PSDBU bit.
 func (i *IE) HasPSDBU() bool {
 	v, err := i.PFCPSRReqFlags()
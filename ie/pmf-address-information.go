@@ -22,43 +22,6 @@ func NewPMFAddressInformation(v4, v6 net.IP, port1, port2 uint16, mac1, mac2 net
 	return New(PMFAddressInformation, b)
 }
 
-// PMFAddressInformation returns PMFAddressInformation in structured format if the type of IE matches.
-func (i *IE) PMFAddressInformation() (*PMFAddressInformationFields, error) {
-	switch i.Type {
-	case PMFAddressInformation:
-		fields, err := ParsePMFAddressInformationFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case ATSSSControlParameters:
-		ies, err := i.ATSSSControlParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PMFParameters {
-				return x.PMFAddressInformation()
-			}
-		}
-		return nil, ErrIENotFound
-	case PMFParameters:
-		ies, err := i.PMFParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PMFAddressInformation {
-				return x.PMFAddressInformation()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // PMFAddressInformationFields represents a fields contained in PMFAddressInformation IE.
 type PMFAddressInformationFields struct {
 	Flags                         uint8
@@ -9,38 +9,6 @@ func NewProxying(ins, arp uint8) *IE {
 	return newUint8ValIE(Proxying, (ins<<1)|arp)
 }
 
-// Proxying returns Proxying in uint8 if the type of IE matches.
-func (i *IE) Proxying() (uint8, error) {
-	switch i.Type {
-	case Proxying:
-		return i.ValueAsUint8()
-	case ForwardingParameters:
-		ies, err := i.ForwardingParameters()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == Proxying {
-				return x.Proxying()
-			}
-		}
-		return 0, ErrIENotFound
-	case UpdateForwardingParameters:
-		ies, err := i.UpdateForwardingParameters()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == Proxying {
-				return x.Proxying()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasINS reports whether an IE has INS bit.
 func (i *IE) HasINS() bool {
 	v, err := i.Proxying()
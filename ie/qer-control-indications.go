@@ -9,39 +9,6 @@ func NewQERControlIndications(nord, mode, rcsr int) *IE {
 	return newUint8ValIE(QERControlIndications, uint8((nord<<2)|(mode<<1)|(rcsr)))
 }
 
-// QERControlIndications returns QERControlIndications in uint8 if the type of IE matches.
-func (i *IE) QERControlIndications() (uint8, error) {
-	switch i.Type {
-	case QERControlIndications:
-		return i.ValueAsUint8()
-	case CreateQER:
-		ies, err := i.CreateQER()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == QERControlIndications {
-				return x.QERControlIndications()
-			}
-		}
-		return 0, ErrIENotFound
-	case UpdateQER:
-		ies, err := i.UpdateQER()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == QERControlIndications {
-				return x.QERControlIndications()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // HasNORD reports whether an IE has NORD bit.
 func (i *IE) HasNORD() bool {
 	v, err := i.QERControlIndications()
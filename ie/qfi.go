@@ -17,11 +17,15 @@ func (i *IE) QFI() (uint8, error) {
 	case QFI:
 		return i.ValueAsUint8()
 	case DownlinkDataServiceInformation:
-		if len(i.Payload) < 2 {
+		offset := 1
+		if i.HasPPI() {
+			offset++
+		}
+		if len(i.Payload) <= offset {
 			return 0, io.ErrUnexpectedEOF
 		}
 
-		return i.Payload[2], nil
+		return i.Payload[offset], nil
 	case CreateQER:
 		ies, err := i.CreateQER()
 		if err != nil {
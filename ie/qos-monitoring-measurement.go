@@ -21,32 +21,6 @@ func NewQoSMonitoringMeasurement(flags uint8, dl, ul, rp uint32) *IE {
 	return New(QoSMonitoringMeasurement, b)
 }
 
-// QoSMonitoringMeasurement returns QoSMonitoringMeasurement in structured format if the type of IE matches.
-func (i *IE) QoSMonitoringMeasurement() (*QoSMonitoringMeasurementFields, error) {
-	switch i.Type {
-	case QoSMonitoringMeasurement:
-		fields, err := ParseQoSMonitoringMeasurementFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case QoSMonitoringReport:
-		ies, err := i.QoSMonitoringReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == QoSMonitoringMeasurement {
-				return x.QoSMonitoringMeasurement()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // QoSMonitoringMeasurementFields represents a fields contained in QoSMonitoringMeasurement IE.
 type QoSMonitoringMeasurementFields struct {
 	Flags                uint8
@@ -9,39 +9,6 @@ func NewQoSReportTrigger(ire, thr, per int) *IE {
 	return newUint8ValIE(QoSReportTrigger, uint8((ire<<2)|(thr<<1)|(per)))
 }
 
-// QoSReportTrigger returns QoSReportTrigger in uint8 if the type of IE matches.
-func (i *IE) QoSReportTrigger() (uint8, error) {
-	switch i.Type {
-	case QoSReportTrigger:
-		return i.ValueAsUint8()
-	case GTPUPathQoSControlInformation:
-		ies, err := i.GTPUPathQoSControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == QoSReportTrigger {
-				return x.QoSReportTrigger()
-			}
-		}
-		return 0, ErrIENotFound
-	case GTPUPathQoSReport:
-		ies, err := i.GTPUPathQoSReport()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == QoSReportTrigger {
-				return x.QoSReportTrigger()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // HasIRE reports whether an IE has IRE bit.
 func (i *IE) HasIRE() bool {
 	v, err := i.QoSReportTrigger()
@@ -8,12 +8,3 @@ package ie
 func NewQueryURR(urrID *IE) *IE {
 	return newGroupedIE(QueryURR, 0, urrID)
 }
-
-// QueryURR returns the IEs above QueryURR if the type of IE matches.
-func (i *IE) QueryURR() ([]*IE, error) {
-	if i.Type != QueryURR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -0,0 +1,391 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SkipChildren is used as a return value from WalkFunc to indicate that the
+// children of the IE passed to the function are to be skipped.
+var SkipChildren = errors.New("skip children")
+
+// WalkFunc is the type of the function called by Walk for each IE visited.
+//
+// parents is the list of grouped IEs that contain i, from the outermost one.
+// If the function returns SkipChildren, the children of i are not visited.
+// Any other non-nil error stops the walk and is returned by Walk.
+type WalkFunc func(parents []*IE, i *IE) error
+
+// Walk visits the IE and all its descendants in depth-first order, calling fn
+// for each of them.
+//
+// The children of grouped IEs are taken from ChildIEs, or parsed from Payload if
+// it is not populated yet. An error is returned if the payload is malformed.
+func (i *IE) Walk(fn WalkFunc) error {
+	return walk(nil, i, fn)
+}
+
+// WalkIEs calls Walk on each of the IEs in order.
+func WalkIEs(ies []*IE, fn WalkFunc) error {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		if err := walk(nil, i, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walk(parents []*IE, i *IE, fn WalkFunc) error {
+	if err := fn(parents, i); err != nil {
+		if errors.Is(err, SkipChildren) {
+			return nil
+		}
+		return err
+	}
+	if !i.IsGrouped() {
+		return nil
+	}
+
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return err
+	}
+
+	path := append(parents[:len(parents):len(parents)], i)
+	for _, c := range children {
+		if err := walk(path, c, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindAll returns all the descendants of the IE that have the given type, in
+// depth-first order. Unlike FindByType, it looks into the children of children.
+//
+// The malformed grouped IEs are silently skipped. Use Validate beforehand if
+// they should be detected.
+func (i *IE) FindAll(typ IEType) []*IE {
+	return FindAllIEs(childrenOf(i), typ)
+}
+
+// FindAllIEs returns all the IEs that have the given type in the list of IEs
+// and their descendants, in depth-first order.
+func FindAllIEs(ies []*IE, typ IEType) []*IE {
+	var found []*IE
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		if i.Type == typ {
+			found = append(found, i)
+		}
+		found = append(found, FindAllIEs(childrenOf(i), typ)...)
+	}
+	return found
+}
+
+// Query returns the descendants of the IE that match the path expression q.
+//
+// The path is a list of steps separated by "/", and each step is evaluated
+// against the children of the IEs matched by the previous step, starting with
+// the children of i. A step is one of the followings:
+//
+//   - The name of IE type, e.g., "CreatePDR", or the name of vendor-specific IE
+//     registered with RegisterVendorIE. "IEType(n)" can be used for the types
+//     unknown to this package.
+//   - "*", which matches any IE.
+//   - "**", which matches the IEs at this level and all the levels below.
+//
+// The name and "*" can be followed by predicates in square brackets:
+//
+//   - [n] selects the n-th (0-based) IE among the siblings matched so far.
+//   - [Name] selects the IEs that have the child of the type.
+//   - [Name=value] selects the IEs that have the child of the type whose value
+//     is equal to value. Integers are compared numerically and can be written in
+//     hex with 0x prefix. Flags, e.g., ApplyAction, match if the flag is set.
+//     Otherwise the value is compared with the one shown by String.
+//
+// For example, "CreatePDR[PDRID=3]/PDI/FTEID" returns the F-TEID in the PDI of
+// the Create PDR whose PDR ID is 3, and "**/FTEID" returns all the F-TEIDs at
+// any depth. The predicates are in the same form as the ones in the Path of
+// Difference, so the IE found by DiffIEs can be looked up again with the Path,
+// in the new list for DiffAdded and in the old one otherwise. This does not
+// apply to the Path that ends with the name of a field, e.g.,
+// "CreatePDR[PDRID=1]/PDI/FTEID/TEID", or that has a vendor-specific IE in it.
+//
+// The malformed grouped IEs are silently skipped. *QueryError is returned only
+// if q is invalid.
+func (i *IE) Query(q string) ([]*IE, error) {
+	return QueryIEs(childrenOf(i), q)
+}
+
+// QueryIEs returns the IEs that match the path expression q, which is evaluated
+// starting with the list of IEs given. See (*IE).Query for the syntax of q.
+func QueryIEs(ies []*IE, q string) ([]*IE, error) {
+	steps, err := parseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := [][]*IE{ies}
+	var matched []*IE
+	for n, s := range steps {
+		matched = nil
+		if s.descendants {
+			for _, level := range levels {
+				matched = appendSubtrees(matched, level)
+			}
+			matched = uniqueIEs(matched)
+			if n == len(steps)-1 {
+				break
+			}
+			levels = append(levels[:0:0], levels...)
+			for _, m := range matched {
+				levels = append(levels, childrenOf(m))
+			}
+			continue
+		}
+
+		for _, level := range levels {
+			matched = append(matched, s.filter(level)...)
+		}
+		levels = make([][]*IE, 0, len(matched))
+		for _, m := range matched {
+			levels = append(levels, childrenOf(m))
+		}
+	}
+	return uniqueIEs(matched), nil
+}
+
+// childrenOf returns the children of grouped IE, or nil if i is not grouped or
+// malformed.
+func childrenOf(i *IE) []*IE {
+	if i == nil || !i.IsGrouped() {
+		return nil
+	}
+	children, err := i.ValueAsGrouped()
+	if err != nil {
+		return nil
+	}
+	return children
+}
+
+func appendSubtrees(dst, ies []*IE) []*IE {
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		dst = append(dst, i)
+		dst = appendSubtrees(dst, childrenOf(i))
+	}
+	return dst
+}
+
+func uniqueIEs(ies []*IE) []*IE {
+	seen := make(map[*IE]bool, len(ies))
+	return slices.DeleteFunc(ies, func(i *IE) bool {
+		if seen[i] {
+			return true
+		}
+		seen[i] = true
+		return false
+	})
+}
+
+type queryStep struct {
+	name        string // empty for "*"
+	descendants bool   // "**"
+	predicates  []queryPredicate
+}
+
+type queryPredicate struct {
+	index    int // -1 if not an index
+	name     string
+	value    string
+	hasValue bool
+}
+
+func (s *queryStep) filter(ies []*IE) []*IE {
+	var matched []*IE
+	for _, i := range ies {
+		if i != nil && (s.name == "" || matchName(i, s.name)) {
+			matched = append(matched, i)
+		}
+	}
+
+	for _, p := range s.predicates {
+		if p.index >= 0 {
+			if p.index >= len(matched) {
+				return nil
+			}
+			matched = matched[p.index : p.index+1]
+			continue
+		}
+		matched = slices.DeleteFunc(matched, func(i *IE) bool {
+			return !slices.ContainsFunc(childrenOf(i), func(c *IE) bool {
+				return matchName(c, p.name) && (!p.hasValue || matchValue(c, p.value))
+			})
+		})
+	}
+	return matched
+}
+
+func matchName(i *IE, name string) bool {
+	return i.TypeName() == name || i.Type.String() == name
+}
+
+// matchValue reports whether the value of i is equal to s.
+func matchValue(i *IE, s string) bool {
+	v, err := i.value()
+	if err != nil || v == nil {
+		return false
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseUint(s, 0, 64); err == nil {
+			return rv.Uint() == n
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, err := strconv.ParseInt(s, 0, 64); err == nil {
+			return rv.Int() == n
+		}
+	case reflect.String:
+		return rv.String() == s
+	}
+
+	if flags, ok := v.([]string); ok {
+		return slices.Contains(flags, s)
+	}
+	if st, ok := v.(fmt.Stringer); ok && st.String() == s {
+		return true
+	}
+
+	// the name without the number is also accepted for enumerated values, e.g.,
+	// "Access" for "Access (0)".
+	f := strings.Trim(formatValue(i.Type, v), `"`)
+	if name, _, ok := strings.Cut(f, " ("); ok && strings.HasSuffix(f, ")") && name == s {
+		return true
+	}
+	return f == s
+}
+
+func parseQuery(q string) ([]*queryStep, error) {
+	if q == "" {
+		return nil, &QueryError{Query: q, Reason: "empty query"}
+	}
+
+	var steps []*queryStep
+	for _, elem := range splitQuery(q) {
+		s, err := parseQueryStep(elem)
+		if err != nil {
+			return nil, &QueryError{Query: q, Reason: err.Error()}
+		}
+		steps = append(steps, s)
+	}
+	return steps, nil
+}
+
+// splitQuery splits q by "/" outside the square brackets.
+func splitQuery(q string) []string {
+	var elems []string
+	depth, start := 0, 0
+	for n, c := range q {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				elems = append(elems, q[start:n])
+				start = n + 1
+			}
+		}
+	}
+	return append(elems, q[start:])
+}
+
+func parseQueryStep(elem string) (*queryStep, error) {
+	name, rest, _ := strings.Cut(elem, "[")
+	if rest != "" || strings.HasSuffix(elem, "[") {
+		rest = "[" + rest
+	}
+
+	s := &queryStep{}
+	switch name {
+	case "":
+		return nil, errors.New("empty step")
+	case "**":
+		if rest != "" {
+			return nil, errors.New("predicates cannot be used with **")
+		}
+		s.descendants = true
+		return s, nil
+	case "*":
+	default:
+		if !knownTypeName(name) {
+			return nil, fmt.Errorf("unknown IE type %q", name)
+		}
+		s.name = name
+	}
+
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return nil, fmt.Errorf("malformed predicate in %q", elem)
+		}
+		p, err := parseQueryPredicate(rest[1:end])
+		if err != nil {
+			return nil, err
+		}
+		s.predicates = append(s.predicates, p)
+		rest = rest[end+1:]
+	}
+	return s, nil
+}
+
+func parseQueryPredicate(expr string) (queryPredicate, error) {
+	if n, err := strconv.Atoi(expr); err == nil {
+		if n < 0 {
+			return queryPredicate{}, fmt.Errorf("negative index %d", n)
+		}
+		return queryPredicate{index: n}, nil
+	}
+
+	name, value, hasValue := strings.Cut(expr, "=")
+	if !knownTypeName(name) {
+		return queryPredicate{}, fmt.Errorf("unknown IE type %q", name)
+	}
+	return queryPredicate{index: -1, name: name, value: value, hasValue: hasValue}, nil
+}
+
+// knownTypeName reports whether the name is the name of any IE type, including
+// the vendor-specific ones registered with RegisterVendorIE.
+func knownTypeName(name string) bool {
+	if _, ok := ieTypeByName(name); ok {
+		return true
+	}
+
+	vendorMu.RLock()
+	defer vendorMu.RUnlock()
+	for _, v := range vendorIEs {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
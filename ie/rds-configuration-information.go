@@ -9,27 +9,6 @@ func NewRDSConfigurationInformation(rds uint8) *IE {
 	return newUint8ValIE(RDSConfigurationInformation, rds&0x01)
 }
 
-// RDSConfigurationInformation returns RDSConfigurationInformation in uint8 if the type of IE matches.
-func (i *IE) RDSConfigurationInformation() (uint8, error) {
-	switch i.Type {
-	case RDSConfigurationInformation:
-		return i.ValueAsUint8()
-	case ProvideRDSConfigurationInformation:
-		ies, err := i.ProvideRDSConfigurationInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == RDSConfigurationInformation {
-				return x.RDSConfigurationInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasRDS reports whether an IE has RDS bit.
 func (i *IE) HasRDS() bool {
 	switch i.Type {
@@ -4,11 +4,6 @@
 
 package ie
 
-// NewRedundantTransmissionParameters creates a new RedundantTransmissionParameters IE.
-func NewRedundantTransmissionParameters(ies ...*IE) *IE {
-	return newGroupedIE(RedundantTransmissionParameters, 0, ies...)
-}
-
 // NewRedundantTransmissionParametersInPDI creates a new RedundantTransmissionParameters IE.
 func NewRedundantTransmissionParametersInPDI(fteid, ni *IE) *IE {
 	return newGroupedIE(RedundantTransmissionParameters, 0, fteid, ni)
@@ -18,79 +13,3 @@ func NewRedundantTransmissionParametersInPDI(fteid, ni *IE) *IE {
 func NewRedundantTransmissionParametersInFAR(ohc, ni *IE) *IE {
 	return newGroupedIE(RedundantTransmissionParameters, 0, ohc, ni)
 }
-
-// RedundantTransmissionParameters returns the IEs above RedundantTransmissionParameters if the type of IE matches.
-func (i *IE) RedundantTransmissionParameters() ([]*IE, error) {
-	switch i.Type {
-	case RedundantTransmissionParameters:
-		return ParseMultiIEs(i.Payload)
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PDI {
-				return x.RedundantTransmissionParameters()
-			}
-		}
-		return nil, ErrIENotFound
-	case PDI:
-		ies, err := i.PDI()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RedundantTransmissionParameters {
-				return x.RedundantTransmissionParameters()
-			}
-		}
-		return nil, ErrIENotFound
-	case CreateFAR:
-		ies, err := i.CreateFAR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RedundantTransmissionParameters {
-				return x.RedundantTransmissionParameters()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateFAR:
-		ies, err := i.UpdateFAR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RedundantTransmissionParameters {
-				return x.RedundantTransmissionParameters()
-			}
-		}
-		return nil, ErrIENotFound
-	case CreateTrafficEndpoint:
-		ies, err := i.CreateTrafficEndpoint()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RedundantTransmissionParameters {
-				return x.RedundantTransmissionParameters()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateTrafficEndpoint:
-		ies, err := i.UpdateTrafficEndpoint()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RedundantTransmissionParameters {
-				return x.RedundantTransmissionParameters()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
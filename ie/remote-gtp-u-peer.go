@@ -21,65 +21,6 @@ func NewRemoteGTPUPeer(flags uint8, v4, v6 string, di uint8, ni string) *IE {
 	return New(RemoteGTPUPeer, b)
 }
 
-// RemoteGTPUPeer returns RemoteGTPUPeer in *RemoteGTPUPeerFields if the type of IE matches.
-func (i *IE) RemoteGTPUPeer() (*RemoteGTPUPeerFields, error) {
-	switch i.Type {
-	case RemoteGTPUPeer:
-		fields, err := ParseRemoteGTPUPeerFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case UserPlanePathFailureReport:
-		ies, err := i.UserPlanePathFailureReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RemoteGTPUPeer {
-				return x.RemoteGTPUPeer()
-			}
-		}
-		return nil, ErrIENotFound
-	case UserPlanePathRecoveryReport:
-		ies, err := i.UserPlanePathRecoveryReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RemoteGTPUPeer {
-				return x.RemoteGTPUPeer()
-			}
-		}
-		return nil, ErrIENotFound
-	case GTPUPathQoSControlInformation:
-		ies, err := i.GTPUPathQoSControlInformation()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RemoteGTPUPeer {
-				return x.RemoteGTPUPeer()
-			}
-		}
-		return nil, ErrIENotFound
-	case GTPUPathQoSReport:
-		ies, err := i.GTPUPathQoSReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == RemoteGTPUPeer {
-				return x.RemoteGTPUPeer()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasNI reports whether an IE has NI bit.
 func (i *IE) HasNI() bool {
 	if i.Type != RemoteGTPUPeer {
@@ -8,12 +8,3 @@ package ie
 func NewRemoveBAR(barID *IE) *IE {
 	return newGroupedIE(RemoveBAR, 0, barID)
 }
-
-// RemoveBAR returns the IEs above RemoveBAR if the type of IE matches.
-func (i *IE) RemoveBAR() ([]*IE, error) {
-	if i.Type != RemoveBAR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -8,12 +8,3 @@ package ie
 func NewRemoveFAR(far *IE) *IE {
 	return newGroupedIE(RemoveFAR, 0, far)
 }
-
-// RemoveFAR returns the IEs above RemoveFAR if the type of IE matches.
-func (i *IE) RemoveFAR() ([]*IE, error) {
-	if i.Type != RemoveFAR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -8,12 +8,3 @@ package ie
 func NewRemoveMAR(marID *IE) *IE {
 	return newGroupedIE(RemoveMAR, 0, marID)
 }
-
-// RemoveMAR returns the IEs above RemoveMAR if the type of IE matches.
-func (i *IE) RemoveMAR() ([]*IE, error) {
-	if i.Type != RemoveMAR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
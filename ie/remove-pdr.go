@@ -8,12 +8,3 @@ package ie
 func NewRemovePDR(pdr *IE) *IE {
 	return newGroupedIE(RemovePDR, 0, pdr)
 }
-
-// RemovePDR returns the IEs above RemovePDR if the type of IE matches.
-func (i *IE) RemovePDR() ([]*IE, error) {
-	if i.Type != RemovePDR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
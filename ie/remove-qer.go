@@ -8,12 +8,3 @@ package ie
 func NewRemoveQER(qer *IE) *IE {
 	return newGroupedIE(RemoveQER, 0, qer)
 }
-
-// RemoveQER returns the IEs above RemoveQER if the type of IE matches.
-func (i *IE) RemoveQER() ([]*IE, error) {
-	if i.Type != RemoveQER {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -8,12 +8,3 @@ package ie
 func NewRemoveSRR(srr *IE) *IE {
 	return newGroupedIE(RemoveSRR, 0, srr)
 }
-
-// RemoveSRR returns the IEs above RemoveSRR if the type of IE matches.
-func (i *IE) RemoveSRR() ([]*IE, error) {
-	if i.Type != RemoveSRR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -8,12 +8,3 @@ package ie
 func NewRemoveTrafficEndpoint(id *IE) *IE {
 	return newGroupedIE(RemoveTrafficEndpoint, 0, id)
 }
-
-// RemoveTrafficEndpoint returns the IEs above RemoveTrafficEndpoint if the type of IE matches.
-func (i *IE) RemoveTrafficEndpoint() ([]*IE, error) {
-	if i.Type != RemoveTrafficEndpoint {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
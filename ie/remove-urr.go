@@ -8,12 +8,3 @@ package ie
 func NewRemoveURR(urr *IE) *IE {
 	return newGroupedIE(RemoveURR, 0, urr)
 }
-
-// RemoveURR returns the IEs above RemoveURR if the type of IE matches.
-func (i *IE) RemoveURR() ([]*IE, error) {
-	if i.Type != RemoveURR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -9,15 +9,6 @@ func NewReportType(upir, erir, usar, dldr int) *IE {
 	return newUint8ValIE(ReportType, uint8((upir<<3)|(erir<<2)|(usar<<1)|(dldr)))
 }
 
-// ReportType returns ReportType in uint8 if the type of IE matches.
-func (i *IE) ReportType() (uint8, error) {
-	if i.Type != ReportType {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-	return i.ValueAsUint8()
-}
-
 // HasUPIR reports whether an IE has UPIR bit.
 func (i *IE) HasUPIR() bool {
 	v, err := i.ReportType()
@@ -9,28 +9,6 @@ func NewReportingFrequency(sesrl, perio, evett int) *IE {
 	return newUint8ValIE(ReportingFrequency, uint8((sesrl<<2)|(perio<<1)|(evett)))
 }
 
-// ReportingFrequency returns ReportingFrequency in uint8 if the type of IE matches.
-func (i *IE) ReportingFrequency() (uint8, error) {
-	switch i.Type {
-	case ReportingFrequency:
-		return i.ValueAsUint8()
-	case QoSMonitoringPerQoSFlowControlInformation:
-		ies, err := i.QoSMonitoringPerQoSFlowControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == ReportingFrequency {
-				return x.ReportingFrequency()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // HasSESRL reports whether an IE has SESRL bit.
 func (i *IE) HasSESRL() bool {
 	v, err := i.ReportingFrequency()
@@ -5,10 +5,14 @@
 package ie
 
 import (
+	"fmt"
 	"io"
 )
 
 // NewReportingTriggers creates a new ReportingTriggers IE.
+//
+// The triggers are given by octets, or as ReportingTriggerFlags like
+// NewReportingTriggers(NewReportingTriggerFlags(ReportingTriggerPERIO, ReportingTriggerVOLTH)...).
 func NewReportingTriggers(triggersOctets ...uint8) *IE {
 	return New(ReportingTriggers, triggersOctets)
 }
@@ -490,3 +494,95 @@ func (i *IE) HasREEMR() bool {
 		return false
 	}
 }
+
+// ReportingTriggerFlag is a flag in ReportingTriggers IE, represented as the position of
+// the bit counted from bit 1 of the 5th octet.
+type ReportingTriggerFlag uint8
+
+// ReportingTriggerFlag definitions.
+const (
+	// 5th octet
+	ReportingTriggerPERIO ReportingTriggerFlag = iota
+	ReportingTriggerVOLTH
+	ReportingTriggerTIMTH
+	ReportingTriggerQUHTI
+	ReportingTriggerSTART
+	ReportingTriggerSTOPT
+	ReportingTriggerDROTH
+	ReportingTriggerLIUSA
+
+	// 6th octet
+	ReportingTriggerVOLQU
+	ReportingTriggerTIMQU
+	ReportingTriggerENVCL
+	ReportingTriggerMACAR
+	ReportingTriggerEVETH
+	ReportingTriggerEVEQU
+	ReportingTriggerIPMJL
+	ReportingTriggerQUVTI
+
+	// 7th octet
+	ReportingTriggerREEMR
+	ReportingTriggerUPINT
+)
+
+// String returns the name of the flag, e.g., "PERIO".
+func (f ReportingTriggerFlag) String() string {
+	return flagName(reportingTriggersFlagNames, uint8(f))
+}
+
+// ReportingTriggerFlags is the value of ReportingTriggers IE.
+//
+// As it is a []byte, it can be given to NewReportingTriggers as it is. The methods
+// that modify the flags return the modified copy, and never change the original.
+type ReportingTriggerFlags []byte
+
+// NewReportingTriggerFlags creates a new ReportingTriggerFlags with the given flags set.
+// It has at least 2 octets.
+func NewReportingTriggerFlags(flags ...ReportingTriggerFlag) ReportingTriggerFlags {
+	return make(ReportingTriggerFlags, 2).Set(flags...)
+}
+
+// Has reports whether all the given flags are set.
+func (f ReportingTriggerFlags) Has(flags ...ReportingTriggerFlag) bool {
+	return hasFlags(f, flags...)
+}
+
+// Set returns the copy of f with the given flags set.
+func (f ReportingTriggerFlags) Set(flags ...ReportingTriggerFlag) ReportingTriggerFlags {
+	return setFlags(f, flags...)
+}
+
+// Clear returns the copy of f with the given flags cleared.
+func (f ReportingTriggerFlags) Clear(flags ...ReportingTriggerFlag) ReportingTriggerFlags {
+	return clearFlags(f, flags...)
+}
+
+// Flags returns the flags set in f in the order of the bit position.
+func (f ReportingTriggerFlags) Flags() []ReportingTriggerFlag {
+	return listFlags[ReportingTriggerFlag](f)
+}
+
+// String returns the names of the flags set in f separated by "|", e.g.,
+// "PERIO|VOLTH", or "none" if no flag is set.
+func (f ReportingTriggerFlags) String() string {
+	return joinFlags(f, reportingTriggersFlagNames)
+}
+
+// Validate checks if the flags are allowed in 3GPP TS 29.244 clause 8.2.19,
+// and returns *FlagsError if not. The 5th and 6th octets shall be present.
+func (f ReportingTriggerFlags) Validate() error {
+	if len(f) < 2 {
+		return &FlagsError{Type: ReportingTriggers, Reason: fmt.Sprintf("octets 5 and 6 shall be present, got %d octets", len(f))}
+	}
+	return nil
+}
+
+// ReportingTriggerFlags returns ReportingTriggers in ReportingTriggerFlags if the type of IE matches.
+func (i *IE) ReportingTriggerFlags() (ReportingTriggerFlags, error) {
+	v, err := i.ReportingTriggers()
+	if err != nil {
+		return nil, err
+	}
+	return ReportingTriggerFlags(v), nil
+}
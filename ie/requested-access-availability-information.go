@@ -9,28 +9,6 @@ func NewRequestedAccessAvailabilityInformation(rrca uint8) *IE {
 	return newUint8ValIE(RequestedAccessAvailabilityInformation, rrca&0x01)
 }
 
-// RequestedAccessAvailabilityInformation returns RequestedAccessAvailabilityInformation in uint8 if the type of IE matches.
-func (i *IE) RequestedAccessAvailabilityInformation() (uint8, error) {
-	switch i.Type {
-	case RequestedAccessAvailabilityInformation:
-		return i.ValueAsUint8()
-	case AccessAvailabilityControlInformation:
-		ies, err := i.AccessAvailabilityControlInformation()
-		if err != nil {
-			return 0, err
-		}
-
-		for _, x := range ies {
-			if x.Type == RequestedAccessAvailabilityInformation {
-				return x.RequestedAccessAvailabilityInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasRRCA reports whether an IE has RRCA bit.
 func (i *IE) HasRRCA() bool {
 	v, err := i.RequestedAccessAvailabilityInformation()
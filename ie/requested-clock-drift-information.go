@@ -9,28 +9,6 @@ func NewRequestedClockDriftInformation(rrcr, rrto uint8) *IE {
 	return newUint8ValIE(RequestedClockDriftInformation, (rrcr<<1)|rrto)
 }
 
-// RequestedClockDriftInformation returns RequestedClockDriftInformation in uint8 if the type of IE matches.
-func (i *IE) RequestedClockDriftInformation() (uint8, error) {
-	switch i.Type {
-	case RequestedClockDriftInformation:
-		return i.ValueAsUint8()
-	case ClockDriftControlInformation:
-		ies, err := i.ClockDriftControlInformation()
-		if err != nil {
-			return 0, err
-		}
-
-		for _, x := range ies {
-			if x.Type == RequestedClockDriftInformation {
-				return x.RequestedClockDriftInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasRRCR reports whether an IE has RRCR bit.
 func (i *IE) HasRRCR() bool {
 	if len(i.Payload) < 1 {
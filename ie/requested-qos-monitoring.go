@@ -9,28 +9,6 @@ func NewRequestedQoSMonitoring(rp, ul, dl int) *IE {
 	return newUint8ValIE(RequestedQoSMonitoring, uint8((rp<<2)|(ul<<1)|(dl)))
 }
 
-// RequestedQoSMonitoring returns RequestedQoSMonitoring in uint8 if the type of IE matches.
-func (i *IE) RequestedQoSMonitoring() (uint8, error) {
-	switch i.Type {
-	case RequestedQoSMonitoring:
-		return i.ValueAsUint8()
-	case QoSMonitoringPerQoSFlowControlInformation:
-		ies, err := i.QoSMonitoringPerQoSFlowControlInformation()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == RequestedQoSMonitoring {
-				return x.RequestedQoSMonitoring()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-
-}
-
 // HasRP reports whether an IE has RP bit.
 func (i *IE) HasRP() bool {
 	v, err := i.RequestedQoSMonitoring()
@@ -20,54 +20,14 @@ func NewSDFFilter(fd, ttc, spi, fl string, fid uint32) *IE {
 	return New(SDFFilter, b)
 }
 
-// SDFFilter returns SDFFilter in structured format if the type of IE matches.
-//
-// This IE has a complex payload that costs much when parsing.
-func (i *IE) SDFFilter() (*SDFFilterFields, error) {
-	switch i.Type {
-	case SDFFilter:
-		fields, err := ParseSDFFilterFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PDI {
-				return x.SDFFilter()
-			}
-		}
-		return nil, ErrIENotFound
-	case PDI:
-		ies, err := i.PDI()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SDFFilter {
-				return x.SDFFilter()
-			}
-		}
-		return nil, ErrIENotFound
-	case EthernetPacketFilter:
-		ies, err := i.EthernetPacketFilter()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SDFFilter {
-				return x.SDFFilter()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
+// NewSDFFilterWithRule creates a new SDFFilter IE with the Flow Description given
+// in IPFilterRule. It returns error if the rule is invalid.
+func NewSDFFilterWithRule(r *IPFilterRule, ttc, spi, fl string, fid uint32) (*IE, error) {
+	f, err := NewSDFFilterFieldsWithRule(r, ttc, spi, fl, fid)
+	if err != nil {
+		return nil, err
 	}
+	return newFieldsIE(SDFFilter, f), nil
 }
 
 // SDFFilterFields represents a fields contained in SDFFilter IE.
@@ -113,6 +73,24 @@ func NewSDFFilterFields(fd, ttc, spi, fl string, fid uint32) *SDFFilterFields {
 	return f
 }
 
+// NewSDFFilterFieldsWithRule creates a new SDFFilterFields with the Flow Description
+// given in IPFilterRule. It returns error if the rule is invalid.
+func NewSDFFilterFieldsWithRule(r *IPFilterRule, ttc, spi, fl string, fid uint32) (*SDFFilterFields, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return NewSDFFilterFields(r.String(), ttc, spi, fl, fid), nil
+}
+
+// IPFilterRule parses the Flow Description as IPFilterRule.
+// It returns ErrElementNotFound if FD flag is not set.
+func (f *SDFFilterFields) IPFilterRule() (*IPFilterRule, error) {
+	if !f.HasFD() {
+		return nil, ErrElementNotFound
+	}
+	return ParseIPFilterRule(f.FlowDescription)
+}
+
 // HasBID reports whether BID flag is set.
 func (f *SDFFilterFields) HasBID() bool {
 	return has5thBit(f.Flags)
@@ -187,6 +165,9 @@ func (f *SDFFilterFields) UnmarshalBinary(b []byte) error {
 			return io.ErrUnexpectedEOF
 		}
 		f.FDLength = binary.BigEndian.Uint16(b[offset : offset+2])
+		if len(b[offset:]) < 2+int(f.FDLength) {
+			return io.ErrUnexpectedEOF
+		}
 		f.FlowDescription = string(b[offset+2 : offset+2+int(f.FDLength)])
 		offset += 2 + int(f.FDLength)
 	}
@@ -21,65 +21,6 @@ func NewSourceIPAddress(v4, v6 net.IP, mpl uint8) *IE {
 	return New(SourceIPAddress, b)
 }
 
-// SourceIPAddress returns SourceIPAddress in structured format if the type of IE matches.
-func (i *IE) SourceIPAddress() (*SourceIPAddressFields, error) {
-	switch i.Type {
-	case SourceIPAddress:
-		fields, err := ParseSourceIPAddressFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == IPMulticastAddressingInfo {
-				return x.SourceIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case IPMulticastAddressingInfo:
-		ies, err := i.IPMulticastAddressingInfo()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SourceIPAddress {
-				return x.SourceIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case JoinIPMulticastInformationWithinUsageReport:
-		ies, err := i.JoinIPMulticastInformationWithinUsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SourceIPAddress {
-				return x.SourceIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case LeaveIPMulticastInformationWithinUsageReport:
-		ies, err := i.LeaveIPMulticastInformationWithinUsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SourceIPAddress {
-				return x.SourceIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // SourceIPAddressFields represents a fields contained in SourceIPAddress IE.
 type SourceIPAddressFields struct {
 	Flags            uint8
@@ -175,7 +116,7 @@ func (f *SourceIPAddressFields) UnmarshalBinary(b []byte) error {
 	}
 
 	if f.HasMPL() {
-		if l < offset {
+		if l <= offset {
 			return io.ErrUnexpectedEOF
 		}
 		f.MaskPrefixLength = b[offset]
@@ -220,7 +161,7 @@ func (f *SourceIPAddressFields) MarshalTo(b []byte) error {
 	}
 
 	if f.HasMPL() {
-		if l < offset {
+		if l <= offset {
 			return io.ErrUnexpectedEOF
 		}
 		b[offset] = f.MaskPrefixLength
@@ -21,54 +21,6 @@ func NewSubsequentVolumeQuota(flags uint8, tvol, uvol, dvol uint64) *IE {
 	return New(SubsequentVolumeQuota, b)
 }
 
-// SubsequentVolumeQuota returns SubsequentVolumeQuota in structured format if the type of IE matches.
-func (i *IE) SubsequentVolumeQuota() (*SubsequentVolumeQuotaFields, error) {
-	switch i.Type {
-	case SubsequentVolumeQuota:
-		fields, err := ParseSubsequentVolumeQuotaFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SubsequentVolumeQuota {
-				return x.SubsequentVolumeQuota()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SubsequentVolumeQuota {
-				return x.SubsequentVolumeQuota()
-			}
-		}
-		return nil, ErrIENotFound
-	case AdditionalMonitoringTime:
-		ies, err := i.AdditionalMonitoringTime()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SubsequentVolumeQuota {
-				return x.SubsequentVolumeQuota()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // SubsequentVolumeQuotaFields represents a fields contained in SubsequentVolumeQuota IE.
 type SubsequentVolumeQuotaFields struct {
 	Flags          uint8
@@ -21,54 +21,6 @@ func NewSubsequentVolumeThreshold(flags uint8, tvol, uvol, dvol uint64) *IE {
 	return New(SubsequentVolumeThreshold, b)
 }
 
-// SubsequentVolumeThreshold returns SubsequentVolumeThreshold in structured format if the type of IE matches.
-func (i *IE) SubsequentVolumeThreshold() (*SubsequentVolumeThresholdFields, error) {
-	switch i.Type {
-	case SubsequentVolumeThreshold:
-		fields, err := ParseSubsequentVolumeThresholdFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SubsequentVolumeThreshold {
-				return x.SubsequentVolumeThreshold()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SubsequentVolumeThreshold {
-				return x.SubsequentVolumeThreshold()
-			}
-		}
-		return nil, ErrIENotFound
-	case AdditionalMonitoringTime:
-		ies, err := i.AdditionalMonitoringTime()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == SubsequentVolumeThreshold {
-				return x.SubsequentVolumeThreshold()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // SubsequentVolumeThresholdFields represents a fields contained in SubsequentVolumeThreshold IE.
 type SubsequentVolumeThresholdFields struct {
 	Flags          uint8
@@ -23,21 +23,6 @@ func NewTraceInformation(mcc, mnc, id string, events []byte, depth uint8, interf
 	return New(TraceInformation, b)
 }
 
-// TraceInformation returns TraceInformation in structured format if the type of IE matches.
-func (i *IE) TraceInformation() (*TraceInformationFields, error) {
-	switch i.Type {
-	case TraceInformation:
-		fields, err := ParseTraceInformationFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // TraceInformationFields represents a fields contained in TraceInformation IE.
 type TraceInformationFields struct {
 	MCC, MNC, TraceID                      string
@@ -99,7 +84,7 @@ func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
 	f.TraceID = string(b[3:6])
 	offset := 6
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	f.TriggeringEventsLength = b[offset]
@@ -111,13 +96,13 @@ func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
 	copy(f.TriggeringEvents, b[offset:offset+int(f.TriggeringEventsLength)])
 	offset += int(f.TriggeringEventsLength)
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	f.SessionTraceDepth = b[offset]
 	offset++
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	f.ListOfInterfacesLength = b[offset]
@@ -129,7 +114,7 @@ func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
 	copy(f.ListOfInterfaces, b[offset:offset+int(f.ListOfInterfacesLength)])
 	offset += int(f.ListOfInterfacesLength)
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	f.IPAddressOfTraceCollectionEntityLength = b[offset]
@@ -167,7 +152,7 @@ func (f *TraceInformationFields) MarshalTo(b []byte) error {
 	copy(b[3:6], f.TraceID)
 	offset := 6
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	b[offset] = f.TriggeringEventsLength
@@ -179,13 +164,13 @@ func (f *TraceInformationFields) MarshalTo(b []byte) error {
 	copy(b[offset:offset+int(f.TriggeringEventsLength)], f.TriggeringEvents)
 	offset += int(f.TriggeringEventsLength)
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	b[offset] = f.SessionTraceDepth
 	offset++
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	b[offset] = f.ListOfInterfacesLength
@@ -197,7 +182,7 @@ func (f *TraceInformationFields) MarshalTo(b []byte) error {
 	copy(b[offset:offset+int(f.ListOfInterfacesLength)], f.ListOfInterfaces)
 	offset += int(f.ListOfInterfacesLength)
 
-	if l < offset {
+	if l <= offset {
 		return io.ErrUnexpectedEOF
 	}
 	b[offset] = f.IPAddressOfTraceCollectionEntityLength
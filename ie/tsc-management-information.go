@@ -31,7 +31,7 @@ func (i *IE) TSCManagementInformation() ([]*IE, error) {
 		TSCManagementInformationWithinSessionModificationResponse,
 		TSCManagementInformationWithinSessionReportRequest:
 
-		return ParseMultiIEs(i.Payload)
+		return i.ValueAsGrouped()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
@@ -64,7 +64,7 @@ func (i *IE) PortManagementInformationForTSC() ([]*IE, error) {
 		PortManagementInformationForTSCWithinSessionModificationResponse,
 		PortManagementInformationForTSCWithinSessionReportRequest:
 
-		return ParseMultiIEs(i.Payload)
+		return i.ValueAsGrouped()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
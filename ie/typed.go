@@ -0,0 +1,110 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// Typed is the descriptor that binds an IE type to the Go type of its value.
+//
+// The descriptors, e.g., PDRIDType and CreatePDRType, are generated in
+// typed_gen.go for the IE types whose value can be handled with the accessor
+// and constructor in this package. The Go type is the one returned by the
+// accessor, or XFields for the grouped IE X.
+//
+//	id, err := ie.PDRIDType.Get(i)     // id is uint16
+//	f := ie.FTEIDType.New(fteidFields) // fteidFields must be *ie.FTEIDFields
+//
+// As the type is fixed per descriptor, the value of wrong type is rejected at
+// compile time, and the generic functions can be written once for all the IE
+// types, e.g., message.Collect.
+type Typed[T any] struct {
+	// Type is the IE type the descriptor is for.
+	Type IEType
+
+	decode func(i *IE) (T, error)
+	encode func(v T) *IE
+}
+
+// NodeIDType binds NodeID IE to string, which is the IP address or FQDN.
+//
+// It is defined by hand as NewNodeID takes each form in separate parameters.
+// FailedRuleID has no descriptor, as its accessor does not return the rule type.
+var NodeIDType = Typed[string]{Type: NodeID, decode: (*IE).NodeID, encode: NewNodeIDHeuristic}
+
+// String returns the name of the IE type.
+func (t Typed[T]) String() string {
+	return t.Type.String()
+}
+
+// Is reports whether the IE has the type of the descriptor.
+func (t Typed[T]) Is(i *IE) bool {
+	return i != nil && i.Type == t.Type
+}
+
+// Get returns the value of the IE.
+//
+// Unlike the accessors, it does not look into the child IEs; *InvalidTypeError
+// is returned if the type of IE is not the one of the descriptor.
+func (t Typed[T]) Get(i *IE) (T, error) {
+	var zero T
+	if i == nil {
+		return zero, ErrIENotFound
+	}
+	if i.Type != t.Type {
+		return zero, &InvalidTypeError{Type: i.Type}
+	}
+	return t.decode(i)
+}
+
+// New creates a new IE with the value given.
+func (t Typed[T]) New(v T) *IE {
+	return t.encode(v)
+}
+
+// Find returns the value of the first IE that has the type of the descriptor in
+// the list of IEs. It does not look into the child IEs; use Collect for that.
+//
+// ErrIENotFound is returned if no IE has the type.
+func (t Typed[T]) Find(ies []*IE) (T, error) {
+	for _, i := range ies {
+		if t.Is(i) {
+			return t.decode(i)
+		}
+	}
+	var zero T
+	return zero, ErrIENotFound
+}
+
+// Collect returns the values of all the IEs that have the type of the descriptor
+// in the list of IEs and their descendants, in depth-first order.
+//
+// It stops at the first IE that fails to be decoded and returns the error.
+func (t Typed[T]) Collect(ies []*IE) ([]T, error) {
+	found := FindAllIEs(ies, t.Type)
+	if len(found) == 0 {
+		return nil, nil
+	}
+
+	vs := make([]T, 0, len(found))
+	for _, i := range found {
+		v, err := t.decode(i)
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, v)
+	}
+	return vs, nil
+}
+
+// decodeFields decodes the grouped IE into XFields, which is used as the decode
+// function of the descriptors of grouped IEs.
+func decodeFields[F any, P interface {
+	*F
+	groupedFields
+}](i *IE) (P, error) {
+	f := P(new(F))
+	if err := unmarshalGroupedFields(i, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,556 @@
+// Code generated by internal/gen from spec/ies.json and spec/grouped.json; DO NOT EDIT.
+
+package ie
+
+import (
+	"net"
+	"time"
+)
+
+// Typed descriptors of IE types.
+var (
+	// CreatePDRType binds CreatePDR IE to *CreatePDRFields.
+	CreatePDRType = Typed[*CreatePDRFields]{Type: CreatePDR, decode: decodeFields[CreatePDRFields], encode: (*CreatePDRFields).ToIE}
+	// PDIType binds PDI IE to *PDIFields.
+	PDIType = Typed[*PDIFields]{Type: PDI, decode: decodeFields[PDIFields], encode: (*PDIFields).ToIE}
+	// CreateFARType binds CreateFAR IE to *CreateFARFields.
+	CreateFARType = Typed[*CreateFARFields]{Type: CreateFAR, decode: decodeFields[CreateFARFields], encode: (*CreateFARFields).ToIE}
+	// ForwardingParametersType binds ForwardingParameters IE to *ForwardingParametersFields.
+	ForwardingParametersType = Typed[*ForwardingParametersFields]{Type: ForwardingParameters, decode: decodeFields[ForwardingParametersFields], encode: (*ForwardingParametersFields).ToIE}
+	// DuplicatingParametersType binds DuplicatingParameters IE to *DuplicatingParametersFields.
+	DuplicatingParametersType = Typed[*DuplicatingParametersFields]{Type: DuplicatingParameters, decode: decodeFields[DuplicatingParametersFields], encode: (*DuplicatingParametersFields).ToIE}
+	// CreateURRType binds CreateURR IE to *CreateURRFields.
+	CreateURRType = Typed[*CreateURRFields]{Type: CreateURR, decode: decodeFields[CreateURRFields], encode: (*CreateURRFields).ToIE}
+	// CreateQERType binds CreateQER IE to *CreateQERFields.
+	CreateQERType = Typed[*CreateQERFields]{Type: CreateQER, decode: decodeFields[CreateQERFields], encode: (*CreateQERFields).ToIE}
+	// CreatedPDRType binds CreatedPDR IE to *CreatedPDRFields.
+	CreatedPDRType = Typed[*CreatedPDRFields]{Type: CreatedPDR, decode: decodeFields[CreatedPDRFields], encode: (*CreatedPDRFields).ToIE}
+	// UpdatePDRType binds UpdatePDR IE to *UpdatePDRFields.
+	UpdatePDRType = Typed[*UpdatePDRFields]{Type: UpdatePDR, decode: decodeFields[UpdatePDRFields], encode: (*UpdatePDRFields).ToIE}
+	// UpdateFARType binds UpdateFAR IE to *UpdateFARFields.
+	UpdateFARType = Typed[*UpdateFARFields]{Type: UpdateFAR, decode: decodeFields[UpdateFARFields], encode: (*UpdateFARFields).ToIE}
+	// UpdateForwardingParametersType binds UpdateForwardingParameters IE to *UpdateForwardingParametersFields.
+	UpdateForwardingParametersType = Typed[*UpdateForwardingParametersFields]{Type: UpdateForwardingParameters, decode: decodeFields[UpdateForwardingParametersFields], encode: (*UpdateForwardingParametersFields).ToIE}
+	// UpdateBARWithinSessionReportResponseType binds UpdateBARWithinSessionReportResponse IE to *UpdateBARWithinSessionReportResponseFields.
+	UpdateBARWithinSessionReportResponseType = Typed[*UpdateBARWithinSessionReportResponseFields]{Type: UpdateBARWithinSessionReportResponse, decode: decodeFields[UpdateBARWithinSessionReportResponseFields], encode: (*UpdateBARWithinSessionReportResponseFields).ToIE}
+	// UpdateURRType binds UpdateURR IE to *UpdateURRFields.
+	UpdateURRType = Typed[*UpdateURRFields]{Type: UpdateURR, decode: decodeFields[UpdateURRFields], encode: (*UpdateURRFields).ToIE}
+	// UpdateQERType binds UpdateQER IE to *UpdateQERFields.
+	UpdateQERType = Typed[*UpdateQERFields]{Type: UpdateQER, decode: decodeFields[UpdateQERFields], encode: (*UpdateQERFields).ToIE}
+	// RemovePDRType binds RemovePDR IE to *RemovePDRFields.
+	RemovePDRType = Typed[*RemovePDRFields]{Type: RemovePDR, decode: decodeFields[RemovePDRFields], encode: (*RemovePDRFields).ToIE}
+	// RemoveFARType binds RemoveFAR IE to *RemoveFARFields.
+	RemoveFARType = Typed[*RemoveFARFields]{Type: RemoveFAR, decode: decodeFields[RemoveFARFields], encode: (*RemoveFARFields).ToIE}
+	// RemoveURRType binds RemoveURR IE to *RemoveURRFields.
+	RemoveURRType = Typed[*RemoveURRFields]{Type: RemoveURR, decode: decodeFields[RemoveURRFields], encode: (*RemoveURRFields).ToIE}
+	// RemoveQERType binds RemoveQER IE to *RemoveQERFields.
+	RemoveQERType = Typed[*RemoveQERFields]{Type: RemoveQER, decode: decodeFields[RemoveQERFields], encode: (*RemoveQERFields).ToIE}
+	// CauseType binds Cause IE to uint8.
+	CauseType = Typed[uint8]{Type: Cause, decode: (*IE).Cause, encode: NewCause}
+	// SourceInterfaceType binds SourceInterface IE to uint8.
+	SourceInterfaceType = Typed[uint8]{Type: SourceInterface, decode: (*IE).SourceInterface, encode: NewSourceInterface}
+	// FTEIDType binds FTEID IE to *FTEIDFields.
+	FTEIDType = Typed[*FTEIDFields]{Type: FTEID, decode: (*IE).FTEID, encode: func(v *FTEIDFields) *IE { return newFieldsIE(FTEID, v) }}
+	// NetworkInstanceType binds NetworkInstance IE to string.
+	NetworkInstanceType = Typed[string]{Type: NetworkInstance, decode: (*IE).NetworkInstance, encode: NewNetworkInstance}
+	// SDFFilterType binds SDFFilter IE to *SDFFilterFields.
+	SDFFilterType = Typed[*SDFFilterFields]{Type: SDFFilter, decode: (*IE).SDFFilter, encode: func(v *SDFFilterFields) *IE { return newFieldsIE(SDFFilter, v) }}
+	// ApplicationIDType binds ApplicationID IE to string.
+	ApplicationIDType = Typed[string]{Type: ApplicationID, decode: (*IE).ApplicationID, encode: NewApplicationID}
+	// GateStatusType binds GateStatus IE to uint8.
+	GateStatusType = Typed[uint8]{Type: GateStatus, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(GateStatus, v) }}
+	// MBRType binds MBR IE to []byte.
+	MBRType = Typed[[]byte]{Type: MBR, decode: (*IE).MBR, encode: func(v []byte) *IE { return New(MBR, v) }}
+	// GBRType binds GBR IE to []byte.
+	GBRType = Typed[[]byte]{Type: GBR, decode: (*IE).GBR, encode: func(v []byte) *IE { return New(GBR, v) }}
+	// QERCorrelationIDType binds QERCorrelationID IE to uint32.
+	QERCorrelationIDType = Typed[uint32]{Type: QERCorrelationID, decode: (*IE).QERCorrelationID, encode: NewQERCorrelationID}
+	// PrecedenceType binds Precedence IE to uint32.
+	PrecedenceType = Typed[uint32]{Type: Precedence, decode: (*IE).Precedence, encode: NewPrecedence}
+	// TransportLevelMarkingType binds TransportLevelMarking IE to uint16.
+	TransportLevelMarkingType = Typed[uint16]{Type: TransportLevelMarking, decode: (*IE).TransportLevelMarking, encode: NewTransportLevelMarking}
+	// VolumeThresholdType binds VolumeThreshold IE to *VolumeThresholdFields.
+	VolumeThresholdType = Typed[*VolumeThresholdFields]{Type: VolumeThreshold, decode: (*IE).VolumeThreshold, encode: func(v *VolumeThresholdFields) *IE { return newFieldsIE(VolumeThreshold, v) }}
+	// TimeThresholdType binds TimeThreshold IE to time.Duration.
+	TimeThresholdType = Typed[time.Duration]{Type: TimeThreshold, decode: (*IE).TimeThreshold, encode: NewTimeThreshold}
+	// MonitoringTimeType binds MonitoringTime IE to time.Time.
+	MonitoringTimeType = Typed[time.Time]{Type: MonitoringTime, decode: (*IE).MonitoringTime, encode: NewMonitoringTime}
+	// SubsequentVolumeThresholdType binds SubsequentVolumeThreshold IE to *SubsequentVolumeThresholdFields.
+	SubsequentVolumeThresholdType = Typed[*SubsequentVolumeThresholdFields]{Type: SubsequentVolumeThreshold, decode: (*IE).SubsequentVolumeThreshold, encode: func(v *SubsequentVolumeThresholdFields) *IE { return newFieldsIE(SubsequentVolumeThreshold, v) }}
+	// SubsequentTimeThresholdType binds SubsequentTimeThreshold IE to time.Duration.
+	SubsequentTimeThresholdType = Typed[time.Duration]{Type: SubsequentTimeThreshold, decode: (*IE).SubsequentTimeThreshold, encode: NewSubsequentTimeThreshold}
+	// InactivityDetectionTimeType binds InactivityDetectionTime IE to uint32.
+	InactivityDetectionTimeType = Typed[uint32]{Type: InactivityDetectionTime, decode: (*IE).InactivityDetectionTime, encode: NewInactivityDetectionTime}
+	// ReportingTriggersType binds ReportingTriggers IE to []byte.
+	ReportingTriggersType = Typed[[]byte]{Type: ReportingTriggers, decode: (*IE).ReportingTriggers, encode: func(v []byte) *IE { return New(ReportingTriggers, v) }}
+	// RedirectInformationType binds RedirectInformation IE to *RedirectInformationFields.
+	RedirectInformationType = Typed[*RedirectInformationFields]{Type: RedirectInformation, decode: (*IE).RedirectInformation, encode: func(v *RedirectInformationFields) *IE { return newFieldsIE(RedirectInformation, v) }}
+	// OffendingIEType binds OffendingIE IE to IEType.
+	OffendingIEType = Typed[IEType]{Type: OffendingIE, decode: (*IE).OffendingIE, encode: NewOffendingIE}
+	// ForwardingPolicyType binds ForwardingPolicy IE to []byte.
+	ForwardingPolicyType = Typed[[]byte]{Type: ForwardingPolicy, decode: (*IE).ForwardingPolicy, encode: func(v []byte) *IE { return New(ForwardingPolicy, v) }}
+	// DestinationInterfaceType binds DestinationInterface IE to uint8.
+	DestinationInterfaceType = Typed[uint8]{Type: DestinationInterface, decode: (*IE).DestinationInterface, encode: NewDestinationInterface}
+	// UPFunctionFeaturesType binds UPFunctionFeatures IE to []byte.
+	UPFunctionFeaturesType = Typed[[]byte]{Type: UPFunctionFeatures, decode: (*IE).UPFunctionFeatures, encode: func(v []byte) *IE { return New(UPFunctionFeatures, v) }}
+	// ApplyActionType binds ApplyAction IE to []byte.
+	ApplyActionType = Typed[[]byte]{Type: ApplyAction, decode: (*IE).ApplyAction, encode: func(v []byte) *IE { return New(ApplyAction, v) }}
+	// DownlinkDataServiceInformationType binds DownlinkDataServiceInformation IE to []byte.
+	DownlinkDataServiceInformationType = Typed[[]byte]{Type: DownlinkDataServiceInformation, decode: (*IE).DownlinkDataServiceInformation, encode: func(v []byte) *IE { return New(DownlinkDataServiceInformation, v) }}
+	// DownlinkDataNotificationDelayType binds DownlinkDataNotificationDelay IE to time.Duration.
+	DownlinkDataNotificationDelayType = Typed[time.Duration]{Type: DownlinkDataNotificationDelay, decode: (*IE).DownlinkDataNotificationDelay, encode: NewDownlinkDataNotificationDelay}
+	// DLBufferingDurationType binds DLBufferingDuration IE to time.Duration.
+	DLBufferingDurationType = Typed[time.Duration]{Type: DLBufferingDuration, decode: (*IE).DLBufferingDuration, encode: NewDLBufferingDuration}
+	// DLBufferingSuggestedPacketCountType binds DLBufferingSuggestedPacketCount IE to uint16.
+	DLBufferingSuggestedPacketCountType = Typed[uint16]{Type: DLBufferingSuggestedPacketCount, decode: (*IE).DLBufferingSuggestedPacketCount, encode: NewDLBufferingSuggestedPacketCount}
+	// PFCPSMReqFlagsType binds PFCPSMReqFlags IE to uint8.
+	PFCPSMReqFlagsType = Typed[uint8]{Type: PFCPSMReqFlags, decode: (*IE).PFCPSMReqFlags, encode: NewPFCPSMReqFlags}
+	// PFCPSRRspFlagsType binds PFCPSRRspFlags IE to uint8.
+	PFCPSRRspFlagsType = Typed[uint8]{Type: PFCPSRRspFlags, decode: (*IE).PFCPSRRspFlags, encode: NewPFCPSRRspFlags}
+	// LoadControlInformationType binds LoadControlInformation IE to *LoadControlInformationFields.
+	LoadControlInformationType = Typed[*LoadControlInformationFields]{Type: LoadControlInformation, decode: decodeFields[LoadControlInformationFields], encode: (*LoadControlInformationFields).ToIE}
+	// SequenceNumberType binds SequenceNumber IE to uint32.
+	SequenceNumberType = Typed[uint32]{Type: SequenceNumber, decode: (*IE).SequenceNumber, encode: NewSequenceNumber}
+	// MetricType binds Metric IE to uint8.
+	MetricType = Typed[uint8]{Type: Metric, decode: (*IE).Metric, encode: NewMetric}
+	// OverloadControlInformationType binds OverloadControlInformation IE to *OverloadControlInformationFields.
+	OverloadControlInformationType = Typed[*OverloadControlInformationFields]{Type: OverloadControlInformation, decode: decodeFields[OverloadControlInformationFields], encode: (*OverloadControlInformationFields).ToIE}
+	// TimerType binds Timer IE to time.Duration.
+	TimerType = Typed[time.Duration]{Type: Timer, decode: (*IE).Timer, encode: NewTimer}
+	// PDRIDType binds PDRID IE to uint16.
+	PDRIDType = Typed[uint16]{Type: PDRID, decode: (*IE).PDRID, encode: NewPDRID}
+	// FSEIDType binds FSEID IE to *FSEIDFields.
+	FSEIDType = Typed[*FSEIDFields]{Type: FSEID, decode: (*IE).FSEID, encode: func(v *FSEIDFields) *IE { return newFieldsIE(FSEID, v) }}
+	// ApplicationIDsPFDsType binds ApplicationIDsPFDs IE to *ApplicationIDsPFDsFields.
+	ApplicationIDsPFDsType = Typed[*ApplicationIDsPFDsFields]{Type: ApplicationIDsPFDs, decode: decodeFields[ApplicationIDsPFDsFields], encode: (*ApplicationIDsPFDsFields).ToIE}
+	// PFDContextType binds PFDContext IE to *PFDContextFields.
+	PFDContextType = Typed[*PFDContextFields]{Type: PFDContext, decode: decodeFields[PFDContextFields], encode: (*PFDContextFields).ToIE}
+	// PFDContentsType binds PFDContents IE to *PFDContentsFields.
+	PFDContentsType = Typed[*PFDContentsFields]{Type: PFDContents, decode: (*IE).PFDContents, encode: func(v *PFDContentsFields) *IE { return newFieldsIE(PFDContents, v) }}
+	// MeasurementMethodType binds MeasurementMethod IE to uint8.
+	MeasurementMethodType = Typed[uint8]{Type: MeasurementMethod, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(MeasurementMethod, v) }}
+	// UsageReportTriggerType binds UsageReportTrigger IE to []byte.
+	UsageReportTriggerType = Typed[[]byte]{Type: UsageReportTrigger, decode: (*IE).UsageReportTrigger, encode: func(v []byte) *IE { return New(UsageReportTrigger, v) }}
+	// MeasurementPeriodType binds MeasurementPeriod IE to time.Duration.
+	MeasurementPeriodType = Typed[time.Duration]{Type: MeasurementPeriod, decode: (*IE).MeasurementPeriod, encode: NewMeasurementPeriod}
+	// FQCSIDType binds FQCSID IE to []byte.
+	FQCSIDType = Typed[[]byte]{Type: FQCSID, decode: (*IE).FQCSID, encode: func(v []byte) *IE { return New(FQCSID, v) }}
+	// VolumeMeasurementType binds VolumeMeasurement IE to *VolumeMeasurementFields.
+	VolumeMeasurementType = Typed[*VolumeMeasurementFields]{Type: VolumeMeasurement, decode: (*IE).VolumeMeasurement, encode: func(v *VolumeMeasurementFields) *IE { return newFieldsIE(VolumeMeasurement, v) }}
+	// DurationMeasurementType binds DurationMeasurement IE to time.Duration.
+	DurationMeasurementType = Typed[time.Duration]{Type: DurationMeasurement, decode: (*IE).DurationMeasurement, encode: NewDurationMeasurement}
+	// ApplicationDetectionInformationType binds ApplicationDetectionInformation IE to *ApplicationDetectionInformationFields.
+	ApplicationDetectionInformationType = Typed[*ApplicationDetectionInformationFields]{Type: ApplicationDetectionInformation, decode: decodeFields[ApplicationDetectionInformationFields], encode: (*ApplicationDetectionInformationFields).ToIE}
+	// TimeOfFirstPacketType binds TimeOfFirstPacket IE to time.Time.
+	TimeOfFirstPacketType = Typed[time.Time]{Type: TimeOfFirstPacket, decode: (*IE).TimeOfFirstPacket, encode: NewTimeOfFirstPacket}
+	// TimeOfLastPacketType binds TimeOfLastPacket IE to time.Time.
+	TimeOfLastPacketType = Typed[time.Time]{Type: TimeOfLastPacket, decode: (*IE).TimeOfLastPacket, encode: NewTimeOfLastPacket}
+	// QuotaHoldingTimeType binds QuotaHoldingTime IE to time.Duration.
+	QuotaHoldingTimeType = Typed[time.Duration]{Type: QuotaHoldingTime, decode: (*IE).QuotaHoldingTime, encode: NewQuotaHoldingTime}
+	// DroppedDLTrafficThresholdType binds DroppedDLTrafficThreshold IE to []byte.
+	DroppedDLTrafficThresholdType = Typed[[]byte]{Type: DroppedDLTrafficThreshold, decode: (*IE).valueAsBytes, encode: func(v []byte) *IE { return New(DroppedDLTrafficThreshold, v) }}
+	// VolumeQuotaType binds VolumeQuota IE to *VolumeQuotaFields.
+	VolumeQuotaType = Typed[*VolumeQuotaFields]{Type: VolumeQuota, decode: (*IE).VolumeQuota, encode: func(v *VolumeQuotaFields) *IE { return newFieldsIE(VolumeQuota, v) }}
+	// TimeQuotaType binds TimeQuota IE to time.Duration.
+	TimeQuotaType = Typed[time.Duration]{Type: TimeQuota, decode: (*IE).TimeQuota, encode: NewTimeQuota}
+	// StartTimeType binds StartTime IE to time.Time.
+	StartTimeType = Typed[time.Time]{Type: StartTime, decode: (*IE).StartTime, encode: NewStartTime}
+	// EndTimeType binds EndTime IE to time.Time.
+	EndTimeType = Typed[time.Time]{Type: EndTime, decode: (*IE).EndTime, encode: NewEndTime}
+	// QueryURRType binds QueryURR IE to *QueryURRFields.
+	QueryURRType = Typed[*QueryURRFields]{Type: QueryURR, decode: decodeFields[QueryURRFields], encode: (*QueryURRFields).ToIE}
+	// UsageReportWithinSessionModificationResponseType binds UsageReportWithinSessionModificationResponse IE to *UsageReportWithinSessionModificationResponseFields.
+	UsageReportWithinSessionModificationResponseType = Typed[*UsageReportWithinSessionModificationResponseFields]{Type: UsageReportWithinSessionModificationResponse, decode: decodeFields[UsageReportWithinSessionModificationResponseFields], encode: (*UsageReportWithinSessionModificationResponseFields).ToIE}
+	// UsageReportWithinSessionDeletionResponseType binds UsageReportWithinSessionDeletionResponse IE to *UsageReportWithinSessionDeletionResponseFields.
+	UsageReportWithinSessionDeletionResponseType = Typed[*UsageReportWithinSessionDeletionResponseFields]{Type: UsageReportWithinSessionDeletionResponse, decode: decodeFields[UsageReportWithinSessionDeletionResponseFields], encode: (*UsageReportWithinSessionDeletionResponseFields).ToIE}
+	// UsageReportWithinSessionReportRequestType binds UsageReportWithinSessionReportRequest IE to *UsageReportWithinSessionReportRequestFields.
+	UsageReportWithinSessionReportRequestType = Typed[*UsageReportWithinSessionReportRequestFields]{Type: UsageReportWithinSessionReportRequest, decode: decodeFields[UsageReportWithinSessionReportRequestFields], encode: (*UsageReportWithinSessionReportRequestFields).ToIE}
+	// URRIDType binds URRID IE to uint32.
+	URRIDType = Typed[uint32]{Type: URRID, decode: (*IE).URRID, encode: NewURRID}
+	// LinkedURRIDType binds LinkedURRID IE to uint32.
+	LinkedURRIDType = Typed[uint32]{Type: LinkedURRID, decode: (*IE).LinkedURRID, encode: NewLinkedURRID}
+	// DownlinkDataReportType binds DownlinkDataReport IE to *DownlinkDataReportFields.
+	DownlinkDataReportType = Typed[*DownlinkDataReportFields]{Type: DownlinkDataReport, decode: decodeFields[DownlinkDataReportFields], encode: (*DownlinkDataReportFields).ToIE}
+	// OuterHeaderCreationType binds OuterHeaderCreation IE to *OuterHeaderCreationFields.
+	OuterHeaderCreationType = Typed[*OuterHeaderCreationFields]{Type: OuterHeaderCreation, decode: (*IE).OuterHeaderCreation, encode: func(v *OuterHeaderCreationFields) *IE { return newFieldsIE(OuterHeaderCreation, v) }}
+	// CreateBARType binds CreateBAR IE to *CreateBARFields.
+	CreateBARType = Typed[*CreateBARFields]{Type: CreateBAR, decode: decodeFields[CreateBARFields], encode: (*CreateBARFields).ToIE}
+	// UpdateBARWithinSessionModificationRequestType binds UpdateBARWithinSessionModificationRequest IE to *UpdateBARWithinSessionModificationRequestFields.
+	UpdateBARWithinSessionModificationRequestType = Typed[*UpdateBARWithinSessionModificationRequestFields]{Type: UpdateBARWithinSessionModificationRequest, decode: decodeFields[UpdateBARWithinSessionModificationRequestFields], encode: (*UpdateBARWithinSessionModificationRequestFields).ToIE}
+	// RemoveBARType binds RemoveBAR IE to *RemoveBARFields.
+	RemoveBARType = Typed[*RemoveBARFields]{Type: RemoveBAR, decode: decodeFields[RemoveBARFields], encode: (*RemoveBARFields).ToIE}
+	// BARIDType binds BARID IE to uint8.
+	BARIDType = Typed[uint8]{Type: BARID, decode: (*IE).BARID, encode: NewBARID}
+	// CPFunctionFeaturesType binds CPFunctionFeatures IE to []byte.
+	CPFunctionFeaturesType = Typed[[]byte]{Type: CPFunctionFeatures, decode: (*IE).CPFunctionFeatures, encode: func(v []byte) *IE { return New(CPFunctionFeatures, v) }}
+	// UsageInformationType binds UsageInformation IE to uint8.
+	UsageInformationType = Typed[uint8]{Type: UsageInformation, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(UsageInformation, v) }}
+	// ApplicationInstanceIDType binds ApplicationInstanceID IE to string.
+	ApplicationInstanceIDType = Typed[string]{Type: ApplicationInstanceID, decode: (*IE).ApplicationInstanceID, encode: NewApplicationInstanceID}
+	// FlowInformationType binds FlowInformation IE to []byte.
+	FlowInformationType = Typed[[]byte]{Type: FlowInformation, decode: (*IE).FlowInformation, encode: func(v []byte) *IE { return New(FlowInformation, v) }}
+	// UEIPAddressType binds UEIPAddress IE to *UEIPAddressFields.
+	UEIPAddressType = Typed[*UEIPAddressFields]{Type: UEIPAddress, decode: (*IE).UEIPAddress, encode: func(v *UEIPAddressFields) *IE { return newFieldsIE(UEIPAddress, v) }}
+	// PacketRateType binds PacketRate IE to *PacketRateFields.
+	PacketRateType = Typed[*PacketRateFields]{Type: PacketRate, decode: (*IE).PacketRate, encode: func(v *PacketRateFields) *IE { return newFieldsIE(PacketRate, v) }}
+	// OuterHeaderRemovalType binds OuterHeaderRemoval IE to []byte.
+	OuterHeaderRemovalType = Typed[[]byte]{Type: OuterHeaderRemoval, decode: (*IE).OuterHeaderRemoval, encode: func(v []byte) *IE { return New(OuterHeaderRemoval, v) }}
+	// RecoveryTimeStampType binds RecoveryTimeStamp IE to time.Time.
+	RecoveryTimeStampType = Typed[time.Time]{Type: RecoveryTimeStamp, decode: (*IE).RecoveryTimeStamp, encode: NewRecoveryTimeStamp}
+	// DLFlowLevelMarkingType binds DLFlowLevelMarking IE to *DLFlowLevelMarkingFields.
+	DLFlowLevelMarkingType = Typed[*DLFlowLevelMarkingFields]{Type: DLFlowLevelMarking, decode: (*IE).DLFlowLevelMarking, encode: func(v *DLFlowLevelMarkingFields) *IE { return newFieldsIE(DLFlowLevelMarking, v) }}
+	// HeaderEnrichmentType binds HeaderEnrichment IE to *HeaderEnrichmentFields.
+	HeaderEnrichmentType = Typed[*HeaderEnrichmentFields]{Type: HeaderEnrichment, decode: (*IE).HeaderEnrichment, encode: func(v *HeaderEnrichmentFields) *IE { return newFieldsIE(HeaderEnrichment, v) }}
+	// ErrorIndicationReportType binds ErrorIndicationReport IE to *ErrorIndicationReportFields.
+	ErrorIndicationReportType = Typed[*ErrorIndicationReportFields]{Type: ErrorIndicationReport, decode: decodeFields[ErrorIndicationReportFields], encode: (*ErrorIndicationReportFields).ToIE}
+	// MeasurementInformationType binds MeasurementInformation IE to uint8.
+	MeasurementInformationType = Typed[uint8]{Type: MeasurementInformation, decode: (*IE).MeasurementInformation, encode: NewMeasurementInformation}
+	// NodeReportTypeType binds NodeReportType IE to uint8.
+	NodeReportTypeType = Typed[uint8]{Type: NodeReportType, decode: (*IE).NodeReportType, encode: NewNodeReportType}
+	// UserPlanePathFailureReportType binds UserPlanePathFailureReport IE to *UserPlanePathFailureReportFields.
+	UserPlanePathFailureReportType = Typed[*UserPlanePathFailureReportFields]{Type: UserPlanePathFailureReport, decode: decodeFields[UserPlanePathFailureReportFields], encode: (*UserPlanePathFailureReportFields).ToIE}
+	// RemoteGTPUPeerType binds RemoteGTPUPeer IE to *RemoteGTPUPeerFields.
+	RemoteGTPUPeerType = Typed[*RemoteGTPUPeerFields]{Type: RemoteGTPUPeer, decode: (*IE).RemoteGTPUPeer, encode: func(v *RemoteGTPUPeerFields) *IE { return newFieldsIE(RemoteGTPUPeer, v) }}
+	// URSEQNType binds URSEQN IE to uint32.
+	URSEQNType = Typed[uint32]{Type: URSEQN, decode: (*IE).URSEQN, encode: NewURSEQN}
+	// UpdateDuplicatingParametersType binds UpdateDuplicatingParameters IE to *UpdateDuplicatingParametersFields.
+	UpdateDuplicatingParametersType = Typed[*UpdateDuplicatingParametersFields]{Type: UpdateDuplicatingParameters, decode: decodeFields[UpdateDuplicatingParametersFields], encode: (*UpdateDuplicatingParametersFields).ToIE}
+	// ActivatePredefinedRulesType binds ActivatePredefinedRules IE to string.
+	ActivatePredefinedRulesType = Typed[string]{Type: ActivatePredefinedRules, decode: (*IE).ActivatePredefinedRules, encode: NewActivatePredefinedRules}
+	// DeactivatePredefinedRulesType binds DeactivatePredefinedRules IE to string.
+	DeactivatePredefinedRulesType = Typed[string]{Type: DeactivatePredefinedRules, decode: (*IE).DeactivatePredefinedRules, encode: NewDeactivatePredefinedRules}
+	// FARIDType binds FARID IE to uint32.
+	FARIDType = Typed[uint32]{Type: FARID, decode: (*IE).FARID, encode: NewFARID}
+	// QERIDType binds QERID IE to uint32.
+	QERIDType = Typed[uint32]{Type: QERID, decode: (*IE).QERID, encode: NewQERID}
+	// OCIFlagsType binds OCIFlags IE to uint8.
+	OCIFlagsType = Typed[uint8]{Type: OCIFlags, decode: (*IE).OCIFlags, encode: NewOCIFlags}
+	// PFCPAssociationReleaseRequestType binds PFCPAssociationReleaseRequest IE to uint8.
+	PFCPAssociationReleaseRequestType = Typed[uint8]{Type: PFCPAssociationReleaseRequest, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(PFCPAssociationReleaseRequest, v) }}
+	// GracefulReleasePeriodType binds GracefulReleasePeriod IE to time.Duration.
+	GracefulReleasePeriodType = Typed[time.Duration]{Type: GracefulReleasePeriod, decode: (*IE).GracefulReleasePeriod, encode: NewGracefulReleasePeriod}
+	// PDNTypeType binds PDNType IE to uint8.
+	PDNTypeType = Typed[uint8]{Type: PDNType, decode: (*IE).PDNType, encode: NewPDNType}
+	// TimeQuotaMechanismType binds TimeQuotaMechanism IE to []byte.
+	TimeQuotaMechanismType = Typed[[]byte]{Type: TimeQuotaMechanism, decode: (*IE).TimeQuotaMechanism, encode: func(v []byte) *IE { return New(TimeQuotaMechanism, v) }}
+	// UserPlaneIPResourceInformationType binds UserPlaneIPResourceInformation IE to *UserPlaneIPResourceInformationFields.
+	UserPlaneIPResourceInformationType = Typed[*UserPlaneIPResourceInformationFields]{Type: UserPlaneIPResourceInformation, decode: (*IE).UserPlaneIPResourceInformation, encode: func(v *UserPlaneIPResourceInformationFields) *IE {
+		return newFieldsIE(UserPlaneIPResourceInformation, v)
+	}}
+	// UserPlaneInactivityTimerType binds UserPlaneInactivityTimer IE to time.Duration.
+	UserPlaneInactivityTimerType = Typed[time.Duration]{Type: UserPlaneInactivityTimer, decode: (*IE).UserPlaneInactivityTimer, encode: NewUserPlaneInactivityTimer}
+	// AggregatedURRsType binds AggregatedURRs IE to *AggregatedURRsFields.
+	AggregatedURRsType = Typed[*AggregatedURRsFields]{Type: AggregatedURRs, decode: decodeFields[AggregatedURRsFields], encode: (*AggregatedURRsFields).ToIE}
+	// MultiplierType binds Multiplier IE to []byte.
+	MultiplierType = Typed[[]byte]{Type: Multiplier, decode: (*IE).Multiplier, encode: func(v []byte) *IE { return New(Multiplier, v) }}
+	// AggregatedURRIDType binds AggregatedURRID IE to uint32.
+	AggregatedURRIDType = Typed[uint32]{Type: AggregatedURRID, decode: (*IE).AggregatedURRID, encode: NewAggregatedURRID}
+	// SubsequentVolumeQuotaType binds SubsequentVolumeQuota IE to *SubsequentVolumeQuotaFields.
+	SubsequentVolumeQuotaType = Typed[*SubsequentVolumeQuotaFields]{Type: SubsequentVolumeQuota, decode: (*IE).SubsequentVolumeQuota, encode: func(v *SubsequentVolumeQuotaFields) *IE { return newFieldsIE(SubsequentVolumeQuota, v) }}
+	// SubsequentTimeQuotaType binds SubsequentTimeQuota IE to time.Duration.
+	SubsequentTimeQuotaType = Typed[time.Duration]{Type: SubsequentTimeQuota, decode: (*IE).SubsequentTimeQuota, encode: NewSubsequentTimeQuota}
+	// RQIType binds RQI IE to uint8.
+	RQIType = Typed[uint8]{Type: RQI, decode: (*IE).RQI, encode: NewRQI}
+	// QFIType binds QFI IE to uint8.
+	QFIType = Typed[uint8]{Type: QFI, decode: (*IE).QFI, encode: NewQFI}
+	// QueryURRReferenceType binds QueryURRReference IE to uint32.
+	QueryURRReferenceType = Typed[uint32]{Type: QueryURRReference, decode: (*IE).QueryURRReference, encode: NewQueryURRReference}
+	// AdditionalUsageReportsInformationType binds AdditionalUsageReportsInformation IE to uint16.
+	AdditionalUsageReportsInformationType = Typed[uint16]{Type: AdditionalUsageReportsInformation, decode: (*IE).AdditionalUsageReportsInformation, encode: NewAdditionalUsageReportsInformation}
+	// CreateTrafficEndpointType binds CreateTrafficEndpoint IE to *CreateTrafficEndpointFields.
+	CreateTrafficEndpointType = Typed[*CreateTrafficEndpointFields]{Type: CreateTrafficEndpoint, decode: decodeFields[CreateTrafficEndpointFields], encode: (*CreateTrafficEndpointFields).ToIE}
+	// CreatedTrafficEndpointType binds CreatedTrafficEndpoint IE to *CreatedTrafficEndpointFields.
+	CreatedTrafficEndpointType = Typed[*CreatedTrafficEndpointFields]{Type: CreatedTrafficEndpoint, decode: decodeFields[CreatedTrafficEndpointFields], encode: (*CreatedTrafficEndpointFields).ToIE}
+	// UpdateTrafficEndpointType binds UpdateTrafficEndpoint IE to *UpdateTrafficEndpointFields.
+	UpdateTrafficEndpointType = Typed[*UpdateTrafficEndpointFields]{Type: UpdateTrafficEndpoint, decode: decodeFields[UpdateTrafficEndpointFields], encode: (*UpdateTrafficEndpointFields).ToIE}
+	// RemoveTrafficEndpointType binds RemoveTrafficEndpoint IE to *RemoveTrafficEndpointFields.
+	RemoveTrafficEndpointType = Typed[*RemoveTrafficEndpointFields]{Type: RemoveTrafficEndpoint, decode: decodeFields[RemoveTrafficEndpointFields], encode: (*RemoveTrafficEndpointFields).ToIE}
+	// TrafficEndpointIDType binds TrafficEndpointID IE to uint8.
+	TrafficEndpointIDType = Typed[uint8]{Type: TrafficEndpointID, decode: (*IE).TrafficEndpointID, encode: NewTrafficEndpointID}
+	// EthernetPacketFilterType binds EthernetPacketFilter IE to *EthernetPacketFilterFields.
+	EthernetPacketFilterType = Typed[*EthernetPacketFilterFields]{Type: EthernetPacketFilter, decode: decodeFields[EthernetPacketFilterFields], encode: (*EthernetPacketFilterFields).ToIE}
+	// MACAddressType binds MACAddress IE to *MACAddressFields.
+	MACAddressType = Typed[*MACAddressFields]{Type: MACAddress, decode: (*IE).MACAddress, encode: func(v *MACAddressFields) *IE { return newFieldsIE(MACAddress, v) }}
+	// CTAGType binds CTAG IE to *CTAGFields.
+	CTAGType = Typed[*CTAGFields]{Type: CTAG, decode: (*IE).CTAG, encode: func(v *CTAGFields) *IE { return newFieldsIE(CTAG, v) }}
+	// STAGType binds STAG IE to *STAGFields.
+	STAGType = Typed[*STAGFields]{Type: STAG, decode: (*IE).STAG, encode: func(v *STAGFields) *IE { return newFieldsIE(STAG, v) }}
+	// EthertypeType binds Ethertype IE to uint16.
+	EthertypeType = Typed[uint16]{Type: Ethertype, decode: (*IE).Ethertype, encode: NewEthertype}
+	// ProxyingType binds Proxying IE to uint8.
+	ProxyingType = Typed[uint8]{Type: Proxying, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(Proxying, v) }}
+	// EthernetFilterIDType binds EthernetFilterID IE to uint32.
+	EthernetFilterIDType = Typed[uint32]{Type: EthernetFilterID, decode: (*IE).EthernetFilterID, encode: NewEthernetFilterID}
+	// EthernetFilterPropertiesType binds EthernetFilterProperties IE to uint8.
+	EthernetFilterPropertiesType = Typed[uint8]{Type: EthernetFilterProperties, decode: (*IE).EthernetFilterProperties, encode: NewEthernetFilterProperties}
+	// SuggestedBufferingPacketsCountType binds SuggestedBufferingPacketsCount IE to uint8.
+	SuggestedBufferingPacketsCountType = Typed[uint8]{Type: SuggestedBufferingPacketsCount, decode: (*IE).SuggestedBufferingPacketsCount, encode: NewSuggestedBufferingPacketsCount}
+	// UserIDType binds UserID IE to *UserIDFields.
+	UserIDType = Typed[*UserIDFields]{Type: UserID, decode: (*IE).UserID, encode: func(v *UserIDFields) *IE { return newFieldsIE(UserID, v) }}
+	// EthernetPDUSessionInformationType binds EthernetPDUSessionInformation IE to uint8.
+	EthernetPDUSessionInformationType = Typed[uint8]{Type: EthernetPDUSessionInformation, decode: (*IE).EthernetPDUSessionInformation, encode: NewEthernetPDUSessionInformation}
+	// EthernetTrafficInformationType binds EthernetTrafficInformation IE to *EthernetTrafficInformationFields.
+	EthernetTrafficInformationType = Typed[*EthernetTrafficInformationFields]{Type: EthernetTrafficInformation, decode: decodeFields[EthernetTrafficInformationFields], encode: (*EthernetTrafficInformationFields).ToIE}
+	// MACAddressesDetectedType binds MACAddressesDetected IE to *MACAddressesDetectedFields.
+	MACAddressesDetectedType = Typed[*MACAddressesDetectedFields]{Type: MACAddressesDetected, decode: (*IE).MACAddressesDetected, encode: func(v *MACAddressesDetectedFields) *IE { return newFieldsIE(MACAddressesDetected, v) }}
+	// MACAddressesRemovedType binds MACAddressesRemoved IE to *MACAddressesRemovedFields.
+	MACAddressesRemovedType = Typed[*MACAddressesRemovedFields]{Type: MACAddressesRemoved, decode: (*IE).MACAddressesRemoved, encode: func(v *MACAddressesRemovedFields) *IE { return newFieldsIE(MACAddressesRemoved, v) }}
+	// EthernetInactivityTimerType binds EthernetInactivityTimer IE to time.Duration.
+	EthernetInactivityTimerType = Typed[time.Duration]{Type: EthernetInactivityTimer, decode: (*IE).EthernetInactivityTimer, encode: NewEthernetInactivityTimer}
+	// AdditionalMonitoringTimeType binds AdditionalMonitoringTime IE to *AdditionalMonitoringTimeFields.
+	AdditionalMonitoringTimeType = Typed[*AdditionalMonitoringTimeFields]{Type: AdditionalMonitoringTime, decode: decodeFields[AdditionalMonitoringTimeFields], encode: (*AdditionalMonitoringTimeFields).ToIE}
+	// EventQuotaType binds EventQuota IE to uint32.
+	EventQuotaType = Typed[uint32]{Type: EventQuota, decode: (*IE).EventQuota, encode: NewEventQuota}
+	// EventThresholdType binds EventThreshold IE to uint32.
+	EventThresholdType = Typed[uint32]{Type: EventThreshold, decode: (*IE).EventThreshold, encode: NewEventThreshold}
+	// SubsequentEventQuotaType binds SubsequentEventQuota IE to uint32.
+	SubsequentEventQuotaType = Typed[uint32]{Type: SubsequentEventQuota, decode: (*IE).SubsequentEventQuota, encode: NewSubsequentEventQuota}
+	// SubsequentEventThresholdType binds SubsequentEventThreshold IE to uint32.
+	SubsequentEventThresholdType = Typed[uint32]{Type: SubsequentEventThreshold, decode: (*IE).SubsequentEventThreshold, encode: NewSubsequentEventThreshold}
+	// TraceInformationType binds TraceInformation IE to *TraceInformationFields.
+	TraceInformationType = Typed[*TraceInformationFields]{Type: TraceInformation, decode: (*IE).TraceInformation, encode: func(v *TraceInformationFields) *IE { return newFieldsIE(TraceInformation, v) }}
+	// FramedRouteType binds FramedRoute IE to string.
+	FramedRouteType = Typed[string]{Type: FramedRoute, decode: (*IE).FramedRoute, encode: NewFramedRoute}
+	// FramedRoutingType binds FramedRouting IE to uint32.
+	FramedRoutingType = Typed[uint32]{Type: FramedRouting, decode: (*IE).FramedRouting, encode: NewFramedRouting}
+	// FramedIPv6RouteType binds FramedIPv6Route IE to string.
+	FramedIPv6RouteType = Typed[string]{Type: FramedIPv6Route, decode: (*IE).FramedIPv6Route, encode: NewFramedIPv6Route}
+	// EventTimeStampType binds EventTimeStamp IE to time.Time.
+	EventTimeStampType = Typed[time.Time]{Type: EventTimeStamp, decode: (*IE).EventTimeStamp, encode: NewEventTimeStamp}
+	// AveragingWindowType binds AveragingWindow IE to uint32.
+	AveragingWindowType = Typed[uint32]{Type: AveragingWindow, decode: (*IE).AveragingWindow, encode: NewAveragingWindow}
+	// PagingPolicyIndicatorType binds PagingPolicyIndicator IE to uint8.
+	PagingPolicyIndicatorType = Typed[uint8]{Type: PagingPolicyIndicator, decode: (*IE).PagingPolicyIndicator, encode: NewPagingPolicyIndicator}
+	// APNDNNType binds APNDNN IE to string.
+	APNDNNType = Typed[string]{Type: APNDNN, decode: (*IE).APNDNN, encode: NewAPNDNN}
+	// TGPPInterfaceTypeType binds TGPPInterfaceType IE to uint8.
+	TGPPInterfaceTypeType = Typed[uint8]{Type: TGPPInterfaceType, decode: (*IE).TGPPInterfaceType, encode: NewTGPPInterfaceType}
+	// PFCPSRReqFlagsType binds PFCPSRReqFlags IE to uint8.
+	PFCPSRReqFlagsType = Typed[uint8]{Type: PFCPSRReqFlags, decode: (*IE).PFCPSRReqFlags, encode: NewPFCPSRReqFlags}
+	// PFCPAUReqFlagsType binds PFCPAUReqFlags IE to uint8.
+	PFCPAUReqFlagsType = Typed[uint8]{Type: PFCPAUReqFlags, decode: (*IE).PFCPAUReqFlags, encode: NewPFCPAUReqFlags}
+	// ActivationTimeType binds ActivationTime IE to time.Time.
+	ActivationTimeType = Typed[time.Time]{Type: ActivationTime, decode: (*IE).ActivationTime, encode: NewActivationTime}
+	// DeactivationTimeType binds DeactivationTime IE to time.Time.
+	DeactivationTimeType = Typed[time.Time]{Type: DeactivationTime, decode: (*IE).DeactivationTime, encode: NewDeactivationTime}
+	// CreateMARType binds CreateMAR IE to *CreateMARFields.
+	CreateMARType = Typed[*CreateMARFields]{Type: CreateMAR, decode: decodeFields[CreateMARFields], encode: (*CreateMARFields).ToIE}
+	// TGPPAccessForwardingActionInformationType binds TGPPAccessForwardingActionInformation IE to *TGPPAccessForwardingActionInformationFields.
+	TGPPAccessForwardingActionInformationType = Typed[*TGPPAccessForwardingActionInformationFields]{Type: TGPPAccessForwardingActionInformation, decode: decodeFields[TGPPAccessForwardingActionInformationFields], encode: (*TGPPAccessForwardingActionInformationFields).ToIE}
+	// NonTGPPAccessForwardingActionInformationType binds NonTGPPAccessForwardingActionInformation IE to *NonTGPPAccessForwardingActionInformationFields.
+	NonTGPPAccessForwardingActionInformationType = Typed[*NonTGPPAccessForwardingActionInformationFields]{Type: NonTGPPAccessForwardingActionInformation, decode: decodeFields[NonTGPPAccessForwardingActionInformationFields], encode: (*NonTGPPAccessForwardingActionInformationFields).ToIE}
+	// RemoveMARType binds RemoveMAR IE to *RemoveMARFields.
+	RemoveMARType = Typed[*RemoveMARFields]{Type: RemoveMAR, decode: decodeFields[RemoveMARFields], encode: (*RemoveMARFields).ToIE}
+	// UpdateMARType binds UpdateMAR IE to *UpdateMARFields.
+	UpdateMARType = Typed[*UpdateMARFields]{Type: UpdateMAR, decode: decodeFields[UpdateMARFields], encode: (*UpdateMARFields).ToIE}
+	// MARIDType binds MARID IE to uint16.
+	MARIDType = Typed[uint16]{Type: MARID, decode: (*IE).MARID, encode: NewMARID}
+	// SteeringFunctionalityType binds SteeringFunctionality IE to uint8.
+	SteeringFunctionalityType = Typed[uint8]{Type: SteeringFunctionality, decode: (*IE).SteeringFunctionality, encode: NewSteeringFunctionality}
+	// SteeringModeType binds SteeringMode IE to uint8.
+	SteeringModeType = Typed[uint8]{Type: SteeringMode, decode: (*IE).SteeringMode, encode: NewSteeringMode}
+	// WeightType binds Weight IE to uint8.
+	WeightType = Typed[uint8]{Type: Weight, decode: (*IE).Weight, encode: NewWeight}
+	// PriorityType binds Priority IE to uint8.
+	PriorityType = Typed[uint8]{Type: Priority, decode: (*IE).Priority, encode: NewPriority}
+	// UpdateTGPPAccessForwardingActionInformationType binds UpdateTGPPAccessForwardingActionInformation IE to *UpdateTGPPAccessForwardingActionInformationFields.
+	UpdateTGPPAccessForwardingActionInformationType = Typed[*UpdateTGPPAccessForwardingActionInformationFields]{Type: UpdateTGPPAccessForwardingActionInformation, decode: decodeFields[UpdateTGPPAccessForwardingActionInformationFields], encode: (*UpdateTGPPAccessForwardingActionInformationFields).ToIE}
+	// UpdateNonTGPPAccessForwardingActionInformationType binds UpdateNonTGPPAccessForwardingActionInformation IE to *UpdateNonTGPPAccessForwardingActionInformationFields.
+	UpdateNonTGPPAccessForwardingActionInformationType = Typed[*UpdateNonTGPPAccessForwardingActionInformationFields]{Type: UpdateNonTGPPAccessForwardingActionInformation, decode: decodeFields[UpdateNonTGPPAccessForwardingActionInformationFields], encode: (*UpdateNonTGPPAccessForwardingActionInformationFields).ToIE}
+	// UEIPAddressPoolIdentityType binds UEIPAddressPoolIdentity IE to []byte.
+	UEIPAddressPoolIdentityType = Typed[[]byte]{Type: UEIPAddressPoolIdentity, decode: (*IE).UEIPAddressPoolIdentity, encode: func(v []byte) *IE { return New(UEIPAddressPoolIdentity, v) }}
+	// AlternativeSMFIPAddressType binds AlternativeSMFIPAddress IE to *AlternativeSMFIPAddressFields.
+	AlternativeSMFIPAddressType = Typed[*AlternativeSMFIPAddressFields]{Type: AlternativeSMFIPAddress, decode: (*IE).AlternativeSMFIPAddress, encode: func(v *AlternativeSMFIPAddressFields) *IE { return newFieldsIE(AlternativeSMFIPAddress, v) }}
+	// PacketReplicationAndDetectionCarryOnInformationType binds PacketReplicationAndDetectionCarryOnInformation IE to uint8.
+	PacketReplicationAndDetectionCarryOnInformationType = Typed[uint8]{Type: PacketReplicationAndDetectionCarryOnInformation, decode: (*IE).PacketReplicationAndDetectionCarryOnInformation, encode: NewPacketReplicationAndDetectionCarryOnInformation}
+	// SMFSetIDType binds SMFSetID IE to string.
+	SMFSetIDType = Typed[string]{Type: SMFSetID, decode: (*IE).SMFSetID, encode: NewSMFSetID}
+	// QuotaValidityTimeType binds QuotaValidityTime IE to time.Duration.
+	QuotaValidityTimeType = Typed[time.Duration]{Type: QuotaValidityTime, decode: (*IE).QuotaValidityTime, encode: NewQuotaValidityTime}
+	// NumberOfReportsType binds NumberOfReports IE to uint16.
+	NumberOfReportsType = Typed[uint16]{Type: NumberOfReports, decode: (*IE).NumberOfReports, encode: NewNumberOfReports}
+	// PFCPSessionRetentionInformationType binds PFCPSessionRetentionInformation IE to *PFCPSessionRetentionInformationFields.
+	PFCPSessionRetentionInformationType = Typed[*PFCPSessionRetentionInformationFields]{Type: PFCPSessionRetentionInformation, decode: decodeFields[PFCPSessionRetentionInformationFields], encode: (*PFCPSessionRetentionInformationFields).ToIE}
+	// PFCPASRspFlagsType binds PFCPASRspFlags IE to uint8.
+	PFCPASRspFlagsType = Typed[uint8]{Type: PFCPASRspFlags, decode: (*IE).PFCPASRspFlags, encode: NewPFCPASRspFlags}
+	// CPPFCPEntityIPAddressType binds CPPFCPEntityIPAddress IE to *CPPFCPEntityIPAddressFields.
+	CPPFCPEntityIPAddressType = Typed[*CPPFCPEntityIPAddressFields]{Type: CPPFCPEntityIPAddress, decode: (*IE).CPPFCPEntityIPAddress, encode: func(v *CPPFCPEntityIPAddressFields) *IE { return newFieldsIE(CPPFCPEntityIPAddress, v) }}
+	// PFCPSEReqFlagsType binds PFCPSEReqFlags IE to uint8.
+	PFCPSEReqFlagsType = Typed[uint8]{Type: PFCPSEReqFlags, decode: (*IE).PFCPSEReqFlags, encode: NewPFCPSEReqFlags}
+	// UserPlanePathRecoveryReportType binds UserPlanePathRecoveryReport IE to *UserPlanePathRecoveryReportFields.
+	UserPlanePathRecoveryReportType = Typed[*UserPlanePathRecoveryReportFields]{Type: UserPlanePathRecoveryReport, decode: decodeFields[UserPlanePathRecoveryReportFields], encode: (*UserPlanePathRecoveryReportFields).ToIE}
+	// IPMulticastAddressingInfoType binds IPMulticastAddressingInfo IE to *IPMulticastAddressingInfoFields.
+	IPMulticastAddressingInfoType = Typed[*IPMulticastAddressingInfoFields]{Type: IPMulticastAddressingInfo, decode: decodeFields[IPMulticastAddressingInfoFields], encode: (*IPMulticastAddressingInfoFields).ToIE}
+	// JoinIPMulticastInformationWithinUsageReportType binds JoinIPMulticastInformationWithinUsageReport IE to *JoinIPMulticastInformationWithinUsageReportFields.
+	JoinIPMulticastInformationWithinUsageReportType = Typed[*JoinIPMulticastInformationWithinUsageReportFields]{Type: JoinIPMulticastInformationWithinUsageReport, decode: decodeFields[JoinIPMulticastInformationWithinUsageReportFields], encode: (*JoinIPMulticastInformationWithinUsageReportFields).ToIE}
+	// LeaveIPMulticastInformationWithinUsageReportType binds LeaveIPMulticastInformationWithinUsageReport IE to *LeaveIPMulticastInformationWithinUsageReportFields.
+	LeaveIPMulticastInformationWithinUsageReportType = Typed[*LeaveIPMulticastInformationWithinUsageReportFields]{Type: LeaveIPMulticastInformationWithinUsageReport, decode: decodeFields[LeaveIPMulticastInformationWithinUsageReportFields], encode: (*LeaveIPMulticastInformationWithinUsageReportFields).ToIE}
+	// IPMulticastAddressType binds IPMulticastAddress IE to *IPMulticastAddressFields.
+	IPMulticastAddressType = Typed[*IPMulticastAddressFields]{Type: IPMulticastAddress, decode: (*IE).IPMulticastAddress, encode: func(v *IPMulticastAddressFields) *IE { return newFieldsIE(IPMulticastAddress, v) }}
+	// SourceIPAddressType binds SourceIPAddress IE to *SourceIPAddressFields.
+	SourceIPAddressType = Typed[*SourceIPAddressFields]{Type: SourceIPAddress, decode: (*IE).SourceIPAddress, encode: func(v *SourceIPAddressFields) *IE { return newFieldsIE(SourceIPAddress, v) }}
+	// PacketRateStatusType binds PacketRateStatus IE to *PacketRateStatusFields.
+	PacketRateStatusType = Typed[*PacketRateStatusFields]{Type: PacketRateStatus, decode: (*IE).PacketRateStatus, encode: func(v *PacketRateStatusFields) *IE { return newFieldsIE(PacketRateStatus, v) }}
+	// CreateBridgeInfoForTSCType binds CreateBridgeInfoForTSC IE to uint8.
+	CreateBridgeInfoForTSCType = Typed[uint8]{Type: CreateBridgeInfoForTSC, decode: (*IE).CreateBridgeInfoForTSC, encode: NewCreateBridgeInfoForTSC}
+	// CreatedBridgeInfoForTSCType binds CreatedBridgeInfoForTSC IE to *CreatedBridgeInfoForTSCFields.
+	CreatedBridgeInfoForTSCType = Typed[*CreatedBridgeInfoForTSCFields]{Type: CreatedBridgeInfoForTSC, decode: decodeFields[CreatedBridgeInfoForTSCFields], encode: (*CreatedBridgeInfoForTSCFields).ToIE}
+	// DSTTPortNumberType binds DSTTPortNumber IE to uint32.
+	DSTTPortNumberType = Typed[uint32]{Type: DSTTPortNumber, decode: (*IE).DSTTPortNumber, encode: NewDSTTPortNumber}
+	// NWTTPortNumberType binds NWTTPortNumber IE to uint32.
+	NWTTPortNumberType = Typed[uint32]{Type: NWTTPortNumber, decode: (*IE).NWTTPortNumber, encode: NewNWTTPortNumber}
+	// TSNBridgeIDType binds TSNBridgeID IE to net.HardwareAddr.
+	TSNBridgeIDType = Typed[net.HardwareAddr]{Type: TSNBridgeID, decode: (*IE).TSNBridgeID, encode: NewTSNBridgeID}
+	// TSCManagementInformationWithinSessionModificationRequestType binds TSCManagementInformationWithinSessionModificationRequest IE to *TSCManagementInformationWithinSessionModificationRequestFields.
+	TSCManagementInformationWithinSessionModificationRequestType = Typed[*TSCManagementInformationWithinSessionModificationRequestFields]{Type: TSCManagementInformationWithinSessionModificationRequest, decode: decodeFields[TSCManagementInformationWithinSessionModificationRequestFields], encode: (*TSCManagementInformationWithinSessionModificationRequestFields).ToIE}
+	// TSCManagementInformationWithinSessionModificationResponseType binds TSCManagementInformationWithinSessionModificationResponse IE to *TSCManagementInformationWithinSessionModificationResponseFields.
+	TSCManagementInformationWithinSessionModificationResponseType = Typed[*TSCManagementInformationWithinSessionModificationResponseFields]{Type: TSCManagementInformationWithinSessionModificationResponse, decode: decodeFields[TSCManagementInformationWithinSessionModificationResponseFields], encode: (*TSCManagementInformationWithinSessionModificationResponseFields).ToIE}
+	// TSCManagementInformationWithinSessionReportRequestType binds TSCManagementInformationWithinSessionReportRequest IE to *TSCManagementInformationWithinSessionReportRequestFields.
+	TSCManagementInformationWithinSessionReportRequestType = Typed[*TSCManagementInformationWithinSessionReportRequestFields]{Type: TSCManagementInformationWithinSessionReportRequest, decode: decodeFields[TSCManagementInformationWithinSessionReportRequestFields], encode: (*TSCManagementInformationWithinSessionReportRequestFields).ToIE}
+	// PortManagementInformationContainerType binds PortManagementInformationContainer IE to string.
+	PortManagementInformationContainerType = Typed[string]{Type: PortManagementInformationContainer, decode: (*IE).PortManagementInformationContainer, encode: NewPortManagementInformationContainer}
+	// ClockDriftControlInformationType binds ClockDriftControlInformation IE to *ClockDriftControlInformationFields.
+	ClockDriftControlInformationType = Typed[*ClockDriftControlInformationFields]{Type: ClockDriftControlInformation, decode: decodeFields[ClockDriftControlInformationFields], encode: (*ClockDriftControlInformationFields).ToIE}
+	// RequestedClockDriftInformationType binds RequestedClockDriftInformation IE to uint8.
+	RequestedClockDriftInformationType = Typed[uint8]{Type: RequestedClockDriftInformation, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(RequestedClockDriftInformation, v) }}
+	// ClockDriftReportType binds ClockDriftReport IE to *ClockDriftReportFields.
+	ClockDriftReportType = Typed[*ClockDriftReportFields]{Type: ClockDriftReport, decode: decodeFields[ClockDriftReportFields], encode: (*ClockDriftReportFields).ToIE}
+	// TSNTimeDomainNumberType binds TSNTimeDomainNumber IE to uint8.
+	TSNTimeDomainNumberType = Typed[uint8]{Type: TSNTimeDomainNumber, decode: (*IE).TSNTimeDomainNumber, encode: NewTSNTimeDomainNumber}
+	// TimeOffsetThresholdType binds TimeOffsetThreshold IE to time.Duration.
+	TimeOffsetThresholdType = Typed[time.Duration]{Type: TimeOffsetThreshold, decode: (*IE).TimeOffsetThreshold, encode: NewTimeOffsetThreshold}
+	// CumulativeRateRatioThresholdType binds CumulativeRateRatioThreshold IE to uint32.
+	CumulativeRateRatioThresholdType = Typed[uint32]{Type: CumulativeRateRatioThreshold, decode: (*IE).CumulativeRateRatioThreshold, encode: NewCumulativeRateRatioThreshold}
+	// TimeOffsetMeasurementType binds TimeOffsetMeasurement IE to time.Duration.
+	TimeOffsetMeasurementType = Typed[time.Duration]{Type: TimeOffsetMeasurement, decode: (*IE).TimeOffsetMeasurement, encode: NewTimeOffsetMeasurement}
+	// CumulativeRateRatioMeasurementType binds CumulativeRateRatioMeasurement IE to uint32.
+	CumulativeRateRatioMeasurementType = Typed[uint32]{Type: CumulativeRateRatioMeasurement, decode: (*IE).CumulativeRateRatioMeasurement, encode: NewCumulativeRateRatioMeasurement}
+	// RemoveSRRType binds RemoveSRR IE to *RemoveSRRFields.
+	RemoveSRRType = Typed[*RemoveSRRFields]{Type: RemoveSRR, decode: decodeFields[RemoveSRRFields], encode: (*RemoveSRRFields).ToIE}
+	// CreateSRRType binds CreateSRR IE to *CreateSRRFields.
+	CreateSRRType = Typed[*CreateSRRFields]{Type: CreateSRR, decode: decodeFields[CreateSRRFields], encode: (*CreateSRRFields).ToIE}
+	// UpdateSRRType binds UpdateSRR IE to *UpdateSRRFields.
+	UpdateSRRType = Typed[*UpdateSRRFields]{Type: UpdateSRR, decode: decodeFields[UpdateSRRFields], encode: (*UpdateSRRFields).ToIE}
+	// SessionReportType binds SessionReport IE to *SessionReportFields.
+	SessionReportType = Typed[*SessionReportFields]{Type: SessionReport, decode: decodeFields[SessionReportFields], encode: (*SessionReportFields).ToIE}
+	// SRRIDType binds SRRID IE to uint8.
+	SRRIDType = Typed[uint8]{Type: SRRID, decode: (*IE).SRRID, encode: NewSRRID}
+	// AccessAvailabilityControlInformationType binds AccessAvailabilityControlInformation IE to *AccessAvailabilityControlInformationFields.
+	AccessAvailabilityControlInformationType = Typed[*AccessAvailabilityControlInformationFields]{Type: AccessAvailabilityControlInformation, decode: decodeFields[AccessAvailabilityControlInformationFields], encode: (*AccessAvailabilityControlInformationFields).ToIE}
+	// RequestedAccessAvailabilityInformationType binds RequestedAccessAvailabilityInformation IE to uint8.
+	RequestedAccessAvailabilityInformationType = Typed[uint8]{Type: RequestedAccessAvailabilityInformation, decode: (*IE).RequestedAccessAvailabilityInformation, encode: NewRequestedAccessAvailabilityInformation}
+	// AccessAvailabilityReportType binds AccessAvailabilityReport IE to *AccessAvailabilityReportFields.
+	AccessAvailabilityReportType = Typed[*AccessAvailabilityReportFields]{Type: AccessAvailabilityReport, decode: decodeFields[AccessAvailabilityReportFields], encode: (*AccessAvailabilityReportFields).ToIE}
+	// AccessAvailabilityInformationType binds AccessAvailabilityInformation IE to uint8.
+	AccessAvailabilityInformationType = Typed[uint8]{Type: AccessAvailabilityInformation, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(AccessAvailabilityInformation, v) }}
+	// ProvideATSSSControlInformationType binds ProvideATSSSControlInformation IE to *ProvideATSSSControlInformationFields.
+	ProvideATSSSControlInformationType = Typed[*ProvideATSSSControlInformationFields]{Type: ProvideATSSSControlInformation, decode: decodeFields[ProvideATSSSControlInformationFields], encode: (*ProvideATSSSControlInformationFields).ToIE}
+	// ATSSSControlParametersType binds ATSSSControlParameters IE to *ATSSSControlParametersFields.
+	ATSSSControlParametersType = Typed[*ATSSSControlParametersFields]{Type: ATSSSControlParameters, decode: decodeFields[ATSSSControlParametersFields], encode: (*ATSSSControlParametersFields).ToIE}
+	// MPTCPControlInformationType binds MPTCPControlInformation IE to uint8.
+	MPTCPControlInformationType = Typed[uint8]{Type: MPTCPControlInformation, decode: (*IE).MPTCPControlInformation, encode: NewMPTCPControlInformation}
+	// ATSSSLLControlInformationType binds ATSSSLLControlInformation IE to uint8.
+	ATSSSLLControlInformationType = Typed[uint8]{Type: ATSSSLLControlInformation, decode: (*IE).ATSSSLLControlInformation, encode: NewATSSSLLControlInformation}
+	// PMFControlInformationType binds PMFControlInformation IE to uint8.
+	PMFControlInformationType = Typed[uint8]{Type: PMFControlInformation, decode: (*IE).PMFControlInformation, encode: NewPMFControlInformation}
+	// MPTCPParametersType binds MPTCPParameters IE to *MPTCPParametersFields.
+	MPTCPParametersType = Typed[*MPTCPParametersFields]{Type: MPTCPParameters, decode: decodeFields[MPTCPParametersFields], encode: (*MPTCPParametersFields).ToIE}
+	// ATSSSLLParametersType binds ATSSSLLParameters IE to *ATSSSLLParametersFields.
+	ATSSSLLParametersType = Typed[*ATSSSLLParametersFields]{Type: ATSSSLLParameters, decode: decodeFields[ATSSSLLParametersFields], encode: (*ATSSSLLParametersFields).ToIE}
+	// PMFParametersType binds PMFParameters IE to *PMFParametersFields.
+	PMFParametersType = Typed[*PMFParametersFields]{Type: PMFParameters, decode: decodeFields[PMFParametersFields], encode: (*PMFParametersFields).ToIE}
+	// MPTCPAddressInformationType binds MPTCPAddressInformation IE to *MPTCPAddressInformationFields.
+	MPTCPAddressInformationType = Typed[*MPTCPAddressInformationFields]{Type: MPTCPAddressInformation, decode: (*IE).MPTCPAddressInformation, encode: func(v *MPTCPAddressInformationFields) *IE { return newFieldsIE(MPTCPAddressInformation, v) }}
+	// UELinkSpecificIPAddressType binds UELinkSpecificIPAddress IE to *UELinkSpecificIPAddressFields.
+	UELinkSpecificIPAddressType = Typed[*UELinkSpecificIPAddressFields]{Type: UELinkSpecificIPAddress, decode: (*IE).UELinkSpecificIPAddress, encode: func(v *UELinkSpecificIPAddressFields) *IE { return newFieldsIE(UELinkSpecificIPAddress, v) }}
+	// PMFAddressInformationType binds PMFAddressInformation IE to *PMFAddressInformationFields.
+	PMFAddressInformationType = Typed[*PMFAddressInformationFields]{Type: PMFAddressInformation, decode: (*IE).PMFAddressInformation, encode: func(v *PMFAddressInformationFields) *IE { return newFieldsIE(PMFAddressInformation, v) }}
+	// ATSSSLLInformationType binds ATSSSLLInformation IE to uint8.
+	ATSSSLLInformationType = Typed[uint8]{Type: ATSSSLLInformation, decode: (*IE).ATSSSLLInformation, encode: NewATSSSLLInformation}
+	// DataNetworkAccessIdentifierType binds DataNetworkAccessIdentifier IE to string.
+	DataNetworkAccessIdentifierType = Typed[string]{Type: DataNetworkAccessIdentifier, decode: (*IE).DataNetworkAccessIdentifier, encode: NewDataNetworkAccessIdentifier}
+	// UEIPAddressPoolInformationType binds UEIPAddressPoolInformation IE to *UEIPAddressPoolInformationFields.
+	UEIPAddressPoolInformationType = Typed[*UEIPAddressPoolInformationFields]{Type: UEIPAddressPoolInformation, decode: decodeFields[UEIPAddressPoolInformationFields], encode: (*UEIPAddressPoolInformationFields).ToIE}
+	// AveragePacketDelayType binds AveragePacketDelay IE to time.Duration.
+	AveragePacketDelayType = Typed[time.Duration]{Type: AveragePacketDelay, decode: (*IE).AveragePacketDelay, encode: NewAveragePacketDelay}
+	// MinimumPacketDelayType binds MinimumPacketDelay IE to time.Duration.
+	MinimumPacketDelayType = Typed[time.Duration]{Type: MinimumPacketDelay, decode: (*IE).MinimumPacketDelay, encode: NewMinimumPacketDelay}
+	// MaximumPacketDelayType binds MaximumPacketDelay IE to time.Duration.
+	MaximumPacketDelayType = Typed[time.Duration]{Type: MaximumPacketDelay, decode: (*IE).MaximumPacketDelay, encode: NewMaximumPacketDelay}
+	// QoSReportTriggerType binds QoSReportTrigger IE to uint8.
+	QoSReportTriggerType = Typed[uint8]{Type: QoSReportTrigger, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(QoSReportTrigger, v) }}
+	// GTPUPathQoSControlInformationType binds GTPUPathQoSControlInformation IE to *GTPUPathQoSControlInformationFields.
+	GTPUPathQoSControlInformationType = Typed[*GTPUPathQoSControlInformationFields]{Type: GTPUPathQoSControlInformation, decode: decodeFields[GTPUPathQoSControlInformationFields], encode: (*GTPUPathQoSControlInformationFields).ToIE}
+	// GTPUPathQoSReportType binds GTPUPathQoSReport IE to *GTPUPathQoSReportFields.
+	GTPUPathQoSReportType = Typed[*GTPUPathQoSReportFields]{Type: GTPUPathQoSReport, decode: decodeFields[GTPUPathQoSReportFields], encode: (*GTPUPathQoSReportFields).ToIE}
+	// QoSInformationInGTPUPathQoSReportType binds QoSInformationInGTPUPathQoSReport IE to *QoSInformationInGTPUPathQoSReportFields.
+	QoSInformationInGTPUPathQoSReportType = Typed[*QoSInformationInGTPUPathQoSReportFields]{Type: QoSInformationInGTPUPathQoSReport, decode: decodeFields[QoSInformationInGTPUPathQoSReportFields], encode: (*QoSInformationInGTPUPathQoSReportFields).ToIE}
+	// GTPUPathInterfaceTypeType binds GTPUPathInterfaceType IE to uint8.
+	GTPUPathInterfaceTypeType = Typed[uint8]{Type: GTPUPathInterfaceType, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(GTPUPathInterfaceType, v) }}
+	// QoSMonitoringPerQoSFlowControlInformationType binds QoSMonitoringPerQoSFlowControlInformation IE to *QoSMonitoringPerQoSFlowControlInformationFields.
+	QoSMonitoringPerQoSFlowControlInformationType = Typed[*QoSMonitoringPerQoSFlowControlInformationFields]{Type: QoSMonitoringPerQoSFlowControlInformation, decode: decodeFields[QoSMonitoringPerQoSFlowControlInformationFields], encode: (*QoSMonitoringPerQoSFlowControlInformationFields).ToIE}
+	// RequestedQoSMonitoringType binds RequestedQoSMonitoring IE to uint8.
+	RequestedQoSMonitoringType = Typed[uint8]{Type: RequestedQoSMonitoring, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(RequestedQoSMonitoring, v) }}
+	// ReportingFrequencyType binds ReportingFrequency IE to uint8.
+	ReportingFrequencyType = Typed[uint8]{Type: ReportingFrequency, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(ReportingFrequency, v) }}
+	// PacketDelayThresholdsType binds PacketDelayThresholds IE to *PacketDelayThresholdsFields.
+	PacketDelayThresholdsType = Typed[*PacketDelayThresholdsFields]{Type: PacketDelayThresholds, decode: (*IE).PacketDelayThresholds, encode: func(v *PacketDelayThresholdsFields) *IE { return newFieldsIE(PacketDelayThresholds, v) }}
+	// MinimumWaitTimeType binds MinimumWaitTime IE to time.Duration.
+	MinimumWaitTimeType = Typed[time.Duration]{Type: MinimumWaitTime, decode: (*IE).MinimumWaitTime, encode: NewMinimumWaitTime}
+	// QoSMonitoringReportType binds QoSMonitoringReport IE to *QoSMonitoringReportFields.
+	QoSMonitoringReportType = Typed[*QoSMonitoringReportFields]{Type: QoSMonitoringReport, decode: decodeFields[QoSMonitoringReportFields], encode: (*QoSMonitoringReportFields).ToIE}
+	// QoSMonitoringMeasurementType binds QoSMonitoringMeasurement IE to *QoSMonitoringMeasurementFields.
+	QoSMonitoringMeasurementType = Typed[*QoSMonitoringMeasurementFields]{Type: QoSMonitoringMeasurement, decode: (*IE).QoSMonitoringMeasurement, encode: func(v *QoSMonitoringMeasurementFields) *IE { return newFieldsIE(QoSMonitoringMeasurement, v) }}
+	// MTEDTControlInformationType binds MTEDTControlInformation IE to uint8.
+	MTEDTControlInformationType = Typed[uint8]{Type: MTEDTControlInformation, decode: (*IE).MTEDTControlInformation, encode: NewMTEDTControlInformation}
+	// DLDataPacketsSizeType binds DLDataPacketsSize IE to uint16.
+	DLDataPacketsSizeType = Typed[uint16]{Type: DLDataPacketsSize, decode: (*IE).DLDataPacketsSize, encode: NewDLDataPacketsSize}
+	// QERControlIndicationsType binds QERControlIndications IE to uint8.
+	QERControlIndicationsType = Typed[uint8]{Type: QERControlIndications, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(QERControlIndications, v) }}
+	// PacketRateStatusReportType binds PacketRateStatusReport IE to *PacketRateStatusReportFields.
+	PacketRateStatusReportType = Typed[*PacketRateStatusReportFields]{Type: PacketRateStatusReport, decode: decodeFields[PacketRateStatusReportFields], encode: (*PacketRateStatusReportFields).ToIE}
+	// NFInstanceIDType binds NFInstanceID IE to []byte.
+	NFInstanceIDType = Typed[[]byte]{Type: NFInstanceID, decode: (*IE).NFInstanceID, encode: NewNFInstanceID}
+	// EthernetContextInformationType binds EthernetContextInformation IE to *EthernetContextInformationFields.
+	EthernetContextInformationType = Typed[*EthernetContextInformationFields]{Type: EthernetContextInformation, decode: decodeFields[EthernetContextInformationFields], encode: (*EthernetContextInformationFields).ToIE}
+	// RedundantTransmissionParametersType binds RedundantTransmissionParameters IE to *RedundantTransmissionParametersFields.
+	RedundantTransmissionParametersType = Typed[*RedundantTransmissionParametersFields]{Type: RedundantTransmissionParameters, decode: decodeFields[RedundantTransmissionParametersFields], encode: (*RedundantTransmissionParametersFields).ToIE}
+	// UpdatedPDRType binds UpdatedPDR IE to *UpdatedPDRFields.
+	UpdatedPDRType = Typed[*UpdatedPDRFields]{Type: UpdatedPDR, decode: decodeFields[UpdatedPDRFields], encode: (*UpdatedPDRFields).ToIE}
+	// SNSSAIType binds SNSSAI IE to []byte.
+	SNSSAIType = Typed[[]byte]{Type: SNSSAI, decode: (*IE).SNSSAI, encode: func(v []byte) *IE { return New(SNSSAI, v) }}
+	// IPVersionType binds IPVersion IE to uint8.
+	IPVersionType = Typed[uint8]{Type: IPVersion, decode: (*IE).ValueAsUint8, encode: func(v uint8) *IE { return newUint8ValIE(IPVersion, v) }}
+	// PFCPASReqFlagsType binds PFCPASReqFlags IE to uint8.
+	PFCPASReqFlagsType = Typed[uint8]{Type: PFCPASReqFlags, decode: (*IE).PFCPASReqFlags, encode: NewPFCPASReqFlags}
+	// DataStatusType binds DataStatus IE to uint8.
+	DataStatusType = Typed[uint8]{Type: DataStatus, decode: (*IE).DataStatus, encode: NewDataStatus}
+	// ProvideRDSConfigurationInformationType binds ProvideRDSConfigurationInformation IE to *ProvideRDSConfigurationInformationFields.
+	ProvideRDSConfigurationInformationType = Typed[*ProvideRDSConfigurationInformationFields]{Type: ProvideRDSConfigurationInformation, decode: decodeFields[ProvideRDSConfigurationInformationFields], encode: (*ProvideRDSConfigurationInformationFields).ToIE}
+	// RDSConfigurationInformationType binds RDSConfigurationInformation IE to uint8.
+	RDSConfigurationInformationType = Typed[uint8]{Type: RDSConfigurationInformation, decode: (*IE).RDSConfigurationInformation, encode: NewRDSConfigurationInformation}
+	// QueryPacketRateStatusWithinSessionModificationRequestType binds QueryPacketRateStatusWithinSessionModificationRequest IE to *QueryPacketRateStatusWithinSessionModificationRequestFields.
+	QueryPacketRateStatusWithinSessionModificationRequestType = Typed[*QueryPacketRateStatusWithinSessionModificationRequestFields]{Type: QueryPacketRateStatusWithinSessionModificationRequest, decode: decodeFields[QueryPacketRateStatusWithinSessionModificationRequestFields], encode: (*QueryPacketRateStatusWithinSessionModificationRequestFields).ToIE}
+	// PacketRateStatusReportWithinSessionModificationResponseType binds PacketRateStatusReportWithinSessionModificationResponse IE to *PacketRateStatusReportWithinSessionModificationResponseFields.
+	PacketRateStatusReportWithinSessionModificationResponseType = Typed[*PacketRateStatusReportWithinSessionModificationResponseFields]{Type: PacketRateStatusReportWithinSessionModificationResponse, decode: decodeFields[PacketRateStatusReportWithinSessionModificationResponseFields], encode: (*PacketRateStatusReportWithinSessionModificationResponseFields).ToIE}
+	// MPTCPApplicableIndicationType binds MPTCPApplicableIndication IE to uint8.
+	MPTCPApplicableIndicationType = Typed[uint8]{Type: MPTCPApplicableIndication, decode: (*IE).MPTCPApplicableIndication, encode: NewMPTCPApplicableIndication}
+	// BridgeManagementInformationContainerType binds BridgeManagementInformationContainer IE to string.
+	BridgeManagementInformationContainerType = Typed[string]{Type: BridgeManagementInformationContainer, decode: (*IE).BridgeManagementInformationContainer, encode: NewBridgeManagementInformationContainer}
+	// UEIPAddressUsageInformationType binds UEIPAddressUsageInformation IE to *UEIPAddressUsageInformationFields.
+	UEIPAddressUsageInformationType = Typed[*UEIPAddressUsageInformationFields]{Type: UEIPAddressUsageInformation, decode: decodeFields[UEIPAddressUsageInformationFields], encode: (*UEIPAddressUsageInformationFields).ToIE}
+	// NumberOfUEIPAddressesType binds NumberOfUEIPAddresses IE to *NumberOfUEIPAddressesFields.
+	NumberOfUEIPAddressesType = Typed[*NumberOfUEIPAddressesFields]{Type: NumberOfUEIPAddresses, decode: (*IE).NumberOfUEIPAddresses, encode: func(v *NumberOfUEIPAddressesFields) *IE { return newFieldsIE(NumberOfUEIPAddresses, v) }}
+	// ValidityTimerType binds ValidityTimer IE to time.Duration.
+	ValidityTimerType = Typed[time.Duration]{Type: ValidityTimer, decode: (*IE).ValidityTimer, encode: NewValidityTimer}
+	// RedundantTransmissionForwardingParametersType binds RedundantTransmissionForwardingParameters IE to *RedundantTransmissionForwardingParametersFields.
+	RedundantTransmissionForwardingParametersType = Typed[*RedundantTransmissionForwardingParametersFields]{Type: RedundantTransmissionForwardingParameters, decode: decodeFields[RedundantTransmissionForwardingParametersFields], encode: (*RedundantTransmissionForwardingParametersFields).ToIE}
+	// TransportDelayReportingType binds TransportDelayReporting IE to *TransportDelayReportingFields.
+	TransportDelayReportingType = Typed[*TransportDelayReportingFields]{Type: TransportDelayReporting, decode: decodeFields[TransportDelayReportingFields], encode: (*TransportDelayReportingFields).ToIE}
+	// RATTypeType binds RATType IE to uint8.
+	RATTypeType = Typed[uint8]{Type: RATType, decode: (*IE).RATType, encode: NewRATType}
+	// AreaSessionIDType binds AreaSessionID IE to uint16.
+	AreaSessionIDType = Typed[uint16]{Type: AreaSessionID, decode: (*IE).AreaSessionID, encode: NewAreaSessionID}
+	// QERIndicationsType binds QERIndications IE to uint8.
+	QERIndicationsType = Typed[uint8]{Type: QERIndications, decode: (*IE).QERIndications, encode: NewQERIndications}
+)
@@ -9,6 +9,17 @@ import (
 	"net"
 )
 
+// UE IP Address flag definitions.
+const (
+	UEIPAddressV6    uint8 = 0x01
+	UEIPAddressV4    uint8 = 0x02
+	UEIPAddressSD    uint8 = 0x04
+	UEIPAddressIPv6D uint8 = 0x08
+	UEIPAddressCHV4  uint8 = 0x10
+	UEIPAddressCHV6  uint8 = 0x20
+	UEIPAddressIP6PL uint8 = 0x40
+)
+
 // NewUEIPAddress creates a new UEIPAddress IE.
 func NewUEIPAddress(flags uint8, v4, v6 string, v6d, v6pl uint8) *IE {
 	fields := NewUEIPAddressFields(flags, v4, v6, v6d, v6pl)
@@ -20,98 +31,6 @@ func NewUEIPAddress(flags uint8, v4, v6 string, v6d, v6pl uint8) *IE {
 	return New(UEIPAddress, b)
 }
 
-// UEIPAddress returns UEIPAddress in *UEIPAddressFields if the type of IE matches.
-func (i *IE) UEIPAddress() (*UEIPAddressFields, error) {
-	switch i.Type {
-	case UEIPAddress:
-		fields, err := ParseUEIPAddressFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case CreatePDR:
-		ies, err := i.CreatePDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == PDI {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case PDI:
-		ies, err := i.PDI()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UEIPAddress {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case CreatedPDR:
-		ies, err := i.CreatedPDR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UEIPAddress {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case UsageReportWithinSessionReportRequest:
-		ies, err := i.UsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UEIPAddress {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case CreateTrafficEndpoint:
-		ies, err := i.CreateTrafficEndpoint()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UEIPAddress {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case CreatedTrafficEndpoint:
-		ies, err := i.CreatedTrafficEndpoint()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UEIPAddress {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateTrafficEndpoint:
-		ies, err := i.UpdateTrafficEndpoint()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UEIPAddress {
-				return x.UEIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasCHV4 reports whether an IE has CHV4 bit.
 // This bit has been introduced in release 16.2
 // with the removal of the bit CH at the same position.
@@ -208,6 +127,56 @@ func NewUEIPAddressFields(flags uint8, v4, v6 string, v6d, v6pl uint8) *UEIPAddr
 	return f
 }
 
+// HasIPv6 reports whether V6 flag is set.
+func (f *UEIPAddressFields) HasIPv6() bool {
+	return has1stBit(f.Flags)
+}
+
+// SetIPv6Flag sets V6 flag in UEIPAddress.
+func (f *UEIPAddressFields) SetIPv6Flag() {
+	f.Flags |= UEIPAddressV6
+}
+
+// HasIPv4 reports whether V4 flag is set.
+func (f *UEIPAddressFields) HasIPv4() bool {
+	return has2ndBit(f.Flags)
+}
+
+// SetIPv4Flag sets V4 flag in UEIPAddress.
+func (f *UEIPAddressFields) SetIPv4Flag() {
+	f.Flags |= UEIPAddressV4
+}
+
+// HasSD reports whether S/D flag is set.
+func (f *UEIPAddressFields) HasSD() bool {
+	return has3rdBit(f.Flags)
+}
+
+// HasIPv6D reports whether IPv6D flag is set.
+func (f *UEIPAddressFields) HasIPv6D() bool {
+	return has4thBit(f.Flags)
+}
+
+// HasCHV4 reports whether CHV4 flag is set.
+func (f *UEIPAddressFields) HasCHV4() bool {
+	return has5thBit(f.Flags)
+}
+
+// HasCHV6 reports whether CHV6 flag is set.
+func (f *UEIPAddressFields) HasCHV6() bool {
+	return has6thBit(f.Flags)
+}
+
+// HasIP6PL reports whether IP6PL flag is set.
+func (f *UEIPAddressFields) HasIP6PL() bool {
+	return has7thBit(f.Flags)
+}
+
+// SetIP6PLFlag sets IP6PL flag in UEIPAddress.
+func (f *UEIPAddressFields) SetIP6PLFlag() {
+	f.Flags |= UEIPAddressIP6PL
+}
+
 // ParseUEIPAddressFields parses b into UEIPAddressFields.
 func ParseUEIPAddressFields(b []byte) (*UEIPAddressFields, error) {
 	f := &UEIPAddressFields{}
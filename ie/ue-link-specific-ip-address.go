@@ -21,43 +21,6 @@ func NewUELinkSpecificIPAddress(v4, v6, nv4, nv6 net.IP) *IE {
 	return New(UELinkSpecificIPAddress, b)
 }
 
-// UELinkSpecificIPAddress returns UELinkSpecificIPAddress in structured format if the type of IE matches.
-func (i *IE) UELinkSpecificIPAddress() (*UELinkSpecificIPAddressFields, error) {
-	switch i.Type {
-	case UELinkSpecificIPAddress:
-		fields, err := ParseUELinkSpecificIPAddressFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case ATSSSControlParameters:
-		ies, err := i.ATSSSControlParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == MPTCPParameters {
-				return x.UELinkSpecificIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	case MPTCPParameters:
-		ies, err := i.MPTCPParameters()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == UELinkSpecificIPAddress {
-				return x.UELinkSpecificIPAddress()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // UELinkSpecificIPAddressFields represents a fields contained in UELinkSpecificIPAddress IE.
 type UELinkSpecificIPAddressFields struct {
 	Flags                                     uint8
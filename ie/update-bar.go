@@ -25,7 +25,7 @@ func (i *IE) UpdateBAR() ([]*IE, error) {
 	case UpdateBARWithinSessionModificationRequest,
 		UpdateBARWithinSessionReportResponse:
 
-		return ParseMultiIEs(i.Payload)
+		return i.ValueAsGrouped()
 	default:
 		return nil, &InvalidTypeError{Type: i.Type}
 	}
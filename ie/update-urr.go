@@ -9,12 +9,3 @@ func NewUpdateURR(ies ...*IE) *IE {
 
 	return newGroupedIE(UpdateURR, 0, ies...)
 }
-
-// UpdateURR returns the IEs above UpdateURR if the type of IE matches.
-func (i *IE) UpdateURR() ([]*IE, error) {
-	if i.Type != UpdateURR {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -9,29 +9,6 @@ func NewUsageInformation(bef, aft, uae, ube int) *IE {
 	return newUint8ValIE(UsageInformation, uint8((ube<<3)|(uae<<2)|(aft<<1)|(bef)))
 }
 
-// UsageInformation returns UsageInformation in uint8 if the type of IE matches.
-func (i *IE) UsageInformation() (uint8, error) {
-	switch i.Type {
-	case UsageInformation:
-		return i.ValueAsUint8()
-	case UsageReportWithinSessionModificationResponse,
-		UsageReportWithinSessionDeletionResponse,
-		UsageReportWithinSessionReportRequest:
-		ies, err := i.UsageReport()
-		if err != nil {
-			return 0, err
-		}
-		for _, x := range ies {
-			if x.Type == UsageInformation {
-				return x.UsageInformation()
-			}
-		}
-		return 0, ErrIENotFound
-	default:
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // HasUBE reports whether and IE has UBE bit.
 func (i *IE) HasUBE() bool {
 	v, err := i.UsageInformation()
@@ -5,10 +5,14 @@
 package ie
 
 import (
+	"fmt"
 	"io"
 )
 
 // NewUsageReportTrigger creates a new UsageReportTrigger IE.
+//
+// The triggers are given by octets, or as UsageReportTriggerFlags like
+// NewUsageReportTrigger(NewUsageReportTriggerFlags(UsageReportTriggerVOLTH)...).
 func NewUsageReportTrigger(triggerOctets ...uint8) *IE {
 	return New(UsageReportTrigger, triggerOctets)
 }
@@ -134,3 +138,103 @@ func (i *IE) HasTEBUR() bool {
 		return false
 	}
 }
+
+// UsageReportTriggerFlag is a flag in UsageReportTrigger IE, represented as the position of
+// the bit counted from bit 1 of the 5th octet.
+type UsageReportTriggerFlag uint8
+
+// UsageReportTriggerFlag definitions.
+const (
+	// 5th octet
+	UsageReportTriggerPERIO UsageReportTriggerFlag = iota
+	UsageReportTriggerVOLTH
+	UsageReportTriggerTIMTH
+	UsageReportTriggerQUHTI
+	UsageReportTriggerSTART
+	UsageReportTriggerSTOPT
+	UsageReportTriggerDROTH
+	UsageReportTriggerIMMER
+
+	// 6th octet
+	UsageReportTriggerVOLQU
+	UsageReportTriggerTIMQU
+	UsageReportTriggerLIUSA
+	UsageReportTriggerTERMR
+	UsageReportTriggerMONIT
+	UsageReportTriggerENVCL
+	UsageReportTriggerMACAR
+	UsageReportTriggerEVETH
+
+	// 7th octet
+	UsageReportTriggerEVEQU
+	UsageReportTriggerTEBUR
+	UsageReportTriggerIPMJL
+	UsageReportTriggerQUVTI
+	UsageReportTriggerEMRRE
+	UsageReportTriggerUPINT
+)
+
+// String returns the name of the flag, e.g., "IMMER".
+func (f UsageReportTriggerFlag) String() string {
+	return flagName(usageReportTriggerFlagNames, uint8(f))
+}
+
+// UsageReportTriggerFlags is the value of UsageReportTrigger IE.
+//
+// As it is a []byte, it can be given to NewUsageReportTrigger as it is. The methods
+// that modify the flags return the modified copy, and never change the original.
+type UsageReportTriggerFlags []byte
+
+// NewUsageReportTriggerFlags creates a new UsageReportTriggerFlags with the given flags set.
+// It has at least 2 octets.
+func NewUsageReportTriggerFlags(flags ...UsageReportTriggerFlag) UsageReportTriggerFlags {
+	return make(UsageReportTriggerFlags, 2).Set(flags...)
+}
+
+// Has reports whether all the given flags are set.
+func (f UsageReportTriggerFlags) Has(flags ...UsageReportTriggerFlag) bool {
+	return hasFlags(f, flags...)
+}
+
+// Set returns the copy of f with the given flags set.
+func (f UsageReportTriggerFlags) Set(flags ...UsageReportTriggerFlag) UsageReportTriggerFlags {
+	return setFlags(f, flags...)
+}
+
+// Clear returns the copy of f with the given flags cleared.
+func (f UsageReportTriggerFlags) Clear(flags ...UsageReportTriggerFlag) UsageReportTriggerFlags {
+	return clearFlags(f, flags...)
+}
+
+// Flags returns the flags set in f in the order of the bit position.
+func (f UsageReportTriggerFlags) Flags() []UsageReportTriggerFlag {
+	return listFlags[UsageReportTriggerFlag](f)
+}
+
+// String returns the names of the flags set in f separated by "|", e.g.,
+// "VOLTH|TERMR", or "none" if no flag is set.
+func (f UsageReportTriggerFlags) String() string {
+	return joinFlags(f, usageReportTriggerFlagNames)
+}
+
+// Validate checks if the flags are allowed in 3GPP TS 29.244 clause 8.2.41,
+// and returns *FlagsError if not. The 5th and 6th octets shall be present, and
+// at least one flag shall be set.
+func (f UsageReportTriggerFlags) Validate() error {
+	if len(f) < 2 {
+		return &FlagsError{Type: UsageReportTrigger, Reason: fmt.Sprintf("octets 5 and 6 shall be present, got %d octets", len(f))}
+	}
+	if len(listFlags[UsageReportTriggerFlag](f)) == 0 {
+		return &FlagsError{Type: UsageReportTrigger, Reason: "at least one flag shall be set"}
+	}
+	return nil
+}
+
+// UsageReportTriggerFlags returns UsageReportTrigger in UsageReportTriggerFlags if the type of IE matches.
+func (i *IE) UsageReportTriggerFlags() (UsageReportTriggerFlags, error) {
+	v, err := i.UsageReportTrigger()
+	if err != nil {
+		return nil, err
+	}
+	return UsageReportTriggerFlags(v), nil
+}
@@ -22,19 +22,6 @@ func NewUserID(flags uint8, imsi, imei, msisdn, nai string) *IE {
 	return New(UserID, b)
 }
 
-// UserID returns UserID in structured format if the type of IE matches.
-func (i *IE) UserID() (*UserIDFields, error) {
-	if i.Type != UserID {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	s, err := ParseUserIDFields(i.Payload)
-	if err != nil {
-		return nil, err
-	}
-	return s, nil
-}
-
 // UserIDFields represents a fields contained in UserID IE.
 type UserIDFields struct {
 	Flags        uint8
@@ -20,20 +20,6 @@ func NewUserPlaneIPResourceInformation(flags uint8, tRange uint8, v4, v6, ni str
 	return New(UserPlaneIPResourceInformation, b)
 }
 
-// UserPlaneIPResourceInformation returns UserPlaneIPResourceInformation in
-// *UserPlaneIPResourceInformationFields if the type of IE matches.
-func (i *IE) UserPlaneIPResourceInformation() (*UserPlaneIPResourceInformationFields, error) {
-	if i.Type != UserPlaneIPResourceInformation {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	f, err := ParseUserPlaneIPResourceInformationFields(i.Payload)
-	if err != nil {
-		return nil, err
-	}
-	return f, nil
-}
-
 // TEIDRI returns the number of bits that are used to partition the TEID range.
 func (i *IE) TEIDRI() int {
 	if i.Type != UserPlaneIPResourceInformation {
@@ -104,6 +90,16 @@ func NewUserPlaneIPResourceInformationFields(flags uint8, tRange uint8, v4, v6,
 	return f
 }
 
+// HasASSONI reports whether ASSONI flag is set.
+func (f *UserPlaneIPResourceInformationFields) HasASSONI() bool {
+	return has6thBit(f.Flags)
+}
+
+// HasASSOSI reports whether ASSOSI flag is set.
+func (f *UserPlaneIPResourceInformationFields) HasASSOSI() bool {
+	return has7thBit(f.Flags)
+}
+
 // ParseUserPlaneIPResourceInformationFields parses b into UserPlaneIPResourceInformationFields.
 func ParseUserPlaneIPResourceInformationFields(b []byte) (*UserPlaneIPResourceInformationFields, error) {
 	f := &UserPlaneIPResourceInformationFields{}
@@ -150,11 +146,11 @@ func (f *UserPlaneIPResourceInformationFields) UnmarshalBinary(b []byte) error {
 	if has6thBit(f.Flags) {
 		n := l
 		if has7thBit(f.Flags) {
-			f.SourceInterface = b[n] & 0x0f
 			n--
+			f.SourceInterface = b[n] & 0x0f
 		}
 
-		if l < offset+n {
+		if n < offset {
 			return io.ErrUnexpectedEOF
 		}
 		f.NetworkInstance = string(b[offset:n])
@@ -162,6 +158,9 @@ func (f *UserPlaneIPResourceInformationFields) UnmarshalBinary(b []byte) error {
 	}
 
 	if has7thBit(f.Flags) {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
 		f.SourceInterface = b[offset] & 0x0f
 	}
 
@@ -8,12 +8,3 @@ package ie
 func NewUserPlanePathFailureReport(peer *IE) *IE {
 	return newGroupedIE(UserPlanePathFailureReport, 0, peer)
 }
-
-// UserPlanePathFailureReport returns the IEs above UserPlanePathFailureReport if the type of IE matches.
-func (i *IE) UserPlanePathFailureReport() ([]*IE, error) {
-	if i.Type != UserPlanePathFailureReport {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
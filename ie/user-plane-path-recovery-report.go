@@ -8,12 +8,3 @@ package ie
 func NewUserPlanePathRecoveryReport(peer *IE) *IE {
 	return newGroupedIE(UserPlanePathRecoveryReport, 0, peer)
 }
-
-// UserPlanePathRecoveryReport returns the IEs above UserPlanePathRecoveryReport if the type of IE matches.
-func (i *IE) UserPlanePathRecoveryReport() ([]*IE, error) {
-	if i.Type != UserPlanePathRecoveryReport {
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-
-	return ParseMultiIEs(i.Payload)
-}
@@ -0,0 +1,453 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Validate checks if the IE is valid as specified in 3GPP TS 29.244 clause 8,
+// and returns *ValidationError if not.
+//
+// For non-grouped IEs, it checks the length of the value, the consistency of the
+// flags and the fields that depend on them, the spare bits and the ranges of the
+// enumerated values. Grouped IEs are valid if all the child IEs are valid.
+// Vendor-specific IEs are valid if the function registered with RegisterVendorIE
+// decodes them without error, or if nothing is registered for them.
+//
+// The octets beyond the ones defined in the spec are allowed in the IEs that
+// may be extended in the future releases, e.g., the flags, as required in
+// 3GPP TS 29.244 clause 8.1.1. The IEs with the fixed-size value, e.g., Cause,
+// are invalid if they have any extra octets.
+func (i *IE) Validate() error {
+	return i.validate(nil)
+}
+
+// ParseStrict parses b into IE and validates it with Validate.
+//
+// The error returned when the IE is invalid is *ValidationError, which has
+// the Cause value to be sent back to the peer.
+func ParseStrict(b []byte) (*IE, error) {
+	i, err := Parse(b)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// ParseMultiIEsStrict decodes multiple IEs at a time and validates each of them
+// with Validate.
+func ParseMultiIEsStrict(b []byte) ([]*IE, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ies {
+		if err := i.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return ies, nil
+}
+
+func (i *IE) validate(parents []IEType) error {
+	if i.IsGrouped() {
+		children := i.ChildIEs
+		if len(children) == 0 && len(i.Payload) != 0 {
+			var err error
+			children, err = ParseMultiIEs(i.Payload)
+			if err != nil {
+				return newValidationError(i.Type, parents, CauseInvalidLength, err.Error())
+			}
+		}
+
+		path := append(parents[:len(parents):len(parents)], i.Type)
+		for _, c := range children {
+			if err := c.validate(path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	b := i.Payload
+	if bounds, ok := ieLengthBounds[i.Type]; ok {
+		if len(b) < bounds.min {
+			return newValidationError(i.Type, parents, CauseInvalidLength,
+				fmt.Sprintf("length %d is shorter than %d", len(b), bounds.min))
+		}
+		if bounds.max != 0 && len(b) > bounds.max {
+			return newValidationError(i.Type, parents, CauseInvalidLength,
+				fmt.Sprintf("length %d is longer than %d", len(b), bounds.max))
+		}
+	}
+
+	if mask, ok := ieSpareBits[i.Type]; ok && len(b) > 0 && b[0]&mask != 0 {
+		return newValidationError(i.Type, parents, CauseMandatoryIEIncorrect,
+			fmt.Sprintf("spare bits are set: %#02x", b[0]&mask))
+	}
+
+	if _, err := i.value(); err != nil {
+		cause := CauseMandatoryIEIncorrect
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, ErrInvalidLength) || errors.Is(err, ErrTooShortToParse) {
+			cause = CauseInvalidLength
+		}
+		return newValidationError(i.Type, parents, cause, err.Error())
+	}
+
+	if fn, ok := ieValidators[i.Type]; ok {
+		if err := fn(b); err != nil {
+			err.Type, err.Parents = i.Type, parents
+			return err
+		}
+	}
+	return nil
+}
+
+func newValidationError(typ IEType, parents []IEType, cause uint8, reason string) *ValidationError {
+	return &ValidationError{Type: typ, Parents: parents, Cause: cause, Reason: reason}
+}
+
+func invalidLength(format string, a ...any) *ValidationError {
+	return &ValidationError{Cause: CauseInvalidLength, Reason: fmt.Sprintf(format, a...)}
+}
+
+func incorrectValue(format string, a ...any) *ValidationError {
+	return &ValidationError{Cause: CauseMandatoryIEIncorrect, Reason: fmt.Sprintf(format, a...)}
+}
+
+type lengthBounds struct {
+	min, max int
+}
+
+// ieLengthBounds is the length of the value of non-grouped IEs. max is zero if
+// the IE has variable length or may be extended in the future releases.
+var ieLengthBounds = map[IEType]lengthBounds{
+	Cause:                             {1, 1},
+	SourceInterface:                   {1, 0},
+	FTEID:                             {1, 0},
+	SDFFilter:                         {2, 0},
+	GateStatus:                        {1, 0},
+	MBR:                               {10, 10},
+	GBR:                               {10, 10},
+	QERCorrelationID:                  {4, 4},
+	Precedence:                        {4, 4},
+	TransportLevelMarking:             {2, 2},
+	VolumeThreshold:                   {1, 0},
+	TimeThreshold:                     {4, 4},
+	MonitoringTime:                    {4, 4},
+	SubsequentVolumeThreshold:         {1, 0},
+	SubsequentTimeThreshold:           {4, 4},
+	InactivityDetectionTime:           {4, 4},
+	ReportingTriggers:                 {2, 0},
+	RedirectInformation:               {3, 0},
+	ReportType:                        {1, 0},
+	OffendingIE:                       {2, 2},
+	ForwardingPolicy:                  {1, 0},
+	DestinationInterface:              {1, 0},
+	UPFunctionFeatures:                {2, 0},
+	ApplyAction:                       {1, 0},
+	DownlinkDataServiceInformation:    {1, 0},
+	DownlinkDataNotificationDelay:     {1, 1},
+	DLBufferingDuration:               {1, 1},
+	DLBufferingSuggestedPacketCount:   {1, 2},
+	PFCPSMReqFlags:                    {1, 0},
+	PFCPSRRspFlags:                    {1, 0},
+	SequenceNumber:                    {4, 4},
+	Metric:                            {1, 1},
+	Timer:                             {1, 1},
+	PDRID:                             {2, 2},
+	FSEID:                             {9, 0},
+	NodeID:                            {2, 0},
+	PFDContents:                       {2, 0},
+	MeasurementMethod:                 {1, 0},
+	UsageReportTrigger:                {2, 0},
+	MeasurementPeriod:                 {4, 4},
+	FQCSID:                            {1, 0},
+	VolumeMeasurement:                 {1, 0},
+	DurationMeasurement:               {4, 4},
+	TimeOfFirstPacket:                 {4, 4},
+	TimeOfLastPacket:                  {4, 4},
+	QuotaHoldingTime:                  {4, 4},
+	DroppedDLTrafficThreshold:         {1, 0},
+	VolumeQuota:                       {1, 0},
+	TimeQuota:                         {4, 4},
+	StartTime:                         {4, 4},
+	EndTime:                           {4, 4},
+	URRID:                             {4, 4},
+	LinkedURRID:                       {4, 4},
+	OuterHeaderCreation:               {2, 0},
+	BARID:                             {1, 1},
+	CPFunctionFeatures:                {1, 0},
+	UsageInformation:                  {1, 0},
+	FlowInformation:                   {3, 0},
+	UEIPAddress:                       {1, 0},
+	PacketRate:                        {1, 0},
+	OuterHeaderRemoval:                {1, 0},
+	RecoveryTimeStamp:                 {4, 4},
+	DLFlowLevelMarking:                {1, 0},
+	HeaderEnrichment:                  {3, 0},
+	MeasurementInformation:            {1, 0},
+	NodeReportType:                    {1, 0},
+	RemoteGTPUPeer:                    {1, 0},
+	URSEQN:                            {4, 4},
+	FARID:                             {4, 4},
+	QERID:                             {4, 4},
+	OCIFlags:                          {1, 0},
+	PFCPAssociationReleaseRequest:     {1, 0},
+	GracefulReleasePeriod:             {1, 1},
+	PDNType:                           {1, 0},
+	FailedRuleID:                      {2, 0},
+	TimeQuotaMechanism:                {5, 5},
+	UserPlaneIPResourceInformation:    {1, 0},
+	UserPlaneInactivityTimer:          {4, 4},
+	Multiplier:                        {12, 12},
+	AggregatedURRID:                   {4, 4},
+	SubsequentVolumeQuota:             {1, 0},
+	SubsequentTimeQuota:               {4, 4},
+	RQI:                               {1, 0},
+	QFI:                               {1, 1},
+	QueryURRReference:                 {4, 4},
+	AdditionalUsageReportsInformation: {2, 2},
+	TrafficEndpointID:                 {1, 1},
+	MACAddress:                        {1, 0},
+	CTAG:                              {3, 3},
+	STAG:                              {3, 3},
+	Ethertype:                         {2, 2},
+	Proxying:                          {1, 0},
+	EthernetFilterID:                  {4, 4},
+	EthernetFilterProperties:          {1, 0},
+	SuggestedBufferingPacketsCount:    {1, 1},
+	UserID:                            {1, 0},
+	EthernetPDUSessionInformation:     {1, 0},
+	MACAddressesDetected:              {1, 0},
+	MACAddressesRemoved:               {1, 0},
+	EthernetInactivityTimer:           {4, 4},
+	EventQuota:                        {4, 4},
+	EventThreshold:                    {4, 4},
+	SubsequentEventQuota:              {4, 4},
+	SubsequentEventThreshold:          {4, 4},
+	FramedRouting:                     {4, 4},
+	EventTimeStamp:                    {4, 4},
+	AveragingWindow:                   {4, 4},
+	PagingPolicyIndicator:             {1, 1},
+	TGPPInterfaceType:                 {1, 1},
+	PFCPSRReqFlags:                    {1, 0},
+	PFCPAUReqFlags:                    {1, 0},
+	ActivationTime:                    {4, 4},
+	DeactivationTime:                  {4, 4},
+	MARID:                             {2, 2},
+	SteeringFunctionality:             {1, 1},
+	SteeringMode:                      {1, 1},
+	Weight:                            {1, 1},
+	Priority:                          {1, 1},
+	UEIPAddressPoolIdentity:           {2, 0},
+	AlternativeSMFIPAddress:           {1, 0},
+	PacketReplicationAndDetectionCarryOnInformation: {1, 0},
+	SMFSetID:                               {1, 0},
+	QuotaValidityTime:                      {4, 4},
+	NumberOfReports:                        {2, 2},
+	PFCPASRspFlags:                         {1, 0},
+	CPPFCPEntityIPAddress:                  {1, 0},
+	PFCPSEReqFlags:                         {1, 0},
+	IPMulticastAddress:                     {1, 0},
+	SourceIPAddress:                        {1, 0},
+	PacketRateStatus:                       {1, 0},
+	CreateBridgeInfoForTSC:                 {1, 0},
+	DSTTPortNumber:                         {4, 4},
+	NWTTPortNumber:                         {4, 4},
+	TSNBridgeID:                            {1, 0},
+	RequestedClockDriftInformation:         {1, 0},
+	TSNTimeDomainNumber:                    {1, 1},
+	TimeOffsetThreshold:                    {8, 8},
+	CumulativeRateRatioThreshold:           {4, 4},
+	TimeOffsetMeasurement:                  {8, 8},
+	CumulativeRateRatioMeasurement:         {4, 4},
+	SRRID:                                  {1, 1},
+	RequestedAccessAvailabilityInformation: {1, 0},
+	AccessAvailabilityInformation:          {1, 0},
+	MPTCPControlInformation:                {1, 0},
+	ATSSSLLControlInformation:              {1, 0},
+	PMFControlInformation:                  {1, 0},
+	MPTCPAddressInformation:                {1, 0},
+	UELinkSpecificIPAddress:                {1, 0},
+	PMFAddressInformation:                  {1, 0},
+	ATSSSLLInformation:                     {1, 0},
+	AveragePacketDelay:                     {4, 4},
+	MinimumPacketDelay:                     {4, 4},
+	MaximumPacketDelay:                     {4, 4},
+	QoSReportTrigger:                       {1, 0},
+	GTPUPathInterfaceType:                  {1, 0},
+	RequestedQoSMonitoring:                 {1, 0},
+	ReportingFrequency:                     {1, 0},
+	PacketDelayThresholds:                  {1, 0},
+	MinimumWaitTime:                        {4, 4},
+	QoSMonitoringMeasurement:               {1, 0},
+	MTEDTControlInformation:                {1, 0},
+	DLDataPacketsSize:                      {2, 2},
+	QERControlIndications:                  {1, 0},
+	NFInstanceID:                           {16, 16},
+	SNSSAI:                                 {4, 4},
+	IPVersion:                              {1, 0},
+	PFCPASReqFlags:                         {1, 0},
+	DataStatus:                             {1, 0},
+	RDSConfigurationInformation:            {1, 0},
+	MPTCPApplicableIndication:              {1, 0},
+	NumberOfUEIPAddresses:                  {1, 0},
+	ValidityTimer:                          {2, 2},
+	RATType:                                {1, 1},
+	AreaSessionID:                          {2, 2},
+	QERIndications:                         {1, 0},
+}
+
+// ieSpareBits is the spare bits in the first octet of the value, which shall be
+// set to zero by the sender.
+var ieSpareBits = map[IEType]uint8{
+	SourceInterface:               0xf0,
+	FTEID:                         0xf0,
+	GateStatus:                    0xf0,
+	DestinationInterface:          0xf0,
+	FSEID:                         0xfc,
+	MeasurementMethod:             0xf8,
+	UEIPAddress:                   0x80,
+	OCIFlags:                      0xfe,
+	PFCPAssociationReleaseRequest: 0xfc,
+	PDNType:                       0xf8,
+	RQI:                           0xfe,
+	QFI:                           0xc0,
+	MACAddress:                    0xf0,
+	CTAG:                          0xf8,
+	STAG:                          0xf8,
+	EthernetFilterProperties:      0xfe,
+	EthernetPDUSessionInformation: 0xfe,
+	PagingPolicyIndicator:         0xf8,
+	TGPPInterfaceType:             0xc0,
+	SteeringFunctionality:         0xf0,
+	SteeringMode:                  0xf0,
+	IPVersion:                     0xfc,
+}
+
+// ieValidators is the checks specific to the IE types, which are called after
+// the length and the spare bits are checked and the value is decoded without
+// error.
+var ieValidators = map[IEType]func(b []byte) *ValidationError{
+	Cause: func(b []byte) *ValidationError {
+		if b[0] == 0 {
+			return incorrectValue("cause value 0 is reserved")
+		}
+		return nil
+	},
+	SourceInterface: func(b []byte) *ValidationError {
+		return checkRange("interface value", b[0]&0x0f, SrcInterface5GVNInternal)
+	},
+	DestinationInterface: func(b []byte) *ValidationError {
+		return checkRange("interface value", b[0]&0x0f, DstInterface5GVNInternal)
+	},
+	GateStatus: func(b []byte) *ValidationError {
+		if err := checkRange("UL gate", (b[0]>>2)&0x03, GateStatusClosed); err != nil {
+			return err
+		}
+		return checkRange("DL gate", b[0]&0x03, GateStatusClosed)
+	},
+	FTEID: func(b []byte) *ValidationError {
+		f := &FTEIDFields{Flags: b[0]}
+		switch {
+		case f.HasCh():
+			want := 1
+			if f.HasChID() {
+				want++
+			}
+			if len(b) > want {
+				return incorrectValue("TEID or address is present while CH flag is set")
+			}
+		case f.HasChID():
+			return incorrectValue("CHID flag is set without CH flag")
+		case !f.HasIPv4() && !f.HasIPv6():
+			return incorrectValue("neither V4 nor V6 flag is set")
+		}
+		return nil
+	},
+	FSEID: func(b []byte) *ValidationError {
+		f := &FSEIDFields{Flags: b[0]}
+		if !f.HasIPv4() && !f.HasIPv6() {
+			return incorrectValue("neither V4 nor V6 flag is set")
+		}
+		return nil
+	},
+	UEIPAddress: func(b []byte) *ValidationError {
+		flags := b[0]
+		v6 := has1stBit(flags) || has6thBit(flags)
+		switch {
+		case has4thBit(flags) && !v6:
+			return incorrectValue("IPv6D flag is set without V6 or CHV6 flag")
+		case has7thBit(flags) && !v6:
+			return incorrectValue("IP6PL flag is set without V6 or CHV6 flag")
+		}
+		return nil
+	},
+	NodeID: func(b []byte) *ValidationError {
+		switch b[0] & 0x0f {
+		case NodeIDIPv4Address:
+			if len(b) != 5 {
+				return invalidLength("IPv4 Node ID should have 4 octets, got %d", len(b)-1)
+			}
+		case NodeIDIPv6Address:
+			if len(b) != 17 {
+				return invalidLength("IPv6 Node ID should have 16 octets, got %d", len(b)-1)
+			}
+		}
+		return nil
+	},
+	ApplyAction: func(b []byte) *ValidationError {
+		return flagsValidationError(ApplyActionFlags(b).Validate())
+	},
+	ReportingTriggers: func(b []byte) *ValidationError {
+		return flagsValidationError(ReportingTriggerFlags(b).Validate())
+	},
+	UsageReportTrigger: func(b []byte) *ValidationError {
+		return flagsValidationError(UsageReportTriggerFlags(b).Validate())
+	},
+	PDNType: func(b []byte) *ValidationError {
+		if v := b[0] & 0x07; v == 0 || v > PDNTypeEthernet {
+			return incorrectValue("PDN type %d is not defined", v)
+		}
+		return nil
+	},
+	RedirectInformation: func(b []byte) *ValidationError {
+		return checkRange("redirect address type", b[0]&0x0f, RedirectAddrIPv4AndIPv6)
+	},
+	FailedRuleID: func(b []byte) *ValidationError {
+		return checkRange("rule ID type", b[0]&0x0f, RuleIDTypeSRR)
+	},
+	SteeringFunctionality: func(b []byte) *ValidationError {
+		return checkRange("steering functionality", b[0]&0x0f, SteeringFunctionalityMPTCP)
+	},
+	SteeringMode: func(b []byte) *ValidationError {
+		return checkRange("steering mode", b[0]&0x0f, SteeringModePriorityBased)
+	},
+	TimeQuotaMechanism: func(b []byte) *ValidationError {
+		return checkRange("base time interval type", b[0]&0x03, 1)
+	},
+}
+
+func checkRange(name string, v, max uint8) *ValidationError {
+	if v > max {
+		return incorrectValue("%s %d is not defined", name, v)
+	}
+	return nil
+}
+
+func flagsValidationError(err error) *ValidationError {
+	var fe *FlagsError
+	if errors.As(err, &fe) {
+		return incorrectValue("%s", fe.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"fmt"
+	"sync"
+)
+
+// VendorIE describes a vendor-specific IE identified by the pair of the
+// Enterprise ID and the IE type.
+//
+// Name is used instead of the IE type number when the IE is printed, encoded
+// in JSON or compared. Grouped reports whether the IE contains other IEs.
+// Decode is called to decode the payload of non-grouped IE into the value that
+// is shown in String and MarshalJSON, and checked in Validate. It can be nil if
+// the value should be shown as raw bytes.
+type VendorIE struct {
+	Name    string
+	Grouped bool
+	Decode  func(b []byte) (any, error)
+}
+
+type vendorIEKey struct {
+	eid uint16
+	typ IEType
+}
+
+var (
+	vendorMu  sync.RWMutex
+	vendorIEs = map[vendorIEKey]VendorIE{}
+)
+
+// RegisterVendorIE registers the vendor-specific IE of the given Enterprise ID
+// and IE type. The existing one with the same pair is replaced.
+//
+// The IE type must have the bit 8 of octet 1 set as specified in 3GPP TS 29.244
+// clause 8.1.1, otherwise *InvalidTypeError is returned.
+//
+// The registered IE takes precedence over AddGroupedIEType and SetIsGroupedFun
+// in IsGrouped, so that the different enterprises can use the same IE type
+// for the different purposes.
+func RegisterVendorIE(eid uint16, typ IEType, v VendorIE) error {
+	if typ&0x8000 == 0 {
+		return &InvalidTypeError{Type: typ}
+	}
+
+	vendorMu.Lock()
+	defer vendorMu.Unlock()
+	vendorIEs[vendorIEKey{eid, typ}] = v
+	return nil
+}
+
+// UnregisterVendorIE removes the vendor-specific IE of the given Enterprise ID
+// and IE type from the registry.
+func UnregisterVendorIE(eid uint16, typ IEType) {
+	vendorMu.Lock()
+	defer vendorMu.Unlock()
+	delete(vendorIEs, vendorIEKey{eid, typ})
+}
+
+// LookupVendorIE returns the vendor-specific IE registered with the given
+// Enterprise ID and IE type.
+func LookupVendorIE(eid uint16, typ IEType) (VendorIE, bool) {
+	vendorMu.RLock()
+	defer vendorMu.RUnlock()
+	v, ok := vendorIEs[vendorIEKey{eid, typ}]
+	return v, ok
+}
+
+// vendorIE returns the registered definition of the IE if it is vendor-specific.
+func (i *IE) vendorIE() (VendorIE, bool) {
+	if !i.IsVendorSpecific() {
+		return VendorIE{}, false
+	}
+	return LookupVendorIE(i.EnterpriseID, i.Type)
+}
+
+// TypeName returns the name of the IE type.
+//
+// For vendor-specific IEs registered with RegisterVendorIE, this returns the
+// registered name. Otherwise, this is the same as i.Type.String().
+func (i *IE) TypeName() string {
+	if v, ok := i.vendorIE(); ok && v.Name != "" {
+		return v.Name
+	}
+	return i.Type.String()
+}
+
+// VendorValue returns the value of the vendor-specific IE decoded by the
+// function registered with RegisterVendorIE.
+//
+// ErrInvalidType is returned if the IE is not registered or the registered one
+// has no decoder, and *InvalidTypeError if it is grouped.
+func (i *IE) VendorValue() (any, error) {
+	v, ok := i.vendorIE()
+	if !ok || v.Decode == nil {
+		return nil, fmt.Errorf("%w: no decoder registered for %s with EnterpriseID %d", ErrInvalidType, i.Type, i.EnterpriseID)
+	}
+	if v.Grouped {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return v.Decode(i.Payload)
+}
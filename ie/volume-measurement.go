@@ -21,34 +21,6 @@ func NewVolumeMeasurement(flags uint8, tvol, uvol, dvol, tpkt, upkt, dpkt uint64
 	return New(VolumeMeasurement, b)
 }
 
-// VolumeMeasurement returns VolumeMeasurement in structured format if the type of IE matches.
-func (i *IE) VolumeMeasurement() (*VolumeMeasurementFields, error) {
-	switch i.Type {
-	case VolumeMeasurement:
-		fields, err := ParseVolumeMeasurementFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return fields, nil
-	case UsageReportWithinSessionModificationResponse,
-		UsageReportWithinSessionDeletionResponse,
-		UsageReportWithinSessionReportRequest:
-		ies, err := i.UsageReport()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == VolumeMeasurement {
-				return x.VolumeMeasurement()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // VolumeMeasurementFields represents a fields contained in VolumeMeasurement IE.
 type VolumeMeasurementFields struct {
 	Flags                   uint8
@@ -21,43 +21,6 @@ func NewVolumeQuota(flags uint8, tvol, uvol, dvol uint64) *IE {
 	return New(VolumeQuota, b)
 }
 
-// VolumeQuota returns VolumeQuota in structured format if the type of IE matches.
-func (i *IE) VolumeQuota() (*VolumeQuotaFields, error) {
-	switch i.Type {
-	case VolumeQuota:
-		f, err := ParseVolumeQuotaFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return f, nil
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == VolumeQuota {
-				return x.VolumeQuota()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == VolumeQuota {
-				return x.VolumeQuota()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // VolumeQuotaFields represents a fields contained in VolumeQuota IE.
 type VolumeQuotaFields struct {
 	Flags          uint8
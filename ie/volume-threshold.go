@@ -21,43 +21,6 @@ func NewVolumeThreshold(flags uint8, tvol, uvol, dvol uint64) *IE {
 	return New(VolumeThreshold, b)
 }
 
-// VolumeThreshold returns VolumeThreshold in structured format if the type of IE matches.
-func (i *IE) VolumeThreshold() (*VolumeThresholdFields, error) {
-	switch i.Type {
-	case VolumeThreshold:
-		f, err := ParseVolumeThresholdFields(i.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		return f, nil
-	case CreateURR:
-		ies, err := i.CreateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == VolumeThreshold {
-				return x.VolumeThreshold()
-			}
-		}
-		return nil, ErrIENotFound
-	case UpdateURR:
-		ies, err := i.UpdateURR()
-		if err != nil {
-			return nil, err
-		}
-		for _, x := range ies {
-			if x.Type == VolumeThreshold {
-				return x.VolumeThreshold()
-			}
-		}
-		return nil, ErrIENotFound
-	default:
-		return nil, &InvalidTypeError{Type: i.Type}
-	}
-}
-
 // VolumeThresholdFields represents a fields contained in VolumeThreshold IE.
 type VolumeThresholdFields struct {
 	Flags          uint8
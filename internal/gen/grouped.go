@@ -0,0 +1,337 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// groupedSpec is the content of grouped.json.
+type groupedSpec struct {
+	// Grouped is the list of grouped IEs and their child IEs.
+	Grouped []*groupedIE `json:"grouped"`
+	// Codecs specifies how to handle the value of IE types whose accessor and
+	// constructor do not match. The value is one of "uint8", "uint16", "uint32",
+	// "uint64", "string" or "bytes".
+	Codecs map[string]string `json:"codecs"`
+}
+
+type groupedIE struct {
+	Type string     `json:"type"`
+	IEs  []*childIE `json:"ies"`
+}
+
+type childIE struct {
+	Type     string `json:"type"`
+	Presence string `json:"presence"`
+	Multiple bool   `json:"multiple"`
+	Name     string `json:"name"`
+
+	// set by resolve.
+	GoType    string
+	Decode    string
+	Encode    string
+	Grouped   bool
+	Mandatory bool
+	Nilable   bool
+}
+
+func loadJSON(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func generateGrouped(specDir, srcDir string) error {
+	var spec groupedSpec
+	if err := loadJSON(filepath.Join(specDir, "grouped.json"), &spec); err != nil {
+		return err
+	}
+
+	src, err := loadSource(srcDir, "grouped_fields_gen.go")
+	if err != nil {
+		return err
+	}
+
+	grouped := map[string]bool{}
+	for _, g := range spec.Grouped {
+		if grouped[g.Type] {
+			return fmt.Errorf("duplicate grouped IE: %s", g.Type)
+		}
+		grouped[g.Type] = true
+	}
+
+	var errs []error
+	imports := map[string]bool{}
+	for _, g := range spec.Grouped {
+		names := map[string]bool{}
+		for _, c := range g.IEs {
+			if err := c.resolve(src, grouped, spec.Codecs); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", g.Type, err))
+				continue
+			}
+			if names[c.Name] {
+				errs = append(errs, fmt.Errorf("%s: duplicate field name: %s", g.Type, c.Name))
+			}
+			names[c.Name] = true
+
+			for _, pkg := range []string{"net", "time"} {
+				if strings.Contains(c.GoType, pkg+".") {
+					imports[pkg] = true
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	var pkgs []string
+	for pkg := range imports {
+		pkgs = append(pkgs, pkg)
+	}
+	slices.Sort(pkgs)
+
+	var b bytes.Buffer
+	if err := groupedTmpl.Execute(&b, map[string]any{
+		"Imports": pkgs,
+		"Grouped": spec.Grouped,
+	}); err != nil {
+		return err
+	}
+	return writeGoFile(filepath.Join(srcDir, "grouped_fields_gen.go"), b.Bytes())
+}
+
+// resolve determines how to decode and encode the value of the child IE.
+func (c *childIE) resolve(src *source, grouped map[string]bool, codecs map[string]string) error {
+	switch c.Presence {
+	case "M":
+		c.Mandatory = true
+	case "", "O", "C":
+	default:
+		return fmt.Errorf("%s: invalid presence: %q", c.Type, c.Presence)
+	}
+
+	if c.Name == "" {
+		c.Name = c.Type
+		if c.Multiple {
+			c.Name = plural(c.Type)
+		}
+	}
+
+	switch codec := codecs[c.Type]; {
+	case grouped[c.Type]:
+		c.Grouped = true
+		c.GoType = "*" + c.Type + "Fields"
+		c.Encode = "%s.ToIE()"
+	case codec == "bytes":
+		c.GoType = "[]byte"
+		c.Decode = "%s.valueAsBytes()"
+		c.Encode = "New(" + c.Type + ", %s)"
+	case codec == "string":
+		c.GoType = "string"
+		c.Decode = "%s.ValueAsString()"
+		c.Encode = "newStringIE(" + c.Type + ", %s)"
+	case strings.HasPrefix(codec, "uint"):
+		c.GoType = codec
+		c.Decode = "%s.ValueAs" + strings.ToUpper(codec[:1]) + codec[1:] + "()"
+		c.Encode = "new" + strings.ToUpper(codec[:1]) + codec[1:] + "ValIE(" + c.Type + ", %s)"
+	case codec != "":
+		return fmt.Errorf("%s: unknown codec: %q", c.Type, codec)
+	default:
+		typ, ok := src.accessors[c.Type]
+		if !ok {
+			return fmt.Errorf("%s: no accessor found", c.Type)
+		}
+		c.GoType = typ
+		c.Decode = "%s." + c.Type + "()"
+
+		params := src.constructors["New"+c.Type]
+		switch {
+		case len(params) == 1 && params[0] == typ:
+			c.Encode = "New" + c.Type + "(%s)"
+		case strings.HasPrefix(typ, "*") && src.marshalers[typ]:
+			c.Encode = "newFieldsIE(" + c.Type + ", %s)"
+		case typ == "[]byte":
+			c.Encode = "New(" + c.Type + ", %s)"
+		default:
+			return fmt.Errorf("%s: cannot encode %s with New%s(%s), specify codec", c.Type, typ, c.Type, strings.Join(params, ", "))
+		}
+	}
+
+	c.Nilable = strings.HasPrefix(c.GoType, "*") || strings.HasPrefix(c.GoType, "[]") || c.GoType == "net.IP" || c.GoType == "net.HardwareAddr"
+	return nil
+}
+
+// plural returns the plural form of the type name used as a field name.
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ss"):
+		return s + "es"
+	case strings.HasSuffix(s, "s"):
+		return s
+	default:
+		return s + "s"
+	}
+}
+
+// FieldType returns the Go type of the field in the struct.
+func (c *childIE) FieldType() string {
+	switch {
+	case c.Multiple:
+		return "[]" + c.GoType
+	case c.Mandatory || c.Nilable:
+		return c.GoType
+	default:
+		return "*" + c.GoType
+	}
+}
+
+// DecodeExpr returns the expression to decode the IE named v.
+func (c *childIE) DecodeExpr(v string) string {
+	return fmt.Sprintf(c.Decode, v)
+}
+
+// EncodeExpr returns the expression to encode the value named v.
+func (c *childIE) EncodeExpr(v string) string {
+	return fmt.Sprintf(c.Encode, v)
+}
+
+// Deref reports whether the field is a pointer to the value.
+func (c *childIE) Deref() bool {
+	return !c.Multiple && !c.Mandatory && !c.Nilable
+}
+
+var groupedTmpl = template.Must(template.New("grouped").Parse(`// Code generated by internal/gen from spec/grouped.json; DO NOT EDIT.
+
+package ie
+{{if .Imports}}
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{end}}
+{{- range .Grouped}}{{$g := .}}
+// {{.Type}}Fields represents the fields in {{.Type}} IE.
+type {{.Type}}Fields struct {
+{{- range .IEs}}
+	{{.Name}} {{.FieldType}}
+{{- end}}
+
+	// IEs are the child IEs that are not represented by the fields above.
+	IEs []*IE
+}
+
+// Parse{{.Type}}Fields parses b into {{.Type}}Fields.
+func Parse{{.Type}}Fields(b []byte) (*{{.Type}}Fields, error) {
+	f := &{{.Type}}Fields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into {{.Type}}Fields.
+func (f *{{.Type}}Fields) UnmarshalBinary(b []byte) error {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return err
+	}
+	return f.unmarshalIEs(ies)
+}
+
+func (f *{{.Type}}Fields) unmarshalIEs(ies []*IE) error {
+{{- range .IEs}}{{if and .Mandatory (not .Multiple)}}
+	var has{{.Name}} bool
+{{- end}}{{end}}
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+
+		switch i.Type {
+{{- range .IEs}}
+		case {{.Type}}:
+{{- if .Grouped}}
+			v := &{{.Type}}Fields{}
+			if err := unmarshalGroupedFields(i, v); err != nil {
+				return &GroupedFieldError{Parent: {{$g.Type}}, Type: i.Type, Err: err}
+			}
+{{- else}}
+			v, err := {{.DecodeExpr "i"}}
+			if err != nil {
+				return &GroupedFieldError{Parent: {{$g.Type}}, Type: i.Type, Err: err}
+			}
+{{- end}}
+{{- if .Multiple}}
+			f.{{.Name}} = append(f.{{.Name}}, v)
+{{- else if .Deref}}
+			f.{{.Name}} = &v
+{{- else}}
+			f.{{.Name}} = v
+{{- end}}
+{{- if and .Mandatory (not .Multiple)}}
+			has{{.Name}} = true
+{{- end}}
+{{- end}}
+		default:
+			f.IEs = append(f.IEs, i)
+		}
+	}
+{{range .IEs}}{{if .Mandatory}}
+{{- if .Multiple}}
+	if len(f.{{.Name}}) == 0 {
+{{- else}}
+	if !has{{.Name}} {
+{{- end}}
+		return &GroupedFieldError{Parent: {{$g.Type}}, Type: {{.Type}}, Err: ErrIENotFound}
+	}
+{{- end}}{{end}}
+	return nil
+}
+
+// ToIE returns {{.Type}} IE built from the fields.
+func (f *{{.Type}}Fields) ToIE() *IE {
+	var ies []*IE
+{{- range .IEs}}
+{{- if .Multiple}}
+	for _, v := range f.{{.Name}} {
+		ies = append(ies, {{.EncodeExpr "v"}})
+	}
+{{- else if .Deref}}
+	if f.{{.Name}} != nil {
+		ies = append(ies, {{.EncodeExpr (printf "*f.%s" .Name)}})
+	}
+{{- else if .Nilable}}
+	if f.{{.Name}} != nil {
+		ies = append(ies, {{.EncodeExpr (printf "f.%s" .Name)}})
+	}
+{{- else}}
+	ies = append(ies, {{.EncodeExpr (printf "f.%s" .Name)}})
+{{- end}}
+{{- end}}
+	ies = append(ies, f.IEs...)
+
+	return newGroupedIE({{.Type}}, 0, ies...)
+}
+{{end}}`))
@@ -0,0 +1,401 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"text/template"
+)
+
+// iesSpec is the content of ies.json.
+type iesSpec struct {
+	IEs []*ieDef `json:"ies"`
+}
+
+// ieDef is the definition of an IE type.
+//
+// Encoding tells the generator how the value of IE is encoded. The constructor
+// and accessor of the IE are generated for the encodings listed in ieEncodings,
+// unless they are hand-written in the package. Encoding defaults to "grouped"
+// for the IEs in grouped.json.
+//
+// The accessor looks up the IE in the grouped IEs that have it as a child in
+// grouped.json. Lookups adds or overrides the parents for the IEs that are not
+// listed there or are found deeper in the parents.
+//
+// Accessor is set when the accessor is hand-written with another name that
+// covers several IE types, e.g., UsageReport() for UsageReportWithinSessionReportRequest.
+type ieDef struct {
+	Name     string      `json:"name"`
+	Type     uint16      `json:"type"`
+	Alias    bool        `json:"alias"`
+	Encoding string      `json:"encoding"`
+	Accessor string      `json:"accessor"`
+	Lookups  []*ieLookup `json:"lookups"`
+	Doc      string      `json:"doc"`
+
+	// set by generateIEs.
+	Parents     []string
+	GenNew      bool
+	GenAccessor bool
+}
+
+// ieLookup is the list of grouped IEs to look up the IE in. If Via is set, the
+// IE is looked up in the child IEs of those types instead of the parents.
+type ieLookup struct {
+	Parents []string `json:"parents"`
+	Via     []string `json:"via"`
+}
+
+// ieEncoding is how to encode and decode the value of IE.
+//
+// New is empty for the encodings whose constructors are always hand-written.
+// Sample is the value used in the generated tests, which are omitted if empty.
+type ieEncoding struct {
+	GoType string
+	New    string
+	Value  string
+	Zero   string
+	Sample string
+}
+
+var ieEncodings = map[string]*ieEncoding{
+	"uint8":     {"uint8", "newUint8ValIE", "ValueAsUint8", "0", "uint8(0x01)"},
+	"uint16":    {"uint16", "newUint16ValIE", "ValueAsUint16", "0", "uint16(0x1122)"},
+	"uint32":    {"uint32", "newUint32ValIE", "ValueAsUint32", "0", "uint32(0x11223344)"},
+	"uint64":    {"uint64", "newUint64ValIE", "ValueAsUint64", "0", "uint64(0x1122334455667788)"},
+	"string":    {"string", "newStringIE", "ValueAsString", `""`, `"go-pfcp"`},
+	"fqdn":      {"string", "newFQDNIE", "ValueAsFQDN", `""`, `"go-pfcp.epc.3gppnetwork.org"`},
+	"timestamp": {"time.Time", "new3GPPTimestampIE", "valueAs3GPPTimestamp", "time.Time{}", "time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)"},
+	"grouped":   {"[]*IE", "newGroupedIE", "ValueAsGrouped", "nil", ""},
+	"fields":    {"", "", "", "nil", ""},
+}
+
+// Enc returns the encoding of the IE.
+func (d *ieDef) Enc() *ieEncoding {
+	return ieEncodings[d.Encoding]
+}
+
+// GoType returns the Go type of the IE value.
+func (d *ieDef) GoType() string {
+	if d.Encoding == "fields" {
+		return "*" + d.Name + "Fields"
+	}
+	return d.Enc().GoType
+}
+
+// Tested reports whether the generated test covers the IE.
+func (d *ieDef) Tested() bool {
+	return d.GenNew && d.GenAccessor && d.Enc().Sample != ""
+}
+
+func generateIEs(specDir, srcDir string) error {
+	var spec iesSpec
+	if err := loadJSON(filepath.Join(specDir, "ies.json"), &spec); err != nil {
+		return err
+	}
+	var grouped groupedSpec
+	if err := loadJSON(filepath.Join(specDir, "grouped.json"), &grouped); err != nil {
+		return err
+	}
+
+	src, err := loadSource(srcDir, "ietype_gen.go", "ies_gen.go")
+	if err != nil {
+		return err
+	}
+
+	defs := map[string]*ieDef{}
+	types := map[uint16]string{}
+	for _, d := range spec.IEs {
+		if _, ok := defs[d.Name]; ok {
+			return fmt.Errorf("duplicate IE: %s", d.Name)
+		}
+		if n, ok := types[d.Type]; ok && !d.Alias {
+			return fmt.Errorf("duplicate type %d: %s and %s", d.Type, n, d.Name)
+		}
+		if d.Encoding != "" && ieEncodings[d.Encoding] == nil {
+			return fmt.Errorf("%s: unknown encoding: %q", d.Name, d.Encoding)
+		}
+		defs[d.Name] = d
+		if !d.Alias {
+			types[d.Type] = d.Name
+		}
+	}
+
+	var groupedTypes []string
+	for _, g := range grouped.Grouped {
+		d, ok := defs[g.Type]
+		if !ok {
+			return fmt.Errorf("grouped IE not found in ies.json: %s", g.Type)
+		}
+		switch d.Encoding {
+		case "":
+			d.Encoding = "grouped"
+		case "grouped":
+		default:
+			return fmt.Errorf("%s: grouped IE has encoding %q", d.Name, d.Encoding)
+		}
+		groupedTypes = append(groupedTypes, g.Type)
+
+		for _, c := range g.IEs {
+			cd, ok := defs[c.Type]
+			if !ok {
+				return fmt.Errorf("%s: child IE not found in ies.json: %s", g.Type, c.Type)
+			}
+			if !slices.Contains(cd.Parents, g.Type) {
+				cd.Parents = append(cd.Parents, g.Type)
+			}
+		}
+	}
+
+	var (
+		generated []*ieDef
+		hasTime   bool
+	)
+	for _, d := range spec.IEs {
+		for _, l := range d.Lookups {
+			for _, n := range slices.Concat(l.Parents, l.Via) {
+				if _, ok := defs[n]; !ok {
+					return fmt.Errorf("%s: IE in lookups not found in ies.json: %s", d.Name, n)
+				}
+			}
+			d.Parents = slices.DeleteFunc(d.Parents, func(p string) bool {
+				return slices.Contains(l.Parents, p)
+			})
+		}
+		if d.Encoding == "" || d.Alias {
+			continue
+		}
+
+		if d.Accessor != "" {
+			if _, ok := src.accessors[d.Accessor]; !ok {
+				return fmt.Errorf("%s: accessor not found: %s", d.Name, d.Accessor)
+			}
+		}
+		_, hasNew := src.constructors["New"+d.Name]
+		_, hasAccessor := src.accessors[d.Name]
+		d.GenNew = !hasNew && d.Enc().New != ""
+		d.GenAccessor = !hasAccessor && d.Accessor == ""
+		if !d.GenNew && !d.GenAccessor {
+			continue
+		}
+		if d.Encoding == "timestamp" {
+			hasTime = true
+		}
+		generated = append(generated, d)
+	}
+
+	data := map[string]any{
+		"IEs":       spec.IEs,
+		"Grouped":   groupedTypes,
+		"Generated": generated,
+		"HasTime":   hasTime,
+	}
+
+	var b bytes.Buffer
+	if err := ieTypesTmpl.Execute(&b, data); err != nil {
+		return err
+	}
+	if err := writeGoFile(filepath.Join(srcDir, "ietype_gen.go"), b.Bytes()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	if err := iesTmpl.Execute(&b, data); err != nil {
+		return err
+	}
+	if err := writeGoFile(filepath.Join(srcDir, "ies_gen.go"), b.Bytes()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	if err := iesTestTmpl.Execute(&b, data); err != nil {
+		return err
+	}
+	return writeGoFile(filepath.Join(srcDir, "ies_gen_test.go"), b.Bytes())
+}
+
+var ieTypesTmpl = template.Must(template.New("ietypes").Parse(`// Code generated by internal/gen from spec/ies.json; DO NOT EDIT.
+
+package ie
+
+import "strconv"
+
+// IE Type definitions.
+const (
+{{- range .IEs}}
+	{{.Name}} IEType = {{.Type}}
+{{- end}}
+)
+
+// String returns the name of IEType.
+func (t IEType) String() string {
+	switch t {
+{{- range .IEs}}{{if not .Alias}}
+	case {{.Name}}:
+		return "{{.Name}}"
+{{- end}}{{end}}
+	default:
+		return "IEType(" + strconv.FormatInt(int64(t), 10) + ")"
+	}
+}
+`))
+
+var iesTmpl = template.Must(template.New("ies").Parse(`// Code generated by internal/gen from spec/ies.json and spec/grouped.json; DO NOT EDIT.
+
+package ie
+{{if .HasTime}}
+import "time"
+{{end}}
+// defaultGroupedIETypes is the list of IE types that are grouped by default.
+var defaultGroupedIETypes = []IEType{
+{{- range .Grouped}}
+	{{.}},
+{{- end}}
+}
+{{range .Generated}}{{$d := .}}{{$e := .Enc}}{{$t := .GoType}}
+{{- if .GenNew}}
+// New{{.Name}} creates a new {{.Name}} IE.
+{{- if eq .Encoding "grouped"}}
+func New{{.Name}}(ies ...*IE) *IE {
+	return newGroupedIE({{.Name}}, 0, ies...)
+}
+{{- else}}
+func New{{.Name}}(v {{$t}}) *IE {
+	return {{$e.New}}({{.Name}}, v)
+}
+{{- end}}
+{{end}}
+{{- if .GenAccessor}}
+{{- if eq .Encoding "grouped"}}
+// {{.Name}} returns the IEs above {{.Name}} if the type of IE matches.
+{{- else if eq .Encoding "fields"}}
+// {{.Name}} returns {{.Name}} in structured format if the type of IE matches.
+{{- else}}
+// {{.Name}} returns {{.Name}} in {{$t}} if the type of IE matches.
+{{- end}}
+{{- if .Doc}}
+//
+// {{.Doc}}
+{{- end}}
+func (i *IE) {{.Name}}() ({{$t}}, error) {
+{{- if or .Parents .Lookups}}
+	switch i.Type {
+	case {{.Name}}:
+		{{template "value" .}}
+{{- if .Parents}}
+	case {{range $n, $p := .Parents}}{{if $n}},
+		{{end}}{{$p}}{{end}}:
+		ies, err := i.ValueAsGrouped()
+		if err != nil {
+			return {{$e.Zero}}, err
+		}
+		for _, x := range ies {
+			if x.Type == {{$d.Name}} {
+				return x.{{$d.Name}}()
+			}
+		}
+		return {{$e.Zero}}, ErrIENotFound
+{{- end}}
+{{- range .Lookups}}
+	case {{range $n, $p := .Parents}}{{if $n}},
+		{{end}}{{$p}}{{end}}:
+		ies, err := i.ValueAsGrouped()
+		if err != nil {
+			return {{$e.Zero}}, err
+		}
+		for _, x := range ies {
+{{- if not .Via}}
+			if x.Type == {{$d.Name}} {
+				return x.{{$d.Name}}()
+			}
+{{- else if eq (len .Via) 1}}
+			if x.Type == {{index .Via 0}} {
+				return x.{{$d.Name}}()
+			}
+{{- else}}
+			switch x.Type {
+			case {{range $n, $v := .Via}}{{if $n}}, {{end}}{{$v}}{{end}}:
+				return x.{{$d.Name}}()
+			}
+{{- end}}
+		}
+		return {{$e.Zero}}, ErrIENotFound
+{{- end}}
+	default:
+		return {{$e.Zero}}, &InvalidTypeError{Type: i.Type}
+	}
+{{- else}}
+	if i.Type != {{.Name}} {
+		return {{$e.Zero}}, &InvalidTypeError{Type: i.Type}
+	}
+
+	{{template "value" .}}
+{{- end}}
+}
+{{end}}
+{{- end}}
+{{- define "value"}}
+{{- if eq .Encoding "fields"}}f, err := Parse{{.Name}}Fields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+{{- else}}return i.{{.Enc.Value}}()
+{{- end}}
+{{- end}}`))
+
+var iesTestTmpl = template.Must(template.New("iestest").Parse(`// Code generated by internal/gen from spec/ies.json and spec/grouped.json; DO NOT EDIT.
+
+package ie_test
+
+import (
+	"testing"
+{{- if .HasTime}}
+	"time"
+{{- end}}
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestGeneratedIEs(t *testing.T) {
+{{- range .Generated}}{{if .Tested}}{{$d := .}}
+	t.Run("{{.Name}}", func(t *testing.T) {
+		want := {{.Enc.Sample}}
+
+		b, err := ie.New{{.Name}}(want).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := i.{{.Name}}()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+{{- range .Parents}}
+
+		got, err = ie.NewGroupedIE(ie.{{.}}, ie.New{{$d.Name}}(want)).{{$d.Name}}()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+{{- end}}
+	})
+{{- end}}{{end}}
+}
+`))
@@ -0,0 +1,55 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Command gen generates the Go code in go-pfcp from the specs in the spec directory.
+//
+// It is intended to be run with go generate in the package directory, e.g.,
+//
+//	//go:generate go run ../internal/gen -spec ../internal/gen/spec ies grouped typed
+//
+// The spec directory contains the definitions of IEs and messages in JSON, which
+// are taken from 3GPP TS 29.244. The Go types of the IE values are determined by
+// the accessors and constructors hand-written in the package.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var generators = map[string]func(specDir, srcDir string) error{
+	"grouped":  generateGrouped,
+	"ies":      generateIEs,
+	"messages": generateMessages,
+	"typed":    generateTyped,
+}
+
+func main() {
+	var (
+		specDir = flag.String("spec", "spec", "directory that contains the spec files")
+		srcDir  = flag.String("src", ".", "directory of the package to generate code in")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] target...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	for _, target := range flag.Args() {
+		gen, ok := generators[target]
+		if !ok {
+			log.Fatalf("unknown target: %s", target)
+		}
+		if err := gen(*specDir, *srcDir); err != nil {
+			log.Fatalf("failed to generate %s: %v", target, err)
+		}
+	}
+}
@@ -0,0 +1,503 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// messagesSpec is the content of messages.json.
+type messagesSpec struct {
+	Messages []*messageDef `json:"messages"`
+}
+
+// messageDef is the definition of a message.
+//
+// Session is true for the messages that have SEID in the header. Params is the
+// list of IEs that are given to the constructor as the positional parameters,
+// which are the same as the ones in the hand-written constructors before the
+// messages were generated.
+type messageDef struct {
+	Name    string        `json:"name"`
+	Type    uint8         `json:"type"`
+	Display string        `json:"display"`
+	Session bool          `json:"session"`
+	Params  []*messageArg `json:"params"`
+	IEs     []*messageIE  `json:"ies"`
+
+	// set by generateMessages.
+	Gap string
+}
+
+type messageArg struct {
+	Name  string `json:"name"`
+	Param string `json:"param"`
+}
+
+// messageIE is the IE in a message. Type is the IE type of the field, which is
+// the same as Name if omitted.
+type messageIE struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Multiple bool   `json:"multiple"`
+
+	// set by generateMessages.
+	Param   bool
+	Grouped bool
+}
+
+// IsRequest reports whether the message is a request.
+func (m *messageDef) IsRequest() bool {
+	return strings.HasSuffix(m.Name, "Request")
+}
+
+// Switched returns the IEs that are not given as the parameters of constructor.
+func (m *messageDef) Switched() []*messageIE {
+	var ies []*messageIE
+	for _, i := range m.IEs {
+		if !i.Param {
+			ies = append(ies, i)
+		}
+	}
+	return ies
+}
+
+// ParamList returns the parameters of constructor in the form of "a, b *ie.IE, ".
+func (m *messageDef) ParamList() string {
+	if len(m.Params) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(m.Params))
+	for n, p := range m.Params {
+		names[n] = p.Param
+	}
+	return strings.Join(names, ", ") + " *ie.IE, "
+}
+
+func generateMessages(specDir, srcDir string) error {
+	var spec messagesSpec
+	if err := loadJSON(filepath.Join(specDir, "messages.json"), &spec); err != nil {
+		return err
+	}
+	var ies iesSpec
+	if err := loadJSON(filepath.Join(specDir, "ies.json"), &ies); err != nil {
+		return err
+	}
+	var grouped groupedSpec
+	if err := loadJSON(filepath.Join(specDir, "grouped.json"), &grouped); err != nil {
+		return err
+	}
+
+	// aliases are grouped if the type they refer to is grouped.
+	types := map[string]uint16{}
+	for _, d := range ies.IEs {
+		types[d.Name] = d.Type
+	}
+	isGrouped := map[uint16]bool{}
+	for _, g := range grouped.Grouped {
+		isGrouped[types[g.Type]] = true
+	}
+
+	names := map[string]bool{}
+	var prev uint8
+	for _, m := range spec.Messages {
+		if names[m.Name] {
+			return fmt.Errorf("duplicate message: %s", m.Name)
+		}
+		names[m.Name] = true
+
+		if m.Type <= prev {
+			return fmt.Errorf("%s: messages must be sorted by type", m.Name)
+		}
+		if prev != 0 && m.Type > prev+1 {
+			m.Gap = fmt.Sprintf("%d to %d: For future use", prev+1, m.Type-1)
+		}
+		prev = m.Type
+
+		fields := map[string]*messageIE{}
+		fieldTypes := map[string]string{}
+		for _, i := range m.IEs {
+			if i.Type == "" {
+				i.Type = i.Name
+			}
+			typ, ok := types[i.Type]
+			if !ok {
+				return fmt.Errorf("%s: unknown IE type: %s", m.Name, i.Type)
+			}
+			if _, ok := fields[i.Name]; ok {
+				return fmt.Errorf("%s: duplicate field: %s", m.Name, i.Name)
+			}
+			if f, ok := fieldTypes[i.Type]; ok {
+				return fmt.Errorf("%s: %s and %s have the same type %s", m.Name, f, i.Name, i.Type)
+			}
+			fields[i.Name] = i
+			fieldTypes[i.Type] = i.Name
+			i.Grouped = isGrouped[typ]
+		}
+
+		for _, p := range m.Params {
+			i, ok := fields[p.Name]
+			if !ok {
+				return fmt.Errorf("%s: parameter %s is not a field", m.Name, p.Name)
+			}
+			if i.Multiple {
+				return fmt.Errorf("%s: parameter %s cannot be multiple", m.Name, p.Name)
+			}
+			i.Param = true
+		}
+		if m.Session && len(m.Params) != 0 {
+			return fmt.Errorf("%s: session message cannot have parameters", m.Name)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := messagesTmpl.Execute(&b, spec.Messages); err != nil {
+		return err
+	}
+	if err := writeGoFile(filepath.Join(srcDir, "messages_gen.go"), b.Bytes()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	if err := messagesTestTmpl.Execute(&b, spec.Messages); err != nil {
+		return err
+	}
+	return writeGoFile(filepath.Join(srcDir, "messages_gen_test.go"), b.Bytes())
+}
+
+var messagesTmpl = template.Must(template.New("messages").Parse(`// Code generated by internal/gen from spec/messages.json; DO NOT EDIT.
+
+package message
+
+import (
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+)
+
+// MessageType definitions.
+const (
+{{- range .}}
+{{- if .Gap}}
+
+	// {{.Gap}}
+{{end}}
+	MsgType{{.Name}} uint8 = {{.Type}}
+{{- end}}
+)
+
+// newMessage returns the empty Message of the given type.
+// Unknown types are handled by *Generic.
+func newMessage(typ uint8) Message {
+	switch typ {
+{{- range .}}
+	case MsgType{{.Name}}:
+		return &{{.Name}}{}
+{{- end}}
+	default:
+		logger.Logf("Parse() got an unknown type of message(Type=%d), parsing with *Generic.", typ)
+		return &Generic{}
+	}
+}
+{{range .}}
+// {{.Name}} is a {{.Name}} formed PFCP Header and its IEs above.
+type {{.Name}} struct {
+	*Header
+{{- range .IEs}}
+	{{.Name}} {{if .Multiple}}[]{{end}}*ie.IE
+{{- end}}
+	IEs []*ie.IE
+}
+
+// New{{.Name}} creates a new {{.Name}}.
+{{- if .Session}}
+func New{{.Name}}(mp, fo uint8, seid uint64, seq uint32, pri uint8, ies ...*ie.IE) *{{.Name}} {
+	m := &{{.Name}}{
+		Header: NewHeader(
+			1, fo, mp, 1,
+			MsgType{{.Name}}, seid, seq, pri,
+			nil,
+		),
+	}
+{{- else}}
+func New{{.Name}}(seq uint32, {{.ParamList}}ies ...*ie.IE) *{{.Name}} {
+	m := &{{.Name}}{
+		Header: NewHeader(
+			1, 0, 0, 0,
+			MsgType{{.Name}}, 0, seq, 0,
+			nil,
+		),
+{{- range .Params}}
+		{{.Name}}: {{.Param}},
+{{- end}}
+{{- if not .Switched}}
+		IEs: ies,
+{{- end}}
+	}
+{{- end}}
+{{- if .Switched}}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+{{- range .Switched}}
+		case ie.{{.Type}}:
+{{- if .Multiple}}
+			m.{{.Name}} = append(m.{{.Name}}, i)
+{{- else}}
+			m.{{.Name}} = i
+{{- end}}
+{{- end}}
+		default:
+			m.IEs = append(m.IEs, i)
+		}
+	}
+{{- end}}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a {{.Name}}.
+func (m *{{.Name}}) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *{{.Name}}) MarshalTo(b []byte) error {
+	m.Header.Payload = nil
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+{{- range .IEs}}
+{{- if .Multiple}}
+	for _, i := range m.{{.Name}} {
+		if i == nil {
+			continue
+		}
+		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+{{- else}}
+	if i := m.{{.Name}}; i != nil {
+		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+{{- end}}
+{{- end}}
+
+	for _, i := range m.IEs {
+		if i == nil {
+			continue
+		}
+		if err := i.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// Parse{{.Name}} decodes a given byte sequence as a {{.Name}}.
+func Parse{{.Name}}(b []byte) (*{{.Name}}, error) {
+	m := &{{.Name}}{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a {{.Name}}.
+func (m *{{.Name}}) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+{{- if .IEs}}
+		switch i.Type {
+{{- range .IEs}}
+		case ie.{{.Type}}:
+{{- if .Multiple}}
+			m.{{.Name}} = append(m.{{.Name}}, i)
+{{- else}}
+			m.{{.Name}} = i
+{{- end}}
+{{- end}}
+		default:
+			m.IEs = append(m.IEs, i)
+		}
+{{- else}}
+		m.IEs = append(m.IEs, i)
+{{- end}}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *{{.Name}}) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+{{- range .IEs}}
+{{- if .Multiple}}
+	for _, i := range m.{{.Name}} {
+		if i == nil {
+			continue
+		}
+		l += i.MarshalLen()
+	}
+{{- else}}
+	if i := m.{{.Name}}; i != nil {
+		l += i.MarshalLen()
+	}
+{{- end}}
+{{- end}}
+
+	for _, i := range m.IEs {
+		if i == nil {
+			continue
+		}
+		l += i.MarshalLen()
+	}
+
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *{{.Name}}) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *{{.Name}}) MessageTypeName() string {
+	return "{{.Display}}"
+}
+
+// SEID returns the SEID in uint64.
+func (m *{{.Name}}) SEID() uint64 {
+	return m.Header.seid()
+}
+
+// IsRequest reports whether the message is a request.
+func (m *{{.Name}}) IsRequest() bool {
+	return {{.IsRequest}}
+}
+
+// MarshalJSON returns the JSON encoding of a {{.Name}}.
+func (m *{{.Name}}) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a {{.Name}}.
+func (m *{{.Name}}) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, m, MsgType{{.Name}})
+}
+
+// String returns the {{.Name}} in human-readable form.
+func (m *{{.Name}}) String() string {
+	return Format(m)
+}
+{{end}}`))
+
+var messagesTestTmpl = template.Must(template.New("messagestest").Parse(`// Code generated by internal/gen from spec/messages.json; DO NOT EDIT.
+
+package message_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// TestGeneratedMessages checks if all the IEs defined in the spec are set to the
+// fields of the message, and the message can be decoded into the same bytes.
+func TestGeneratedMessages(t *testing.T) {
+{{- range .}}
+	t.Run("{{.Name}}", func(t *testing.T) {
+{{- if .Session}}
+		m := message.New{{.Name}}(
+			1, 0, 0x1122334455667788, 1, 0,
+{{- else}}
+		m := message.New{{.Name}}(
+			1,
+{{- range .Params}}
+			nil,
+{{- end}}
+{{- end}}
+		)
+{{- range .IEs}}
+{{- if .Multiple}}
+		m.{{.Name}} = []*ie.IE{ {{- template "ie" .}}, {{template "ie" .}} }
+{{- else}}
+		m.{{.Name}} = {{template "ie" .}}
+{{- end}}
+{{- end}}
+		m.SetLength()
+
+		b, err := m.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := message.Parse{{.Name}}(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+{{- range .IEs}}
+{{- if .Multiple}}
+		if n := len(got.{{.Name}}); n != 2 {
+			t.Errorf("got %d {{.Name}}, want 2", n)
+		}
+{{- else}}
+		if got.{{.Name}} == nil || got.{{.Name}}.Type != ie.{{.Type}} {
+			t.Errorf("{{.Name}} not decoded: %v", got.{{.Name}})
+		}
+{{- end}}
+{{- end}}
+		if len(got.IEs) != 0 {
+			t.Errorf("unexpected IEs: %v", got.IEs)
+		}
+
+		b2, err := got.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b, b2) {
+			t.Errorf("bytes mismatch:\n got: %x\nwant: %x", b2, b)
+		}
+	})
+{{- end}}
+}
+{{define "ie"}}{{if .Grouped}}ie.NewGroupedIE(ie.{{.Type}}){{else}}ie.New(ie.{{.Type}}, []byte{0x01}){{end}}{{end}}
+`))
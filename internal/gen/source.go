@@ -0,0 +1,116 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// source is the set of declarations found in the hand-written Go files.
+type source struct {
+	// accessors maps the name of method on *IE to its result type,
+	// for the methods that return (T, error).
+	accessors map[string]string
+	// constructors maps the name of New* function to its parameter types.
+	constructors map[string][]string
+	// marshalers is the set of types that have Marshal() ([]byte, error).
+	marshalers map[string]bool
+}
+
+// loadSource parses the Go files in dir except the tests and the given files,
+// which are usually the ones to be generated.
+func loadSource(dir string, skip ...string) (*source, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &source{
+		accessors:    map[string]string{},
+		constructors: map[string][]string{},
+		marshalers:   map[string]bool{},
+	}
+	fset := token.NewFileSet()
+	for _, f := range files {
+		if strings.HasSuffix(f, "_test.go") || slices.Contains(skip, filepath.Base(f)) {
+			continue
+		}
+
+		af, err := parser.ParseFile(fset, f, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range af.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			s.addFunc(fset, fd)
+		}
+	}
+	return s, nil
+}
+
+func (s *source) addFunc(fset *token.FileSet, fd *ast.FuncDecl) {
+	if fd.Recv == nil {
+		if !strings.HasPrefix(fd.Name.Name, "New") {
+			return
+		}
+
+		var params []string
+		for _, p := range fd.Type.Params.List {
+			t := exprString(fset, p.Type)
+			n := len(p.Names)
+			if n == 0 {
+				n = 1
+			}
+			for range n {
+				params = append(params, t)
+			}
+		}
+		s.constructors[fd.Name.Name] = params
+		return
+	}
+
+	recv := exprString(fset, fd.Recv.List[0].Type)
+	results := fd.Type.Results
+	if results == nil || len(results.List) != 2 || exprString(fset, results.List[1].Type) != "error" {
+		return
+	}
+	result := exprString(fset, results.List[0].Type)
+
+	switch {
+	case recv == "*IE" && fd.Type.Params.NumFields() == 0:
+		s.accessors[fd.Name.Name] = result
+	case fd.Name.Name == "Marshal" && result == "[]byte":
+		s.marshalers[recv] = true
+	}
+}
+
+func exprString(fset *token.FileSet, e ast.Expr) string {
+	var b bytes.Buffer
+	if err := format.Node(&b, fset, e); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
+// writeGoFile formats src and writes it to path.
+func writeGoFile(path string, src []byte) error {
+	b, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
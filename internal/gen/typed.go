@@ -0,0 +1,126 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// typedIE is the descriptor of IE generated in typed_gen.go.
+type typedIE struct {
+	Name   string
+	GoType string
+	Decode string
+	Encode string
+}
+
+// generateTyped generates the Typed descriptors for the IE types whose value
+// can be decoded and encoded with the accessor and constructor in the package.
+//
+// The Go type and the way to handle it are determined in the same way as the
+// fields of grouped IEs. The types that cannot be handled, e.g., the ones whose
+// constructor takes the fields in separate parameters without Marshal on the
+// fields struct, are just skipped.
+func generateTyped(specDir, srcDir string) error {
+	var spec iesSpec
+	if err := loadJSON(filepath.Join(specDir, "ies.json"), &spec); err != nil {
+		return err
+	}
+	var grouped groupedSpec
+	if err := loadJSON(filepath.Join(specDir, "grouped.json"), &grouped); err != nil {
+		return err
+	}
+
+	src, err := loadSource(srcDir, "typed_gen.go")
+	if err != nil {
+		return err
+	}
+
+	isGrouped := map[string]bool{}
+	for _, g := range grouped.Grouped {
+		isGrouped[g.Type] = true
+	}
+
+	var typed []*typedIE
+	imports := map[string]bool{}
+	for _, d := range spec.IEs {
+		if d.Alias {
+			continue
+		}
+
+		if isGrouped[d.Name] {
+			typed = append(typed, &typedIE{
+				Name:   d.Name,
+				GoType: "*" + d.Name + "Fields",
+				Decode: "decodeFields[" + d.Name + "Fields]",
+				Encode: "(*" + d.Name + "Fields).ToIE",
+			})
+			continue
+		}
+
+		c := &childIE{Type: d.Name}
+		if err := c.resolve(src, isGrouped, grouped.Codecs); err != nil {
+			continue
+		}
+
+		t := &typedIE{Name: d.Name, GoType: c.GoType}
+		// the method is used as it is if the value is returned without conversion.
+		if dec := c.DecodeExpr("i"); strings.HasPrefix(dec, "i.") && strings.Count(dec, "(") == 1 && strings.HasSuffix(dec, "()") {
+			t.Decode = "(*IE)." + strings.TrimSuffix(dec[2:], "()")
+		} else {
+			t.Decode = "func(i *IE) (" + c.GoType + ", error) { return " + dec + " }"
+		}
+		if enc := c.EncodeExpr("v"); enc == "New"+d.Name+"(v)" {
+			t.Encode = "New" + d.Name
+		} else {
+			t.Encode = "func(v " + c.GoType + ") *IE { return " + enc + " }"
+		}
+		typed = append(typed, t)
+
+		for _, pkg := range []string{"net", "time"} {
+			if strings.Contains(c.GoType, pkg+".") {
+				imports[pkg] = true
+			}
+		}
+	}
+
+	var pkgs []string
+	for pkg := range imports {
+		pkgs = append(pkgs, pkg)
+	}
+	slices.Sort(pkgs)
+
+	var b bytes.Buffer
+	if err := typedTmpl.Execute(&b, map[string]any{
+		"Imports": pkgs,
+		"Typed":   typed,
+	}); err != nil {
+		return err
+	}
+	return writeGoFile(filepath.Join(srcDir, "typed_gen.go"), b.Bytes())
+}
+
+var typedTmpl = template.Must(template.New("typed").Parse(`// Code generated by internal/gen from spec/ies.json and spec/grouped.json; DO NOT EDIT.
+
+package ie
+{{if .Imports}}
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{end}}
+// Typed descriptors of IE types.
+var (
+{{- range .Typed}}
+	// {{.Name}}Type binds {{.Name}} IE to {{.GoType}}.
+	{{.Name}}Type = Typed[{{.GoType}}]{Type: {{.Name}}, decode: {{.Decode}}, encode: {{.Encode}}}
+{{- end}}
+)
+`))
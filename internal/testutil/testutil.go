@@ -101,6 +101,10 @@ func Run(t *testing.T, cases []TestCase, decode ParseFunc) {
 				if got, want := decoded.IsRequest(), c.Structured.(message.Message).IsRequest(); got != want {
 					t.Fatalf("got %v want %v", got, want)
 				}
+
+				if _, err := message.ParseStrict(c.Serialized); err != nil {
+					t.Errorf("valid message rejected: %v", err)
+				}
 			})
 		})
 	}
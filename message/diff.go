@@ -0,0 +1,47 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"fmt"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Diff compares two messages and returns the differences found in them.
+//
+// The differences in the header are reported with the Path "Header/<field name>",
+// and the ones in the IEs are found by ie.DiffIEs. See ie.Difference for the
+// details. It returns nil if there is no difference.
+func Diff(a, b Message) ([]*ie.Difference, error) {
+	ga, err := toGeneric(a)
+	if err != nil {
+		return nil, err
+	}
+	gb, err := toGeneric(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []*ie.Difference
+	header := func(name string, x, y any) {
+		o, n := fmt.Sprint(x), fmt.Sprint(y)
+		if o == n {
+			return
+		}
+		diffs = append(diffs, &ie.Difference{Kind: ie.DiffChanged, Path: "Header/" + name, Old: o, New: n})
+	}
+
+	header("Version", ga.Version(), gb.Version())
+	header("Type", a.MessageTypeName(), b.MessageTypeName())
+	header("FO", ga.HasFO(), gb.HasFO())
+	header("MP", ga.HasMP(), gb.HasMP())
+	header("S", ga.HasSEID(), gb.HasSEID())
+	header("SEID", fmt.Sprintf("%#016x", ga.SEID()), fmt.Sprintf("%#016x", gb.SEID()))
+	header("SequenceNumber", fmt.Sprintf("%#x", ga.SequenceNumber), fmt.Sprintf("%#x", gb.SequenceNumber))
+	header("MessagePriority", ga.MP(), gb.MP())
+
+	return append(diffs, ie.DiffIEs(ga.IEs, gb.IEs)...), nil
+}
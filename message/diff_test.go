@@ -0,0 +1,70 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestDiff(t *testing.T) {
+	a := message.NewSessionModificationRequest(0, 0, 0x1111111122222222, 1, 0,
+		ie.NewUpdateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x04),
+		),
+		ie.NewCreatePDR(
+			ie.NewPDRID(2),
+			ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)),
+		),
+	)
+	b := message.NewSessionModificationRequest(0, 0, 0x1111111122222223, 2, 0,
+		ie.NewUpdateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+			ie.NewUpdateForwardingParameters(
+				ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.1", "", 0, 0, 0),
+			),
+		),
+		ie.NewCreatePDR(
+			ie.NewPDRID(2),
+			ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)),
+		),
+		ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+	)
+
+	got, err := message.Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var lines []string
+	for _, d := range got {
+		lines = append(lines, d.String())
+	}
+
+	want := []string{
+		"~ Header/SEID: 0x1111111122222222 -> 0x1111111122222223",
+		"~ Header/SequenceNumber: 0x1 -> 0x2",
+		"~ UpdateFAR[FARID=1]/ApplyAction: BUFF -> FORW",
+		"+ UpdateFAR[FARID=1]/UpdateForwardingParameters: {OuterHeaderCreation: OuterHeaderCreationDescription=256 TEID=286331153 IPv4Address=127.0.0.1}",
+		"+ FSEID: SEID=0x1111111122222222 v4=127.0.0.1",
+	}
+	if diff := cmp.Diff(lines, want); diff != "" {
+		t.Error(diff)
+	}
+
+	none, err := message.Diff(a, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(none) != 0 {
+		t.Errorf("expected no difference, got %v", none)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format returns a Message in human-readable form.
+//
+// The header fields are shown first, followed by the IEs formatted as an indented
+// tree by (*ie.IE).String() in the order they appear on the wire.
+func Format(m Message) string {
+	g, err := toGeneric(m)
+	if err != nil {
+		return fmt.Sprintf("%s: <malformed: %v>", m.MessageTypeName(), err)
+	}
+
+	s := &strings.Builder{}
+	fmt.Fprintf(s, "%s (%d)\n", m.MessageTypeName(), g.Type)
+	fmt.Fprintf(s, "  Version: %d\n", g.Version())
+	fmt.Fprintf(s, "  Flags: FO=%t MP=%t S=%t\n", g.HasFO(), g.HasMP(), g.HasSEID())
+	fmt.Fprintf(s, "  Length: %d\n", g.Length)
+	if g.HasSEID() {
+		fmt.Fprintf(s, "  SEID: %#016x\n", g.SEID())
+	}
+	fmt.Fprintf(s, "  Sequence Number: %#x\n", g.SequenceNumber)
+	if g.HasMP() {
+		fmt.Fprintf(s, "  Message Priority: %d\n", g.MP())
+	}
+
+	if len(g.IEs) == 0 {
+		s.WriteString("  IEs: (none)")
+		return s.String()
+	}
+
+	s.WriteString("  IEs:")
+	for _, i := range g.IEs {
+		for _, line := range strings.Split(i.String(), "\n") {
+			s.WriteString("\n    ")
+			s.WriteString(line)
+		}
+	}
+	return s.String()
+}
+
+// String returns the Generic in human-readable form.
+func (m *Generic) String() string {
+	return Format(m)
+}
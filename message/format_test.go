@@ -0,0 +1,61 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestFormat(t *testing.T) {
+	m := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+		ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+		ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				ie.NewUEIPAddress(0x02, "10.0.0.1", "", 0, 0),
+			),
+			ie.NewFARID(1),
+		),
+		ie.NewCreateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+		),
+		ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	want := `Session Establishment Request (50)
+  Version: 1
+  Flags: FO=false MP=false S=true
+  Length: 136
+  SEID: 0x1122334455667788
+  Sequence Number: 0x112233
+  IEs:
+    NodeID: "go-pfcp.epc.3gppnetwork.org"
+    FSEID: SEID=0x1111111122222222 v4=127.0.0.1
+    CreatePDR:
+      PDRID: 1
+      PDI:
+        SourceInterface: Access (0)
+        FTEID: TEID=0x11111111 v4=127.0.0.1
+        UEIPAddress: S v4=10.0.0.1
+      FARID: 1
+    CreateFAR:
+      FARID: 1
+      ApplyAction: FORW
+    RecoveryTimeStamp: 2019-01-01T00:00:00Z`
+
+	if diff := cmp.Diff(m.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// messageJSON is the JSON representation of a message.
+type messageJSON struct {
+	Type            string   `json:"type"`
+	TypeID          uint8    `json:"type_id"`
+	Version         int      `json:"version"`
+	FO              bool     `json:"fo,omitempty"`
+	SEID            *uint64  `json:"seid,omitempty"`
+	SequenceNumber  uint32   `json:"sequence_number"`
+	MessagePriority *uint8   `json:"message_priority,omitempty"`
+	IEs             []*ie.IE `json:"ies"`
+}
+
+// MarshalJSON returns the JSON encoding of a Message.
+//
+// The header fields are shown as named fields and the IEs are encoded with
+// (*ie.IE).MarshalJSON in the order they appear on the wire. SEID and MessagePriority
+// are present only when the corresponding flags are set in the header.
+func MarshalJSON(m Message) ([]byte, error) {
+	g, err := toGeneric(m)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &messageJSON{
+		Type:           m.MessageTypeName(),
+		TypeID:         g.Type,
+		Version:        g.Version(),
+		FO:             g.HasFO(),
+		SequenceNumber: g.SequenceNumber,
+		IEs:            g.IEs,
+	}
+	if v.IEs == nil {
+		v.IEs = []*ie.IE{}
+	}
+	if g.HasSEID() {
+		seid := g.SEID()
+		v.SEID = &seid
+	}
+	if g.HasMP() {
+		mp := g.MP()
+		v.MessagePriority = &mp
+	}
+
+	return json.Marshal(v)
+}
+
+// ParseJSON decodes the JSON encoding of a message generated by MarshalJSON into
+// the Message of the type specified by "type_id".
+func ParseJSON(b []byte) (Message, error) {
+	raw, err := jsonToBinary(b, 0)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(raw)
+}
+
+// unmarshalJSON decodes b into m. If typ is not zero, the type in b must match it.
+func unmarshalJSON(b []byte, m Message, typ uint8) error {
+	raw, err := jsonToBinary(b, typ)
+	if err != nil {
+		return err
+	}
+	return m.UnmarshalBinary(raw)
+}
+
+// jsonToBinary converts the JSON encoding of a message into the binary form.
+func jsonToBinary(b []byte, typ uint8) ([]byte, error) {
+	var v messageJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	if typ != 0 && v.TypeID != typ {
+		return nil, fmt.Errorf("got message type %d, expected %d", v.TypeID, typ)
+	}
+
+	var (
+		fo, mp, s uint8
+		seid      uint64
+		pri       uint8
+	)
+	if v.FO {
+		fo = 1
+	}
+	if v.SEID != nil {
+		s = 1
+		seid = *v.SEID
+	}
+	if v.MessagePriority != nil {
+		mp = 1
+		pri = *v.MessagePriority
+	}
+
+	ver := v.Version
+	if ver == 0 {
+		ver = 1
+	}
+
+	g := &Generic{
+		Header: NewHeader(uint8(ver), fo, mp, s, v.TypeID, seid, v.SequenceNumber, (pri<<4)&0xf0, nil),
+		IEs:    v.IEs,
+	}
+	g.SetLength()
+
+	return g.Marshal()
+}
+
+// MarshalJSON returns the JSON encoding of a Generic.
+func (m *Generic) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a Generic.
+// Any type of message is accepted.
+func (m *Generic) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, m, 0)
+}
@@ -0,0 +1,135 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  message.Message
+		decoded     message.Message
+	}{
+		{
+			"HeartbeatRequest",
+			message.NewHeartbeatRequest(seq,
+				ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				ie.NewSourceIPAddress(net.ParseIP("127.0.0.1"), nil, 0),
+			),
+			&message.HeartbeatRequest{},
+		}, {
+			"SessionEstablishmentRequest",
+			message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+				ie.NewCreatePDR(
+					ie.NewPDRID(1),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceAccess),
+						ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+					),
+					ie.NewFARID(1),
+				),
+				ie.NewCreateFAR(
+					ie.NewFARID(1),
+					ie.NewApplyAction(0x02),
+				),
+				ie.NewVendorSpecificIE(0xffff, 10415, []byte{0xde, 0xad, 0xbe, 0xef}),
+			),
+			&message.SessionEstablishmentRequest{},
+		}, {
+			"Generic",
+			message.NewGeneric(0xff, seid, seq, ie.NewCause(ie.CauseRequestAccepted)),
+			&message.Generic{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			want := make([]byte, c.structured.MarshalLen())
+			if err := c.structured.MarshalTo(want); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := json.Marshal(c.structured)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			t.Run("Unmarshal", func(t *testing.T) {
+				if err := json.Unmarshal(b, c.decoded); err != nil {
+					t.Fatal(err)
+				}
+
+				got := make([]byte, c.decoded.MarshalLen())
+				if err := c.decoded.MarshalTo(got); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Error(diff)
+				}
+			})
+
+			t.Run("ParseJSON", func(t *testing.T) {
+				m, err := message.ParseJSON(b)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got, want := m.MessageTypeName(), c.structured.MessageTypeName(); got != want {
+					t.Errorf("got %s, want %s", got, want)
+				}
+			})
+		})
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	m := message.NewSessionEstablishmentRequest(mp, fo, seid, seq, pri,
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPDI(
+				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+			),
+		),
+	)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"type":"Session Establishment Request","type_id":50,"version":1,` +
+		`"seid":1234605616436508552,"sequence_number":1122867,"ies":[` +
+		`{"type":"CreatePDR","type_id":1,"ies":[` +
+		`{"type":"PDRID","type_id":56,"value":1,"payload":"0001"},` +
+		`{"type":"PDI","type_id":2,"ies":[` +
+		`{"type":"FTEID","type_id":21,"value":{"Flags":1,"TEID":286331153,"IPv4Address":"127.0.0.1","ChooseID":0},"payload":"01111111117f000001"}` +
+		`]}]}]}`
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestJSONTypeMismatch(t *testing.T) {
+	b, err := json.Marshal(message.NewHeartbeatRequest(seq, nil, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(b, &message.HeartbeatResponse{}); err == nil {
+		t.Error("expected error when decoding Heartbeat Request as Heartbeat Response")
+	}
+}
@@ -4,43 +4,9 @@
 
 package message
 
-import (
-	"io"
+//go:generate go run ../internal/gen -spec ../internal/gen/spec messages
 
-	"github.com/wmnsk/go-pfcp/internal/logger"
-)
-
-// MessageType definitions.
-const (
-	MsgTypeHeartbeatRequest            uint8 = 1
-	MsgTypeHeartbeatResponse           uint8 = 2
-	MsgTypePFDManagementRequest        uint8 = 3
-	MsgTypePFDManagementResponse       uint8 = 4
-	MsgTypeAssociationSetupRequest     uint8 = 5
-	MsgTypeAssociationSetupResponse    uint8 = 6
-	MsgTypeAssociationUpdateRequest    uint8 = 7
-	MsgTypeAssociationUpdateResponse   uint8 = 8
-	MsgTypeAssociationReleaseRequest   uint8 = 9
-	MsgTypeAssociationReleaseResponse  uint8 = 10
-	MsgTypeVersionNotSupportedResponse uint8 = 11
-	MsgTypeNodeReportRequest           uint8 = 12
-	MsgTypeNodeReportResponse          uint8 = 13
-	MsgTypeSessionSetDeletionRequest   uint8 = 14
-	MsgTypeSessionSetDeletionResponse  uint8 = 15
-
-	// 16 to 49: For future use
-
-	MsgTypeSessionEstablishmentRequest  uint8 = 50
-	MsgTypeSessionEstablishmentResponse uint8 = 51
-	MsgTypeSessionModificationRequest   uint8 = 52
-	MsgTypeSessionModificationResponse  uint8 = 53
-	MsgTypeSessionDeletionRequest       uint8 = 54
-	MsgTypeSessionDeletionResponse      uint8 = 55
-	MsgTypeSessionReportRequest         uint8 = 56
-	MsgTypeSessionReportResponse        uint8 = 57
-
-	// 58 to 99: for future use
-)
+import "io"
 
 // Message is an interface that defines PFCP messages.
 type Message interface {
@@ -62,61 +28,37 @@ func Parse(b []byte) (Message, error) {
 		return nil, io.ErrUnexpectedEOF
 	}
 
-	var m Message
-	switch b[1] {
-	case MsgTypeHeartbeatRequest:
-		m = &HeartbeatRequest{}
-	case MsgTypeHeartbeatResponse:
-		m = &HeartbeatResponse{}
-	case MsgTypePFDManagementRequest:
-		m = &PFDManagementRequest{}
-	case MsgTypePFDManagementResponse:
-		m = &PFDManagementResponse{}
-	case MsgTypeAssociationSetupRequest:
-		m = &AssociationSetupRequest{}
-	case MsgTypeAssociationSetupResponse:
-		m = &AssociationSetupResponse{}
-	case MsgTypeAssociationUpdateRequest:
-		m = &AssociationUpdateRequest{}
-	case MsgTypeAssociationUpdateResponse:
-		m = &AssociationUpdateResponse{}
-	case MsgTypeAssociationReleaseRequest:
-		m = &AssociationReleaseRequest{}
-	case MsgTypeAssociationReleaseResponse:
-		m = &AssociationReleaseResponse{}
-	case MsgTypeVersionNotSupportedResponse:
-		m = &VersionNotSupportedResponse{}
-	case MsgTypeNodeReportRequest:
-		m = &NodeReportRequest{}
-	case MsgTypeNodeReportResponse:
-		m = &NodeReportResponse{}
-	case MsgTypeSessionSetDeletionRequest:
-		m = &SessionSetDeletionRequest{}
-	case MsgTypeSessionSetDeletionResponse:
-		m = &SessionSetDeletionResponse{}
-	case MsgTypeSessionEstablishmentRequest:
-		m = &SessionEstablishmentRequest{}
-	case MsgTypeSessionEstablishmentResponse:
-		m = &SessionEstablishmentResponse{}
-	case MsgTypeSessionModificationRequest:
-		m = &SessionModificationRequest{}
-	case MsgTypeSessionModificationResponse:
-		m = &SessionModificationResponse{}
-	case MsgTypeSessionDeletionRequest:
-		m = &SessionDeletionRequest{}
-	case MsgTypeSessionDeletionResponse:
-		m = &SessionDeletionResponse{}
-	case MsgTypeSessionReportRequest:
-		m = &SessionReportRequest{}
-	case MsgTypeSessionReportResponse:
-		m = &SessionReportResponse{}
-	default:
-		logger.Logf("Parse() got an unknown type of message(Type=%d), parsing with *Generic.", b[1])
-		m = &Generic{}
-	}
-
+	m := newMessage(b[1])
 	if err := m.UnmarshalBinary(b); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
+
+// ParseStrict parses the given bytes as Message, and validates all the IEs in
+// it with (*ie.IE).Validate.
+//
+// The error returned when any of the IEs is invalid is *ie.ValidationError,
+// which has the Cause value to be sent back to the peer in the response.
+func ParseStrict(b []byte) (Message, error) {
+	g, err := ParseGeneric(b)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range g.IEs {
+		if err := i.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return Parse(b)
+}
+
+// toGeneric converts any Message into Generic to access the header and IEs in
+// the order they appear on the wire.
+func toGeneric(m Message) (*Generic, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return ParseGeneric(b)
+}
@@ -4,7 +4,14 @@
 
 package message_test
 
-import "net"
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
 
 var (
 	mac1, _         = net.ParseMAC("12:34:56:78:90:01")
@@ -19,3 +26,19 @@ var (
 	seq  uint32 = 0x112233           // Sequence Number
 	pri  uint8  = 0                  // Message Priority
 )
+
+func TestParseStrict(t *testing.T) {
+	b, err := message.NewHeartbeatRequest(seq, ie.New(ie.RecoveryTimeStamp, []byte{0x01, 0x02, 0x03}), nil).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := message.Parse(b); err != nil {
+		t.Fatalf("Parse should not validate: %v", err)
+	}
+
+	_, err = message.ParseStrict(b)
+	var ve *ie.ValidationError
+	if !errors.As(err, &ve) || ve.Cause != ie.CauseInvalidLength {
+		t.Fatalf("got %v, want ValidationError with CauseInvalidLength", err)
+	}
+}
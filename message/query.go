@@ -0,0 +1,88 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"reflect"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Walk visits all the IEs in the message and their descendants in depth-first
+// order, calling fn for each of them. See (*ie.IE).Walk for the details.
+//
+// Unlike Diff and Format, the IEs passed to fn are the ones held by the message,
+// not the copies of them. The top-level IEs are visited in the order of the
+// fields of the message struct, followed by the ones in the IEs field.
+func Walk(m Message, fn ie.WalkFunc) error {
+	return ie.WalkIEs(messageIEs(m), fn)
+}
+
+// FindAll returns all the IEs that have the given type in the message, at any
+// depth. See ie.FindAllIEs for the details.
+func FindAll(m Message, typ ie.IEType) []*ie.IE {
+	return ie.FindAllIEs(messageIEs(m), typ)
+}
+
+// Query returns the IEs in the message that match the path expression q, which
+// is evaluated starting with the top-level IEs. See (*ie.IE).Query for the syntax.
+//
+//	fteids, err := message.Query(msg, "CreatePDR[PDRID=3]/PDI/FTEID")
+func Query(m Message, q string) ([]*ie.IE, error) {
+	return ie.QueryIEs(messageIEs(m), q)
+}
+
+// Update calls fn with each of the IEs in the message that match the path
+// expression q, and then updates Payload and Length of the IEs that contain
+// them. See (*ie.IE).Update for the details.
+//
+// The message can be marshaled and sent as it is after the modification.
+func Update(m Message, q string, fn func(target *ie.IE) error) error {
+	return ie.UpdateIEs(messageIEs(m), q, fn)
+}
+
+// Collect returns the values of all the IEs of the type described by t in the
+// message, at any depth. See (ie.Typed).Collect for the details.
+//
+//	teids, err := message.Collect(msg, ie.FTEIDType) // teids is []*ie.FTEIDFields
+func Collect[T any](m Message, t ie.Typed[T]) ([]T, error) {
+	return t.Collect(messageIEs(m))
+}
+
+var (
+	ieType      = reflect.TypeFor[*ie.IE]()
+	ieSliceType = reflect.TypeFor[[]*ie.IE]()
+)
+
+// messageIEs returns the top-level IEs held by the message.
+func messageIEs(m Message) []*ie.IE {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	v = v.Elem()
+
+	var ies []*ie.IE
+	for n := 0; n < v.NumField(); n++ {
+		if !v.Type().Field(n).IsExported() {
+			continue
+		}
+
+		f := v.Field(n)
+		switch f.Type() {
+		case ieType:
+			if i := f.Interface().(*ie.IE); i != nil {
+				ies = append(ies, i)
+			}
+		case ieSliceType:
+			for _, i := range f.Interface().([]*ie.IE) {
+				if i != nil {
+					ies = append(ies, i)
+				}
+			}
+		}
+	}
+	return ies
+}
@@ -0,0 +1,118 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestQuery(t *testing.T) {
+	pdr := func(id uint16, teid uint32) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, teid, net.ParseIP("127.0.0.1"), nil, 0),
+			),
+		)
+	}
+	m := message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0,
+		ie.NewNodeID("127.0.0.1", "", ""),
+		ie.NewFSEID(1, net.ParseIP("127.0.0.1"), nil),
+		pdr(1, 0x11111111),
+		pdr(3, 0x33333333),
+		ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(0x02)),
+	)
+
+	got, err := message.Query(m, "CreatePDR[PDRID=3]/PDI/FTEID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0] != m.CreatePDR[1].ChildIEs[1].ChildIEs[1] {
+		t.Fatalf("got %v, want the F-TEID held by the message", got)
+	}
+
+	if got := message.FindAll(m, ie.FTEID); len(got) != 2 {
+		t.Errorf("got %d F-TEIDs, want 2", len(got))
+	}
+
+	var n int
+	if err := message.Walk(m, func(_ []*ie.IE, _ *ie.IE) error {
+		n++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if n != 15 {
+		t.Errorf("visited %d IEs, want 15", n)
+	}
+
+	ids, err := message.Collect(m, ie.PDRIDType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[1] != 3 {
+		t.Errorf("got PDR IDs %v", ids)
+	}
+
+	g := message.NewGeneric(message.MsgTypeSessionEstablishmentRequest, 0, 1, m.CreatePDR...)
+	if got, err := message.Query(g, "**/FTEID"); err != nil || len(got) != 2 {
+		t.Errorf("got %d IEs, err: %v", len(got), err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	b, err := message.NewSessionModificationRequest(0, 0, 1, 1, 0,
+		ie.NewUpdateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+			ie.NewUpdateForwardingParameters(
+				ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.1", "", 0, 0, 0),
+			),
+		),
+	).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	received, err := message.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// modify the received message and forward it.
+	err = message.Update(received, "UpdateFAR[FARID=1]/UpdateForwardingParameters", func(fp *ie.IE) error {
+		return fp.Insert(0, ie.NewDestinationInterface(ie.DstInterfaceAccess))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fwd, err := received.(*message.SessionModificationRequest).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := message.ParseSessionModificationRequest(fwd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fps, err := m.UpdateFAR[0].FindByType(ie.UpdateForwardingParameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	di, err := fps.DestinationInterface()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if di != ie.DstInterfaceAccess {
+		t.Errorf("got %d", di)
+	}
+	if got, want := m.UpdateFAR[0].Length, received.(*message.SessionModificationRequest).UpdateFAR[0].Length; got != want {
+		t.Errorf("Length: got %d, want %d", got, want)
+	}
+}
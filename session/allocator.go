@@ -0,0 +1,91 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Allocator allocates the F-TEIDs and UE IP addresses requested with CHOOSE
+// flags, i.e., CH in F-TEID, and CHV4 or CHV6 in UE IP Address.
+//
+// The allocation is made per PDR or Traffic Endpoint, and released when it is
+// removed, its PDI is replaced, or the session is deleted. The allocations made
+// while applying a request that fails are released before the Store returns.
+type Allocator interface {
+	FTEIDAllocator
+	UEIPAddressAllocator
+}
+
+// FTEIDAllocator is the F-TEID part of Allocator.
+type FTEIDAllocator interface {
+	// AllocateFTEID returns the F-TEID to be used for the one requested, which
+	// must not have CH flag set.
+	AllocateFTEID(req *AllocationRequest, fteid *ie.FTEIDFields) (*ie.FTEIDFields, error)
+	// ReleaseFTEID releases the F-TEID returned by AllocateFTEID.
+	ReleaseFTEID(req *AllocationRequest, fteid *ie.FTEIDFields)
+}
+
+// UEIPAddressAllocator is the UE IP address part of Allocator.
+type UEIPAddressAllocator interface {
+	// AllocateUEIPAddress returns the UE IP address to be used for the one
+	// requested, which must not have CHV4 and CHV6 flags set.
+	AllocateUEIPAddress(req *AllocationRequest, addr *ie.UEIPAddressFields) (*ie.UEIPAddressFields, error)
+	// ReleaseUEIPAddress releases the UE IP address returned by AllocateUEIPAddress.
+	ReleaseUEIPAddress(req *AllocationRequest, addr *ie.UEIPAddressFields)
+}
+
+// NewAllocator combines the F-TEID and UE IP address allocators into an
+// Allocator.
+func NewAllocator(fteid FTEIDAllocator, ueip UEIPAddressAllocator) Allocator {
+	return &allocator{fteid, ueip}
+}
+
+type allocator struct {
+	FTEIDAllocator
+	UEIPAddressAllocator
+}
+
+// AllocationRequest is the context in which the F-TEID or UE IP address is
+// requested.
+type AllocationRequest struct {
+	// SEID is the local SEID of the session.
+	SEID uint64
+	// Rule is ie.CreatePDR or ie.CreateTrafficEndpoint, and ID is the PDR ID
+	// or the Traffic Endpoint ID. The same ones are used for the requests made
+	// by the Update IEs.
+	Rule ie.IEType
+	ID   uint32
+
+	// SourceInterface is the one in PDI, which is 0 for Traffic Endpoints.
+	SourceInterface uint8
+	// NetworkInstance is the one in PDI or Traffic Endpoint, if any.
+	NetworkInstance string
+	// UEIPAddressPoolIdentities are the ones in Create PDR, if any.
+	UEIPAddressPoolIdentities [][]byte
+	// SNSSAI is the S-NSSAI of the session, if any.
+	SNSSAI []byte
+}
+
+type allocKey struct {
+	rule ie.IEType
+	id   uint32
+}
+
+// allocation is an F-TEID or UE IP address allocated.
+type allocation struct {
+	req   *AllocationRequest
+	fteid *ie.FTEIDFields
+	ueip  *ie.UEIPAddressFields
+}
+
+func (a *allocation) release(alloc Allocator) {
+	if a.fteid != nil {
+		alloc.ReleaseFTEID(a.req, a.fteid)
+	}
+	if a.ueip != nil {
+		alloc.ReleaseUEIPAddress(a.req, a.ueip)
+	}
+}
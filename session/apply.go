@@ -0,0 +1,433 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// txn is the set of changes made to the copy of a session by a request, which
+// is committed only if all of them succeed.
+type txn struct {
+	alloc  Allocator
+	rules  *PredefinedRules
+	sess   *Session
+	result *Result
+
+	// allocated is the allocations made in the txn, which are released on
+	// rollback, and released is the ones to be released on commit.
+	allocated []*allocation
+	released  []*allocation
+}
+
+func (t *txn) commit() {
+	for _, a := range t.released {
+		a.release(t.alloc)
+	}
+}
+
+func (t *txn) rollback() {
+	for _, a := range t.allocated {
+		a.release(t.alloc)
+	}
+}
+
+// create applies the Create IEs, allocating the resources requested by PDRs
+// and Traffic Endpoints.
+func (t *txn) create(c ruleIEs) error {
+	return t.sess.create(c, t.createPDR, t.createTrafficEndpoint)
+}
+
+// update applies the Update IEs of a Session Modification Request.
+func (t *txn) update(c ruleIEs) error {
+	s := t.sess
+	if err := updateRules(c.tes, ie.UpdateTrafficEndpointType, s.TrafficEndpoints, t.updateTrafficEndpoint,
+		func(f *ie.UpdateTrafficEndpointFields) uint8 { return f.TrafficEndpointID }); err != nil {
+		return err
+	}
+	if err := updateRules(c.pdrs, ie.UpdatePDRType, s.PDRs, t.updatePDR,
+		func(f *ie.UpdatePDRFields) uint16 { return f.PDRID }); err != nil {
+		return err
+	}
+	if err := updateRules(c.fars, ie.UpdateFARType, s.FARs, noError(updateFAR),
+		func(f *ie.UpdateFARFields) uint32 { return f.FARID }); err != nil {
+		return err
+	}
+	if err := updateRules(c.urrs, ie.UpdateURRType, s.URRs, noError(update[ie.CreateURRFields, ie.UpdateURRFields]),
+		func(f *ie.UpdateURRFields) uint32 { return f.URRID }); err != nil {
+		return err
+	}
+	if err := updateRules(c.qers, ie.UpdateQERType, s.QERs, noError(update[ie.CreateQERFields, ie.UpdateQERFields]),
+		func(f *ie.UpdateQERFields) uint32 { return f.QERID }); err != nil {
+		return err
+	}
+	if err := updateRules(c.mars, ie.UpdateMARType, s.MARs, noError(updateMAR),
+		func(f *ie.UpdateMARFields) uint16 { return f.MARID }); err != nil {
+		return err
+	}
+	if err := updateRules(c.srrs, ie.UpdateSRRType, s.SRRs, noError(update[ie.CreateSRRFields, ie.UpdateSRRFields]),
+		func(f *ie.UpdateSRRFields) uint8 { return f.SRRID }); err != nil {
+		return err
+	}
+
+	for _, i := range c.bars {
+		f, err := ie.UpdateBARWithinSessionModificationRequestType.Get(i)
+		if err != nil {
+			return newRuleError(i, err)
+		}
+		if s.BAR == nil || s.BAR.BARID != f.BARID {
+			return &RuleError{Type: i.Type, ID: uint32(f.BARID), Err: ErrRuleNotFound}
+		}
+		s.BAR = update(s.BAR, f)
+	}
+	return nil
+}
+
+// remove applies the Remove IEs of a Session Modification Request.
+func (t *txn) remove(c ruleIEs) error {
+	s := t.sess
+	if err := removeRules(c.pdrs, ie.RemovePDRType, s.PDRs, func(id uint16) { t.releaseAll(allocKey{ie.CreatePDR, uint32(id)}) },
+		func(f *ie.RemovePDRFields) uint16 { return f.PDRID }); err != nil {
+		return err
+	}
+	if err := removeRules(c.fars, ie.RemoveFARType, s.FARs, nil,
+		func(f *ie.RemoveFARFields) uint32 { return f.FARID }); err != nil {
+		return err
+	}
+	if err := removeRules(c.urrs, ie.RemoveURRType, s.URRs, nil,
+		func(f *ie.RemoveURRFields) uint32 { return f.URRID }); err != nil {
+		return err
+	}
+	if err := removeRules(c.qers, ie.RemoveQERType, s.QERs, nil,
+		func(f *ie.RemoveQERFields) uint32 { return f.QERID }); err != nil {
+		return err
+	}
+	if err := removeRules(c.tes, ie.RemoveTrafficEndpointType, s.TrafficEndpoints, func(id uint8) { t.releaseAll(allocKey{ie.CreateTrafficEndpoint, uint32(id)}) },
+		func(f *ie.RemoveTrafficEndpointFields) uint8 { return f.TrafficEndpointID }); err != nil {
+		return err
+	}
+	if err := removeRules(c.mars, ie.RemoveMARType, s.MARs, nil,
+		func(f *ie.RemoveMARFields) uint16 { return f.MARID }); err != nil {
+		return err
+	}
+	if err := removeRules(c.srrs, ie.RemoveSRRType, s.SRRs, nil,
+		func(f *ie.RemoveSRRFields) uint8 { return f.SRRID }); err != nil {
+		return err
+	}
+
+	for _, i := range c.bars {
+		f, err := ie.RemoveBARType.Get(i)
+		if err != nil {
+			return newRuleError(i, err)
+		}
+		if s.BAR == nil || s.BAR.BARID != f.BARID {
+			return &RuleError{Type: i.Type, ID: uint32(f.BARID), Err: ErrRuleNotFound}
+		}
+		s.BAR = nil
+	}
+	return nil
+}
+
+func (t *txn) createPDR(f *ie.CreatePDRFields) error {
+	if err := checkPredefinedRules(t.rules, f.ActivatePredefinedRules); err != nil {
+		return err
+	}
+	if f.PDI == nil {
+		return nil
+	}
+	fteids, addrs, err := t.allocate(t.pdrRequest(f.PDRID, f.PDI, f.UEIPAddressPoolIdentities), &f.PDI.FTEID, f.PDI.UEIPAddresses)
+	if err != nil || len(fteids)+len(addrs) == 0 {
+		return err
+	}
+	t.result.CreatedPDRs = append(t.result.CreatedPDRs, ie.CreatedPDRType.New(&ie.CreatedPDRFields{
+		PDRID:         f.PDRID,
+		LocalFTEIDs:   fteids,
+		UEIPAddresses: addrs,
+	}))
+	return nil
+}
+
+func (t *txn) updatePDR(old *ie.CreatePDRFields, u *ie.UpdatePDRFields) (*ie.CreatePDRFields, error) {
+	// only the ones newly activated are checked, so that the PDR can still be
+	// updated after the predefined rule it activated is deleted.
+	if err := checkPredefinedRules(t.rules, u.ActivatePredefinedRules); err != nil {
+		return nil, err
+	}
+	f := update(old, u)
+
+	// Activate/Deactivate Predefined Rules are the changes to the ones that
+	// are already active, not the replacement.
+	f.ActivatePredefinedRules = append(
+		missing(old.ActivatePredefinedRules, u.DeactivatePredefinedRules),
+		missing(u.ActivatePredefinedRules, old.ActivatePredefinedRules)...,
+	)
+	if u.PDI == nil {
+		return f, nil
+	}
+
+	// the PDI is replaced as a whole, so the allocations for the old one are
+	// released unless the new one has the same values.
+	req := t.pdrRequest(f.PDRID, f.PDI, f.UEIPAddressPoolIdentities)
+	fteids, addrs, err := t.reallocate(req, &f.PDI.FTEID, &f.PDI.UEIPAddresses)
+	if err != nil || len(fteids)+len(addrs) == 0 {
+		return f, err
+	}
+	t.result.UpdatedPDRs = append(t.result.UpdatedPDRs, ie.UpdatedPDRType.New(&ie.UpdatedPDRFields{
+		PDRID:         f.PDRID,
+		LocalFTEIDs:   fteids,
+		UEIPAddresses: addrs,
+	}))
+	return f, nil
+}
+
+func (t *txn) createTrafficEndpoint(f *ie.CreateTrafficEndpointFields) error {
+	fteids, addrs, err := t.allocate(t.trafficEndpointRequest(f.TrafficEndpointID, f.NetworkInstance), &f.FTEID, f.UEIPAddresses)
+	if err != nil || len(fteids)+len(addrs) == 0 {
+		return err
+	}
+	t.result.CreatedTrafficEndpoints = append(t.result.CreatedTrafficEndpoints, ie.CreatedTrafficEndpointType.New(&ie.CreatedTrafficEndpointFields{
+		TrafficEndpointID: f.TrafficEndpointID,
+		LocalFTEIDs:       fteids,
+		UEIPAddresses:     addrs,
+	}))
+	return nil
+}
+
+// updateTrafficEndpoint applies Update Traffic Endpoint. The resources allocated
+// for it are returned in Created Traffic Endpoint as well, which is the one used
+// in Session Modification Response for both.
+func (t *txn) updateTrafficEndpoint(old *ie.CreateTrafficEndpointFields, u *ie.UpdateTrafficEndpointFields) (*ie.CreateTrafficEndpointFields, error) {
+	f := update(old, u)
+	if u.FTEID == nil && len(u.UEIPAddresses) == 0 {
+		return f, nil
+	}
+
+	req := t.trafficEndpointRequest(f.TrafficEndpointID, f.NetworkInstance)
+	fteids, addrs, err := t.reallocate(req, &f.FTEID, &f.UEIPAddresses)
+	if err != nil || len(fteids)+len(addrs) == 0 {
+		return f, err
+	}
+	t.result.CreatedTrafficEndpoints = append(t.result.CreatedTrafficEndpoints, ie.CreatedTrafficEndpointType.New(&ie.CreatedTrafficEndpointFields{
+		TrafficEndpointID: f.TrafficEndpointID,
+		LocalFTEIDs:       fteids,
+		UEIPAddresses:     addrs,
+	}))
+	return f, nil
+}
+
+func (t *txn) pdrRequest(id uint16, pdi *ie.PDIFields, pools [][]byte) *AllocationRequest {
+	req := &AllocationRequest{
+		SEID:                      t.sess.LocalSEID,
+		Rule:                      ie.CreatePDR,
+		ID:                        uint32(id),
+		SourceInterface:           pdi.SourceInterface,
+		UEIPAddressPoolIdentities: pools,
+		SNSSAI:                    t.sess.SNSSAI,
+	}
+	if pdi.NetworkInstance != nil {
+		req.NetworkInstance = *pdi.NetworkInstance
+	}
+	return req
+}
+
+func (t *txn) trafficEndpointRequest(id uint8, ni *string) *AllocationRequest {
+	req := &AllocationRequest{
+		SEID:   t.sess.LocalSEID,
+		Rule:   ie.CreateTrafficEndpoint,
+		ID:     uint32(id),
+		SNSSAI: t.sess.SNSSAI,
+	}
+	if ni != nil {
+		req.NetworkInstance = *ni
+	}
+	return req
+}
+
+// allocate allocates the F-TEID and UE IP addresses requested with CHOOSE flags,
+// replaces the requested ones with them, and returns the ones allocated.
+func (t *txn) allocate(req *AllocationRequest, fteid **ie.FTEIDFields, addrs []*ie.UEIPAddressFields) ([]*ie.FTEIDFields, []*ie.UEIPAddressFields, error) {
+	var (
+		fteids []*ie.FTEIDFields
+		ueips  []*ie.UEIPAddressFields
+	)
+	if f := *fteid; f != nil && f.HasCh() {
+		if t.alloc == nil {
+			return nil, nil, ErrNoAllocator
+		}
+		a, err := t.alloc.AllocateFTEID(req, f)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.record(&allocation{req: req, fteid: a})
+		*fteid = a
+		fteids = append(fteids, a)
+	}
+
+	for n, u := range addrs {
+		if !u.HasCHV4() && !u.HasCHV6() {
+			continue
+		}
+		if t.alloc == nil {
+			return nil, nil, ErrNoAllocator
+		}
+		a, err := t.alloc.AllocateUEIPAddress(req, u)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.record(&allocation{req: req, ueip: a})
+		addrs[n] = a
+		ueips = append(ueips, a)
+	}
+	return fteids, ueips, nil
+}
+
+// reallocate releases the allocations that are no longer used after the update
+// and allocates the ones newly requested.
+func (t *txn) reallocate(req *AllocationRequest, fteid **ie.FTEIDFields, addrs *[]*ie.UEIPAddressFields) ([]*ie.FTEIDFields, []*ie.UEIPAddressFields, error) {
+	key := allocKey{req.Rule, req.ID}
+	var kept []*allocation
+	for _, a := range t.sess.allocated[key] {
+		switch {
+		case a.fteid != nil && *fteid != nil && sameFTEID(a.fteid, *fteid),
+			a.ueip != nil && slices.ContainsFunc(*addrs, func(u *ie.UEIPAddressFields) bool { return sameUEIPAddress(a.ueip, u) }):
+			kept = append(kept, a)
+		default:
+			t.released = append(t.released, a)
+		}
+	}
+	if len(kept) == 0 {
+		delete(t.sess.allocated, key)
+	} else {
+		t.sess.allocated[key] = kept
+	}
+
+	*addrs = slices.Clone(*addrs)
+	return t.allocate(req, fteid, *addrs)
+}
+
+func (t *txn) record(a *allocation) {
+	key := allocKey{a.req.Rule, a.req.ID}
+	t.sess.allocated[key] = append(slices.Clip(t.sess.allocated[key]), a)
+	t.allocated = append(t.allocated, a)
+}
+
+func (t *txn) releaseAll(key allocKey) {
+	t.released = append(t.released, t.sess.allocated[key]...)
+	delete(t.sess.allocated, key)
+}
+
+func sameFTEID(a, b *ie.FTEIDFields) bool {
+	return a.TEID == b.TEID && a.IPv4Address.Equal(b.IPv4Address) && a.IPv6Address.Equal(b.IPv6Address)
+}
+
+func sameUEIPAddress(a, b *ie.UEIPAddressFields) bool {
+	return a.IPv4Address.Equal(b.IPv4Address) && a.IPv6Address.Equal(b.IPv6Address)
+}
+
+type ruleID interface {
+	~uint8 | ~uint16 | ~uint32
+}
+
+// createRules decodes the Create IEs and adds them to rules, calling hook with
+// each of them before it is added if given.
+func createRules[K ruleID, F any](ies []*ie.IE, typ ie.Typed[*F], rules map[K]*F, hook func(*F) error, id func(*F) K) error {
+	for _, i := range ies {
+		f, err := typ.Get(i)
+		if err != nil {
+			return newRuleError(i, err)
+		}
+		k := id(f)
+		if _, ok := rules[k]; ok {
+			return &RuleError{Type: i.Type, ID: uint32(k), Err: ErrDuplicateRule}
+		}
+		if hook != nil {
+			if err := hook(f); err != nil {
+				return &RuleError{Type: i.Type, ID: uint32(k), Err: err}
+			}
+		}
+		rules[k] = f
+	}
+	return nil
+}
+
+// updateRules decodes the Update IEs and replaces the rules with the ones
+// returned by fn.
+func updateRules[K ruleID, F, U any](ies []*ie.IE, typ ie.Typed[*U], rules map[K]*F, fn func(*F, *U) (*F, error), id func(*U) K) error {
+	for _, i := range ies {
+		u, err := typ.Get(i)
+		if err != nil {
+			return newRuleError(i, err)
+		}
+		k := id(u)
+		old, ok := rules[k]
+		if !ok {
+			return &RuleError{Type: i.Type, ID: uint32(k), Err: ErrRuleNotFound}
+		}
+		f, err := fn(old, u)
+		if err != nil {
+			return &RuleError{Type: i.Type, ID: uint32(k), Err: err}
+		}
+		rules[k] = f
+	}
+	return nil
+}
+
+// removeRules decodes the Remove IEs and deletes the rules, calling hook with
+// the ID of each of them if given.
+func removeRules[K ruleID, F, R any](ies []*ie.IE, typ ie.Typed[*R], rules map[K]*F, hook func(K), id func(*R) K) error {
+	for _, i := range ies {
+		r, err := typ.Get(i)
+		if err != nil {
+			return newRuleError(i, err)
+		}
+		k := id(r)
+		if _, ok := rules[k]; !ok {
+			return &RuleError{Type: i.Type, ID: uint32(k), Err: ErrRuleNotFound}
+		}
+		delete(rules, k)
+		if hook != nil {
+			hook(k)
+		}
+	}
+	return nil
+}
+
+func noError[F, U any](fn func(*F, *U) *F) func(*F, *U) (*F, error) {
+	return func(f *F, u *U) (*F, error) {
+		return fn(f, u), nil
+	}
+}
+
+// ruleIDTypes maps the type of rule IEs to the type of its ID.
+var ruleIDTypes = map[ie.IEType]ie.IEType{
+	ie.CreatePDR: ie.PDRID, ie.UpdatePDR: ie.PDRID, ie.RemovePDR: ie.PDRID,
+	ie.CreateFAR: ie.FARID, ie.UpdateFAR: ie.FARID, ie.RemoveFAR: ie.FARID,
+	ie.CreateURR: ie.URRID, ie.UpdateURR: ie.URRID, ie.RemoveURR: ie.URRID,
+	ie.CreateQER: ie.QERID, ie.UpdateQER: ie.QERID, ie.RemoveQER: ie.QERID,
+	ie.CreateBAR: ie.BARID, ie.UpdateBARWithinSessionModificationRequest: ie.BARID, ie.RemoveBAR: ie.BARID,
+	ie.CreateMAR: ie.MARID, ie.UpdateMAR: ie.MARID, ie.RemoveMAR: ie.MARID,
+	ie.CreateSRR: ie.SRRID, ie.UpdateSRR: ie.SRRID, ie.RemoveSRR: ie.SRRID,
+	ie.CreateTrafficEndpoint: ie.TrafficEndpointID, ie.UpdateTrafficEndpoint: ie.TrafficEndpointID, ie.RemoveTrafficEndpoint: ie.TrafficEndpointID,
+}
+
+// newRuleError returns the RuleError for the rule IE that could not be decoded,
+// with the ID found in it if any.
+func newRuleError(i *ie.IE, err error) *RuleError {
+	e := &RuleError{Type: i.Type, Err: err}
+	if c, ferr := i.FindByType(ruleIDTypes[i.Type]); ferr == nil && len(c.Payload) <= 4 {
+		for _, b := range c.Payload {
+			e.ID = e.ID<<8 | uint32(b)
+		}
+	}
+	return e
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,310 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// CheckEstablishment checks the rules in the Session Establishment Request, and
+// returns the errors for all the duplicate rule IDs and the references to the
+// rules that are not created, e.g., FAR ID in Create PDR.
+//
+// The errors are *RuleError, and the ones for the broken references have
+// *ReferenceError in it.
+func CheckEstablishment(req *message.SessionEstablishmentRequest) []*RuleError {
+	c := newChecker(newRules())
+	c.create(ruleIEs{
+		pdrs: req.CreatePDR, fars: req.CreateFAR, urrs: req.CreateURR, qers: req.CreateQER, bars: one(req.CreateBAR),
+		tes: req.CreateTrafficEndpoint, mars: req.CreateMAR, srrs: req.CreateSRR,
+	})
+	c.references()
+	return c.errs
+}
+
+// CheckModification checks the rules in the Session Modification Request
+// against the rules in the session, in the same way as CheckEstablishment. The
+// removals of the rules still referred to by the others are reported with the
+// Remove IEs and ErrRuleInUse.
+//
+// rules can be nil if the state of the session is not known, and then only the
+// references to the rules created or removed in the request are checked.
+func CheckModification(req *message.SessionModificationRequest, rules *Rules) []*RuleError {
+	c := newChecker(rules)
+	c.remove(ruleIEs{
+		pdrs: req.RemovePDR, fars: req.RemoveFAR, urrs: req.RemoveURR, qers: req.RemoveQER, bars: one(req.RemoveBAR),
+		tes: req.RemoveTrafficEndpoint, mars: req.RemoveMAR, srrs: req.RemoveSRR,
+	})
+	c.create(ruleIEs{
+		pdrs: req.CreatePDR, fars: req.CreateFAR, urrs: req.CreateURR, qers: req.CreateQER, bars: one(req.CreateBAR),
+		tes: req.CreateTrafficEndpoint, mars: req.CreateMAR, srrs: req.CreateSRR,
+	})
+	c.update(ruleIEs{
+		pdrs: req.UpdatePDR, fars: req.UpdateFAR, urrs: req.UpdateURR, qers: req.UpdateQER, bars: one(req.UpdateBAR),
+		tes: req.UpdateTrafficEndpoint, mars: req.UpdateMAR, srrs: req.UpdateSRR,
+	})
+	c.references()
+	return c.errs
+}
+
+// ruleKey identifies a rule with the type of the rule ID IE, e.g., ie.FARID.
+type ruleKey struct {
+	typ ie.IEType
+	id  uint32
+}
+
+// checker applies the rules in a request to the copy of the rules without
+// stopping at the errors, and checks the references in the result.
+type checker struct {
+	// known is true if the rules other than the ones in the request are known.
+	known bool
+	rules *Rules
+	bars  map[uint8]*ie.CreateBARFields
+
+	// origin is the type of the IE that created or updated the rule last,
+	// and removed is the type of the IE that removed the rule.
+	origin  map[ruleKey]ie.IEType
+	removed map[ruleKey]ie.IEType
+
+	errs []*RuleError
+}
+
+func newChecker(rules *Rules) *checker {
+	c := &checker{
+		known:   rules != nil,
+		rules:   newRules(),
+		origin:  map[ruleKey]ie.IEType{},
+		removed: map[ruleKey]ie.IEType{},
+	}
+	if c.known {
+		c.rules = rules.clone()
+	}
+	c.bars = barRules(c.rules.BAR)
+	if c.bars == nil {
+		c.bars = map[uint8]*ie.CreateBARFields{}
+	}
+	return c
+}
+
+func (c *checker) remove(rs ruleIEs) {
+	r := c.rules
+	checkRemoves(c, rs.pdrs, ie.RemovePDRType, r.PDRs, func(f *ie.RemovePDRFields) uint16 { return f.PDRID })
+	checkRemoves(c, rs.fars, ie.RemoveFARType, r.FARs, func(f *ie.RemoveFARFields) uint32 { return f.FARID })
+	checkRemoves(c, rs.urrs, ie.RemoveURRType, r.URRs, func(f *ie.RemoveURRFields) uint32 { return f.URRID })
+	checkRemoves(c, rs.qers, ie.RemoveQERType, r.QERs, func(f *ie.RemoveQERFields) uint32 { return f.QERID })
+	checkRemoves(c, rs.bars, ie.RemoveBARType, c.bars, func(f *ie.RemoveBARFields) uint8 { return f.BARID })
+	checkRemoves(c, rs.tes, ie.RemoveTrafficEndpointType, r.TrafficEndpoints,
+		func(f *ie.RemoveTrafficEndpointFields) uint8 { return f.TrafficEndpointID })
+	checkRemoves(c, rs.mars, ie.RemoveMARType, r.MARs, func(f *ie.RemoveMARFields) uint16 { return f.MARID })
+	checkRemoves(c, rs.srrs, ie.RemoveSRRType, r.SRRs, func(f *ie.RemoveSRRFields) uint8 { return f.SRRID })
+}
+
+func (c *checker) create(rs ruleIEs) {
+	r := c.rules
+	checkCreates(c, rs.tes, ie.CreateTrafficEndpointType, r.TrafficEndpoints,
+		func(f *ie.CreateTrafficEndpointFields) uint8 { return f.TrafficEndpointID })
+	checkCreates(c, rs.pdrs, ie.CreatePDRType, r.PDRs, func(f *ie.CreatePDRFields) uint16 { return f.PDRID })
+	checkCreates(c, rs.fars, ie.CreateFARType, r.FARs, func(f *ie.CreateFARFields) uint32 { return f.FARID })
+	checkCreates(c, rs.urrs, ie.CreateURRType, r.URRs, func(f *ie.CreateURRFields) uint32 { return f.URRID })
+	checkCreates(c, rs.qers, ie.CreateQERType, r.QERs, func(f *ie.CreateQERFields) uint32 { return f.QERID })
+	checkCreates(c, rs.mars, ie.CreateMARType, r.MARs, func(f *ie.CreateMARFields) uint16 { return f.MARID })
+	checkCreates(c, rs.srrs, ie.CreateSRRType, r.SRRs, func(f *ie.CreateSRRFields) uint8 { return f.SRRID })
+
+	// a session has at most one BAR.
+	for _, i := range rs.bars {
+		if len(c.bars) > 0 {
+			c.errs = append(c.errs, newRuleError(i, ErrDuplicateRule))
+			continue
+		}
+		checkCreates(c, []*ie.IE{i}, ie.CreateBARType, c.bars, func(f *ie.CreateBARFields) uint8 { return f.BARID })
+	}
+}
+
+func (c *checker) update(rs ruleIEs) {
+	r := c.rules
+	checkUpdates(c, rs.tes, ie.UpdateTrafficEndpointType, r.TrafficEndpoints,
+		update[ie.CreateTrafficEndpointFields, ie.UpdateTrafficEndpointFields],
+		func(f *ie.UpdateTrafficEndpointFields) uint8 { return f.TrafficEndpointID })
+	checkUpdates(c, rs.pdrs, ie.UpdatePDRType, r.PDRs, update[ie.CreatePDRFields, ie.UpdatePDRFields],
+		func(f *ie.UpdatePDRFields) uint16 { return f.PDRID })
+	checkUpdates(c, rs.fars, ie.UpdateFARType, r.FARs, updateFAR, func(f *ie.UpdateFARFields) uint32 { return f.FARID })
+	checkUpdates(c, rs.urrs, ie.UpdateURRType, r.URRs, update[ie.CreateURRFields, ie.UpdateURRFields],
+		func(f *ie.UpdateURRFields) uint32 { return f.URRID })
+	checkUpdates(c, rs.qers, ie.UpdateQERType, r.QERs, update[ie.CreateQERFields, ie.UpdateQERFields],
+		func(f *ie.UpdateQERFields) uint32 { return f.QERID })
+	checkUpdates(c, rs.bars, ie.UpdateBARWithinSessionModificationRequestType, c.bars,
+		update[ie.CreateBARFields, ie.UpdateBARWithinSessionModificationRequestFields],
+		func(f *ie.UpdateBARWithinSessionModificationRequestFields) uint8 { return f.BARID })
+	checkUpdates(c, rs.mars, ie.UpdateMARType, r.MARs, updateMAR, func(f *ie.UpdateMARFields) uint16 { return f.MARID })
+	checkUpdates(c, rs.srrs, ie.UpdateSRRType, r.SRRs, update[ie.CreateSRRFields, ie.UpdateSRRFields],
+		func(f *ie.UpdateSRRFields) uint8 { return f.SRRID })
+}
+
+// references checks the references from the rules after the request is
+// applied, in the order of PDR, FAR, URR and MAR, each sorted by the ID.
+func (c *checker) references() {
+	r := c.rules
+	for _, id := range sortedKeys(r.PDRs) {
+		f, from := r.PDRs[id], ruleKey{ie.PDRID, uint32(id)}
+		if f.FARID != nil {
+			c.ref(from, ruleKey{ie.FARID, *f.FARID})
+		}
+		for _, v := range f.URRIDs {
+			c.ref(from, ruleKey{ie.URRID, v})
+		}
+		for _, v := range f.QERIDs {
+			c.ref(from, ruleKey{ie.QERID, v})
+		}
+		if f.MARID != nil {
+			c.ref(from, ruleKey{ie.MARID, uint32(*f.MARID)})
+		}
+		if f.PDI != nil {
+			for _, v := range f.PDI.TrafficEndpointIDs {
+				c.ref(from, ruleKey{ie.TrafficEndpointID, uint32(v)})
+			}
+		}
+	}
+	for _, id := range sortedKeys(r.FARs) {
+		f, from := r.FARs[id], ruleKey{ie.FARID, id}
+		if f.BARID != nil {
+			c.ref(from, ruleKey{ie.BARID, uint32(*f.BARID)})
+		}
+		if fp := f.ForwardingParameters; fp != nil && fp.LinkedTrafficEndpointID != nil {
+			c.ref(from, ruleKey{ie.TrafficEndpointID, uint32(*fp.LinkedTrafficEndpointID)})
+		}
+	}
+	for _, id := range sortedKeys(r.URRs) {
+		f, from := r.URRs[id], ruleKey{ie.URRID, id}
+		for _, v := range f.LinkedURRIDs {
+			c.ref(from, ruleKey{ie.URRID, v})
+		}
+		if f.FARIDForQuotaAction != nil {
+			c.ref(from, ruleKey{ie.FARID, *f.FARIDForQuotaAction})
+		}
+	}
+	for _, id := range sortedKeys(r.MARs) {
+		f, from := r.MARs[id], ruleKey{ie.MARID, uint32(id)}
+		if info := f.TGPPAccessForwardingActionInformation; info != nil {
+			c.ref(from, ruleKey{ie.FARID, info.FARID})
+			for _, v := range info.URRIDs {
+				c.ref(from, ruleKey{ie.URRID, v})
+			}
+		}
+		if info := f.NonTGPPAccessForwardingActionInformation; info != nil {
+			c.ref(from, ruleKey{ie.FARID, info.FARID})
+			for _, v := range info.URRIDs {
+				c.ref(from, ruleKey{ie.URRID, v})
+			}
+		}
+	}
+}
+
+// ref checks the reference from a rule to another. The broken one is reported
+// with the IE in the request that created or updated the rule, or the one that
+// removed the rule referred to if the rule is not in the request.
+func (c *checker) ref(from, to ruleKey) {
+	if c.exists(to) {
+		return
+	}
+	if _, ok := c.removed[to]; !ok && !c.known {
+		return
+	}
+
+	if typ, ok := c.origin[from]; ok {
+		c.errs = append(c.errs, &RuleError{
+			Type: typ, ID: from.id,
+			Err: &ReferenceError{Type: to.typ, ID: to.id, Err: ErrRuleNotFound},
+		})
+		return
+	}
+	if typ, ok := c.removed[to]; ok {
+		c.errs = append(c.errs, &RuleError{
+			Type: typ, ID: to.id,
+			Err: &ReferenceError{Type: from.typ, ID: from.id, Err: ErrRuleInUse},
+		})
+	}
+}
+
+func (c *checker) exists(k ruleKey) bool {
+	var ok bool
+	switch k.typ {
+	case ie.PDRID:
+		_, ok = c.rules.PDRs[uint16(k.id)]
+	case ie.FARID:
+		_, ok = c.rules.FARs[k.id]
+	case ie.URRID:
+		_, ok = c.rules.URRs[k.id]
+	case ie.QERID:
+		_, ok = c.rules.QERs[k.id]
+	case ie.BARID:
+		_, ok = c.bars[uint8(k.id)]
+	case ie.TrafficEndpointID:
+		_, ok = c.rules.TrafficEndpoints[uint8(k.id)]
+	case ie.MARID:
+		_, ok = c.rules.MARs[uint16(k.id)]
+	case ie.SRRID:
+		_, ok = c.rules.SRRs[uint8(k.id)]
+	}
+	return ok
+}
+
+func checkRemoves[K ruleID, F, R any](c *checker, ies []*ie.IE, typ ie.Typed[*R], rules map[K]*F, id func(*R) K) {
+	for _, i := range ies {
+		f, err := typ.Get(i)
+		if err != nil {
+			c.errs = append(c.errs, newRuleError(i, err))
+			continue
+		}
+		k := id(f)
+		if _, ok := rules[k]; !ok && c.known {
+			c.errs = append(c.errs, &RuleError{Type: i.Type, ID: uint32(k), Err: ErrRuleNotFound})
+			continue
+		}
+		delete(rules, k)
+		c.removed[ruleKey{ruleIDTypes[i.Type], uint32(k)}] = i.Type
+	}
+}
+
+func checkCreates[K ruleID, F any](c *checker, ies []*ie.IE, typ ie.Typed[*F], rules map[K]*F, id func(*F) K) {
+	for _, i := range ies {
+		f, err := typ.Get(i)
+		if err != nil {
+			c.errs = append(c.errs, newRuleError(i, err))
+			continue
+		}
+		k := id(f)
+		if _, ok := rules[k]; ok {
+			c.errs = append(c.errs, &RuleError{Type: i.Type, ID: uint32(k), Err: ErrDuplicateRule})
+			continue
+		}
+		rules[k] = f
+		c.origin[ruleKey{ruleIDTypes[i.Type], uint32(k)}] = i.Type
+	}
+}
+
+// checkUpdates applies the Update IEs. If the rules are not known, the ones not
+// in the request are regarded as the empty ones to check the references in the
+// Update IEs.
+func checkUpdates[K ruleID, F, U any](c *checker, ies []*ie.IE, typ ie.Typed[*U], rules map[K]*F, fn func(*F, *U) *F, id func(*U) K) {
+	for _, i := range ies {
+		u, err := typ.Get(i)
+		if err != nil {
+			c.errs = append(c.errs, newRuleError(i, err))
+			continue
+		}
+		k, key := id(u), ruleKey{ruleIDTypes[i.Type], uint32(id(u))}
+		old, ok := rules[k]
+		if !ok {
+			if _, removed := c.removed[key]; c.known || removed {
+				c.errs = append(c.errs, &RuleError{Type: i.Type, ID: uint32(k), Err: ErrRuleNotFound})
+				continue
+			}
+			old = new(F)
+		}
+		rules[k] = fn(old, u)
+		c.origin[key] = i.Type
+	}
+}
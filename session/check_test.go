@@ -0,0 +1,155 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+func TestCheckEstablishment(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		if errs := session.CheckEstablishment(newTestEstablishmentRequest()); len(errs) != 0 {
+			t.Errorf("got errors: %v", errs)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		req := message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0,
+			ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(100),
+				ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess), ie.NewTrafficEndpointID(3)),
+				ie.NewFARID(1),
+				ie.NewURRID(1),
+				ie.NewURRID(2),
+				ie.NewQERID(1),
+				ie.NewMARID(1),
+			),
+			newTestFAR(1),
+			ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(0x04)),
+			ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyAction(0x04), ie.NewBARID(1)),
+			ie.NewCreateURR(ie.NewURRID(1), ie.NewMeasurementMethod(0, 1, 0), ie.NewReportingTriggers(0x01, 0x00, 0x00)),
+		)
+
+		want := []*session.RuleError{
+			{Type: ie.CreateFAR, ID: 1, Err: session.ErrDuplicateRule},
+			{Type: ie.CreatePDR, ID: 1, Err: &session.ReferenceError{Type: ie.URRID, ID: 2, Err: session.ErrRuleNotFound}},
+			{Type: ie.CreatePDR, ID: 1, Err: &session.ReferenceError{Type: ie.QERID, ID: 1, Err: session.ErrRuleNotFound}},
+			{Type: ie.CreatePDR, ID: 1, Err: &session.ReferenceError{Type: ie.MARID, ID: 1, Err: session.ErrRuleNotFound}},
+			{Type: ie.CreatePDR, ID: 1, Err: &session.ReferenceError{Type: ie.TrafficEndpointID, ID: 3, Err: session.ErrRuleNotFound}},
+			{Type: ie.CreateFAR, ID: 2, Err: &session.ReferenceError{Type: ie.BARID, ID: 1, Err: session.ErrRuleNotFound}},
+		}
+		if diff := cmp.Diff(session.CheckEstablishment(req), want, cmp.Comparer(errorIs)); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestCheckModification(t *testing.T) {
+	st := session.NewStore(newTestAllocator())
+	r := st.Establish(newTestEstablishmentRequest(
+		ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyAction(0x04), ie.NewBARID(1)),
+	))
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	rules := &r.Session.Rules
+
+	cases := []struct {
+		description string
+		ies         []*ie.IE
+		rules       *session.Rules
+		want        []*session.RuleError
+	}{
+		{
+			"Valid",
+			[]*ie.IE{
+				ie.NewRemoveFAR(ie.NewFARID(2)),
+				ie.NewCreateFAR(ie.NewFARID(3), ie.NewApplyAction(0x02)),
+				ie.NewUpdatePDR(ie.NewPDRID(1), ie.NewFARID(3)),
+				ie.NewRemoveFAR(ie.NewFARID(1)),
+			},
+			rules,
+			nil,
+		}, {
+			"RemovedInUse",
+			[]*ie.IE{
+				ie.NewRemoveFAR(ie.NewFARID(1)),
+				ie.NewRemoveBAR(ie.NewBARID(1)),
+			},
+			rules,
+			[]*session.RuleError{
+				{Type: ie.RemoveFAR, ID: 1, Err: &session.ReferenceError{Type: ie.PDRID, ID: 1, Err: session.ErrRuleInUse}},
+				{Type: ie.RemoveBAR, ID: 1, Err: &session.ReferenceError{Type: ie.FARID, ID: 2, Err: session.ErrRuleInUse}},
+			},
+		}, {
+			"NotFound",
+			[]*ie.IE{
+				ie.NewRemoveQER(ie.NewQERID(2)),
+				ie.NewCreateURR(ie.NewURRID(1), ie.NewMeasurementMethod(0, 1, 0), ie.NewReportingTriggers(0x01, 0x00, 0x00)),
+				ie.NewUpdateFAR(ie.NewFARID(3), ie.NewApplyAction(0x02)),
+				ie.NewUpdatePDR(ie.NewPDRID(1), ie.NewQERID(5)),
+			},
+			rules,
+			[]*session.RuleError{
+				{Type: ie.RemoveQER, ID: 2, Err: session.ErrRuleNotFound},
+				{Type: ie.CreateURR, ID: 1, Err: session.ErrDuplicateRule},
+				{Type: ie.UpdateFAR, ID: 3, Err: session.ErrRuleNotFound},
+				{Type: ie.UpdatePDR, ID: 1, Err: &session.ReferenceError{Type: ie.QERID, ID: 5, Err: session.ErrRuleNotFound}},
+			},
+		}, {
+			"UnknownState",
+			[]*ie.IE{
+				ie.NewRemoveFAR(ie.NewFARID(2)),
+				ie.NewUpdatePDR(ie.NewPDRID(1), ie.NewFARID(2), ie.NewQERID(5)),
+				ie.NewUpdateFAR(ie.NewFARID(3), ie.NewApplyAction(0x02)),
+			},
+			nil,
+			[]*session.RuleError{
+				{Type: ie.UpdatePDR, ID: 1, Err: &session.ReferenceError{Type: ie.FARID, ID: 2, Err: session.ErrRuleNotFound}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			req := message.NewSessionModificationRequest(0, 0, r.Session.LocalSEID, 2, 0, c.ies...)
+			if diff := cmp.Diff(session.CheckModification(req, c.rules), c.want, cmp.Comparer(errorIs)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	// the first error is used in the response.
+	errs := session.CheckModification(message.NewSessionModificationRequest(0, 0, 1, 2, 0, ie.NewRemoveFAR(ie.NewFARID(1))), rules)
+	if len(errs) == 0 {
+		t.Fatal("no error")
+	}
+	if diff := cmp.Diff(errs[0].FailedRuleID(), ie.NewFailedRuleID(ie.RuleIDTypeFAR, 1)); diff != "" {
+		t.Error(diff)
+	}
+	if got, want := errs[0].Error(), "failed to apply RemoveFAR with ID 1: referred to by PDRID 1"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// errorIs compares the errors by the sentinel errors and the fields in them.
+func errorIs(x, y error) bool {
+	var ex, ey *session.RuleError
+	if errors.As(x, &ex) && errors.As(y, &ey) {
+		return ex.Type == ey.Type && ex.ID == ey.ID && errorIs(ex.Err, ey.Err)
+	}
+	var rx, ry *session.ReferenceError
+	if errors.As(x, &rx) && errors.As(y, &ry) {
+		return rx.Type == ry.Type && rx.ID == ry.ID && errors.Is(rx.Err, ry.Err)
+	}
+	return errors.Is(x, y)
+}
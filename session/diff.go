@@ -0,0 +1,335 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// NewModificationRequest creates a Session Modification Request that changes
+// the rules in the session from prev to desired, with the IEs computed by
+// DiffRules. ies are added to the request as well, e.g., CP F-SEID.
+//
+// prev should be the rules that the UP function has, i.e., with the F-TEIDs
+// and UE IP addresses allocated for the CHOOSE flags.
+func NewModificationRequest(seid uint64, seq uint32, prev, desired *Rules, ies ...*ie.IE) (*message.SessionModificationRequest, error) {
+	diff, err := DiffRules(prev, desired)
+	if err != nil {
+		return nil, err
+	}
+	return message.NewSessionModificationRequest(0, 0, seid, seq, 0, append(diff, ies...)...), nil
+}
+
+// DiffRules returns the Remove, Create and Update IEs that change the rules
+// from prev to desired, in this order. The IEs of each kind are in the order
+// of the fields in Session Modification Request and sorted by the ID.
+//
+// The changed rules are updated with the Update IEs that have only the values
+// changed. The rules are removed and created again instead if the change
+// cannot be expressed with the Update IE, e.g., an optional IE is removed or
+// the IE does not exist in the Update IE.
+//
+// The error is returned if any of the rules cannot be encoded or decoded.
+func DiffRules(prev, desired *Rules) ([]*ie.IE, error) {
+	var r, c, u ruleIEs
+	var err error
+
+	r.pdrs, c.pdrs, u.pdrs, err = diffRules(prev.PDRs, desired.PDRs, ie.CreatePDRType,
+		func(id uint16) *ie.IE { return ie.RemovePDRType.New(&ie.RemovePDRFields{PDRID: id}) },
+		updatePDRIE)
+	if err != nil {
+		return nil, err
+	}
+	r.fars, c.fars, u.fars, err = diffRules(prev.FARs, desired.FARs, ie.CreateFARType,
+		func(id uint32) *ie.IE { return ie.RemoveFARType.New(&ie.RemoveFARFields{FARID: id}) },
+		updateFARIE)
+	if err != nil {
+		return nil, err
+	}
+	r.urrs, c.urrs, u.urrs, err = diffRules(prev.URRs, desired.URRs, ie.CreateURRType,
+		func(id uint32) *ie.IE { return ie.RemoveURRType.New(&ie.RemoveURRFields{URRID: id}) },
+		func(o, n *ie.CreateURRFields) *ie.IE {
+			return updateIE(&ie.UpdateURRFields{URRID: n.URRID}, o, n)
+		})
+	if err != nil {
+		return nil, err
+	}
+	r.qers, c.qers, u.qers, err = diffRules(prev.QERs, desired.QERs, ie.CreateQERType,
+		func(id uint32) *ie.IE { return ie.RemoveQERType.New(&ie.RemoveQERFields{QERID: id}) },
+		func(o, n *ie.CreateQERFields) *ie.IE {
+			return updateIE(&ie.UpdateQERFields{QERID: n.QERID}, o, n)
+		})
+	if err != nil {
+		return nil, err
+	}
+	r.bars, c.bars, u.bars, err = diffRules(barRules(prev.BAR), barRules(desired.BAR), ie.CreateBARType,
+		func(id uint8) *ie.IE { return ie.RemoveBARType.New(&ie.RemoveBARFields{BARID: id}) },
+		func(o, n *ie.CreateBARFields) *ie.IE {
+			return updateIE(&ie.UpdateBARWithinSessionModificationRequestFields{BARID: n.BARID}, o, n)
+		})
+	if err != nil {
+		return nil, err
+	}
+	r.tes, c.tes, u.tes, err = diffRules(prev.TrafficEndpoints, desired.TrafficEndpoints, ie.CreateTrafficEndpointType,
+		func(id uint8) *ie.IE {
+			return ie.RemoveTrafficEndpointType.New(&ie.RemoveTrafficEndpointFields{TrafficEndpointID: id})
+		},
+		func(o, n *ie.CreateTrafficEndpointFields) *ie.IE {
+			return updateIE(&ie.UpdateTrafficEndpointFields{TrafficEndpointID: n.TrafficEndpointID}, o, n)
+		})
+	if err != nil {
+		return nil, err
+	}
+	r.mars, c.mars, u.mars, err = diffRules(prev.MARs, desired.MARs, ie.CreateMARType,
+		func(id uint16) *ie.IE { return ie.RemoveMARType.New(&ie.RemoveMARFields{MARID: id}) },
+		updateMARIE)
+	if err != nil {
+		return nil, err
+	}
+	r.srrs, c.srrs, u.srrs, err = diffRules(prev.SRRs, desired.SRRs, ie.CreateSRRType,
+		func(id uint8) *ie.IE { return ie.RemoveSRRType.New(&ie.RemoveSRRFields{SRRID: id}) },
+		func(o, n *ie.CreateSRRFields) *ie.IE {
+			return updateIE(&ie.UpdateSRRFields{SRRID: n.SRRID}, o, n)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	var ies []*ie.IE
+	for _, c := range []ruleIEs{r, c, u} {
+		ies = append(ies, c.list()...)
+	}
+	return ies, nil
+}
+
+// list returns the IEs in the order of the fields in Session Modification
+// Request.
+func (c ruleIEs) list() []*ie.IE {
+	return slices.Concat(c.pdrs, c.fars, c.urrs, c.qers, c.bars, c.tes, c.mars, c.srrs)
+}
+
+// diffRules compares the rules of a kind with the same ID, and returns the
+// Remove, Create and Update IEs for them. update returns nil if the change
+// cannot be expressed with the Update IE, and the rule is replaced then.
+//
+// The rules are compared after being encoded and decoded, so that the ones
+// with the same values on the wire are regarded as the same.
+func diffRules[K ruleID, F any](
+	prev, desired map[K]*F, typ ie.Typed[*F], remove func(K) *ie.IE, update func(o, n *F) *ie.IE,
+) (removed, created, updated []*ie.IE, err error) {
+	var ids []K
+	for id := range prev {
+		if _, ok := desired[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	for _, id := range sortedKeys(desired) {
+		n, err := typ.Get(typ.New(desired[id]))
+		if err != nil {
+			return nil, nil, nil, newRuleError(typ.New(desired[id]), err)
+		}
+		old, ok := prev[id]
+		if !ok {
+			created = append(created, typ.New(n))
+			continue
+		}
+		o, err := typ.Get(typ.New(old))
+		if err != nil {
+			return nil, nil, nil, newRuleError(typ.New(old), err)
+		}
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		if i := update(o, n); i != nil {
+			updated = append(updated, i)
+			continue
+		}
+		ids = append(ids, id)
+		created = append(created, typ.New(n))
+	}
+
+	slices.Sort(ids)
+	for _, id := range ids {
+		removed = append(removed, remove(id))
+	}
+	return removed, created, updated, nil
+}
+
+// barRules returns the BAR in a map keyed by BAR ID, so that it can be compared
+// in the same way as the other rules.
+func barRules(bar *ie.CreateBARFields) map[uint8]*ie.CreateBARFields {
+	if bar == nil {
+		return nil
+	}
+	return map[uint8]*ie.CreateBARFields{bar.BARID: bar}
+}
+
+// updateIE sets the changed fields from old to new to u and returns it as IE,
+// or nil if the change cannot be expressed with u. It is used for the rules
+// that have no nested Update IEs.
+func updateIE[U interface{ ToIE() *ie.IE }](u U, old, new any) *ie.IE {
+	if !diffFields(u, old, new) {
+		return nil
+	}
+	return u.ToIE()
+}
+
+func updatePDRIE(o, n *ie.CreatePDRFields) *ie.IE {
+	u := &ie.UpdatePDRFields{PDRID: n.PDRID}
+	if !diffFields(u, o, n, "ActivatePredefinedRules") {
+		return nil
+	}
+
+	// the predefined rules are activated and deactivated by the names, which
+	// are not affected by the order.
+	u.ActivatePredefinedRules = missing(n.ActivatePredefinedRules, o.ActivatePredefinedRules)
+	u.DeactivatePredefinedRules = missing(o.ActivatePredefinedRules, n.ActivatePredefinedRules)
+	return u.ToIE()
+}
+
+func updateFARIE(o, n *ie.CreateFARFields) *ie.IE {
+	u := &ie.UpdateFARFields{FARID: n.FARID}
+	if !diffFields(u, o, n, "ForwardingParameters", "DuplicatingParameters") {
+		return nil
+	}
+
+	if !reflect.DeepEqual(o.ForwardingParameters, n.ForwardingParameters) {
+		if n.ForwardingParameters == nil {
+			return nil
+		}
+		fp := &ie.UpdateForwardingParametersFields{}
+		old := o.ForwardingParameters
+		if old == nil {
+			old = &ie.ForwardingParametersFields{}
+			fp.DestinationInterface = &n.ForwardingParameters.DestinationInterface
+		}
+		if !diffFields(fp, old, n.ForwardingParameters) {
+			return nil
+		}
+		u.UpdateForwardingParameters = fp
+	}
+
+	// Update Duplicating Parameters are applied in the same order, so the ones
+	// before the last changed one are given even if they are not changed. The
+	// removed ones cannot be expressed.
+	if len(n.DuplicatingParameters) < len(o.DuplicatingParameters) {
+		return nil
+	}
+	var dps []*ie.UpdateDuplicatingParametersFields
+	for i, dp := range n.DuplicatingParameters {
+		ud := &ie.UpdateDuplicatingParametersFields{}
+		old := &ie.DuplicatingParametersFields{}
+		if i < len(o.DuplicatingParameters) {
+			old = o.DuplicatingParameters[i]
+		} else {
+			ud.DestinationInterface = &dp.DestinationInterface
+		}
+		if !diffFields(ud, old, dp) {
+			return nil
+		}
+		dps = append(dps, ud)
+		if i >= len(o.DuplicatingParameters) || !reflect.DeepEqual(old, dp) {
+			u.UpdateDuplicatingParameters = dps
+		}
+	}
+	return u.ToIE()
+}
+
+func updateMARIE(o, n *ie.CreateMARFields) *ie.IE {
+	u := &ie.UpdateMARFields{MARID: n.MARID}
+	if !diffFields(u, o, n, "TGPPAccessForwardingActionInformation", "NonTGPPAccessForwardingActionInformation") {
+		return nil
+	}
+
+	// the forwarding action information not in the MAR yet is given as it is,
+	// and the one in the MAR is updated with the Update IE.
+	switch old, new := o.TGPPAccessForwardingActionInformation, n.TGPPAccessForwardingActionInformation; {
+	case reflect.DeepEqual(old, new):
+	case new == nil:
+		return nil
+	case old == nil:
+		u.TGPPAccessForwardingActionInformation = new
+	default:
+		u.UpdateTGPPAccessForwardingActionInformation = &ie.UpdateTGPPAccessForwardingActionInformationFields{}
+		if !diffFields(u.UpdateTGPPAccessForwardingActionInformation, old, new) {
+			return nil
+		}
+	}
+	switch old, new := o.NonTGPPAccessForwardingActionInformation, n.NonTGPPAccessForwardingActionInformation; {
+	case reflect.DeepEqual(old, new):
+	case new == nil:
+		return nil
+	case old == nil:
+		u.NonTGPPAccessForwardingActionInformation = new
+	default:
+		u.UpdateNonTGPPAccessForwardingActionInformation = &ie.UpdateNonTGPPAccessForwardingActionInformationFields{}
+		if !diffFields(u.UpdateNonTGPPAccessForwardingActionInformation, old, new) {
+			return nil
+		}
+	}
+	return u.ToIE()
+}
+
+// diffFields sets the fields of u to the values of the same-named fields in new
+// that differ from the ones in old, where u is the pointer to the XFields struct
+// of Update IE and old and new are the ones of the rule, e.g., *ie.UpdateURRFields
+// and *ie.CreateURRFields. It is the reverse of mergeFields.
+//
+// false is returned if any of the changes cannot be expressed with u, i.e., the
+// field is not in u, the optional value is removed, or IEs are changed. The
+// fields in skip are ignored, as the nested Update IEs need to be handled
+// separately.
+func diffFields(u, old, new any, skip ...string) bool {
+	d := reflect.ValueOf(u).Elem()
+	o := reflect.ValueOf(old).Elem()
+	n := reflect.ValueOf(new).Elem()
+	for i := 0; i < n.NumField(); i++ {
+		name := n.Type().Field(i).Name
+		nv, ov := n.Field(i), o.Field(i)
+		if slices.Contains(skip, name) || reflect.DeepEqual(nv.Interface(), ov.Interface()) {
+			continue
+		}
+		if name == "IEs" {
+			return false
+		}
+		switch nv.Kind() {
+		case reflect.Pointer, reflect.Slice:
+			if nv.IsZero() || nv.Kind() == reflect.Slice && nv.Len() == 0 {
+				return false
+			}
+		}
+
+		dv := d.FieldByName(name)
+		switch {
+		case !dv.IsValid():
+			return false
+		case dv.Type() == nv.Type():
+			dv.Set(nv)
+		case dv.Kind() == reflect.Pointer && dv.Type().Elem() == nv.Type():
+			p := reflect.New(nv.Type())
+			p.Elem().Set(nv)
+			dv.Set(p)
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// missing returns the names in a that are not in b.
+func missing(a, b []string) []string {
+	var m []string
+	for _, s := range a {
+		if !slices.Contains(b, s) {
+			m = append(m, s)
+		}
+	}
+	return m
+}
@@ -0,0 +1,223 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+func newTestFAR(id uint32, fp ...*ie.IE) *ie.IE {
+	ies := []*ie.IE{ie.NewFARID(id), ie.NewApplyAction(0x02)}
+	if len(fp) > 0 {
+		ies = append(ies, ie.NewForwardingParameters(fp...))
+	}
+	return ie.NewCreateFAR(ies...)
+}
+
+func newTestPDR(id uint16, ies ...*ie.IE) *ie.IE {
+	return ie.NewCreatePDR(append([]*ie.IE{
+		ie.NewPDRID(id),
+		ie.NewPrecedence(100),
+		ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+		ie.NewFARID(1),
+	}, ies...)...)
+}
+
+func newTestMAR(id uint16, farID uint32) *ie.IE {
+	return ie.NewCreateMAR(
+		ie.NewMARID(id),
+		ie.NewSteeringFunctionality(0),
+		ie.NewSteeringMode(0),
+		ie.NewTGPPAccessForwardingActionInformation(ie.NewFARID(farID), ie.NewWeight(100)),
+	)
+}
+
+func TestDiffRules(t *testing.T) {
+	cases := []struct {
+		description   string
+		prev, desired []*ie.IE
+		want          []ie.IEType
+	}{
+		{
+			"NoChange",
+			[]*ie.IE{newTestPDR(1), newTestFAR(1), ie.NewCreateBAR(ie.NewBARID(1))},
+			[]*ie.IE{newTestPDR(1), newTestFAR(1), ie.NewCreateBAR(ie.NewBARID(1))},
+			nil,
+		}, {
+			"AddedAndRemoved",
+			[]*ie.IE{newTestPDR(1), newTestPDR(2), newTestFAR(1)},
+			[]*ie.IE{newTestPDR(1), newTestPDR(3), newTestFAR(1), ie.NewCreateURR(ie.NewURRID(1), ie.NewMeasurementMethod(0, 1, 0), ie.NewReportingTriggers(0x01, 0x00, 0x00))},
+			[]ie.IEType{ie.RemovePDR, ie.CreatePDR, ie.CreateURR},
+		}, {
+			"UpdateForwardingParameters",
+			[]*ie.IE{newTestFAR(1, ie.NewDestinationInterface(ie.DstInterfaceCore), ie.NewNetworkInstance("internet"))},
+			[]*ie.IE{newTestFAR(1,
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewNetworkInstance("ims"),
+				ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.3", "", 0, 0, 0),
+			)},
+			[]ie.IEType{ie.UpdateFAR},
+		}, {
+			"ForwardingParametersAdded",
+			[]*ie.IE{newTestFAR(1)},
+			[]*ie.IE{newTestFAR(1, ie.NewDestinationInterface(ie.DstInterfaceAccess))},
+			[]ie.IEType{ie.UpdateFAR},
+		}, {
+			"ForwardingParametersRemoved",
+			[]*ie.IE{newTestFAR(1, ie.NewDestinationInterface(ie.DstInterfaceCore))},
+			[]*ie.IE{newTestFAR(1)},
+			[]ie.IEType{ie.RemoveFAR, ie.CreateFAR},
+		}, {
+			"UpdateDuplicatingParameters",
+			[]*ie.IE{ie.NewCreateFAR(
+				ie.NewFARID(1), ie.NewApplyAction(0x02, 0x00),
+				ie.NewDuplicatingParameters(ie.NewDestinationInterface(ie.DstInterfaceLIFunction)),
+				ie.NewDuplicatingParameters(ie.NewDestinationInterface(ie.DstInterfaceLIFunction)),
+			)},
+			[]*ie.IE{ie.NewCreateFAR(
+				ie.NewFARID(1), ie.NewApplyAction(0x02, 0x00),
+				ie.NewDuplicatingParameters(ie.NewDestinationInterface(ie.DstInterfaceLIFunction)),
+				ie.NewDuplicatingParameters(ie.NewDestinationInterface(ie.DstInterfaceLIFunction), ie.NewTransportLevelMarking(0x1111)),
+				ie.NewDuplicatingParameters(ie.NewDestinationInterface(ie.DstInterfaceCore)),
+			)},
+			[]ie.IEType{ie.UpdateFAR},
+		}, {
+			"UpdatePDR",
+			[]*ie.IE{newTestPDR(1, ie.NewActivatePredefinedRules("a"), ie.NewActivatePredefinedRules("b")), newTestFAR(1), newTestFAR(2)},
+			[]*ie.IE{newTestFAR(1), newTestFAR(2), ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(200),
+				ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore), ie.NewUEIPAddress(0x02, "10.0.0.1", "", 0, 0)),
+				ie.NewFARID(2),
+				ie.NewActivatePredefinedRules("b"),
+				ie.NewActivatePredefinedRules("c"),
+			)},
+			[]ie.IEType{ie.UpdatePDR},
+		}, {
+			"PDRFieldNotInUpdate",
+			[]*ie.IE{newTestPDR(1), newTestFAR(1), newTestMAR(1, 1)},
+			[]*ie.IE{newTestPDR(1, ie.NewMARID(1)), newTestFAR(1), newTestMAR(1, 1)},
+			[]ie.IEType{ie.RemovePDR, ie.CreatePDR},
+		}, {
+			"UpdateQERAndBAR",
+			[]*ie.IE{
+				ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen)),
+				ie.NewCreateBAR(ie.NewBARID(1)),
+			},
+			[]*ie.IE{
+				ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen), ie.NewQFI(9)),
+				ie.NewCreateBAR(ie.NewBARID(1), ie.NewDownlinkDataNotificationDelay(100*time.Millisecond)),
+			},
+			[]ie.IEType{ie.UpdateQER, ie.UpdateBARWithinSessionModificationRequest},
+		}, {
+			"BARReplaced",
+			[]*ie.IE{ie.NewCreateBAR(ie.NewBARID(1))},
+			[]*ie.IE{ie.NewCreateBAR(ie.NewBARID(2))},
+			[]ie.IEType{ie.RemoveBAR, ie.CreateBAR},
+		}, {
+			"UpdateMAR",
+			[]*ie.IE{newTestFAR(1), newTestFAR(2), ie.NewCreateMAR(
+				ie.NewMARID(1),
+				ie.NewSteeringFunctionality(0),
+				ie.NewSteeringMode(0),
+				ie.NewTGPPAccessForwardingActionInformation(ie.NewFARID(1), ie.NewWeight(10)),
+			)},
+			[]*ie.IE{newTestFAR(1), newTestFAR(2), ie.NewCreateMAR(
+				ie.NewMARID(1),
+				ie.NewSteeringFunctionality(0),
+				ie.NewSteeringMode(2),
+				ie.NewTGPPAccessForwardingActionInformation(ie.NewFARID(1), ie.NewWeight(60)),
+				ie.NewNonTGPPAccessForwardingActionInformation(ie.NewFARID(2), ie.NewWeight(40)),
+			)},
+			[]ie.IEType{ie.UpdateMAR},
+		}, {
+			"TrafficEndpoint",
+			[]*ie.IE{
+				ie.NewCreateTrafficEndpoint(ie.NewTrafficEndpointID(1), ie.NewNetworkInstance("internet")),
+				ie.NewCreateTrafficEndpoint(ie.NewTrafficEndpointID(2), ie.NewNetworkInstance("internet")),
+			},
+			[]*ie.IE{
+				ie.NewCreateTrafficEndpoint(ie.NewTrafficEndpointID(1), ie.NewNetworkInstance("ims")),
+			},
+			[]ie.IEType{ie.RemoveTrafficEndpoint, ie.UpdateTrafficEndpoint},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			prev, err := session.NewRules(c.prev...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			desired, err := session.NewRules(c.desired...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ies, err := session.DiffRules(prev, desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []ie.IEType
+			for _, i := range ies {
+				got = append(got, i.Type)
+			}
+			if diff := cmp.Diff(got, c.want); diff != "" {
+				t.Fatal(diff)
+			}
+
+			// the request applied to the session with prev results in desired.
+			st := session.NewStore(nil)
+			r := st.Establish(message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0, append([]*ie.IE{
+				ie.NewNodeID("127.0.0.2", "", ""),
+				ie.NewFSEID(0xcafe, net.ParseIP("127.0.0.2"), nil),
+			}, c.prev...)...))
+			if !r.Accepted() {
+				t.Fatalf("rejected: %v", r.Err)
+			}
+
+			req, err := session.NewModificationRequest(r.Session.LocalSEID, 2, prev, desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := req.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := message.Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = st.Modify(msg.(*message.SessionModificationRequest))
+			if !r.Accepted() {
+				t.Fatalf("rejected: %v", r.Err)
+			}
+			if diff := cmp.Diff(marshalIEs(t, r.Session.IEs()), marshalIEs(t, desired.IEs())); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func marshalIEs(t *testing.T, ies []*ie.IE) [][]byte {
+	t.Helper()
+
+	var bs [][]byte
+	for _, i := range ies {
+		b, err := i.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bs = append(bs, b)
+	}
+	return bs
+}
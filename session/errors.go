@@ -0,0 +1,95 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Error definitions.
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrRuleNotFound    = errors.New("rule not found")
+	ErrDuplicateRule   = errors.New("rule ID already in use")
+	ErrRuleInUse       = errors.New("rule referred to by another rule")
+	ErrNoAllocator     = errors.New("no allocator for CHOOSE request")
+
+	ErrPredefinedRuleNotFound = errors.New("predefined rule not found")
+	ErrInvalidPredefinedRule  = errors.New("invalid predefined rule")
+)
+
+// RuleError indicates the rule in a Create, Update or Remove IE could not be
+// applied to the session.
+//
+// Type is the type of the IE that failed, e.g., ie.UpdateFAR, and ID is the
+// rule ID in it, which is 0 if the IE is too malformed to find the ID.
+type RuleError struct {
+	Type ie.IEType
+	ID   uint32
+	Err  error
+}
+
+// Error returns message with the rule and the cause.
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("failed to apply %s with ID %d: %v", e.Type, e.ID, e.Err)
+}
+
+// Unwrap returns the cause of the error.
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// FailedRuleID returns the FailedRuleID IE that identifies the rule, or nil if
+// the rule has no Rule ID Type, e.g., Traffic Endpoint.
+func (e *RuleError) FailedRuleID() *ie.IE {
+	var typ uint8
+	switch e.Type {
+	case ie.CreatePDR, ie.UpdatePDR, ie.RemovePDR:
+		typ = ie.RuleIDTypePDR
+	case ie.CreateFAR, ie.UpdateFAR, ie.RemoveFAR:
+		typ = ie.RuleIDTypeFAR
+	case ie.CreateQER, ie.UpdateQER, ie.RemoveQER:
+		typ = ie.RuleIDTypeQER
+	case ie.CreateURR, ie.UpdateURR, ie.RemoveURR:
+		typ = ie.RuleIDTypeURR
+	case ie.CreateBAR, ie.UpdateBARWithinSessionModificationRequest, ie.RemoveBAR:
+		typ = ie.RuleIDTypeBAR
+	case ie.CreateMAR, ie.UpdateMAR, ie.RemoveMAR:
+		typ = ie.RuleIDTypeMAR
+	case ie.CreateSRR, ie.UpdateSRR, ie.RemoveSRR:
+		typ = ie.RuleIDTypeSRR
+	default:
+		return nil
+	}
+	return ie.NewFailedRuleID(typ, e.ID)
+}
+
+// ReferenceError indicates the reference between the rules is broken, which is
+// given in RuleError.
+//
+// Type and ID are the rule ID referred to by the failed rule, e.g., ie.FARID,
+// with Err ErrRuleNotFound, or the one that refers to the rule to be removed
+// with Err ErrRuleInUse.
+type ReferenceError struct {
+	Type ie.IEType
+	ID   uint32
+	Err  error
+}
+
+// Error returns message with the rule ID and the cause.
+func (e *ReferenceError) Error() string {
+	if errors.Is(e.Err, ErrRuleInUse) {
+		return fmt.Sprintf("referred to by %s %d", e.Type, e.ID)
+	}
+	return fmt.Sprintf("referring to %s %d: %v", e.Type, e.ID, e.Err)
+}
+
+// Unwrap returns the cause of the error.
+func (e *ReferenceError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,105 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// mergeFields sets the fields of dst to the values of the same-named fields in
+// src that are present, where dst and src are the pointers to XFields structs,
+// e.g., *ie.CreateURRFields and *ie.UpdateURRFields.
+//
+// The optional field in src, which is a pointer, is dereferenced if the field in
+// dst is a mandatory one. The fields only in either of them and IEs are ignored,
+// as the nested Update IEs need to be merged separately.
+func mergeFields(dst, src any) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for n := 0; n < s.NumField(); n++ {
+		name := s.Type().Field(n).Name
+		sv := s.Field(n)
+		if name == "IEs" || sv.IsZero() {
+			continue
+		}
+
+		dv := d.FieldByName(name)
+		switch {
+		case !dv.IsValid():
+		case sv.Type() == dv.Type():
+			dv.Set(sv)
+		case sv.Kind() == reflect.Pointer && sv.Type().Elem() == dv.Type():
+			dv.Set(sv.Elem())
+		}
+	}
+}
+
+func updateFAR(old *ie.CreateFARFields, u *ie.UpdateFARFields) *ie.CreateFARFields {
+	f := *old
+	mergeFields(&f, u)
+
+	if u.UpdateForwardingParameters != nil {
+		fp := &ie.ForwardingParametersFields{}
+		if f.ForwardingParameters != nil {
+			*fp = *f.ForwardingParameters
+		}
+		mergeFields(fp, u.UpdateForwardingParameters)
+		f.ForwardingParameters = fp
+	}
+
+	// Update Duplicating Parameters has no ID, so they are applied to the
+	// Duplicating Parameters in the same order.
+	if len(u.UpdateDuplicatingParameters) > 0 {
+		dps := slices.Clone(f.DuplicatingParameters)
+		for n, ud := range u.UpdateDuplicatingParameters {
+			dp := &ie.DuplicatingParametersFields{}
+			if n < len(dps) {
+				*dp = *dps[n]
+			}
+			mergeFields(dp, ud)
+			if n < len(dps) {
+				dps[n] = dp
+			} else {
+				dps = append(dps, dp)
+			}
+		}
+		f.DuplicatingParameters = dps
+	}
+	return &f
+}
+
+func updateMAR(old *ie.CreateMARFields, u *ie.UpdateMARFields) *ie.CreateMARFields {
+	f := *old
+	mergeFields(&f, u)
+
+	if v := u.UpdateTGPPAccessForwardingActionInformation; v != nil {
+		info := &ie.TGPPAccessForwardingActionInformationFields{}
+		if f.TGPPAccessForwardingActionInformation != nil {
+			*info = *f.TGPPAccessForwardingActionInformation
+		}
+		mergeFields(info, v)
+		f.TGPPAccessForwardingActionInformation = info
+	}
+	if v := u.UpdateNonTGPPAccessForwardingActionInformation; v != nil {
+		info := &ie.NonTGPPAccessForwardingActionInformationFields{}
+		if f.NonTGPPAccessForwardingActionInformation != nil {
+			*info = *f.NonTGPPAccessForwardingActionInformation
+		}
+		mergeFields(info, v)
+		f.NonTGPPAccessForwardingActionInformation = info
+	}
+	return &f
+}
+
+// update merges the Update IE into the copy of the rule, which is used for the
+// rules that have no nested Update IEs.
+func update[F, U any](old *F, u *U) *F {
+	f := *old
+	mergeFields(&f, u)
+	return &f
+}
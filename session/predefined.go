@@ -0,0 +1,243 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// PredefinedRules is the registry of the predefined rules configured in UP
+// function, which are activated by the name in Activate Predefined Rules IE in
+// Create PDR and Update PDR, as specified in 3GPP TS 29.244 clause 5.19.
+//
+// Each predefined rule is a set of rules, typically PDRs with the FARs, QERs
+// and URRs they refer to.
+//
+// PredefinedRules is safe for concurrent use.
+type PredefinedRules struct {
+	mu    sync.RWMutex
+	rules map[string]*Rules
+}
+
+// NewPredefinedRules creates a new empty PredefinedRules.
+func NewPredefinedRules() *PredefinedRules {
+	return &PredefinedRules{rules: map[string]*Rules{}}
+}
+
+// predefinedRuleJSON is the JSON representation of a predefined rule.
+type predefinedRuleJSON struct {
+	Name string   `json:"name"`
+	IEs  []*ie.IE `json:"ies"`
+}
+
+// ParsePredefinedRules creates PredefinedRules from the JSON encoding generated
+// by MarshalJSON, e.g., the content of a configuration file.
+//
+// It is a list of the predefined rules with "name" and "ies", which are the
+// Create IEs in the form of (*ie.IE).MarshalJSON.
+func ParsePredefinedRules(b []byte) (*PredefinedRules, error) {
+	var v []*predefinedRuleJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	p := NewPredefinedRules()
+	for _, r := range v {
+		if err := p.Set(r.Name, r.IEs...); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// MarshalJSON returns the JSON encoding of the predefined rules in the order
+// of the name, which can be decoded with ParsePredefinedRules.
+func (p *PredefinedRules) MarshalJSON() ([]byte, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	v := make([]*predefinedRuleJSON, 0, len(p.rules))
+	for _, name := range sortedKeys(p.rules) {
+		v = append(v, &predefinedRuleJSON{Name: name, IEs: p.rules[name].IEs()})
+	}
+	return json.Marshal(v)
+}
+
+// Set sets the predefined rule with the Create IEs, replacing the one with the
+// same name if any.
+//
+// The error is returned if the name is empty, or the IEs cannot be held in
+// Rules as described in NewRules.
+func (p *PredefinedRules) Set(name string, ies ...*ie.IE) error {
+	if name == "" {
+		return fmt.Errorf("predefined rule %q: %w", name, ErrInvalidPredefinedRule)
+	}
+	r, err := NewRules(ies...)
+	if err != nil {
+		return fmt.Errorf("predefined rule %q: %w", name, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.rules[name] = r
+	return nil
+}
+
+// Delete deletes the predefined rule. The sessions that have activated it fail
+// to be resolved after that.
+func (p *PredefinedRules) Delete(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.rules, name)
+}
+
+// Get returns the rules of the predefined rule. The Rules must not be modified
+// by the caller.
+func (p *PredefinedRules) Get(name string) (*Rules, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	r, ok := p.rules[name]
+	return r, ok
+}
+
+// Names returns the names of the predefined rules in order.
+func (p *PredefinedRules) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return sortedKeys(p.rules)
+}
+
+// Resolve returns the rules in effect at now, which are the PDRs in r that are
+// active at now as reported by IsActive, the other rules in r, and the rules of
+// the predefined rules activated by those PDRs.
+//
+// *RuleError with the PDR that activates the predefined rule is returned if the
+// predefined rule is not found, or it has a rule ID in use in r or in another
+// predefined rule activated. The name of the predefined rule is in the error.
+func (p *PredefinedRules) Resolve(r *Rules, now time.Time) (*Rules, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	res := r.clone()
+	res.PDRs = map[uint16]*ie.CreatePDRFields{}
+	for id, f := range r.PDRs {
+		if IsActive(f, now) {
+			res.PDRs[id] = f
+		}
+	}
+
+	activated := map[string]bool{}
+	for _, id := range sortedKeys(res.PDRs) {
+		for _, name := range res.PDRs[id].ActivatePredefinedRules {
+			if activated[name] {
+				continue
+			}
+			activated[name] = true
+
+			if err := p.merge(res, r, name); err != nil {
+				return nil, &RuleError{Type: ie.CreatePDR, ID: uint32(id), Err: err}
+			}
+		}
+	}
+	return res, nil
+}
+
+// merge adds the rules of the predefined rule to res. The PDR IDs in r are not
+// used even if the PDRs are not active.
+func (p *PredefinedRules) merge(res, r *Rules, name string) error {
+	x, ok := p.rules[name]
+	if !ok {
+		return fmt.Errorf("predefined rule %q: %w", name, ErrPredefinedRuleNotFound)
+	}
+
+	if err := x.mergeInto(res, r.PDRs); err != nil {
+		return fmt.Errorf("predefined rule %q: %w", name, err)
+	}
+	return nil
+}
+
+// mergeInto adds the rules to res, failing with the first one that has the ID
+// in use.
+func (r *Rules) mergeInto(res *Rules, pdrs map[uint16]*ie.CreatePDRFields) error {
+	if err := mergeRules(ie.CreatePDR, res.PDRs, r.PDRs, pdrs); err != nil {
+		return err
+	}
+	if err := mergeRules(ie.CreateFAR, res.FARs, r.FARs, nil); err != nil {
+		return err
+	}
+	if err := mergeRules(ie.CreateURR, res.URRs, r.URRs, nil); err != nil {
+		return err
+	}
+	if err := mergeRules(ie.CreateQER, res.QERs, r.QERs, nil); err != nil {
+		return err
+	}
+	if err := mergeRules(ie.CreateTrafficEndpoint, res.TrafficEndpoints, r.TrafficEndpoints, nil); err != nil {
+		return err
+	}
+	if err := mergeRules(ie.CreateMAR, res.MARs, r.MARs, nil); err != nil {
+		return err
+	}
+	if err := mergeRules(ie.CreateSRR, res.SRRs, r.SRRs, nil); err != nil {
+		return err
+	}
+	if r.BAR != nil {
+		if res.BAR != nil {
+			return &RuleError{Type: ie.CreateBAR, ID: uint32(r.BAR.BARID), Err: ErrDuplicateRule}
+		}
+		res.BAR = r.BAR
+	}
+	return nil
+}
+
+// mergeRules adds the rules in src to dst, failing with the first one that has
+// the ID in dst or in reserved.
+func mergeRules[K ruleID, F any](typ ie.IEType, dst, src, reserved map[K]*F) error {
+	for _, id := range sortedKeys(src) {
+		if _, ok := dst[id]; ok {
+			return &RuleError{Type: typ, ID: uint32(id), Err: ErrDuplicateRule}
+		}
+		if _, ok := reserved[id]; ok {
+			return &RuleError{Type: typ, ID: uint32(id), Err: ErrDuplicateRule}
+		}
+	}
+	maps.Copy(dst, src)
+	return nil
+}
+
+// IsActive reports whether the PDR is active at now, i.e., now is not before
+// its Activation Time and is before its Deactivation Time, if any.
+func IsActive(pdr *ie.CreatePDRFields, now time.Time) bool {
+	if pdr.ActivationTime != nil && now.Before(*pdr.ActivationTime) {
+		return false
+	}
+	if pdr.DeactivationTime != nil && !now.Before(*pdr.DeactivationTime) {
+		return false
+	}
+	return true
+}
+
+// checkPredefinedRules returns the error for the first name that is not in the
+// predefined rules. Nothing is checked if p is nil.
+func checkPredefinedRules(p *PredefinedRules, names []string) error {
+	if p == nil {
+		return nil
+	}
+	for _, name := range names {
+		if _, ok := p.Get(name); !ok {
+			return fmt.Errorf("predefined rule %q: %w", name, ErrPredefinedRuleNotFound)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+func TestPredefinedRulesJSON(t *testing.T) {
+	p := session.NewPredefinedRules()
+	if err := p.Set("video",
+		ie.NewCreatePDR(
+			ie.NewPDRID(0x8001),
+			ie.NewPrecedence(50),
+			ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore), ie.NewApplicationID("video")),
+			ie.NewFARID(0x80000001),
+			ie.NewQERID(0x80000001),
+		),
+		ie.NewCreateFAR(ie.NewFARID(0x80000001), ie.NewApplyAction(0x02)),
+		ie.NewCreateQER(ie.NewQERID(0x80000001), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen)),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Set("block", ie.NewCreateFAR(ie.NewFARID(0x80000002), ie.NewApplyAction(0x01))); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := session.ParsePredefinedRules(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got.Names(), []string{"block", "video"}); diff != "" {
+		t.Fatal(diff)
+	}
+	for _, name := range got.Names() {
+		x, _ := got.Get(name)
+		y, _ := p.Get(name)
+		if diff := cmp.Diff(marshalIEs(t, x.IEs()), marshalIEs(t, y.IEs())); diff != "" {
+			t.Errorf("%s: %s", name, diff)
+		}
+	}
+
+	if _, err := session.ParsePredefinedRules([]byte(`[{"name": "", "ies": []}]`)); !errors.Is(err, session.ErrInvalidPredefinedRule) {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestPredefinedRulesResolve(t *testing.T) {
+	p := session.NewPredefinedRules()
+	if err := p.Set("video",
+		ie.NewCreatePDR(
+			ie.NewPDRID(0x8001),
+			ie.NewPrecedence(50),
+			ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore), ie.NewApplicationID("video")),
+			ie.NewFARID(0x80000001),
+			ie.NewQERID(0x80000001),
+		),
+		ie.NewCreateFAR(ie.NewFARID(0x80000001), ie.NewApplyAction(0x02)),
+		ie.NewCreateQER(ie.NewQERID(0x80000001), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen)),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Set("block", ie.NewCreateFAR(ie.NewFARID(0x80000002), ie.NewApplyAction(0x01))); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	rules, err := session.NewRules(
+		newTestPDR(1, ie.NewActivatePredefinedRules("video"), ie.NewActivationTime(start)),
+		newTestPDR(2, ie.NewActivatePredefinedRules("video"), ie.NewDeactivationTime(start.Add(time.Hour))),
+		newTestFAR(1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		description string
+		now         time.Time
+		pdrs        []uint16
+	}{
+		{"BeforeActivation", start.Add(-time.Second), []uint16{2, 0x8001}},
+		{"BothActive", start, []uint16{1, 2, 0x8001}},
+		{"AfterDeactivation", start.Add(time.Hour), []uint16{1, 0x8001}},
+	} {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := p.Resolve(rules, c.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []uint16
+			for _, i := range got.CreatePDRs() {
+				id, err := i.PDRID()
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, id)
+			}
+			if diff := cmp.Diff(ids, c.pdrs); diff != "" {
+				t.Error(diff)
+			}
+			if len(got.FARs) != 2 || len(got.QERs) != 1 {
+				t.Errorf("got %d FARs, %d QERs", len(got.FARs), len(got.QERs))
+			}
+		})
+	}
+
+	t.Run("NotActivated", func(t *testing.T) {
+		rules, err := session.NewRules(newTestPDR(1, ie.NewActivatePredefinedRules("video"), ie.NewDeactivationTime(start)), newTestFAR(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := p.Resolve(rules, start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.PDRs) != 0 || len(got.FARs) != 1 {
+			t.Errorf("got %d PDRs, %d FARs", len(got.PDRs), len(got.FARs))
+		}
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		for _, c := range []struct {
+			description string
+			ies         []*ie.IE
+			want        error
+		}{
+			{
+				"NotFound",
+				[]*ie.IE{newTestPDR(1, ie.NewActivatePredefinedRules("unknown")), newTestFAR(1)},
+				session.ErrPredefinedRuleNotFound,
+			}, {
+				"DuplicateRule",
+				[]*ie.IE{newTestPDR(1, ie.NewActivatePredefinedRules("block")), newTestFAR(0x80000002)},
+				session.ErrDuplicateRule,
+			},
+		} {
+			t.Run(c.description, func(t *testing.T) {
+				rules, err := session.NewRules(c.ies...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = p.Resolve(rules, start)
+				var rerr *session.RuleError
+				if !errors.Is(err, c.want) || !errors.As(err, &rerr) {
+					t.Fatalf("got error %v", err)
+				}
+				if rerr.Type != ie.CreatePDR || rerr.ID != 1 {
+					t.Errorf("got %s with ID %d", rerr.Type, rerr.ID)
+				}
+			})
+		}
+	})
+}
+
+func TestStorePredefinedRules(t *testing.T) {
+	p := session.NewPredefinedRules()
+	if err := p.Set("video", ie.NewCreateFAR(ie.NewFARID(0x80000001), ie.NewApplyAction(0x02))); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Set("block", ie.NewCreateFAR(ie.NewFARID(0x80000002), ie.NewApplyAction(0x01))); err != nil {
+		t.Fatal(err)
+	}
+	st := session.NewStore(newTestAllocator())
+	st.SetPredefinedRules(p)
+
+	r := st.Establish(newTestEstablishmentRequest(newTestPDR(2, ie.NewActivatePredefinedRules("video"))))
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	seid := r.Session.LocalSEID
+
+	r = st.Modify(message.NewSessionModificationRequest(0, 0, seid, 2, 0,
+		ie.NewUpdatePDR(ie.NewPDRID(2), ie.NewActivatePredefinedRules("block"), ie.NewDeactivatePredefinedRules("video")),
+	))
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	if diff := cmp.Diff(r.Session.PDRs[2].ActivatePredefinedRules, []string{"block"}); diff != "" {
+		t.Error(diff)
+	}
+
+	r = st.Modify(message.NewSessionModificationRequest(0, 0, seid, 3, 0,
+		ie.NewUpdatePDR(ie.NewPDRID(2), ie.NewActivatePredefinedRules("unknown")),
+	))
+	if r.Accepted() || !errors.Is(r.Err, session.ErrPredefinedRuleNotFound) || !strings.Contains(r.Err.Error(), `"unknown"`) {
+		t.Fatalf("got cause %d, error %v", r.Cause, r.Err)
+	}
+	if diff := cmp.Diff(r.FailedRuleID(), ie.NewFailedRuleID(ie.RuleIDTypePDR, 2)); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"errors"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// Result is the result of applying a request to the Store, which has the IEs
+// to be set in the response.
+type Result struct {
+	// Session is the state of the session after the request is applied. It is
+	// the one before the request if it failed, or nil if the session does not
+	// exist. For Session Deletion Request, it is the session deleted.
+	Session *Session
+
+	// Cause is the value of Cause IE in the response, and Err is the reason
+	// why the request is rejected, which is nil if Cause is Request accepted.
+	// Err is *RuleError if a rule failed to be applied.
+	Cause uint8
+	Err   error
+	// OffendingIE is the type of IE that is missing or incorrect, if any.
+	OffendingIE ie.IEType
+
+	// CreatedPDRs, CreatedTrafficEndpoints and UpdatedPDRs are the IEs that
+	// have the F-TEIDs and UE IP addresses allocated.
+	CreatedPDRs             []*ie.IE
+	CreatedTrafficEndpoints []*ie.IE
+	UpdatedPDRs             []*ie.IE
+
+	// seid is the SEID to be set in the header of the response.
+	seid uint64
+}
+
+func (r *Result) reject(cause uint8, offending ie.IEType, err error) *Result {
+	r.Cause = cause
+	r.OffendingIE = offending
+	r.Err = err
+	r.CreatedPDRs = nil
+	r.CreatedTrafficEndpoints = nil
+	r.UpdatedPDRs = nil
+	return r
+}
+
+// fail rejects the request with the error from applying the rules.
+func (r *Result) fail(err error) *Result {
+	return r.reject(ie.CauseRuleCreationModificationFailure, 0, err)
+}
+
+// Accepted reports whether the request has been accepted.
+func (r *Result) Accepted() bool {
+	return r.Cause == ie.CauseRequestAccepted
+}
+
+// FailedRuleID returns the FailedRuleID IE for the rule that failed, or nil if
+// the request did not fail in applying a rule.
+func (r *Result) FailedRuleID() *ie.IE {
+	var re *RuleError
+	if !errors.As(r.Err, &re) {
+		return nil
+	}
+	return re.FailedRuleID()
+}
+
+// IEs returns the IEs to be set in the response: Cause, Offending IE, Failed
+// Rule ID, Created PDR, Created Traffic Endpoint and Updated PDR, the ones not
+// applicable being omitted.
+func (r *Result) IEs() []*ie.IE {
+	ies := []*ie.IE{ie.NewCause(r.Cause)}
+	if r.OffendingIE != 0 {
+		ies = append(ies, ie.NewOffendingIE(r.OffendingIE))
+	}
+	if f := r.FailedRuleID(); f != nil {
+		ies = append(ies, f)
+	}
+	ies = append(ies, r.CreatedPDRs...)
+	ies = append(ies, r.CreatedTrafficEndpoints...)
+	return append(ies, r.UpdatedPDRs...)
+}
+
+// EstablishmentResponse returns the Session Establishment Response with the
+// IEs in the Result and the ones given, e.g., Node ID and UP F-SEID.
+func (r *Result) EstablishmentResponse(seq uint32, ies ...*ie.IE) *message.SessionEstablishmentResponse {
+	return message.NewSessionEstablishmentResponse(0, 0, r.seid, seq, 0, append(r.IEs(), ies...)...)
+}
+
+// ModificationResponse returns the Session Modification Response with the IEs
+// in the Result and the ones given.
+func (r *Result) ModificationResponse(seq uint32, ies ...*ie.IE) *message.SessionModificationResponse {
+	return message.NewSessionModificationResponse(0, 0, r.seid, seq, 0, append(r.IEs(), ies...)...)
+}
+
+// DeletionResponse returns the Session Deletion Response with the IEs in the
+// Result and the ones given, e.g., Usage Report.
+func (r *Result) DeletionResponse(seq uint32, ies ...*ie.IE) *message.SessionDeletionResponse {
+	return message.NewSessionDeletionResponse(0, 0, r.seid, seq, 0, append(r.IEs(), ies...)...)
+}
@@ -0,0 +1,174 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"maps"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Rules is the set of rules in a PFCP session, held in the typed fields of the
+// Create IEs and keyed by the rule IDs.
+type Rules struct {
+	PDRs             map[uint16]*ie.CreatePDRFields
+	FARs             map[uint32]*ie.CreateFARFields
+	URRs             map[uint32]*ie.CreateURRFields
+	QERs             map[uint32]*ie.CreateQERFields
+	BAR              *ie.CreateBARFields
+	TrafficEndpoints map[uint8]*ie.CreateTrafficEndpointFields
+	MARs             map[uint16]*ie.CreateMARFields
+	SRRs             map[uint8]*ie.CreateSRRFields
+}
+
+func newRules() *Rules {
+	return &Rules{
+		PDRs:             map[uint16]*ie.CreatePDRFields{},
+		FARs:             map[uint32]*ie.CreateFARFields{},
+		URRs:             map[uint32]*ie.CreateURRFields{},
+		QERs:             map[uint32]*ie.CreateQERFields{},
+		TrafficEndpoints: map[uint8]*ie.CreateTrafficEndpointFields{},
+		MARs:             map[uint16]*ie.CreateMARFields{},
+		SRRs:             map[uint8]*ie.CreateSRRFields{},
+	}
+}
+
+// NewRules creates the Rules from the Create IEs, e.g., the ones to be sent
+// in Session Establishment Request. The rules are held as they are; nothing is
+// allocated for the CHOOSE flags.
+//
+// *RuleError is returned if any of the IEs is malformed or has the ID in use,
+// and *ie.InvalidTypeError if it is not a Create IE.
+func NewRules(ies ...*ie.IE) (*Rules, error) {
+	var c ruleIEs
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.CreatePDR:
+			c.pdrs = append(c.pdrs, i)
+		case ie.CreateFAR:
+			c.fars = append(c.fars, i)
+		case ie.CreateURR:
+			c.urrs = append(c.urrs, i)
+		case ie.CreateQER:
+			c.qers = append(c.qers, i)
+		case ie.CreateBAR:
+			c.bars = append(c.bars, i)
+		case ie.CreateTrafficEndpoint:
+			c.tes = append(c.tes, i)
+		case ie.CreateMAR:
+			c.mars = append(c.mars, i)
+		case ie.CreateSRR:
+			c.srrs = append(c.srrs, i)
+		default:
+			return nil, &ie.InvalidTypeError{Type: i.Type}
+		}
+	}
+
+	r := newRules()
+	if err := r.create(c, nil, nil); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// clone returns the copy of the Rules. The rules are shared, as they are
+// replaced instead of being modified in place.
+func (r *Rules) clone() *Rules {
+	return &Rules{
+		PDRs:             maps.Clone(r.PDRs),
+		FARs:             maps.Clone(r.FARs),
+		URRs:             maps.Clone(r.URRs),
+		QERs:             maps.Clone(r.QERs),
+		BAR:              r.BAR,
+		TrafficEndpoints: maps.Clone(r.TrafficEndpoints),
+		MARs:             maps.Clone(r.MARs),
+		SRRs:             maps.Clone(r.SRRs),
+	}
+}
+
+// CreatePDRs returns the PDRs as CreatePDR IEs in the order of PDR ID, which
+// can be given to the packet classifier.
+func (r *Rules) CreatePDRs() []*ie.IE {
+	return toIEs(r.PDRs, (*ie.CreatePDRFields).ToIE)
+}
+
+// IEs returns all the rules as the Create IEs, in the order of PDR, FAR, URR,
+// QER, BAR, Traffic Endpoint, MAR and SRR, each sorted by the ID.
+func (r *Rules) IEs() []*ie.IE {
+	ies := r.CreatePDRs()
+	ies = append(ies, toIEs(r.FARs, (*ie.CreateFARFields).ToIE)...)
+	ies = append(ies, toIEs(r.URRs, (*ie.CreateURRFields).ToIE)...)
+	ies = append(ies, toIEs(r.QERs, (*ie.CreateQERFields).ToIE)...)
+	if r.BAR != nil {
+		ies = append(ies, r.BAR.ToIE())
+	}
+	ies = append(ies, toIEs(r.TrafficEndpoints, (*ie.CreateTrafficEndpointFields).ToIE)...)
+	ies = append(ies, toIEs(r.MARs, (*ie.CreateMARFields).ToIE)...)
+	return append(ies, toIEs(r.SRRs, (*ie.CreateSRRFields).ToIE)...)
+}
+
+func toIEs[K ruleID, F any](rules map[K]*F, toIE func(*F) *ie.IE) []*ie.IE {
+	ies := make([]*ie.IE, 0, len(rules))
+	for _, id := range sortedKeys(rules) {
+		ies = append(ies, toIE(rules[id]))
+	}
+	return ies
+}
+
+// ruleIEs is the list of rule IEs of each kind in a request, which are all of
+// Create, Update or Remove IEs.
+type ruleIEs struct {
+	pdrs, fars, urrs, qers, bars, tes, mars, srrs []*ie.IE
+}
+
+// create applies the Create IEs in the order that the rules referenced by the
+// others are created first, calling the hooks with the PDRs and the Traffic
+// Endpoints before they are added if given.
+func (r *Rules) create(c ruleIEs, pdrHook func(*ie.CreatePDRFields) error, teHook func(*ie.CreateTrafficEndpointFields) error) error {
+	if err := createRules(c.tes, ie.CreateTrafficEndpointType, r.TrafficEndpoints, teHook,
+		func(f *ie.CreateTrafficEndpointFields) uint8 { return f.TrafficEndpointID }); err != nil {
+		return err
+	}
+	if err := createRules(c.pdrs, ie.CreatePDRType, r.PDRs, pdrHook,
+		func(f *ie.CreatePDRFields) uint16 { return f.PDRID }); err != nil {
+		return err
+	}
+	if err := createRules(c.fars, ie.CreateFARType, r.FARs, nil,
+		func(f *ie.CreateFARFields) uint32 { return f.FARID }); err != nil {
+		return err
+	}
+	if err := createRules(c.urrs, ie.CreateURRType, r.URRs, nil,
+		func(f *ie.CreateURRFields) uint32 { return f.URRID }); err != nil {
+		return err
+	}
+	if err := createRules(c.qers, ie.CreateQERType, r.QERs, nil,
+		func(f *ie.CreateQERFields) uint32 { return f.QERID }); err != nil {
+		return err
+	}
+	if err := createRules(c.mars, ie.CreateMARType, r.MARs, nil,
+		func(f *ie.CreateMARFields) uint16 { return f.MARID }); err != nil {
+		return err
+	}
+	if err := createRules(c.srrs, ie.CreateSRRType, r.SRRs, nil,
+		func(f *ie.CreateSRRFields) uint8 { return f.SRRID }); err != nil {
+		return err
+	}
+
+	// a session has at most one BAR.
+	for _, i := range c.bars {
+		f, err := ie.CreateBARType.Get(i)
+		if err != nil {
+			return newRuleError(i, err)
+		}
+		if r.BAR != nil {
+			return &RuleError{Type: i.Type, ID: uint32(f.BARID), Err: ErrDuplicateRule}
+		}
+		r.BAR = f
+	}
+	return nil
+}
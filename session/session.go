@@ -0,0 +1,61 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package session provides the model of PFCP session state, which is the set of
+// rules created and modified by the Session Establishment and Modification
+// procedures specified in 3GPP TS 29.244 clause 7.5.
+//
+// Store applies the requests to the sessions it holds and returns the Result
+// to build the response with. It is intended for the UP function stand-ins used
+// in tests, and the sessions can be given to the packet classifier and the other
+// components in upf directory.
+package session
+
+import (
+	"maps"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Session is the state of a PFCP session.
+//
+// The rules are held in the typed fields of the Create IEs, with the Update IEs
+// applied on them. The F-TEIDs and UE IP addresses requested with CHOOSE flags
+// are replaced with the ones allocated.
+//
+// The Session returned by Store is a snapshot; it is not modified by the later
+// requests, and must not be modified by the caller.
+type Session struct {
+	// LocalSEID is the SEID allocated by the Store, and RemoteSEID is the one
+	// in CP F-SEID.
+	LocalSEID  uint64
+	RemoteSEID uint64
+
+	// NodeID is the Node ID of the CP function in string.
+	NodeID string
+	// CPFSEID is the CP F-SEID given in the latest request.
+	CPFSEID *ie.FSEIDFields
+	// SNSSAI is the S-NSSAI of the session in the encoded form, if any.
+	SNSSAI []byte
+
+	Rules
+
+	// allocated is the resources allocated for each PDR or Traffic Endpoint.
+	allocated map[allocKey][]*allocation
+}
+
+func newSession() *Session {
+	return &Session{
+		Rules:     *newRules(),
+		allocated: map[allocKey][]*allocation{},
+	}
+}
+
+// clone returns the copy of the session to apply the modification on.
+func (s *Session) clone() *Session {
+	c := *s
+	c.Rules = *s.Rules.clone()
+	c.allocated = maps.Clone(s.allocated)
+	return &c
+}
@@ -0,0 +1,231 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session
+
+import (
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// Store holds the sessions and applies the session related requests to them.
+//
+// Each request is applied as a whole; if any of the rules in it fails, the
+// request is rejected with Cause Rule creation/modification failure and the
+// Failed Rule ID, and the session is left as it was. The references between the
+// rules are also checked as CheckEstablishment and CheckModification do, so that
+// a rule referring to the one that does not exist, or the removal of a rule still
+// referred to, fails the request as well.
+//
+// Store is safe for concurrent use.
+type Store struct {
+	mu       sync.Mutex
+	alloc    Allocator
+	rules    *PredefinedRules
+	sessions map[uint64]*Session
+	lastSEID uint64
+}
+
+// NewStore creates a new Store. alloc is used to allocate the F-TEIDs and UE IP
+// addresses requested with CHOOSE flags, which can be nil if they are not used;
+// the rules that request them fail with ErrNoAllocator.
+func NewStore(alloc Allocator) *Store {
+	return &Store{
+		alloc:    alloc,
+		sessions: map[uint64]*Session{},
+	}
+}
+
+// SetPredefinedRules sets the predefined rules that the names in Activate
+// Predefined Rules in Create PDR and Update PDR must be in. The names are not
+// checked if it is not set.
+//
+// The rules are not applied to the sessions; use (*PredefinedRules).Resolve
+// to get the rules in effect.
+func (st *Store) SetPredefinedRules(p *PredefinedRules) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.rules = p
+}
+
+// Session returns the session with the local SEID given.
+func (st *Store) Session(seid uint64) (*Session, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	s, ok := st.sessions[seid]
+	return s, ok
+}
+
+// Sessions returns all the sessions in the order of local SEID.
+func (st *Store) Sessions() []*Session {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	ss := make([]*Session, 0, len(st.sessions))
+	for _, seid := range sortedKeys(st.sessions) {
+		ss = append(ss, st.sessions[seid])
+	}
+	return ss
+}
+
+// Establish creates a new session with the rules in the Session Establishment
+// Request. The local SEID is allocated by the Store.
+func (st *Store) Establish(req *message.SessionEstablishmentRequest) *Result {
+	r := &Result{Cause: ie.CauseRequestAccepted}
+	if req.NodeID == nil {
+		return r.reject(ie.CauseMandatoryIEMissing, ie.NodeID, nil)
+	}
+	if req.CPFSEID == nil {
+		return r.reject(ie.CauseMandatoryIEMissing, ie.FSEID, nil)
+	}
+	nodeID, err := req.NodeID.NodeID()
+	if err != nil {
+		return r.reject(ie.CauseMandatoryIEIncorrect, ie.NodeID, err)
+	}
+	fseid, err := req.CPFSEID.FSEID()
+	if err != nil {
+		return r.reject(ie.CauseMandatoryIEIncorrect, ie.FSEID, err)
+	}
+	r.seid = fseid.SEID
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	s := newSession()
+	s.LocalSEID = st.newSEID()
+	s.RemoteSEID = fseid.SEID
+	s.NodeID = nodeID
+	s.CPFSEID = fseid
+	if req.SNSSAI != nil {
+		s.SNSSAI = req.SNSSAI.Payload
+	}
+
+	t := &txn{alloc: st.alloc, rules: st.rules, sess: s, result: r}
+	if err := t.create(ruleIEs{
+		pdrs: req.CreatePDR, fars: req.CreateFAR, urrs: req.CreateURR, qers: req.CreateQER, bars: one(req.CreateBAR),
+		tes: req.CreateTrafficEndpoint, mars: req.CreateMAR, srrs: req.CreateSRR,
+	}); err != nil {
+		t.rollback()
+		return r.fail(err)
+	}
+	if errs := CheckEstablishment(req); len(errs) > 0 {
+		t.rollback()
+		return r.fail(errs[0])
+	}
+	t.commit()
+
+	st.sessions[s.LocalSEID] = s
+	r.Session = s
+	return r
+}
+
+// Modify applies the Session Modification Request to the session identified by
+// the SEID in the header. The Remove IEs are applied first, followed by the
+// Create IEs and then the Update IEs.
+func (st *Store) Modify(req *message.SessionModificationRequest) *Result {
+	r := &Result{Cause: ie.CauseRequestAccepted}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	old, ok := st.sessions[req.SEID()]
+	if !ok {
+		return r.reject(ie.CauseSessionContextNotFound, 0, ErrSessionNotFound)
+	}
+	r.Session = old
+	r.seid = old.RemoteSEID
+
+	s := old.clone()
+	if req.CPFSEID != nil {
+		fseid, err := req.CPFSEID.FSEID()
+		if err != nil {
+			return r.reject(ie.CauseMandatoryIEIncorrect, ie.FSEID, err)
+		}
+		s.CPFSEID = fseid
+		s.RemoteSEID = fseid.SEID
+	}
+	if req.SNSSAI != nil {
+		s.SNSSAI = req.SNSSAI.Payload
+	}
+
+	t := &txn{alloc: st.alloc, rules: st.rules, sess: s, result: r}
+	if err := t.apply(req); err != nil {
+		t.rollback()
+		return r.fail(err)
+	}
+	if errs := CheckModification(req, &old.Rules); len(errs) > 0 {
+		t.rollback()
+		return r.fail(errs[0])
+	}
+	t.commit()
+
+	st.sessions[s.LocalSEID] = s
+	r.Session = s
+	return r
+}
+
+func (t *txn) apply(req *message.SessionModificationRequest) error {
+	if err := t.remove(ruleIEs{
+		pdrs: req.RemovePDR, fars: req.RemoveFAR, urrs: req.RemoveURR, qers: req.RemoveQER, bars: one(req.RemoveBAR),
+		tes: req.RemoveTrafficEndpoint, mars: req.RemoveMAR, srrs: req.RemoveSRR,
+	}); err != nil {
+		return err
+	}
+	if err := t.create(ruleIEs{
+		pdrs: req.CreatePDR, fars: req.CreateFAR, urrs: req.CreateURR, qers: req.CreateQER, bars: one(req.CreateBAR),
+		tes: req.CreateTrafficEndpoint, mars: req.CreateMAR, srrs: req.CreateSRR,
+	}); err != nil {
+		return err
+	}
+	return t.update(ruleIEs{
+		pdrs: req.UpdatePDR, fars: req.UpdateFAR, urrs: req.UpdateURR, qers: req.UpdateQER, bars: one(req.UpdateBAR),
+		tes: req.UpdateTrafficEndpoint, mars: req.UpdateMAR, srrs: req.UpdateSRR,
+	})
+}
+
+// one returns the IE in a slice, or nil if it is nil.
+func one(i *ie.IE) []*ie.IE {
+	if i == nil {
+		return nil
+	}
+	return []*ie.IE{i}
+}
+
+// Delete deletes the session identified by the SEID in the header of Session
+// Deletion Request, and releases the resources allocated for it.
+func (st *Store) Delete(req *message.SessionDeletionRequest) *Result {
+	r := &Result{Cause: ie.CauseRequestAccepted}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	s, ok := st.sessions[req.SEID()]
+	if !ok {
+		return r.reject(ie.CauseSessionContextNotFound, 0, ErrSessionNotFound)
+	}
+	delete(st.sessions, s.LocalSEID)
+	r.Session = s
+	r.seid = s.RemoteSEID
+
+	for _, as := range s.allocated {
+		for _, a := range as {
+			a.release(st.alloc)
+		}
+	}
+	return r
+}
+
+// newSEID returns the local SEID not in use, which is never 0.
+func (st *Store) newSEID() uint64 {
+	for {
+		st.lastSEID++
+		if _, ok := st.sessions[st.lastSEID]; !ok && st.lastSEID != 0 {
+			return st.lastSEID
+		}
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package session_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+// testAllocator allocates the TEIDs and UE IP addresses sequentially.
+type testAllocator struct {
+	teid  uint32
+	ueip  byte
+	inUse map[string]bool
+}
+
+func newTestAllocator() *testAllocator {
+	return &testAllocator{teid: 0x100, ueip: 1, inUse: map[string]bool{}}
+}
+
+func (a *testAllocator) AllocateFTEID(_ *session.AllocationRequest, _ *ie.FTEIDFields) (*ie.FTEIDFields, error) {
+	a.teid++
+	f := ie.NewFTEIDFields(0x01, a.teid, net.IP{192, 168, 0, 1}, nil, 0)
+	a.inUse[fmt.Sprintf("fteid/%s/%#x", f.IPv4Address, f.TEID)] = true
+	return f, nil
+}
+
+func (a *testAllocator) AllocateUEIPAddress(_ *session.AllocationRequest, _ *ie.UEIPAddressFields) (*ie.UEIPAddressFields, error) {
+	a.ueip++
+	u := &ie.UEIPAddressFields{Flags: 0x02, IPv4Address: net.IP{10, 0, 0, a.ueip}}
+	a.inUse["ueip/"+u.IPv4Address.String()] = true
+	return u, nil
+}
+
+func (a *testAllocator) ReleaseFTEID(_ *session.AllocationRequest, f *ie.FTEIDFields) {
+	delete(a.inUse, fmt.Sprintf("fteid/%s/%#x", f.IPv4Address, f.TEID))
+}
+
+func (a *testAllocator) ReleaseUEIPAddress(_ *session.AllocationRequest, u *ie.UEIPAddressFields) {
+	delete(a.inUse, "ueip/"+u.IPv4Address.String())
+}
+
+func newTestEstablishmentRequest(ies ...*ie.IE) *message.SessionEstablishmentRequest {
+	return message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0, append([]*ie.IE{
+		ie.NewNodeID("127.0.0.2", "", ""),
+		ie.NewFSEID(0xcafe, net.ParseIP("127.0.0.2"), nil),
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x05, 0, nil, nil, 0), // CH with V4
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(0x12, "", "", 0, 0), // CHV4
+			),
+			ie.NewFARID(1),
+			ie.NewURRID(1),
+		),
+		ie.NewCreateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewNetworkInstance("internet"),
+			),
+		),
+		ie.NewCreateURR(ie.NewURRID(1), ie.NewMeasurementMethod(0, 1, 0), ie.NewReportingTriggers(0x01, 0x00, 0x00)),
+		ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen)),
+		ie.NewCreateBAR(ie.NewBARID(1)),
+	}, ies...)...)
+}
+
+func TestStoreEstablish(t *testing.T) {
+	alloc := newTestAllocator()
+	st := session.NewStore(alloc)
+
+	r := st.Establish(newTestEstablishmentRequest())
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+
+	s := r.Session
+	if s.LocalSEID == 0 || s.RemoteSEID != 0xcafe || s.NodeID != "127.0.0.2" {
+		t.Errorf("got SEIDs %#x/%#x, Node ID %s", s.LocalSEID, s.RemoteSEID, s.NodeID)
+	}
+	if len(s.PDRs) != 1 || len(s.FARs) != 1 || len(s.URRs) != 1 || len(s.QERs) != 1 || s.BAR == nil {
+		t.Fatalf("got %d PDRs, %d FARs, %d URRs, %d QERs, BAR %v", len(s.PDRs), len(s.FARs), len(s.URRs), len(s.QERs), s.BAR)
+	}
+	if got, ok := st.Session(s.LocalSEID); !ok || got != s {
+		t.Error("session not found in the store")
+	}
+
+	pdi := s.PDRs[1].PDI
+	if pdi.FTEID.HasCh() || pdi.FTEID.TEID != 0x101 {
+		t.Errorf("F-TEID not allocated: %+v", pdi.FTEID)
+	}
+	if len(pdi.UEIPAddresses) != 1 || !pdi.UEIPAddresses[0].IPv4Address.Equal(net.IP{10, 0, 0, 2}) {
+		t.Errorf("UE IP address not allocated: %+v", pdi.UEIPAddresses)
+	}
+
+	// the response is encoded and parsed as the SMF would do.
+	b, err := r.EstablishmentResponse(1, ie.NewNodeID("127.0.0.1", "", "")).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := message.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := msg.(*message.SessionEstablishmentResponse)
+	if res.SEID() != 0xcafe {
+		t.Errorf("got SEID %#x", res.SEID())
+	}
+	created, err := message.Collect(res, ie.CreatedPDRType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ie.CreatedPDRFields{{
+		PDRID:         1,
+		LocalFTEIDs:   []*ie.FTEIDFields{pdi.FTEID},
+		UEIPAddresses: pdi.UEIPAddresses,
+	}}
+	if diff := cmp.Diff(created, want); diff != "" {
+		t.Error(diff)
+	}
+
+	t.Run("MandatoryIEMissing", func(t *testing.T) {
+		r := st.Establish(message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0, ie.NewNodeID("127.0.0.2", "", "")))
+		if r.Cause != ie.CauseMandatoryIEMissing || r.OffendingIE != ie.FSEID {
+			t.Errorf("got cause %d, offending IE %s", r.Cause, r.OffendingIE)
+		}
+	})
+
+	t.Run("DanglingFAR", func(t *testing.T) {
+		r := st.Establish(newTestEstablishmentRequest(
+			ie.NewCreatePDR(ie.NewPDRID(2), ie.NewPrecedence(1), ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)), ie.NewFARID(9)),
+		))
+		if !errors.Is(r.Err, session.ErrRuleNotFound) || r.Session != nil {
+			t.Fatalf("got %v", r.Err)
+		}
+		if diff := cmp.Diff(r.FailedRuleID(), ie.NewFailedRuleID(ie.RuleIDTypePDR, 2)); diff != "" {
+			t.Error(diff)
+		}
+		if len(st.Sessions()) != 1 || len(alloc.inUse) != 2 {
+			t.Errorf("got %d sessions, allocations %v", len(st.Sessions()), alloc.inUse)
+		}
+	})
+
+	t.Run("NoAllocator", func(t *testing.T) {
+		r := session.NewStore(nil).Establish(newTestEstablishmentRequest())
+		if !errors.Is(r.Err, session.ErrNoAllocator) || r.Session != nil {
+			t.Errorf("got %v", r.Err)
+		}
+	})
+}
+
+func TestStoreModify(t *testing.T) {
+	alloc := newTestAllocator()
+	st := session.NewStore(alloc)
+	est := st.Establish(newTestEstablishmentRequest(
+		ie.NewCreateTrafficEndpoint(ie.NewTrafficEndpointID(1), ie.NewFTEID(0x05, 0, nil, nil, 0)),
+	))
+	if !est.Accepted() {
+		t.Fatalf("rejected: %v", est.Err)
+	}
+	seid := est.Session.LocalSEID
+	if len(est.CreatedTrafficEndpoints) != 1 || len(alloc.inUse) != 3 {
+		t.Fatalf("got %d Created Traffic Endpoints, %d allocations", len(est.CreatedTrafficEndpoints), len(alloc.inUse))
+	}
+
+	r := st.Modify(message.NewSessionModificationRequest(0, 0, seid, 2, 0,
+		ie.NewRemoveQER(ie.NewQERID(1)),
+		ie.NewCreateQER(ie.NewQERID(2), ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusClosed)),
+		ie.NewUpdatePDR(
+			ie.NewPDRID(1),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x05, 0, nil, nil, 0),
+				ie.NewUEIPAddress(0x02, "10.0.0.2", "", 0, 0), // the one allocated
+			),
+		),
+		ie.NewUpdateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x0c),
+			ie.NewUpdateForwardingParameters(
+				ie.NewOuterHeaderCreation(0x0100, 0x11111111, "192.168.0.2", "", 0, 0, 0),
+			),
+		),
+	))
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+
+	s := r.Session
+	if _, ok := s.QERs[1]; ok || len(s.QERs) != 1 {
+		t.Errorf("got %d QERs", len(s.QERs))
+	}
+	far := s.FARs[1]
+	if diff := cmp.Diff(far.ApplyAction, []byte{0x0c}); diff != "" {
+		t.Error(diff)
+	}
+	fp := far.ForwardingParameters
+	if fp.DestinationInterface != ie.DstInterfaceCore || *fp.NetworkInstance != "internet" || fp.OuterHeaderCreation.TEID != 0x11111111 {
+		t.Errorf("Update Forwarding Parameters not merged: %+v", fp)
+	}
+	if s.PDRs[1].Precedence != 100 || s.PDRs[1].PDI.FTEID.TEID != 0x103 {
+		t.Errorf("Update PDR not applied: %+v", s.PDRs[1])
+	}
+	if len(r.UpdatedPDRs) != 1 {
+		t.Fatalf("got %d Updated PDRs", len(r.UpdatedPDRs))
+	}
+
+	// the old F-TEID is released, while the UE IP address is kept.
+	if len(alloc.inUse) != 3 || !alloc.inUse["ueip/10.0.0.2"] {
+		t.Errorf("got allocations %v", alloc.inUse)
+	}
+
+	// the snapshot returned before is not modified.
+	// F-TEID of the Traffic Endpoint is allocated first, as it can be referenced by PDRs.
+	if est.Session.PDRs[1].PDI.FTEID.TEID != 0x102 || len(est.Session.QERs) != 1 {
+		t.Error("the previous snapshot is modified")
+	}
+
+	if r := st.Delete(message.NewSessionDeletionRequest(0, 0, seid, 3, 0)); !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	if len(alloc.inUse) != 0 || len(st.Sessions()) != 0 {
+		t.Errorf("not released: %v", alloc.inUse)
+	}
+}
+
+func TestStoreModifyFailure(t *testing.T) {
+	cases := []struct {
+		description string
+		ies         []*ie.IE
+		err         error
+		failedRule  *ie.IE
+	}{
+		{
+			"UnknownFAR",
+			[]*ie.IE{ie.NewUpdateFAR(ie.NewFARID(2), ie.NewApplyAction(0x02))},
+			session.ErrRuleNotFound,
+			ie.NewFailedRuleID(ie.RuleIDTypeFAR, 2),
+		}, {
+			"DuplicatePDR",
+			[]*ie.IE{ie.NewCreatePDR(ie.NewPDRID(1), ie.NewPrecedence(1), ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)))},
+			session.ErrDuplicateRule,
+			ie.NewFailedRuleID(ie.RuleIDTypePDR, 1),
+		}, {
+			"UnknownBAR",
+			[]*ie.IE{ie.NewRemoveBAR(ie.NewBARID(2))},
+			session.ErrRuleNotFound,
+			ie.NewFailedRuleID(ie.RuleIDTypeBAR, 2),
+		}, {
+			"MalformedQER",
+			[]*ie.IE{ie.NewCreateQER(ie.NewQERID(3))},
+			ie.ErrIENotFound,
+			ie.NewFailedRuleID(ie.RuleIDTypeQER, 3),
+		}, {
+			"AfterAllocation",
+			[]*ie.IE{
+				ie.NewCreatePDR(ie.NewPDRID(2), ie.NewPrecedence(1), ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewFTEID(0x05, 0, nil, nil, 0),
+				)),
+				ie.NewUpdateURR(ie.NewURRID(2)),
+			},
+			session.ErrRuleNotFound,
+			ie.NewFailedRuleID(ie.RuleIDTypeURR, 2),
+		}, {
+			"DanglingFAR",
+			[]*ie.IE{ie.NewCreatePDR(ie.NewPDRID(2), ie.NewPrecedence(1), ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore)), ie.NewFARID(9))},
+			session.ErrRuleNotFound,
+			ie.NewFailedRuleID(ie.RuleIDTypePDR, 2),
+		}, {
+			"DanglingBAR",
+			[]*ie.IE{ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyAction(0x04), ie.NewBARID(9))},
+			session.ErrRuleNotFound,
+			ie.NewFailedRuleID(ie.RuleIDTypeFAR, 2),
+		}, {
+			"FARInUse",
+			[]*ie.IE{ie.NewRemoveFAR(ie.NewFARID(1))},
+			session.ErrRuleInUse,
+			ie.NewFailedRuleID(ie.RuleIDTypeFAR, 1),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			alloc := newTestAllocator()
+			st := session.NewStore(alloc)
+			est := st.Establish(newTestEstablishmentRequest())
+			if !est.Accepted() {
+				t.Fatalf("rejected: %v", est.Err)
+			}
+
+			r := st.Modify(message.NewSessionModificationRequest(0, 0, est.Session.LocalSEID, 2, 0, c.ies...))
+			if r.Cause != ie.CauseRuleCreationModificationFailure || !errors.Is(r.Err, c.err) {
+				t.Fatalf("got cause %d, err %v", r.Cause, r.Err)
+			}
+			if diff := cmp.Diff(r.FailedRuleID(), c.failedRule); diff != "" {
+				t.Error(diff)
+			}
+			if r.Session != est.Session {
+				t.Error("the session should be left as it was")
+			}
+			if len(alloc.inUse) != 2 || len(r.CreatedPDRs) != 0 {
+				t.Errorf("allocations in the failed request not released: %v", alloc.inUse)
+			}
+
+			res := r.ModificationResponse(2)
+			if res.FailedRuleID == nil || res.Cause == nil {
+				t.Error("response should have Cause and Failed Rule ID")
+			}
+		})
+	}
+
+	t.Run("SessionNotFound", func(t *testing.T) {
+		r := session.NewStore(nil).Modify(message.NewSessionModificationRequest(0, 0, 1, 1, 0))
+		if r.Cause != ie.CauseSessionContextNotFound || !errors.Is(r.Err, session.ErrSessionNotFound) {
+			t.Errorf("got cause %d, err %v", r.Cause, r.Err)
+		}
+	})
+}
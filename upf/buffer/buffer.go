@@ -0,0 +1,334 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package buffer provides the model of downlink data buffering and Downlink
+// Data Notification by the FARs with BUFF and NOCP flags and the BAR, as
+// specified in 3GPP TS 29.244 clause 5.2.3 and 5.2.4.1.
+package buffer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+// ErrFARNotFound is returned when the FAR ID given is not in the Buffer.
+var ErrFARNotFound = errors.New("FAR not found")
+
+// Action is what the Buffer did with a packet.
+type Action uint8
+
+// Action definitions.
+const (
+	// ActionForward is returned when the FAR has FORW flag; the packet is not
+	// buffered and should be forwarded by the caller.
+	ActionForward Action = iota
+	ActionBuffer
+	ActionDrop
+)
+
+// String returns the name of the action, e.g., "BUFF".
+func (a Action) String() string {
+	switch a {
+	case ActionForward:
+		return "FORW"
+	case ActionBuffer:
+		return "BUFF"
+	case ActionDrop:
+		return "DROP"
+	default:
+		return fmt.Sprintf("Action(%d)", uint8(a))
+	}
+}
+
+// Packet is a downlink packet given to the Buffer.
+type Packet struct {
+	*packet.Packet
+
+	// PDRID and FARID are the rules that the packet hits.
+	PDRID uint16
+	FARID uint32
+
+	// PPI is the Paging Policy Indicator for the packet, if any, e.g., the one
+	// in the QER.
+	PPI *uint8
+}
+
+// Buffer buffers the downlink packets for the FARs with BUFF flag, and notifies
+// the CP function of the first packet for the ones with NOCP flag.
+//
+// The notifications are handled in the following way:
+//
+//   - The notification is due after the Downlink Data Notification Delay in
+//     BAR, and is cancelled if the FAR no longer has NOCP flag before that.
+//   - The notifications are suppressed for a FAR once sent, until the FAR is
+//     set again with NOCP flag, and during the DL Buffering Duration given in
+//     Session Report Response.
+//   - The number of buffered packets in the session is limited by the DL
+//     Buffering Suggested Packet Count during the DL Buffering Duration, or the
+//     Suggested Buffering Packets Count in BAR otherwise. The packets that
+//     exceed it are dropped.
+//   - When the DL Buffering Duration expires, the buffered packets are
+//     discarded and the notifications are no longer suppressed.
+//
+// Buffer is not safe for concurrent use.
+type Buffer struct {
+	bar  *ie.CreateBARFields
+	fars map[uint32]*far
+
+	pending []*Notification
+
+	// bufferUntil and bufferCount are the DL Buffering Duration and the DL
+	// Buffering Suggested Packet Count given in Session Report Response.
+	bufferUntil time.Time
+	bufferCount *uint16
+
+	dropped int
+}
+
+type far struct {
+	action   ie.ApplyActionFlags
+	packets  []*Packet
+	notified bool
+}
+
+// New creates a new Buffer with the BAR and the FARs of a session. bar can be
+// nil if the session has no BAR.
+func New(bar *ie.CreateBARFields, fars ...*ie.CreateFARFields) *Buffer {
+	b := &Buffer{bar: bar, fars: map[uint32]*far{}}
+	b.SetFAR(fars...)
+	return b
+}
+
+// SetBAR replaces the BAR, e.g., with the one updated by Session Modification
+// Request.
+func (b *Buffer) SetBAR(bar *ie.CreateBARFields) {
+	b.bar = bar
+}
+
+// SetFAR adds the FARs, or replaces the ones that have the same FAR ID, and
+// returns the buffered packets to be forwarded by the FARs that no longer have
+// BUFF flag but FORW flag. The packets are discarded if the FAR has neither.
+//
+// The notifications are enabled again for the FARs with NOCP flag.
+func (b *Buffer) SetFAR(fars ...*ie.CreateFARFields) []*Packet {
+	var released []*Packet
+	for _, f := range fars {
+		action := ie.ApplyActionFlags(f.ApplyAction)
+		x, ok := b.fars[f.FARID]
+		if !ok {
+			b.fars[f.FARID] = &far{action: action}
+			continue
+		}
+
+		x.action, x.notified = action, false
+		if !action.Has(ie.ApplyActionNOCP) {
+			b.cancel(f.FARID)
+		}
+		switch {
+		case action.Has(ie.ApplyActionBUFF):
+		case action.Has(ie.ApplyActionFORW):
+			released = append(released, x.packets...)
+			x.packets = nil
+		default:
+			b.dropped += len(x.packets)
+			x.packets = nil
+		}
+	}
+	return released
+}
+
+// RemoveFAR removes the FARs and discards the packets buffered for them.
+func (b *Buffer) RemoveFAR(ids ...uint32) {
+	b.Drop(ids...)
+	for _, id := range ids {
+		b.cancel(id)
+		delete(b.fars, id)
+	}
+}
+
+// Drop discards the packets buffered for the FARs, e.g., when DROBU flag is
+// set in Session Modification Request. All the packets are discarded if no ID
+// is given.
+func (b *Buffer) Drop(ids ...uint32) {
+	if len(ids) == 0 {
+		ids = b.ids()
+	}
+	for _, id := range ids {
+		if x, ok := b.fars[id]; ok {
+			b.dropped += len(x.packets)
+			x.packets = nil
+		}
+	}
+}
+
+// Enqueue handles the downlink packet with the FAR it hits, and returns what
+// is done with it. The notification for the packet, if any, is returned by Tick
+// when it is due.
+func (b *Buffer) Enqueue(now time.Time, p *Packet) (Action, error) {
+	x, ok := b.fars[p.FARID]
+	if !ok {
+		return ActionDrop, fmt.Errorf("FAR %d: %w", p.FARID, ErrFARNotFound)
+	}
+	b.expire(now)
+
+	if x.action.Has(ie.ApplyActionNOCP) && !x.notified && b.bufferUntil.IsZero() {
+		x.notified = true
+		n := &Notification{FARID: p.FARID, PDRID: p.PDRID, PPI: p.PPI, Time: now}
+		if p.Packet != nil {
+			n.QFI = p.QFI
+		}
+		if b.bar != nil && b.bar.DownlinkDataNotificationDelay != nil {
+			n.Time = now.Add(*b.bar.DownlinkDataNotificationDelay)
+		}
+		b.pending = append(b.pending, n)
+	}
+
+	switch {
+	case x.action.Has(ie.ApplyActionBUFF):
+		if limit, ok := b.limit(); ok && b.Len() >= limit {
+			b.dropped++
+			return ActionDrop, nil
+		}
+		x.packets = append(x.packets, p)
+		return ActionBuffer, nil
+	case x.action.Has(ie.ApplyActionFORW):
+		return ActionForward, nil
+	default:
+		return ActionDrop, nil
+	}
+}
+
+// Tick returns the notifications due at now in the order of the time, and
+// discards the buffered packets if the DL Buffering Duration expires.
+func (b *Buffer) Tick(now time.Time) []*Notification {
+	b.expire(now)
+
+	var due []*Notification
+	b.pending = slices.DeleteFunc(b.pending, func(n *Notification) bool {
+		if n.Time.After(now) {
+			return false
+		}
+		due = append(due, n)
+		return true
+	})
+	slices.SortStableFunc(due, func(a, b *Notification) int { return a.Time.Compare(b.Time) })
+	return due
+}
+
+// ApplyReportResponse applies the Update BAR and the DROBU flag in the Session
+// Report Response for the notification. The response that is not accepted is
+// ignored.
+func (b *Buffer) ApplyReportResponse(now time.Time, res *message.SessionReportResponse) error {
+	if res.Cause == nil {
+		return ie.ErrIENotFound
+	}
+	cause, err := res.Cause.Cause()
+	if err != nil {
+		return err
+	}
+	if cause != ie.CauseRequestAccepted {
+		return nil
+	}
+
+	if res.UpdateBAR != nil {
+		u, err := ie.UpdateBARWithinSessionReportResponseType.Get(res.UpdateBAR)
+		if err != nil {
+			return err
+		}
+		b.UpdateBAR(now, u)
+	}
+	if res.PFCPSRRspFlags != nil && res.PFCPSRRspFlags.HasDROBU() {
+		b.Drop()
+	}
+	return nil
+}
+
+// UpdateBAR applies the Update BAR in Session Report Response. The DL
+// Buffering Duration and the DL Buffering Suggested Packet Count start at now.
+func (b *Buffer) UpdateBAR(now time.Time, u *ie.UpdateBARWithinSessionReportResponseFields) {
+	bar := &ie.CreateBARFields{BARID: u.BARID}
+	if b.bar != nil {
+		*bar = *b.bar
+	}
+	if u.DownlinkDataNotificationDelay != nil {
+		bar.DownlinkDataNotificationDelay = u.DownlinkDataNotificationDelay
+	}
+	if u.SuggestedBufferingPacketsCount != nil {
+		bar.SuggestedBufferingPacketsCount = u.SuggestedBufferingPacketsCount
+	}
+	b.bar = bar
+
+	if u.DLBufferingDuration != nil {
+		b.bufferUntil, b.bufferCount = time.Time{}, nil
+		if *u.DLBufferingDuration > 0 {
+			b.bufferUntil = now.Add(*u.DLBufferingDuration)
+			b.bufferCount = u.DLBufferingSuggestedPacketCount
+		}
+	}
+}
+
+// Buffered returns the packets buffered for the FAR in the order of arrival.
+func (b *Buffer) Buffered(id uint32) []*Packet {
+	if x, ok := b.fars[id]; ok {
+		return slices.Clone(x.packets)
+	}
+	return nil
+}
+
+// Len returns the number of the packets buffered in the session.
+func (b *Buffer) Len() int {
+	var n int
+	for _, x := range b.fars {
+		n += len(x.packets)
+	}
+	return n
+}
+
+// Dropped returns the number of the packets dropped or discarded after being
+// buffered so far, including the ones that exceed the limit.
+func (b *Buffer) Dropped() int {
+	return b.dropped
+}
+
+// limit returns the maximum number of the packets buffered in the session.
+func (b *Buffer) limit() (int, bool) {
+	if !b.bufferUntil.IsZero() && b.bufferCount != nil {
+		return int(*b.bufferCount), true
+	}
+	if b.bar != nil && b.bar.SuggestedBufferingPacketsCount != nil {
+		return int(*b.bar.SuggestedBufferingPacketsCount), true
+	}
+	return 0, false
+}
+
+// expire ends the DL Buffering Duration if it has passed.
+func (b *Buffer) expire(now time.Time) {
+	if b.bufferUntil.IsZero() || now.Before(b.bufferUntil) {
+		return
+	}
+	b.bufferUntil, b.bufferCount = time.Time{}, nil
+	b.Drop()
+	for _, x := range b.fars {
+		x.notified = false
+	}
+}
+
+func (b *Buffer) cancel(id uint32) {
+	b.pending = slices.DeleteFunc(b.pending, func(n *Notification) bool { return n.FARID == id })
+}
+
+func (b *Buffer) ids() []uint32 {
+	ids := make([]uint32, 0, len(b.fars))
+	for id := range b.fars {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,227 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package buffer_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/upf/buffer"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func u8(v uint8) *uint8 { return &v }
+
+func newFAR(id uint32, flags ...ie.ApplyActionFlag) *ie.CreateFARFields {
+	return &ie.CreateFARFields{FARID: id, ApplyAction: ie.NewApplyActionFlags(flags...)}
+}
+
+func newPacket(farID uint32, n int) *buffer.Packet {
+	return &buffer.Packet{
+		Packet: &packet.Packet{SourceInterface: ie.SrcInterfaceCore, QFI: u8(9), Data: []byte{byte(n)}},
+		PDRID:  2,
+		FARID:  farID,
+		PPI:    u8(3),
+	}
+}
+
+func enqueue(t *testing.T, b *buffer.Buffer, sec, n int, want buffer.Action) {
+	t.Helper()
+
+	got, err := b.Enqueue(t0.Add(time.Duration(sec)*time.Second), newPacket(1, n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("packet %d at %ds: got %v, want %v", n, sec, got, want)
+	}
+}
+
+func data(ps []*buffer.Packet) []byte {
+	var b []byte
+	for _, p := range ps {
+		b = append(b, p.Data...)
+	}
+	return b
+}
+
+func TestBuffer(t *testing.T) {
+	t.Run("Paging", func(t *testing.T) {
+		delay := 2 * time.Second
+		b := buffer.New(
+			&ie.CreateBARFields{BARID: 1, DownlinkDataNotificationDelay: &delay},
+			newFAR(1, ie.ApplyActionBUFF, ie.ApplyActionNOCP),
+		)
+		enqueue(t, b, 0, 1, buffer.ActionBuffer)
+		enqueue(t, b, 1, 2, buffer.ActionBuffer)
+		if ns := b.Tick(t0.Add(time.Second)); len(ns) != 0 {
+			t.Fatalf("got %d notifications before the delay", len(ns))
+		}
+
+		ns := b.Tick(t0.Add(2 * time.Second))
+		if len(ns) != 1 {
+			t.Fatalf("got %d notifications", len(ns))
+		}
+		enqueue(t, b, 3, 3, buffer.ActionBuffer)
+		if ns := b.Tick(t0.Add(10 * time.Second)); len(ns) != 0 {
+			t.Errorf("got %d notifications while suppressed", len(ns))
+		}
+
+		// the request is encoded and parsed as the SMF would do.
+		b1, err := ns[0].SessionReportRequest(0x1111, 10).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		req, err := message.ParseSessionReportRequest(b1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !req.ReportType.HasDLDR() {
+			t.Error("Report Type has no DLDR")
+		}
+		got, err := ie.DownlinkDataReportType.Get(req.DownlinkDataReport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &ie.DownlinkDataReportFields{
+			PDRIDs:                         []uint16{2},
+			DownlinkDataServiceInformation: [][]byte{{0x03, 0x03, 0x09}},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		released := b.SetFAR(newFAR(1, ie.ApplyActionFORW))
+		if diff := cmp.Diff(data(released), []byte{1, 2, 3}); diff != "" {
+			t.Error(diff)
+		}
+		enqueue(t, b, 11, 4, buffer.ActionForward)
+		if b.Len() != 0 || b.Dropped() != 0 {
+			t.Errorf("got %d buffered, %d dropped", b.Len(), b.Dropped())
+		}
+	})
+
+	t.Run("CancelledByUpdate", func(t *testing.T) {
+		delay := 5 * time.Second
+		b := buffer.New(
+			&ie.CreateBARFields{BARID: 1, DownlinkDataNotificationDelay: &delay},
+			newFAR(1, ie.ApplyActionBUFF, ie.ApplyActionNOCP),
+		)
+		enqueue(t, b, 0, 1, buffer.ActionBuffer)
+		b.SetFAR(newFAR(1, ie.ApplyActionDROP))
+		if ns := b.Tick(t0.Add(5 * time.Second)); len(ns) != 0 {
+			t.Errorf("got %d notifications", len(ns))
+		}
+		enqueue(t, b, 6, 2, buffer.ActionDrop)
+		if b.Dropped() != 1 {
+			t.Errorf("got %d dropped", b.Dropped())
+		}
+	})
+
+	t.Run("RearmedByUpdate", func(t *testing.T) {
+		b := buffer.New(nil, newFAR(1, ie.ApplyActionBUFF, ie.ApplyActionNOCP))
+		enqueue(t, b, 0, 1, buffer.ActionBuffer)
+		if ns := b.Tick(t0); len(ns) != 1 {
+			t.Fatalf("got %d notifications", len(ns))
+		}
+		b.SetFAR(newFAR(1, ie.ApplyActionBUFF, ie.ApplyActionNOCP))
+		enqueue(t, b, 1, 2, buffer.ActionBuffer)
+		if ns := b.Tick(t0.Add(time.Second)); len(ns) != 1 {
+			t.Errorf("got %d notifications", len(ns))
+		}
+		if diff := cmp.Diff(data(b.Buffered(1)), []byte{1, 2}); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("SuggestedCount", func(t *testing.T) {
+		b := buffer.New(
+			&ie.CreateBARFields{BARID: 1, SuggestedBufferingPacketsCount: u8(2)},
+			newFAR(1, ie.ApplyActionBUFF),
+		)
+		enqueue(t, b, 0, 1, buffer.ActionBuffer)
+		enqueue(t, b, 0, 2, buffer.ActionBuffer)
+		enqueue(t, b, 0, 3, buffer.ActionDrop)
+		if b.Len() != 2 || b.Dropped() != 1 {
+			t.Errorf("got %d buffered, %d dropped", b.Len(), b.Dropped())
+		}
+	})
+
+	t.Run("BufferingDuration", func(t *testing.T) {
+		b := buffer.New(nil, newFAR(1, ie.ApplyActionBUFF, ie.ApplyActionNOCP))
+		enqueue(t, b, 0, 1, buffer.ActionBuffer)
+		if ns := b.Tick(t0); len(ns) != 1 {
+			t.Fatalf("got %d notifications", len(ns))
+		}
+
+		res := message.NewSessionReportResponse(0, 0, 0x2222, 10, 0,
+			ie.NewCause(ie.CauseRequestAccepted),
+			ie.NewUpdateBARWithinSessionReportResponse(
+				ie.NewBARID(1),
+				ie.NewDLBufferingDuration(30*time.Second),
+				ie.NewDLBufferingSuggestedPacketCount(2),
+			),
+		)
+		if err := b.ApplyReportResponse(t0.Add(time.Second), res); err != nil {
+			t.Fatal(err)
+		}
+		b.SetFAR(newFAR(1, ie.ApplyActionBUFF, ie.ApplyActionNOCP))
+		enqueue(t, b, 2, 2, buffer.ActionBuffer)
+		enqueue(t, b, 3, 3, buffer.ActionDrop)
+		if ns := b.Tick(t0.Add(30 * time.Second)); len(ns) != 0 {
+			t.Errorf("got %d notifications during the buffering duration", len(ns))
+		}
+
+		// the buffered packets are discarded when the duration expires.
+		b.Tick(t0.Add(31 * time.Second))
+		if b.Len() != 0 || b.Dropped() != 3 {
+			t.Errorf("got %d buffered, %d dropped", b.Len(), b.Dropped())
+		}
+		enqueue(t, b, 32, 4, buffer.ActionBuffer)
+		if ns := b.Tick(t0.Add(32 * time.Second)); len(ns) != 1 {
+			t.Errorf("got %d notifications after the buffering duration", len(ns))
+		}
+	})
+
+	t.Run("DROBU", func(t *testing.T) {
+		b := buffer.New(nil, newFAR(1, ie.ApplyActionBUFF))
+		enqueue(t, b, 0, 1, buffer.ActionBuffer)
+
+		rejected := message.NewSessionReportResponse(0, 0, 0x2222, 10, 0,
+			ie.NewCause(ie.CauseRequestRejected),
+			ie.NewPFCPSRRspFlags(0x01),
+		)
+		if err := b.ApplyReportResponse(t0.Add(time.Second), rejected); err != nil {
+			t.Fatal(err)
+		}
+		if b.Len() != 1 {
+			t.Fatalf("got %d buffered after rejected response", b.Len())
+		}
+
+		res := message.NewSessionReportResponse(0, 0, 0x2222, 10, 0,
+			ie.NewCause(ie.CauseRequestAccepted),
+			ie.NewPFCPSRRspFlags(0x01),
+		)
+		if err := b.ApplyReportResponse(t0.Add(time.Second), res); err != nil {
+			t.Fatal(err)
+		}
+		if b.Len() != 0 || b.Dropped() != 1 {
+			t.Errorf("got %d buffered, %d dropped", b.Len(), b.Dropped())
+		}
+	})
+
+	t.Run("FARNotFound", func(t *testing.T) {
+		b := buffer.New(nil)
+		if _, err := b.Enqueue(t0, newPacket(1, 1)); !errors.Is(err, buffer.ErrFARNotFound) {
+			t.Errorf("got error %v", err)
+		}
+	})
+}
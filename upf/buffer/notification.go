@@ -0,0 +1,53 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package buffer
+
+import (
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// Notification is the Downlink Data Notification, which is sent to the CP
+// function in Session Report Request with the Report Type DLDR.
+type Notification struct {
+	// FARID and PDRID are the rules that the first downlink packet hits.
+	FARID uint32
+	PDRID uint16
+
+	// QFI and PPI are the ones of the packet, if any.
+	QFI *uint8
+	PPI *uint8
+
+	// Time is the time when the notification is due, which is the arrival of
+	// the packet delayed by the Downlink Data Notification Delay.
+	Time time.Time
+}
+
+// DownlinkDataReport returns the DownlinkDataReport IE of the notification.
+func (n *Notification) DownlinkDataReport() *ie.IE {
+	ies := []*ie.IE{ie.NewPDRID(n.PDRID)}
+	if n.QFI != nil || n.PPI != nil {
+		var qfi, ppi uint8
+		if n.QFI != nil {
+			qfi = *n.QFI
+		}
+		if n.PPI != nil {
+			ppi = *n.PPI
+		}
+		ies = append(ies, ie.NewDownlinkDataServiceInformation(n.PPI != nil, n.QFI != nil, ppi, qfi))
+	}
+	return ie.NewDownlinkDataReport(ies...)
+}
+
+// SessionReportRequest returns the Session Report Request to send the
+// notification with. seid is the SEID of the CP function.
+func (n *Notification) SessionReportRequest(seid uint64, seq uint32, ies ...*ie.IE) *message.SessionReportRequest {
+	return message.NewSessionReportRequest(0, 0, seid, seq, 0, append([]*ie.IE{
+		ie.NewReportType(0, 0, 0, 1),
+		n.DownlinkDataReport(),
+	}, ies...)...)
+}
@@ -0,0 +1,189 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package classify provides the packet classifier that finds the PDR a packet
+// hits by evaluating the PDIs as specified in 3GPP TS 29.244 clause 5.2.1.
+//
+// All the results are explainable; Explain shows which conditions in each PDR
+// matched or did not match the packet.
+package classify
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+// ErrNoPDI is returned when a PDR has no PDI.
+var ErrNoPDI = errors.New("PDR has no PDI")
+
+// Classifier finds the PDR with the highest precedence that matches a packet.
+//
+// The PDRs are evaluated in the order of Precedence, in which the lower value
+// means the higher precedence. The ones with the same Precedence are evaluated
+// in the order they are added.
+//
+// Classifier is not safe for concurrent use.
+type Classifier struct {
+	pdrs []*ie.CreatePDRFields
+	pfds map[string][]*ie.PFDContentsFields
+}
+
+// New creates a new Classifier with the given CreatePDR IEs.
+func New(pdrs ...*ie.IE) (*Classifier, error) {
+	c := &Classifier{pfds: map[string][]*ie.PFDContentsFields{}}
+	if err := c.AddPDR(pdrs...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// AddPDR adds the CreatePDR IEs to the Classifier. The PDRs that have the same
+// PDR ID as the existing ones replace them.
+func (c *Classifier) AddPDR(pdrs ...*ie.IE) error {
+	fields := make([]*ie.CreatePDRFields, 0, len(pdrs))
+	for _, i := range pdrs {
+		if i.Type != ie.CreatePDR {
+			return &ie.InvalidTypeError{Type: i.Type}
+		}
+		f, err := ie.ParseCreatePDRFields(i.Payload)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, f)
+	}
+	return c.AddPDRFields(fields...)
+}
+
+// AddPDRFields adds the PDRs in the typed fields to the Classifier. The PDRs
+// that have the same PDR ID as the existing ones replace them.
+func (c *Classifier) AddPDRFields(pdrs ...*ie.CreatePDRFields) error {
+	for _, f := range pdrs {
+		if f.PDI == nil {
+			return fmt.Errorf("PDR %d: %w", f.PDRID, ErrNoPDI)
+		}
+	}
+
+	for _, f := range pdrs {
+		c.RemovePDR(f.PDRID)
+		c.pdrs = append(c.pdrs, f)
+	}
+	slices.SortStableFunc(c.pdrs, func(a, b *ie.CreatePDRFields) int {
+		switch {
+		case a.Precedence < b.Precedence:
+			return -1
+		case a.Precedence > b.Precedence:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return nil
+}
+
+// RemovePDR removes the PDR with the given ID, and reports whether it existed.
+func (c *Classifier) RemovePDR(id uint16) bool {
+	n := len(c.pdrs)
+	c.pdrs = slices.DeleteFunc(c.pdrs, func(f *ie.CreatePDRFields) bool {
+		return f.PDRID == id
+	})
+	return len(c.pdrs) != n
+}
+
+// PDRs returns the PDRs in the order of evaluation.
+func (c *Classifier) PDRs() []*ie.CreatePDRFields {
+	return slices.Clone(c.pdrs)
+}
+
+// SetPFDs sets the PFDs of the applications given in ApplicationIDsPFDs IEs,
+// which are used to evaluate the ApplicationID in PDIs. The PFDs of the same
+// application are replaced, and the application without PFD context is removed,
+// as the PFD Management Request does.
+func (c *Classifier) SetPFDs(apps ...*ie.IE) error {
+	for _, i := range apps {
+		if i.Type != ie.ApplicationIDsPFDs {
+			return &ie.InvalidTypeError{Type: i.Type}
+		}
+		f, err := ie.ParseApplicationIDsPFDsFields(i.Payload)
+		if err != nil {
+			return err
+		}
+
+		var contents []*ie.PFDContentsFields
+		for _, ctx := range f.PFDContexts {
+			contents = append(contents, ctx.PFDContents...)
+		}
+		if len(contents) == 0 {
+			delete(c.pfds, f.ApplicationID)
+			continue
+		}
+		c.pfds[f.ApplicationID] = contents
+	}
+	return nil
+}
+
+// Classify returns the Result of the PDR with the highest precedence that
+// matches the packet, or nil if no PDR matches.
+func (c *Classifier) Classify(p *packet.Packet) *Result {
+	for _, f := range c.pdrs {
+		if r := c.evaluate(f, p, false); r.Matched {
+			return r
+		}
+	}
+	return nil
+}
+
+// Explain evaluates all the PDRs against the packet and returns the Results in
+// the order of evaluation. The first one that has Matched is the one Classify
+// returns.
+func (c *Classifier) Explain(p *packet.Packet) []*Result {
+	results := make([]*Result, len(c.pdrs))
+	for n, f := range c.pdrs {
+		results[n] = c.evaluate(f, p, true)
+	}
+	return results
+}
+
+// Result is the result of evaluating a PDR against a packet.
+//
+// Checks has the conditions in the PDI evaluated, and Matched is true if all of
+// them matched. Classify stops evaluating the PDR at the first condition that
+// does not match, while Explain evaluates all of them.
+type Result struct {
+	PDR     *ie.CreatePDRFields
+	Matched bool
+	Checks  []*Check
+}
+
+// String returns the Result in a line, with the reasons if it did not match.
+func (r *Result) String() string {
+	if r.Matched {
+		return fmt.Sprintf("PDR %d (precedence %d): matched", r.PDR.PDRID, r.PDR.Precedence)
+	}
+
+	var reasons []string
+	for _, c := range r.Checks {
+		if !c.Matched {
+			reasons = append(reasons, c.String())
+		}
+	}
+	return fmt.Sprintf("PDR %d (precedence %d): not matched: %s", r.PDR.PDRID, r.PDR.Precedence, strings.Join(reasons, "; "))
+}
+
+// Check is the result of evaluating a condition in PDI, e.g., SourceInterface.
+// Detail describes the reason why it matched or not.
+type Check struct {
+	Name    string
+	Matched bool
+	Detail  string
+}
+
+// String returns the Check in the form of "Name: Detail".
+func (c *Check) String() string {
+	return c.Name + ": " + c.Detail
+}
@@ -0,0 +1,255 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package classify_test
+
+import (
+	"net"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/classify"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+func uint8Ptr(v uint8) *uint8 { return &v }
+
+func newClassifier(t *testing.T) *classify.Classifier {
+	t.Helper()
+
+	c, err := classify.New(
+		// uplink DNS with QFI 9
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x100, net.ParseIP("192.0.2.10"), nil, 0),
+				ie.NewUEIPAddress(0x02, "10.60.0.1", "", 0, 0),
+				ie.NewSDFFilter("permit out 17 from 192.0.2.1 53 to assigned", "", "", "", 0),
+				ie.NewQFI(9),
+			),
+		),
+		// uplink default
+		ie.NewCreatePDR(
+			ie.NewPDRID(2),
+			ie.NewPrecedence(200),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x100, net.ParseIP("192.0.2.10"), nil, 0),
+				ie.NewUEIPAddress(0x02, "10.60.0.1", "", 0, 0),
+			),
+		),
+		// downlink
+		ie.NewCreatePDR(
+			ie.NewPDRID(3),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewUEIPAddress(0x06, "10.60.0.1", "", 0, 0),
+				ie.NewSDFFilter("permit out ip from any to assigned", "", "", "", 0),
+			),
+		),
+		// uplink application
+		ie.NewCreatePDR(
+			ie.NewPDRID(4),
+			ie.NewPrecedence(50),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewUEIPAddress(0x02, "10.60.0.1", "", 0, 0),
+				ie.NewApplicationID("app1"),
+			),
+		),
+		// Ethernet
+		ie.NewCreatePDR(
+			ie.NewPDRID(5),
+			ie.NewPrecedence(300),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewEthernetPacketFilter(
+					ie.NewEthernetFilterID(1),
+					ie.NewEthertype(0x0800),
+					ie.NewCTAG(0x04, 0, 0, 100),
+				),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.SetPFDs(ie.NewApplicationIDsPFDs(
+		ie.NewApplicationID("app1"),
+		ie.NewPFDContext(
+			ie.NewPFDContents("permit out 6 from 198.51.100.0/24 to assigned", "", "", "", "", nil, nil, nil),
+			ie.NewPFDContents("", "", "example.com", "", "", nil, nil, nil),
+		),
+	)); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClassify(t *testing.T) {
+	c := newClassifier(t)
+
+	cases := []struct {
+		description string
+		packet      *packet.Packet
+		want        uint16
+	}{
+		{
+			"UplinkDNS",
+			&packet.Packet{
+				SourceInterface: ie.SrcInterfaceAccess,
+				Tunnel:          &packet.Tunnel{TEID: 0x100, Dst: net.ParseIP("192.0.2.10")},
+				QFI:             uint8Ptr(9),
+				IP: &packet.IP{
+					Version: 4, Src: net.IP{10, 60, 0, 1}, Dst: net.IP{192, 0, 2, 1},
+					Protocol: packet.ProtocolUDP, SrcPort: 40000, DstPort: 53,
+				},
+			},
+			1,
+		}, {
+			"UplinkOther",
+			&packet.Packet{
+				SourceInterface: ie.SrcInterfaceAccess,
+				Tunnel:          &packet.Tunnel{TEID: 0x100},
+				QFI:             uint8Ptr(9),
+				IP: &packet.IP{
+					Version: 4, Src: net.IP{10, 60, 0, 1}, Dst: net.IP{192, 0, 2, 1},
+					Protocol: packet.ProtocolTCP, SrcPort: 40000, DstPort: 443,
+				},
+			},
+			2,
+		}, {
+			"Downlink",
+			&packet.Packet{
+				SourceInterface: ie.SrcInterfaceCore,
+				IP: &packet.IP{
+					Version: 4, Src: net.IP{192, 0, 2, 1}, Dst: net.IP{10, 60, 0, 1},
+					Protocol: packet.ProtocolUDP, SrcPort: 53, DstPort: 40000,
+				},
+			},
+			3,
+		}, {
+			"ApplicationByFlowDescription",
+			&packet.Packet{
+				SourceInterface: ie.SrcInterfaceAccess,
+				IP: &packet.IP{
+					Version: 4, Src: net.IP{10, 60, 0, 1}, Dst: net.IP{198, 51, 100, 7},
+					Protocol: packet.ProtocolTCP, SrcPort: 40000, DstPort: 443,
+				},
+			},
+			4,
+		}, {
+			"ApplicationByDomainName",
+			&packet.Packet{
+				SourceInterface: ie.SrcInterfaceAccess,
+				DomainName:      "www.example.com",
+				IP: &packet.IP{
+					Version: 4, Src: net.IP{10, 60, 0, 1}, Dst: net.IP{203, 0, 113, 1},
+					Protocol: packet.ProtocolTCP, SrcPort: 40000, DstPort: 443,
+				},
+			},
+			4,
+		}, {
+			"Ethernet",
+			&packet.Packet{
+				SourceInterface: ie.SrcInterfaceAccess,
+				Ethernet: &packet.Ethernet{
+					Src:       net.HardwareAddr{0x02, 0, 0, 0, 0, 1},
+					Dst:       net.HardwareAddr{0x02, 0, 0, 0, 0, 2},
+					CTAG:      &packet.VLAN{VID: 100},
+					EtherType: 0x0800,
+				},
+			},
+			5,
+		},
+	}
+
+	for _, c2 := range cases {
+		t.Run(c2.description, func(t *testing.T) {
+			r := c.Classify(c2.packet)
+			if r == nil {
+				for _, x := range c.Explain(c2.packet) {
+					t.Log(x)
+				}
+				t.Fatal("no PDR matched")
+			}
+			if r.PDR.PDRID != c2.want {
+				t.Errorf("got PDR %d, want %d", r.PDR.PDRID, c2.want)
+			}
+		})
+	}
+
+	t.Run("NoMatch", func(t *testing.T) {
+		p := &packet.Packet{SourceInterface: ie.SrcInterfaceCPFunction}
+		if r := c.Classify(p); r != nil {
+			t.Errorf("unexpected match: %s", r)
+		}
+	})
+}
+
+func TestExplain(t *testing.T) {
+	c := newClassifier(t)
+
+	p := &packet.Packet{
+		SourceInterface: ie.SrcInterfaceAccess,
+		Tunnel:          &packet.Tunnel{TEID: 0x100},
+		QFI:             uint8Ptr(5),
+		IP: &packet.IP{
+			Version: 4, Src: net.IP{10, 60, 0, 1}, Dst: net.IP{192, 0, 2, 1},
+			Protocol: packet.ProtocolTCP, SrcPort: 40000, DstPort: 443,
+		},
+	}
+
+	results := c.Explain(p)
+	var order []uint16
+	for _, r := range results {
+		order = append(order, r.PDR.PDRID)
+	}
+	if got, want := order, []uint16{4, 1, 3, 2, 5}; !slices.Equal(got, want) {
+		t.Fatalf("got order %v, want %v", got, want)
+	}
+
+	pdr1 := results[1]
+	if pdr1.Matched {
+		t.Fatal("PDR 1 should not match")
+	}
+	var failed []string
+	for _, x := range pdr1.Checks {
+		if !x.Matched {
+			failed = append(failed, x.Name)
+		}
+	}
+	if got := strings.Join(failed, ","); got != "SDFFilter,QFI" {
+		t.Errorf("got failed checks %s, want SDFFilter,QFI", got)
+	}
+	if s := pdr1.String(); !strings.Contains(s, "got protocol 6, want 17") || !strings.Contains(s, "got 5, want 9") {
+		t.Errorf("unexpected explanation: %s", s)
+	}
+
+	if !results[3].Matched {
+		t.Errorf("PDR 2 should match: %s", results[3])
+	}
+	if r := c.Classify(p); r == nil || r.PDR.PDRID != 2 {
+		t.Errorf("got %v, want PDR 2", r)
+	}
+}
+
+func TestRemovePDR(t *testing.T) {
+	c := newClassifier(t)
+	if !c.RemovePDR(2) {
+		t.Fatal("PDR 2 not found")
+	}
+	if c.RemovePDR(2) {
+		t.Fatal("PDR 2 removed twice")
+	}
+	if n := len(c.PDRs()); n != 4 {
+		t.Errorf("got %d PDRs, want 4", n)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package classify
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+// EthernetFilter evaluates an Ethernet Packet Filter against Ethernet frames,
+// as specified in 3GPP TS 29.244 clause 5.13.
+//
+// All the conditions in the filter should match. Among the MAC Address IEs and
+// the SDF Filters, any one of each should match. A MAC Address IE with the
+// upper source or destination address (USOU or UDES flag) matches the range of
+// addresses, inclusive of both ends.
+//
+// If the filter is bidirectional (BIDE flag in Ethernet Filter Properties), the
+// frame also matches if it does in the opposite direction, i.e., with source
+// and destination swapped both in the MAC addresses and in the SDF Filters.
+type EthernetFilter struct {
+	*ie.EthernetPacketFilterFields
+}
+
+// NewEthernetFilter creates a new EthernetFilter from EthernetPacketFilter IE.
+func NewEthernetFilter(i *ie.IE) (*EthernetFilter, error) {
+	if i.Type != ie.EthernetPacketFilter {
+		return nil, &ie.InvalidTypeError{Type: i.Type}
+	}
+	f, err := ie.ParseEthernetPacketFilterFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return NewEthernetFilterFromFields(f), nil
+}
+
+// NewEthernetFilterFromFields creates a new EthernetFilter from the typed fields
+// of EthernetPacketFilter IE.
+func NewEthernetFilterFromFields(f *ie.EthernetPacketFilterFields) *EthernetFilter {
+	return &EthernetFilter{EthernetPacketFilterFields: f}
+}
+
+// IsBidirectional reports whether the filter has BIDE flag set.
+func (f *EthernetFilter) IsBidirectional() bool {
+	return f.EthernetFilterProperties != nil && *f.EthernetFilterProperties&0x01 != 0
+}
+
+// Match reports whether the packet matches the filter.
+//
+// The flow descriptions in SDF Filters are evaluated with the direction given by
+// SourceInterface of the packet, and "assigned" in them matches any address.
+func (f *EthernetFilter) Match(p *packet.Packet) bool {
+	ok, _ := f.match(p, nil)
+	return ok
+}
+
+// MatchFrame decodes b as an Ethernet frame received from the access side, and
+// reports whether it matches the filter.
+func (f *EthernetFilter) MatchFrame(b []byte) (bool, error) {
+	p, err := packet.ParseEthernet(b)
+	if err != nil {
+		return false, err
+	}
+	p.SourceInterface = ie.SrcInterfaceAccess
+	return f.Match(p), nil
+}
+
+// Explain evaluates the filter against the packet and returns the Check that
+// describes why it matched or not.
+func (f *EthernetFilter) Explain(p *packet.Packet) *Check {
+	ok, d := f.match(p, nil)
+	return &Check{Name: "EthernetPacketFilter", Matched: ok, Detail: d}
+}
+
+func (f *EthernetFilter) match(p *packet.Packet, ue []*net.IPNet) (bool, string) {
+	if p.Ethernet == nil {
+		return false, "not an Ethernet frame"
+	}
+
+	ok, d := f.matchDirection(p, ue)
+	if ok || !f.IsBidirectional() {
+		return ok, d
+	}
+
+	if ok, rd := f.matchDirection(reverse(p), ue); ok {
+		return true, "reverse direction: " + rd
+	}
+	return false, d
+}
+
+// matchDirection evaluates the conditions in the filter as they are.
+func (f *EthernetFilter) matchDirection(p *packet.Packet, ue []*net.IPNet) (bool, string) {
+	eth := p.Ethernet
+
+	var matched []string
+	if len(f.MACAddresses) != 0 {
+		ok, d := matchAny(f.MACAddresses, func(m *ie.MACAddressFields) (bool, string) {
+			return matchMACAddress(m, eth)
+		})
+		if !ok {
+			return false, d
+		}
+		matched = append(matched, d)
+	}
+
+	if f.Ethertype != nil {
+		if eth.EtherType != *f.Ethertype {
+			return false, fmt.Sprintf("got Ethertype %#04x, want %#04x", eth.EtherType, *f.Ethertype)
+		}
+		matched = append(matched, fmt.Sprintf("Ethertype %#04x", eth.EtherType))
+	}
+
+	if t := f.CTAG; t != nil {
+		if ok, d := matchVLAN("C-TAG", eth.CTAG, t.HasPCP(), t.HasDEI(), t.HasVID(), t.PCP, t.DEIFlag, t.CVID); !ok {
+			return false, d
+		}
+		matched = append(matched, "C-TAG")
+	}
+	if t := f.STAG; t != nil {
+		if ok, d := matchVLAN("S-TAG", eth.STAG, t.HasPCP(), t.HasDEI(), t.HasVID(), t.PCP, t.DEIFlag, t.CVID); !ok {
+			return false, d
+		}
+		matched = append(matched, "S-TAG")
+	}
+
+	if len(f.SDFFilters) != 0 {
+		ok, d := matchAny(f.SDFFilters, func(s *ie.SDFFilterFields) (bool, string) {
+			return matchSDFFilter(s, p, ue)
+		})
+		if !ok {
+			return false, d
+		}
+		matched = append(matched, d)
+	}
+
+	if len(matched) == 0 {
+		return true, "no conditions"
+	}
+	return true, strings.Join(matched, ", ")
+}
+
+// reverse returns the copy of the packet with the source and destination
+// swapped, which is evaluated against the filter as the frame in the opposite
+// direction.
+func reverse(p *packet.Packet) *packet.Packet {
+	r := *p
+
+	eth := *p.Ethernet
+	eth.Src, eth.Dst = eth.Dst, eth.Src
+	r.Ethernet = &eth
+
+	if p.IP != nil {
+		ip := *p.IP
+		ip.Src, ip.Dst = ip.Dst, ip.Src
+		ip.SrcPort, ip.DstPort = ip.DstPort, ip.SrcPort
+		r.IP = &ip
+	}
+	return &r
+}
+
+func matchMACAddress(m *ie.MACAddressFields, eth *packet.Ethernet) (bool, string) {
+	var matched []string
+	if m.HasSOUR() {
+		ok, d := matchMACRange("source", eth.Src, m.SourceMACAddress, m.UpperSourceMACAddress, m.HasUSOU())
+		if !ok {
+			return false, d
+		}
+		matched = append(matched, d)
+	}
+	if m.HasDEST() {
+		ok, d := matchMACRange("destination", eth.Dst, m.DestinationMACAddress, m.UpperDestinationMACAddress, m.HasUDES())
+		if !ok {
+			return false, d
+		}
+		matched = append(matched, d)
+	}
+
+	if len(matched) == 0 {
+		return true, "any MAC address"
+	}
+	return true, strings.Join(matched, ", ")
+}
+
+// matchMACRange reports whether the address is equal to lower, or is in the
+// range from lower to upper if hasUpper is true.
+func matchMACRange(which string, addr, lower, upper net.HardwareAddr, hasUpper bool) (bool, string) {
+	if !hasUpper {
+		if !bytes.Equal(addr, lower) {
+			return false, fmt.Sprintf("got %s %s, want %s", which, addr, lower)
+		}
+		return true, fmt.Sprintf("%s %s", which, addr)
+	}
+
+	if bytes.Compare(addr, lower) < 0 || bytes.Compare(addr, upper) > 0 {
+		return false, fmt.Sprintf("%s %s not in %s-%s", which, addr, lower, upper)
+	}
+	return true, fmt.Sprintf("%s %s in %s-%s", which, addr, lower, upper)
+}
+
+func matchVLAN(name string, v *packet.VLAN, hasPCP, hasDEI, hasVID bool, pcp, dei uint8, vid uint16) (bool, string) {
+	if v == nil {
+		return false, "frame has no " + name
+	}
+	if hasPCP && v.PCP != pcp {
+		return false, fmt.Sprintf("got %s PCP %d, want %d", name, v.PCP, pcp)
+	}
+	if hasDEI && v.DEI != (dei != 0) {
+		return false, fmt.Sprintf("got %s DEI %t, want %t", name, v.DEI, dei != 0)
+	}
+	if hasVID && v.VID != vid {
+		return false, fmt.Sprintf("got %s VID %d, want %d", name, v.VID, vid)
+	}
+	return true, ""
+}
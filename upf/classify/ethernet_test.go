@@ -0,0 +1,186 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package classify_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/classify"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+var (
+	mac1 = net.HardwareAddr{0x02, 0, 0, 0, 0, 0x01}
+	mac2 = net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02}
+	mac9 = net.HardwareAddr{0x02, 0, 0, 0, 0, 0x09}
+	macF = net.HardwareAddr{0x02, 0, 0, 0, 0, 0x0f}
+)
+
+// frame builds an Ethernet frame that carries ipv4UDP in the packet tests,
+// i.e., UDP 10.0.0.1:1234 -> 192.0.2.1:53.
+func frame(src, dst net.HardwareAddr, tags ...byte) []byte {
+	var b []byte
+	b = append(b, dst...)
+	b = append(b, src...)
+	b = append(b, tags...)
+	b = append(b, 0x08, 0x00)
+	return append(b, []byte{
+		0x45, 0x00, 0x00, 0x20, 0x00, 0x00, 0x40, 0x00, 0x40, 0x11, 0x00, 0x00,
+		0x0a, 0x00, 0x00, 0x01, 0xc0, 0x00, 0x02, 0x01,
+		0x04, 0xd2, 0x00, 0x35, 0x00, 0x0c, 0x00, 0x00,
+		0xde, 0xad, 0xbe, 0xef,
+	}...)
+}
+
+func TestEthernetFilter(t *testing.T) {
+	qinq := []byte{0x88, 0xa8, 0x20, 0xc8, 0x81, 0x00, 0x00, 0x64}
+
+	cases := []struct {
+		description string
+		filter      *ie.IE
+		frame       []byte
+		want        bool
+	}{
+		{
+			"NoConditions",
+			ie.NewEthernetPacketFilter(ie.NewEthernetFilterID(1)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"SourceMAC",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac1, nil, nil, nil)),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"SourceMACMismatch",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac2, nil, nil, nil)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"AnyOfMACAddresses",
+			ie.NewEthernetPacketFilter(
+				ie.NewMACAddress(mac9, nil, nil, nil),
+				ie.NewMACAddress(nil, mac2, nil, nil),
+			),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"SourceMACRange",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac1, nil, macF, nil)),
+			frame(mac9, mac2),
+			true,
+		}, {
+			"SourceMACRangeUpperBound",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(mac1, nil, macF, nil)),
+			frame(macF, mac2),
+			true,
+		}, {
+			"DestinationMACOutOfRange",
+			ie.NewEthernetPacketFilter(ie.NewMACAddress(nil, mac9, nil, macF)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"QinQ",
+			ie.NewEthernetPacketFilter(
+				ie.NewEthertype(0x0800),
+				ie.NewSTAG(0x05, 1, 0, 200),
+				ie.NewCTAG(0x04, 0, 0, 100),
+			),
+			frame(mac1, mac2, qinq...),
+			true,
+		}, {
+			"QinQWrongPCP",
+			ie.NewEthernetPacketFilter(ie.NewSTAG(0x01, 3, 0, 0)),
+			frame(mac1, mac2, qinq...),
+			false,
+		}, {
+			"MissingCTAG",
+			ie.NewEthernetPacketFilter(ie.NewCTAG(0x04, 0, 0, 100)),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"SDFFilter",
+			ie.NewEthernetPacketFilter(
+				ie.NewMACAddress(mac1, nil, nil, nil),
+				ie.NewSDFFilter("permit out 17 from 192.0.2.1 53 to assigned", "", "", "", 0),
+			),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"Unidirectional",
+			ie.NewEthernetPacketFilter(
+				ie.NewMACAddress(mac2, mac1, nil, nil),
+			),
+			frame(mac1, mac2),
+			false,
+		}, {
+			"Bidirectional",
+			ie.NewEthernetPacketFilter(
+				ie.NewEthernetFilterProperties(0x01),
+				ie.NewMACAddress(mac2, mac1, nil, nil),
+			),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"BidirectionalWithSDFFilter",
+			ie.NewEthernetPacketFilter(
+				ie.NewEthernetFilterProperties(0x01),
+				ie.NewMACAddress(mac2, mac1, nil, nil),
+				ie.NewSDFFilter("permit out 17 from 10.0.0.1 1234 to assigned 53", "", "", "", 0),
+			),
+			frame(mac1, mac2),
+			true,
+		}, {
+			"BidirectionalMismatch",
+			ie.NewEthernetPacketFilter(
+				ie.NewEthernetFilterProperties(0x01),
+				ie.NewMACAddress(mac2, mac9, nil, nil),
+			),
+			frame(mac1, mac2),
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			f, err := classify.NewEthernetFilter(c.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := f.MatchFrame(c.frame)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("got %t, want %t", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEthernetFilterExplain(t *testing.T) {
+	f, err := classify.NewEthernetFilter(ie.NewEthernetPacketFilter(
+		ie.NewMACAddress(mac1, nil, mac9, nil),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := packet.ParseEthernet(frame(macF, mac2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := f.Explain(p)
+	if c.Matched {
+		t.Fatal("should not match")
+	}
+	if want := "source 02:00:00:00:00:0f not in 02:00:00:00:00:01-02:00:00:00:00:09"; !strings.Contains(c.Detail, want) {
+		t.Errorf("got %q, want %q", c.Detail, want)
+	}
+}
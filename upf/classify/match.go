@@ -0,0 +1,355 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package classify
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+// evaluate evaluates the PDI of the PDR against the packet. If all is false, it
+// stops at the first condition that does not match.
+func (c *Classifier) evaluate(f *ie.CreatePDRFields, p *packet.Packet, all bool) *Result {
+	r := &Result{PDR: f, Matched: true}
+	pdi := f.PDI
+	ue := ueAddresses(pdi.UEIPAddresses)
+
+	check := func(name string, fn func() (bool, string)) {
+		if !r.Matched && !all {
+			return
+		}
+		ok, detail := fn()
+		r.Checks = append(r.Checks, &Check{Name: name, Matched: ok, Detail: detail})
+		r.Matched = r.Matched && ok
+	}
+
+	check("SourceInterface", func() (bool, string) {
+		return matchValue(p.SourceInterface, pdi.SourceInterface)
+	})
+	if pdi.FTEID != nil {
+		check("FTEID", func() (bool, string) {
+			return matchFTEID(pdi.FTEID, p)
+		})
+	}
+	if len(pdi.UEIPAddresses) != 0 {
+		check("UEIPAddress", func() (bool, string) {
+			return matchUEIPAddress(pdi.UEIPAddresses, p)
+		})
+	}
+	if len(pdi.SDFFilters) != 0 {
+		check("SDFFilter", func() (bool, string) {
+			return matchAny(pdi.SDFFilters, func(s *ie.SDFFilterFields) (bool, string) {
+				return matchSDFFilter(s, p, ue)
+			})
+		})
+	}
+	if pdi.ApplicationID != nil {
+		check("ApplicationID", func() (bool, string) {
+			return c.matchApplication(*pdi.ApplicationID, p, ue)
+		})
+	}
+	if pdi.EthernetPDUSessionInformation != nil && *pdi.EthernetPDUSessionInformation&0x01 != 0 {
+		check("EthernetPDUSessionInformation", func() (bool, string) {
+			if p.Ethernet == nil {
+				return false, "not an Ethernet frame"
+			}
+			return true, "Ethernet frame"
+		})
+	}
+	if len(pdi.EthernetPacketFilters) != 0 {
+		check("EthernetPacketFilter", func() (bool, string) {
+			return matchAny(pdi.EthernetPacketFilters, func(e *ie.EthernetPacketFilterFields) (bool, string) {
+				return NewEthernetFilterFromFields(e).match(p, ue)
+			})
+		})
+	}
+	if len(pdi.QFIs) != 0 {
+		check("QFI", func() (bool, string) {
+			if p.QFI == nil {
+				return false, "packet has no QFI"
+			}
+			return matchAny(pdi.QFIs, func(q uint8) (bool, string) {
+				return matchValue(*p.QFI, q)
+			})
+		})
+	}
+
+	return r
+}
+
+func matchValue[T comparable](got, want T) (bool, string) {
+	if got != want {
+		return false, fmt.Sprintf("got %v, want %v", got, want)
+	}
+	return true, fmt.Sprintf("%v", got)
+}
+
+// matchAny reports whether any of the conditions matches. The detail is the
+// one of the matched condition, or all the details of the unmatched ones.
+func matchAny[T any](conds []T, fn func(T) (bool, string)) (bool, string) {
+	details := make([]string, len(conds))
+	for n, c := range conds {
+		ok, d := fn(c)
+		if ok {
+			if len(conds) == 1 {
+				return true, d
+			}
+			return true, fmt.Sprintf("[%d] %s", n, d)
+		}
+		details[n] = d
+	}
+
+	if len(conds) == 1 {
+		return false, details[0]
+	}
+	for n, d := range details {
+		details[n] = fmt.Sprintf("[%d] %s", n, d)
+	}
+	return false, strings.Join(details, ", ")
+}
+
+func matchFTEID(f *ie.FTEIDFields, p *packet.Packet) (bool, string) {
+	if f.HasCh() {
+		return false, "F-TEID is not allocated yet (CH flag is set)"
+	}
+	if p.Tunnel == nil {
+		return false, "packet is not received in GTP-U"
+	}
+	if p.Tunnel.TEID != f.TEID {
+		return false, fmt.Sprintf("got TEID %#x, want %#x", p.Tunnel.TEID, f.TEID)
+	}
+
+	if dst := p.Tunnel.Dst; dst != nil && (f.IPv4Address != nil || f.IPv6Address != nil) {
+		if !dst.Equal(f.IPv4Address) && !dst.Equal(f.IPv6Address) {
+			return false, fmt.Sprintf("got address %s, want %s", dst, fteidAddresses(f))
+		}
+	}
+	return true, fmt.Sprintf("TEID %#x", f.TEID)
+}
+
+func fteidAddresses(f *ie.FTEIDFields) string {
+	var s []string
+	for _, ip := range []net.IP{f.IPv4Address, f.IPv6Address} {
+		if ip != nil {
+			s = append(s, ip.String())
+		}
+	}
+	return strings.Join(s, " or ")
+}
+
+// ueAddresses returns the prefixes of the UE IP addresses. IPv6 address is
+// considered as the prefix of the given length, or /64 by default.
+func ueAddresses(ues []*ie.UEIPAddressFields) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, u := range ues {
+		if u.HasIPv4() && !u.HasCHV4() && u.IPv4Address != nil {
+			nets = append(nets, &net.IPNet{IP: u.IPv4Address.To4(), Mask: net.CIDRMask(32, 32)})
+		}
+		if u.HasIPv6() && !u.HasCHV6() && u.IPv6Address != nil {
+			l := 64
+			switch {
+			case u.HasIP6PL():
+				l = int(u.IPv6PrefixLength)
+			case u.HasIPv6D():
+				l = 64 - int(u.IPv6PrefixDelegationBits)
+			}
+			if l < 0 || l > 128 {
+				l = 64
+			}
+			mask := net.CIDRMask(l, 128)
+			nets = append(nets, &net.IPNet{IP: u.IPv6Address.Mask(mask), Mask: mask})
+		}
+	}
+	return nets
+}
+
+func matchUEIPAddress(ues []*ie.UEIPAddressFields, p *packet.Packet) (bool, string) {
+	if p.IP == nil {
+		return false, "not an IP packet"
+	}
+
+	return matchAny(ues, func(u *ie.UEIPAddressFields) (bool, string) {
+		addr, which := p.IP.Src, "source"
+		if u.HasSD() {
+			addr, which = p.IP.Dst, "destination"
+		}
+
+		nets := ueAddresses([]*ie.UEIPAddressFields{u})
+		if len(nets) == 0 {
+			return false, "UE IP address is not allocated yet"
+		}
+		for _, n := range nets {
+			if n.Contains(addr) {
+				return true, fmt.Sprintf("%s %s in %s", which, addr, n)
+			}
+		}
+		return false, fmt.Sprintf("%s %s not in %s", which, addr, joinNets(nets))
+	})
+}
+
+func joinNets(nets []*net.IPNet) string {
+	s := make([]string, len(nets))
+	for n, x := range nets {
+		s[n] = x.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func matchSDFFilter(f *ie.SDFFilterFields, p *packet.Packet, ue []*net.IPNet) (bool, string) {
+	if !f.HasFD() && !f.HasTTC() && !f.HasSPI() && !f.HasFL() {
+		return true, "no conditions"
+	}
+	if p.IP == nil {
+		return false, "not an IP packet"
+	}
+
+	var matched []string
+	if f.HasFD() {
+		rule, err := f.IPFilterRule()
+		if err != nil {
+			return false, fmt.Sprintf("invalid flow description: %v", err)
+		}
+		if ok, d := matchIPFilterRule(rule, p, ue); !ok {
+			return false, d
+		}
+		matched = append(matched, fmt.Sprintf("%q", f.FlowDescription))
+	}
+
+	if f.HasTTC() {
+		if len(f.ToSTrafficClass) < 2 {
+			return false, "invalid ToS/Traffic Class"
+		}
+		v, mask := f.ToSTrafficClass[0], f.ToSTrafficClass[1]
+		if p.IP.TrafficClass&mask != v&mask {
+			return false, fmt.Sprintf("got ToS/Traffic Class %#x, want %#x/%#x", p.IP.TrafficClass, v, mask)
+		}
+		matched = append(matched, fmt.Sprintf("ToS/Traffic Class %#x/%#x", v, mask))
+	}
+
+	if f.HasSPI() {
+		if len(f.SecurityParameterIndex) < 4 {
+			return false, "invalid SPI"
+		}
+		spi := binary.BigEndian.Uint32([]byte(f.SecurityParameterIndex))
+		if p.IP.SPI != spi {
+			return false, fmt.Sprintf("got SPI %#x, want %#x", p.IP.SPI, spi)
+		}
+		matched = append(matched, fmt.Sprintf("SPI %#x", spi))
+	}
+
+	if f.HasFL() {
+		if len(f.FlowLabel) < 3 {
+			return false, "invalid Flow Label"
+		}
+		b := []byte(f.FlowLabel)
+		fl := (uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])) & 0x0fffff
+		if p.IP.FlowLabel != fl {
+			return false, fmt.Sprintf("got Flow Label %#x, want %#x", p.IP.FlowLabel, fl)
+		}
+		matched = append(matched, fmt.Sprintf("Flow Label %#x", fl))
+	}
+
+	return true, strings.Join(matched, ", ")
+}
+
+// matchIPFilterRule reports whether the packet matches the rule.
+//
+// The rule with the direction "out" describes the downlink traffic, i.e., the
+// source is the remote and the destination is the UE. Such rule is applied to
+// the uplink traffic, which is the one received from the access side, with the
+// source and destination swapped as described in 3GPP TS 29.212 clause 5.4.2.
+func matchIPFilterRule(r *ie.IPFilterRule, p *packet.Packet, ue []*net.IPNet) (bool, string) {
+	if r.Action != ie.IPFilterActionPermit {
+		return false, fmt.Sprintf("action is %s", r.Action)
+	}
+
+	ip := p.IP
+	if r.Protocol != ie.IPFilterProtocolAny && r.Protocol != ip.Protocol {
+		return false, fmt.Sprintf("got protocol %d, want %d", ip.Protocol, r.Protocol)
+	}
+
+	src, dst := &r.Source, &r.Destination
+	if p.SourceInterface == ie.SrcInterfaceAccess {
+		src, dst = dst, src
+	}
+
+	if ok, d := matchEndpoint(src, ip.Src, ip.SrcPort, "source", ue); !ok {
+		return false, d
+	}
+	if ok, d := matchEndpoint(dst, ip.Dst, ip.DstPort, "destination", ue); !ok {
+		return false, d
+	}
+	return true, r.String()
+}
+
+func matchEndpoint(e *ie.IPFilterEndpoint, addr net.IP, port uint16, which string, ue []*net.IPNet) (bool, string) {
+	switch {
+	case e.Assigned:
+		if len(ue) != 0 && !containsAny(ue, addr) {
+			return false, fmt.Sprintf("%s %s is not the UE address %s", which, addr, joinNets(ue))
+		}
+	case e.Prefix != nil:
+		if !e.Prefix.Contains(addr) {
+			return false, fmt.Sprintf("%s %s not in %s", which, addr, e.Prefix)
+		}
+	}
+
+	if len(e.Ports) == 0 {
+		return true, ""
+	}
+	for _, r := range e.Ports {
+		if r.Contains(port) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("%s port %d not in %s", which, port, e.String())
+}
+
+func containsAny(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchApplication evaluates the flow descriptions and domain names in the PFDs
+// of the application.
+func (c *Classifier) matchApplication(id string, p *packet.Packet, ue []*net.IPNet) (bool, string) {
+	contents, ok := c.pfds[id]
+	if !ok {
+		return false, fmt.Sprintf("no PFD for application %q", id)
+	}
+
+	for _, pfd := range contents {
+		var fds []string
+		if pfd.FlowDescription != "" {
+			fds = append(fds, pfd.FlowDescription)
+		}
+		fds = append(fds, pfd.AdditionalFlowDescription...)
+		for _, fd := range fds {
+			rule, err := ie.ParseIPFilterRule(fd)
+			if err != nil || p.IP == nil {
+				continue
+			}
+			if ok, _ := matchIPFilterRule(rule, p, ue); ok {
+				return true, fmt.Sprintf("application %q by flow description %q", id, fd)
+			}
+		}
+
+		if dn := pfd.DomainName; dn != "" && p.DomainName != "" {
+			if p.DomainName == dn || strings.HasSuffix(p.DomainName, "."+dn) {
+				return true, fmt.Sprintf("application %q by domain name %q", id, dn)
+			}
+		}
+	}
+	return false, fmt.Sprintf("no PFD of application %q matched", id)
+}
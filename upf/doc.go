@@ -0,0 +1,11 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package upf is the root of the packages that model the behavior of UPF, which
+// are intended for testing PFCP implementations without a real UPF, e.g., in the
+// unit tests with no kernel modules.
+//
+// None of them reads the actual clock; the current time is given by the caller
+// wherever it matters, so the results are deterministic.
+package upf
@@ -0,0 +1,544 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package engine provides the reference forwarding engine that processes the
+// user plane packets with the rules of PFCP sessions, putting together the
+// other packages in upf directory: the packets are classified against the PDRs
+// with classify, the QERs are enforced with qos, the URRs are measured with
+// usage, and the packets for the FARs with BUFF flag are held in buffer.
+//
+// The packets are given by the caller and the ones to be sent are returned, so
+// it can be driven without any network interfaces.
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+	"github.com/wmnsk/go-pfcp/upf/buffer"
+	"github.com/wmnsk/go-pfcp/upf/classify"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+	"github.com/wmnsk/go-pfcp/upf/qos"
+	"github.com/wmnsk/go-pfcp/upf/usage"
+)
+
+// Error definitions.
+var (
+	ErrSessionNotFound                = errors.New("session not found")
+	ErrUnsupportedFormat              = errors.New("unsupported packet format")
+	ErrUnsupportedOuterHeaderRemoval  = errors.New("unsupported outer header removal")
+	ErrUnsupportedOuterHeaderCreation = errors.New("unsupported outer header creation")
+)
+
+// Format is the format of the packets given to Engine.
+type Format uint8
+
+// Format definitions.
+const (
+	// FormatIP is an IPv4 or IPv6 packet, e.g., received on N6.
+	FormatIP Format = iota
+	// FormatGTPU is a G-PDU without the outer IP and UDP headers, e.g., the
+	// payload received on the UDP port 2152 on N3 or N9. The T-PDU in it is
+	// decoded as IP packet.
+	FormatGTPU
+	// FormatEthernet is an Ethernet frame, e.g., received on N6 for Ethernet
+	// PDU sessions.
+	FormatEthernet
+)
+
+// String returns the name of the format.
+func (f Format) String() string {
+	switch f {
+	case FormatIP:
+		return "IP"
+	case FormatGTPU:
+		return "GTP-U"
+	case FormatEthernet:
+		return "Ethernet"
+	default:
+		return fmt.Sprintf("Format(%d)", uint8(f))
+	}
+}
+
+// Input is a packet given to Engine.
+type Input struct {
+	// SourceInterface is the interface the packet is received from, which is
+	// one of the ie.SrcInterface* values.
+	SourceInterface uint8
+	Format          Format
+	Data            []byte
+
+	// Dst is the local IP address the G-PDU is received on, if known, which is
+	// matched against the F-TEID in PDI.
+	Dst net.IP
+	// DomainName is the domain name the packet is destined for, if known,
+	// which is used to detect the applications by PFD.
+	DomainName string
+}
+
+// Output is a packet to be sent by the caller.
+type Output struct {
+	// PDRID and FARID are the rules that the packet hits.
+	PDRID uint16
+	FARID uint32
+
+	// DestinationInterface and NetworkInstance are the ones in Forwarding
+	// Parameters or Duplicating Parameters.
+	DestinationInterface uint8
+	NetworkInstance      string
+
+	// Peer is the address and port in Outer Header Creation that the packet
+	// is sent to, which is zero if the FAR has no Outer Header Creation. The
+	// port is 2152 for GTP-U, and 0 if the packet is sent over IP.
+	Peer netip.AddrPort
+
+	// Data is the packet with the outer header created, e.g., a G-PDU without
+	// the outer IP and UDP headers for GTP-U.
+	Data []byte
+
+	// Duplicated is true for the copy sent with Duplicating Parameters.
+	Duplicated bool
+}
+
+// Result is the result of processing a packet.
+type Result struct {
+	// SEID is the local SEID of the session the packet hits, and PDR and FAR
+	// are the rules applied to it. They are zero if no PDR matches.
+	SEID uint64
+	PDR  *ie.CreatePDRFields
+	FAR  *ie.CreateFARFields
+
+	// Action is what is done with the packet, and Reason is the reason if it
+	// is dropped.
+	Action buffer.Action
+	Reason string
+	// Verdict is the result of the QER enforcement.
+	Verdict qos.Verdict
+
+	// Outputs are the packets to be sent, which include the copies sent with
+	// Duplicating Parameters.
+	Outputs []*Output
+	// Reports are the usage reports triggered by the packet, which are sent
+	// in Session Report Request.
+	Reports []*usage.Report
+}
+
+// Report is the reports of a session triggered by the time, which are sent in
+// Session Report Request.
+type Report struct {
+	SEID          uint64
+	Usage         []*usage.Report
+	Notifications []*buffer.Notification
+}
+
+// Engine processes the packets with the rules of the sessions.
+//
+// The packets are processed in the following way:
+//
+//   - The session and the PDR are found by classifying the packet against the
+//     PDRs of each session in the order of SEID. The PDRs are skipped outside
+//     of their Activation Time and Deactivation Time.
+//   - The Outer Header Removal in the PDR is applied. GTP-U header and VLAN
+//     tags are removed, and the outer IP and UDP headers are considered to be
+//     removed by the caller already.
+//   - The FAR is the one in FAR ID for Quota Action of the URR whose quota is
+//     exhausted, or the one in the PDR. The packet is dropped if the URR has
+//     no FAR ID for Quota Action.
+//   - The QERs are enforced on the size of the packet after Outer Header
+//     Removal, and the packet is dropped if any of them drops it.
+//   - The Apply Action in the FAR is executed. The packets for FORW and DUPL
+//     are returned in Outputs with the Outer Header Creation, and the downlink
+//     ones for BUFF are held until the FAR is updated to forward them. BUFF
+//     and NOCP are ignored for the uplink packets.
+//   - The URRs measure the packets that are not dropped.
+//
+// Engine is not safe for concurrent use.
+type Engine struct {
+	sessions map[uint64]*sess
+	pfds     []*ie.IE
+}
+
+// sess is the state of a session in Engine.
+type sess struct {
+	rules *session.Rules
+
+	classifier *classify.Classifier
+	buffer     *buffer.Buffer
+	qos        *qos.Enforcer
+	usage      *usage.Engine
+}
+
+// New creates a new Engine with no session.
+func New() *Engine {
+	return &Engine{sessions: map[uint64]*sess{}}
+}
+
+// SetSession adds the session with the local SEID and the rules, or replaces
+// the rules of the existing one, e.g., with the ones in session.Session after
+// Session Modification Request. The rules must not be modified after given.
+//
+// The rules that are not changed keep their state, e.g., the usage measured,
+// as the ones in session.Session are replaced only when they are updated. The
+// final reports for the URRs removed, which are sent in Session Modification
+// Response, are returned with the buffered packets released by the FARs that
+// are updated to forward them.
+//
+// The predefined rules activated in the PDRs are not applied; give the rules
+// resolved by (*session.PredefinedRules).Resolve to use them.
+func (e *Engine) SetSession(now time.Time, seid uint64, r *session.Rules) ([]*Output, []*usage.Report, error) {
+	c, err := classify.New()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := c.SetPFDs(e.pfds...); err != nil {
+		return nil, nil, err
+	}
+	for _, id := range slices.Sorted(maps.Keys(r.PDRs)) {
+		if err := c.AddPDRFields(r.PDRs[id]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	s, ok := e.sessions[seid]
+	if !ok {
+		q, _ := qos.New(now)
+		u, _ := usage.New(now)
+		s = &sess{
+			rules:  &session.Rules{},
+			buffer: buffer.New(nil),
+			qos:    q,
+			usage:  u,
+		}
+		e.sessions[seid] = s
+	}
+	old := s.rules
+	s.rules, s.classifier = r, c
+
+	// the rules are given to the components only if they are changed.
+	s.buffer.SetBAR(r.BAR)
+	if ids := removed(old.FARs, r.FARs); len(ids) > 0 {
+		// Drop in RemoveFAR discards all the packets if no ID is given.
+		s.buffer.RemoveFAR(ids...)
+	}
+	released := s.buffer.SetFAR(changed(old.FARs, r.FARs)...)
+	s.qos.RemoveQER(removed(old.QERs, r.QERs)...)
+	s.qos.AddQERFields(now, changed(old.QERs, r.QERs)...)
+	var reports []*usage.Report
+	if ids := removed(old.URRs, r.URRs); len(ids) > 0 {
+		// RemoveURR removes all the URRs if no ID is given.
+		if reports, err = s.usage.RemoveURR(now, ids...); err != nil {
+			return nil, nil, err
+		}
+	}
+	s.usage.AddURRFields(now, changed(old.URRs, r.URRs)...)
+
+	var outs []*Output
+	for _, p := range released {
+		far, ok := r.FARs[p.FARID]
+		if !ok {
+			continue
+		}
+		o, err := forward(p.PDRID, far, p.Packet)
+		if err != nil {
+			return nil, nil, err
+		}
+		outs = append(outs, o...)
+	}
+	return outs, reports, nil
+}
+
+// RemoveSession removes the session, and returns the final reports for the
+// URRs, which are sent in Session Deletion Response. The buffered packets are
+// discarded.
+func (e *Engine) RemoveSession(now time.Time, seid uint64) ([]*usage.Report, error) {
+	s, ok := e.sessions[seid]
+	if !ok {
+		return nil, fmt.Errorf("SEID %#x: %w", seid, ErrSessionNotFound)
+	}
+	delete(e.sessions, seid)
+	return s.usage.Close(now), nil
+}
+
+// SetPFDs sets the PFDs of the applications given in ApplicationIDsPFDs IEs
+// for all the sessions, in the same way as (*classify.Classifier).SetPFDs.
+func (e *Engine) SetPFDs(apps ...*ie.IE) error {
+	for _, s := range e.sessions {
+		if err := s.classifier.SetPFDs(apps...); err != nil {
+			return err
+		}
+	}
+	e.pfds = append(e.pfds, apps...)
+	return nil
+}
+
+// ApplyReportResponse applies the Session Report Response for the Downlink Data
+// Notification to the session, in the same way as
+// (*buffer.Buffer).ApplyReportResponse.
+func (e *Engine) ApplyReportResponse(now time.Time, seid uint64, res *message.SessionReportResponse) error {
+	s, ok := e.sessions[seid]
+	if !ok {
+		return fmt.Errorf("SEID %#x: %w", seid, ErrSessionNotFound)
+	}
+	return s.buffer.ApplyReportResponse(now, res)
+}
+
+// Process processes the packet received at now, and returns the Result with the
+// packets to be sent. The error is returned if the packet cannot be decoded or
+// the rules it hits cannot be applied, e.g., the FAR is not found.
+func (e *Engine) Process(now time.Time, in *Input) (*Result, error) {
+	p, err := decode(in)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Result{Action: buffer.ActionDrop}
+	for _, seid := range slices.Sorted(maps.Keys(e.sessions)) {
+		s := e.sessions[seid]
+		if pdr := s.classify(now, p); pdr != nil {
+			r.SEID, r.PDR = seid, pdr
+			return r, s.process(now, p, in.Data, r)
+		}
+	}
+	r.Reason = "no PDR matched"
+	return r, nil
+}
+
+// Tick evaluates the sessions at now, and returns the reports of the ones that
+// have the usage reports or the Downlink Data Notifications due, in the order
+// of SEID.
+func (e *Engine) Tick(now time.Time) []*Report {
+	var rs []*Report
+	for _, seid := range slices.Sorted(maps.Keys(e.sessions)) {
+		s := e.sessions[seid]
+		r := &Report{SEID: seid, Usage: s.usage.Tick(now), Notifications: s.buffer.Tick(now)}
+		if len(r.Usage)+len(r.Notifications) > 0 {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+// decode decodes the packet in the format given.
+func decode(in *Input) (*packet.Packet, error) {
+	var p *packet.Packet
+	var err error
+	switch in.Format {
+	case FormatIP:
+		p, err = packet.ParseIP(in.Data)
+	case FormatGTPU:
+		p, err = packet.ParseGTPU(in.Data)
+		if err == nil {
+			p.Tunnel.Dst = in.Dst
+		}
+	case FormatEthernet:
+		p, err = packet.ParseEthernet(in.Data)
+	default:
+		return nil, fmt.Errorf("%s: %w", in.Format, ErrUnsupportedFormat)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.SourceInterface = in.SourceInterface
+	p.DomainName = in.DomainName
+	return p, nil
+}
+
+// classify returns the PDR with the highest precedence that matches the packet
+// and is active at now, or nil if none.
+func (s *sess) classify(now time.Time, p *packet.Packet) *ie.CreatePDRFields {
+	r := s.classifier.Classify(p)
+	if r == nil {
+		return nil
+	}
+	if session.IsActive(r.PDR, now) {
+		return r.PDR
+	}
+
+	// the inactive one is skipped, which needs all the PDRs to be evaluated.
+	for _, r := range s.classifier.Explain(p) {
+		if r.Matched && session.IsActive(r.PDR, now) {
+			return r.PDR
+		}
+	}
+	return nil
+}
+
+// process applies the PDR in r to the packet. raw is the packet as received.
+func (s *sess) process(now time.Time, p *packet.Packet, raw []byte, r *Result) error {
+	pdr := r.PDR
+	data, err := removeOuterHeader(pdr.OuterHeaderRemoval, p, raw)
+	if err != nil {
+		return fmt.Errorf("PDR %d: %w", pdr.PDRID, err)
+	}
+
+	farID, reason := s.farID(pdr)
+	if reason != "" {
+		r.Reason = reason
+		return nil
+	}
+	far, ok := s.rules.FARs[farID]
+	if !ok {
+		return fmt.Errorf("PDR %d: FAR %d: %w", pdr.PDRID, farID, session.ErrRuleNotFound)
+	}
+	r.FAR = far
+
+	dir := qos.Downlink
+	if p.SourceInterface == ie.SrcInterfaceAccess {
+		dir = qos.Uplink
+	}
+	r.Verdict, err = s.qos.Enforce(now, dir, len(data), pdr.QERIDs...)
+	if err != nil {
+		return fmt.Errorf("PDR %d: %w", pdr.PDRID, err)
+	}
+	if r.Verdict == qos.VerdictDrop {
+		r.Reason = "dropped by QER"
+		return nil
+	}
+
+	// the packet is held with the QFI and the PPI in the QERs, which are used
+	// when it is released from the buffer.
+	x := *p
+	x.Data = data
+	qfi, ppi := s.qerValues(pdr.QERIDs)
+	if qfi != nil {
+		x.QFI = qfi
+	}
+
+	action := ie.ApplyActionFlags(far.ApplyAction)
+	if action.Has(ie.ApplyActionDUPL) {
+		for _, dp := range far.DuplicatingParameters {
+			o, err := newOutput(dp.DestinationInterface, "", dp.OuterHeaderCreation, &x)
+			if err != nil {
+				return fmt.Errorf("FAR %d: %w", far.FARID, err)
+			}
+			o.PDRID, o.FARID, o.Duplicated = pdr.PDRID, far.FARID, true
+			r.Outputs = append(r.Outputs, o)
+		}
+	}
+
+	// BUFF and NOCP apply only to the downlink packets.
+	switch {
+	case dir == qos.Downlink:
+		r.Action, err = s.buffer.Enqueue(now, &buffer.Packet{Packet: &x, PDRID: pdr.PDRID, FARID: far.FARID, PPI: ppi})
+		if err != nil {
+			return err
+		}
+	case action.Has(ie.ApplyActionFORW):
+		r.Action = buffer.ActionForward
+	default:
+		r.Action = buffer.ActionDrop
+	}
+	switch r.Action {
+	case buffer.ActionForward:
+		o, err := forward(pdr.PDRID, far, &x)
+		if err != nil {
+			return err
+		}
+		r.Outputs = append(o, r.Outputs...)
+	case buffer.ActionDrop:
+		if dir == qos.Downlink && action.Has(ie.ApplyActionBUFF) {
+			r.Reason = "buffer full"
+		} else {
+			r.Reason = "dropped by FAR"
+		}
+	}
+	if r.Action == buffer.ActionDrop && len(r.Outputs) == 0 {
+		return nil
+	}
+
+	c := usage.Downlink(len(data))
+	if dir == qos.Uplink {
+		c = usage.Uplink(len(data))
+	}
+	r.Reports, err = s.usage.Record(now, c, pdr.URRIDs...)
+	if err != nil {
+		return fmt.Errorf("PDR %d: %w", pdr.PDRID, err)
+	}
+	return nil
+}
+
+// farID returns the FAR ID to apply, or the reason to drop the packet.
+func (s *sess) farID(pdr *ie.CreatePDRFields) (uint32, string) {
+	for _, id := range pdr.URRIDs {
+		if !s.usage.Exhausted(id) {
+			continue
+		}
+		if u, ok := s.rules.URRs[id]; ok && u.FARIDForQuotaAction != nil {
+			return *u.FARIDForQuotaAction, ""
+		}
+		return 0, "quota exhausted"
+	}
+	if pdr.FARID == nil {
+		return 0, "no FAR"
+	}
+	return *pdr.FARID, ""
+}
+
+// qerValues returns the first QFI and PPI in the QERs.
+func (s *sess) qerValues(ids []uint32) (qfi, ppi *uint8) {
+	for _, id := range ids {
+		q, ok := s.rules.QERs[id]
+		if !ok {
+			continue
+		}
+		if qfi == nil {
+			qfi = q.QFI
+		}
+		if ppi == nil {
+			ppi = q.PagingPolicyIndicator
+		}
+	}
+	return qfi, ppi
+}
+
+// forward returns the packet to be sent with the Forwarding Parameters.
+func forward(pdrID uint16, far *ie.CreateFARFields, p *packet.Packet) ([]*Output, error) {
+	var dst uint8
+	var ni string
+	var ohc *ie.OuterHeaderCreationFields
+	if fp := far.ForwardingParameters; fp != nil {
+		dst, ohc = fp.DestinationInterface, fp.OuterHeaderCreation
+		if fp.NetworkInstance != nil {
+			ni = *fp.NetworkInstance
+		}
+	}
+	o, err := newOutput(dst, ni, ohc, p)
+	if err != nil {
+		return nil, fmt.Errorf("FAR %d: %w", far.FARID, err)
+	}
+	o.PDRID, o.FARID = pdrID, far.FARID
+	return []*Output{o}, nil
+}
+
+// removed returns the IDs in old that are not in rules.
+func removed[K uint16 | uint32, F any](old, rules map[K]*F) []K {
+	var ids []K
+	for id := range old {
+		if _, ok := rules[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// changed returns the rules that are not the same as the ones in old, in the
+// order of ID.
+func changed[K uint16 | uint32, F any](old, rules map[K]*F) []*F {
+	var fs []*F
+	for _, id := range slices.Sorted(maps.Keys(rules)) {
+		if old[id] != rules[id] {
+			fs = append(fs, rules[id])
+		}
+	}
+	return fs
+}
@@ -0,0 +1,523 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package engine_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/session"
+	"github.com/wmnsk/go-pfcp/upf/buffer"
+	"github.com/wmnsk/go-pfcp/upf/engine"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+	"github.com/wmnsk/go-pfcp/upf/qos"
+	"github.com/wmnsk/go-pfcp/upf/usage"
+)
+
+const seid = 0xcafe
+
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ipv4 returns an IPv4/UDP packet with n bytes of payload.
+func ipv4(src, dst string, n int) []byte {
+	b := make([]byte, 28+n)
+	b[0] = 0x45
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)))
+	b[9] = packet.ProtocolUDP
+	copy(b[12:16], net.ParseIP(src).To4())
+	copy(b[16:20], net.ParseIP(dst).To4())
+	binary.BigEndian.PutUint16(b[20:22], 1234)
+	binary.BigEndian.PutUint16(b[22:24], 53)
+	binary.BigEndian.PutUint16(b[24:26], uint16(8+n))
+	return b
+}
+
+// gtpu returns a G-PDU with the data.
+func gtpu(teid uint32, data []byte) []byte {
+	b := make([]byte, 8+len(data))
+	b[0], b[1] = 0x30, packet.GTPUMessageTypeGPDU
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(data)))
+	binary.BigEndian.PutUint32(b[4:8], teid)
+	copy(b[8:], data)
+	return b
+}
+
+// uplink and downlink return the packets of UE 10.60.0.1, which is sent to
+// F-TEID 0x100 at 192.0.2.10 in uplink.
+func uplink(n int) *engine.Input {
+	return &engine.Input{
+		SourceInterface: ie.SrcInterfaceAccess,
+		Format:          engine.FormatGTPU,
+		Data:            gtpu(0x100, ipv4("10.60.0.1", "192.0.2.1", n)),
+		Dst:             net.ParseIP("192.0.2.10"),
+	}
+}
+
+func downlink(n int) *engine.Input {
+	return &engine.Input{
+		SourceInterface: ie.SrcInterfaceCore,
+		Format:          engine.FormatIP,
+		Data:            ipv4("192.0.2.1", "10.60.0.1", n),
+	}
+}
+
+func u8(v uint8) *uint8 {
+	return &v
+}
+
+// step is what is done to the session at sec, which is processing in if set,
+// setting the rules if set, or ticking otherwise.
+type step struct {
+	sec   int
+	in    *engine.Input
+	rules []*ie.IE
+
+	want result
+}
+
+// result is the part of the result of step to be compared.
+type result struct {
+	PDRID   uint16
+	FARID   uint32
+	Action  buffer.Action
+	Verdict qos.Verdict
+	Outputs []output
+	// Volumes are the total volumes in the usage reports.
+	Volumes       []uint64
+	Notifications []*buffer.Notification
+}
+
+// output is the Output with the G-PDU parsed.
+type output struct {
+	FARID                uint32
+	DestinationInterface uint8
+	NetworkInstance      string
+	Peer                 string
+	Duplicated           bool
+
+	TEID uint32
+	QFI  *uint8
+	Data []byte
+}
+
+func newOutputs(t *testing.T, outs []*engine.Output) []output {
+	t.Helper()
+
+	var got []output
+	for _, o := range outs {
+		x := output{
+			FARID:                o.FARID,
+			DestinationInterface: o.DestinationInterface,
+			NetworkInstance:      o.NetworkInstance,
+			Duplicated:           o.Duplicated,
+			Data:                 o.Data,
+		}
+		if o.Peer.IsValid() {
+			x.Peer = o.Peer.String()
+		}
+		if o.Peer.Port() == 2152 {
+			p, err := packet.ParseGTPU(o.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			x.TEID, x.QFI, x.Data = p.Tunnel.TEID, p.QFI, p.Data
+		}
+		got = append(got, x)
+	}
+	return got
+}
+
+func volumes(rs []*usage.Report) []uint64 {
+	var vs []uint64
+	for _, r := range rs {
+		vs = append(vs, r.Volume.TotalVolume)
+	}
+	return vs
+}
+
+func (s *step) run(t *testing.T, e *engine.Engine) result {
+	t.Helper()
+
+	now := t0.Add(time.Duration(s.sec) * time.Second)
+	switch {
+	case s.in != nil:
+		r, err := e.Process(now, s.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := result{Action: r.Action, Verdict: r.Verdict, Outputs: newOutputs(t, r.Outputs), Volumes: volumes(r.Reports)}
+		if r.PDR != nil {
+			if r.SEID != seid {
+				t.Errorf("got SEID %#x", r.SEID)
+			}
+			got.PDRID = r.PDR.PDRID
+		}
+		if r.FAR != nil {
+			got.FARID = r.FAR.FARID
+		}
+		return got
+	case s.rules != nil:
+		rules, err := session.NewRules(s.rules...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outs, rs, err := e.SetSession(now, seid, rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result{Outputs: newOutputs(t, outs), Volumes: volumes(rs)}
+	default:
+		var got result
+		for _, r := range e.Tick(now) {
+			if r.SEID != seid {
+				t.Errorf("got SEID %#x", r.SEID)
+			}
+			got.Volumes = append(got.Volumes, volumes(r.Usage)...)
+			got.Notifications = append(got.Notifications, r.Notifications...)
+		}
+		return got
+	}
+}
+
+func TestEngine(t *testing.T) {
+	cases := []struct {
+		description string
+		rules       []*ie.IE
+		steps       []step
+	}{
+		{
+			description: "Forward",
+			rules: []*ie.IE{
+				ie.NewCreatePDR(
+					ie.NewPDRID(1),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceAccess),
+						ie.NewFTEID(ie.FTEIDV4, 0x100, net.ParseIP("192.0.2.10"), nil, 0),
+						ie.NewUEIPAddress(ie.UEIPAddressV4, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewOuterHeaderRemoval(0, 0),
+					ie.NewFARID(1),
+					ie.NewURRID(1),
+					ie.NewQERID(1),
+				),
+				ie.NewCreatePDR(
+					ie.NewPDRID(2),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceCore),
+						ie.NewUEIPAddress(ie.UEIPAddressV4|ie.UEIPAddressSD, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewFARID(2),
+					ie.NewURRID(1),
+					ie.NewQERID(1),
+				),
+				ie.NewCreateFAR(
+					ie.NewFARID(1),
+					ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW)...),
+					ie.NewForwardingParameters(
+						ie.NewDestinationInterface(ie.DstInterfaceCore),
+						ie.NewNetworkInstance("internet"),
+					),
+				),
+				ie.NewCreateFAR(
+					ie.NewFARID(2),
+					ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW, ie.ApplyActionDUPL)...),
+					ie.NewForwardingParameters(
+						ie.NewDestinationInterface(ie.DstInterfaceAccess),
+						ie.NewOuterHeaderCreation(0x0100, 0x200, "192.0.2.20", "", 0, 0, 0),
+					),
+					ie.NewDuplicatingParameters(
+						ie.NewDestinationInterface(ie.DstInterfaceLIFunction),
+						ie.NewOuterHeaderCreation(0x0400, 0, "198.51.100.1", "", 5000, 0, 0),
+					),
+				),
+				ie.NewCreateURR(
+					ie.NewURRID(1),
+					ie.NewMeasurementMethod(0, 1, 0),
+					ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLTH)...),
+					ie.NewVolumeThreshold(0x01, 100, 0, 0),
+				),
+				ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen), ie.NewQFI(9)),
+			},
+			steps: []step{
+				{
+					sec: 1,
+					in:  uplink(32),
+					want: result{PDRID: 1, FARID: 1, Action: buffer.ActionForward, Outputs: []output{{
+						FARID:                1,
+						DestinationInterface: ie.DstInterfaceCore,
+						NetworkInstance:      "internet",
+						Data:                 ipv4("10.60.0.1", "192.0.2.1", 32), // GTP-U header removed
+					}}},
+				},
+				{
+					// 60 bytes in uplink and 60 bytes in downlink.
+					sec: 2,
+					in:  downlink(32),
+					want: result{PDRID: 2, FARID: 2, Action: buffer.ActionForward, Outputs: []output{{
+						FARID:                2,
+						DestinationInterface: ie.DstInterfaceAccess,
+						Peer:                 "192.0.2.20:2152",
+						TEID:                 0x200,
+						QFI:                  u8(9),
+						Data:                 ipv4("192.0.2.1", "10.60.0.1", 32),
+					}, {
+						FARID:                2,
+						DestinationInterface: ie.DstInterfaceLIFunction,
+						Peer:                 "198.51.100.1:5000",
+						Duplicated:           true,
+						Data:                 ipv4("192.0.2.1", "10.60.0.1", 32),
+					}}, Volumes: []uint64{120}},
+				},
+				{
+					sec:  3,
+					in:   &engine.Input{SourceInterface: ie.SrcInterfaceCore, Data: ipv4("192.0.2.1", "10.60.0.2", 32)},
+					want: result{Action: buffer.ActionDrop},
+				},
+			},
+		}, {
+			description: "Buffer",
+			rules: []*ie.IE{
+				ie.NewCreatePDR(
+					ie.NewPDRID(2),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceCore),
+						ie.NewUEIPAddress(ie.UEIPAddressV4|ie.UEIPAddressSD, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewFARID(2),
+					ie.NewQERID(1),
+				),
+				ie.NewCreateFAR(
+					ie.NewFARID(2),
+					ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionBUFF, ie.ApplyActionNOCP)...),
+					ie.NewBARID(1),
+				),
+				ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen), ie.NewQFI(9)),
+				ie.NewCreateBAR(ie.NewBARID(1), ie.NewDownlinkDataNotificationDelay(time.Second)),
+			},
+			steps: []step{
+				{sec: 0, in: downlink(32), want: result{PDRID: 2, FARID: 2, Action: buffer.ActionBuffer}},
+				{sec: 0}, // notified after the delay.
+				{sec: 1, in: downlink(32), want: result{PDRID: 2, FARID: 2, Action: buffer.ActionBuffer}},
+				{
+					sec: 1,
+					want: result{Notifications: []*buffer.Notification{
+						{FARID: 2, PDRID: 2, QFI: u8(9), Time: t0.Add(time.Second)},
+					}},
+				},
+				{
+					// the packets are released when the FAR is updated to forward them.
+					sec: 2,
+					rules: []*ie.IE{
+						ie.NewCreatePDR(
+							ie.NewPDRID(2),
+							ie.NewPrecedence(100),
+							ie.NewPDI(
+								ie.NewSourceInterface(ie.SrcInterfaceCore),
+								ie.NewUEIPAddress(ie.UEIPAddressV4|ie.UEIPAddressSD, "10.60.0.1", "", 0, 0),
+							),
+							ie.NewFARID(2),
+							ie.NewQERID(1),
+						),
+						ie.NewCreateFAR(
+							ie.NewFARID(2),
+							ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW)...),
+							ie.NewForwardingParameters(
+								ie.NewDestinationInterface(ie.DstInterfaceAccess),
+								ie.NewOuterHeaderCreation(0x0100, 0x200, "192.0.2.20", "", 0, 0, 0),
+							),
+						),
+						ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen), ie.NewQFI(9)),
+					},
+					want: result{Outputs: []output{{
+						FARID:                2,
+						DestinationInterface: ie.DstInterfaceAccess,
+						Peer:                 "192.0.2.20:2152",
+						TEID:                 0x200,
+						QFI:                  u8(9),
+						Data:                 ipv4("192.0.2.1", "10.60.0.1", 32),
+					}, {
+						FARID:                2,
+						DestinationInterface: ie.DstInterfaceAccess,
+						Peer:                 "192.0.2.20:2152",
+						TEID:                 0x200,
+						QFI:                  u8(9),
+						Data:                 ipv4("192.0.2.1", "10.60.0.1", 32),
+					}}},
+				},
+			},
+		}, {
+			description: "UplinkNotBuffered",
+			rules: []*ie.IE{
+				ie.NewCreatePDR(
+					ie.NewPDRID(1),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceAccess),
+						ie.NewFTEID(ie.FTEIDV4, 0x100, net.ParseIP("192.0.2.10"), nil, 0),
+						ie.NewUEIPAddress(ie.UEIPAddressV4, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewOuterHeaderRemoval(0, 0),
+					ie.NewFARID(1),
+				),
+				ie.NewCreateFAR(
+					ie.NewFARID(1),
+					ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionBUFF, ie.ApplyActionNOCP)...),
+				),
+			},
+			steps: []step{
+				{sec: 0, in: uplink(32), want: result{PDRID: 1, FARID: 1, Action: buffer.ActionDrop}},
+				{sec: 0}, // not notified.
+				{
+					// nothing is released when the FAR is updated to forward.
+					sec: 1,
+					rules: []*ie.IE{
+						ie.NewCreatePDR(
+							ie.NewPDRID(1),
+							ie.NewPrecedence(100),
+							ie.NewPDI(
+								ie.NewSourceInterface(ie.SrcInterfaceAccess),
+								ie.NewFTEID(ie.FTEIDV4, 0x100, net.ParseIP("192.0.2.10"), nil, 0),
+								ie.NewUEIPAddress(ie.UEIPAddressV4, "10.60.0.1", "", 0, 0),
+							),
+							ie.NewOuterHeaderRemoval(0, 0),
+							ie.NewFARID(1),
+						),
+						ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW)...)),
+					},
+				},
+			},
+		}, {
+			description: "Enforce",
+			rules: []*ie.IE{
+				ie.NewCreatePDR(
+					ie.NewPDRID(1),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceAccess),
+						ie.NewFTEID(ie.FTEIDV4, 0x100, net.ParseIP("192.0.2.10"), nil, 0),
+						ie.NewUEIPAddress(ie.UEIPAddressV4, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewOuterHeaderRemoval(0, 0),
+					ie.NewFARID(1),
+					ie.NewQERID(1),
+				),
+				ie.NewCreatePDR(
+					ie.NewPDRID(2),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceCore),
+						ie.NewUEIPAddress(ie.UEIPAddressV4|ie.UEIPAddressSD, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewFARID(2),
+					ie.NewURRID(1),
+				),
+				ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW)...)),
+				ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW)...)),
+				ie.NewCreateFAR(ie.NewFARID(3), ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionDROP)...)),
+				ie.NewCreateURR(
+					ie.NewURRID(1),
+					ie.NewMeasurementMethod(0, 1, 0),
+					ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLQU)...),
+					ie.NewVolumeQuota(0x01, 100, 0, 0),
+					ie.NewFARID(3),
+				),
+				// the gate is closed for uplink.
+				ie.NewCreateQER(ie.NewQERID(1), ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen)),
+			},
+			steps: []step{
+				{
+					sec: 1,
+					in:  downlink(72),
+					want: result{PDRID: 2, FARID: 2, Action: buffer.ActionForward, Outputs: []output{
+						{FARID: 2, Data: ipv4("192.0.2.1", "10.60.0.1", 72)},
+					}, Volumes: []uint64{100}},
+				},
+				{sec: 2, in: downlink(32), want: result{PDRID: 2, FARID: 3, Action: buffer.ActionDrop}}, // quota exhausted
+				{sec: 3, in: uplink(32), want: result{PDRID: 1, FARID: 1, Action: buffer.ActionDrop, Verdict: qos.VerdictDrop}},
+			},
+		}, {
+			description: "ActivationTime",
+			rules: []*ie.IE{
+				ie.NewCreatePDR(
+					ie.NewPDRID(2),
+					ie.NewPrecedence(100),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceCore),
+						ie.NewUEIPAddress(ie.UEIPAddressV4|ie.UEIPAddressSD, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewFARID(2),
+				),
+				ie.NewCreatePDR(
+					ie.NewPDRID(3),
+					ie.NewPrecedence(50),
+					ie.NewPDI(
+						ie.NewSourceInterface(ie.SrcInterfaceCore),
+						ie.NewUEIPAddress(ie.UEIPAddressV4|ie.UEIPAddressSD, "10.60.0.1", "", 0, 0),
+					),
+					ie.NewFARID(3),
+					ie.NewActivationTime(t0.Add(10*time.Second)),
+					ie.NewDeactivationTime(t0.Add(20*time.Second)),
+				),
+				ie.NewCreateFAR(ie.NewFARID(2), ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionFORW)...)),
+				ie.NewCreateFAR(ie.NewFARID(3), ie.NewApplyAction(ie.NewApplyActionFlags(ie.ApplyActionDROP)...)),
+			},
+			steps: []step{
+				{sec: 9, in: downlink(32), want: result{PDRID: 2, FARID: 2, Action: buffer.ActionForward, Outputs: []output{
+					{FARID: 2, Data: ipv4("192.0.2.1", "10.60.0.1", 32)},
+				}}},
+				{sec: 10, in: downlink(32), want: result{PDRID: 3, FARID: 3, Action: buffer.ActionDrop}},
+				{sec: 20, in: downlink(32), want: result{PDRID: 2, FARID: 2, Action: buffer.ActionForward, Outputs: []output{
+					{FARID: 2, Data: ipv4("192.0.2.1", "10.60.0.1", 32)},
+				}}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			e := engine.New()
+			set := step{rules: c.rules}
+			set.run(t, e)
+
+			for i, s := range c.steps {
+				if diff := cmp.Diff(s.run(t, e), s.want); diff != "" {
+					t.Errorf("step %d: %s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEngineRemoveSession(t *testing.T) {
+	e := engine.New()
+	s := step{rules: []*ie.IE{
+		ie.NewCreateURR(
+			ie.NewURRID(1),
+			ie.NewMeasurementMethod(0, 1, 0),
+			ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLTH)...),
+			ie.NewVolumeThreshold(0x01, 100, 0, 0),
+		),
+	}}
+	s.run(t, e)
+
+	rs, err := e.RemoveSession(t0.Add(time.Second), seid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 1 || rs[0].URRID != 1 {
+		t.Errorf("got final reports %+v", rs)
+	}
+	if _, err := e.RemoveSession(t0.Add(time.Second), seid); !errors.Is(err, engine.ErrSessionNotFound) {
+		t.Errorf("got error %v", err)
+	}
+}
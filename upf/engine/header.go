@@ -0,0 +1,142 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+// Outer Header Removal Description values.
+const (
+	removeGTPUUDPIPv4 uint8 = iota
+	removeGTPUUDPIPv6
+	removeUDPIPv4
+	removeUDPIPv6
+	removeIPv4
+	removeIPv6
+	removeGTPUUDPIP
+	removeSTAG
+	removeSTAGAndCTAG
+)
+
+// Outer Header Creation Description flags.
+const (
+	createGTPUUDPIPv4 uint16 = 0x0100
+	createGTPUUDPIPv6 uint16 = 0x0200
+	createUDPIPv4     uint16 = 0x0400
+	createUDPIPv6     uint16 = 0x0800
+	createIPv4        uint16 = 0x1000
+	createIPv6        uint16 = 0x2000
+	createCTAG        uint16 = 0x4000
+	createSTAG        uint16 = 0x8000
+)
+
+// GTPUPort is the UDP port of GTP-U, which is used in Output.Peer.
+const GTPUPort uint16 = 2152
+
+// removeOuterHeader returns the packet with the outer header removed as desc,
+// the value of Outer Header Removal. raw is the packet as received.
+func removeOuterHeader(desc []byte, p *packet.Packet, raw []byte) ([]byte, error) {
+	if len(desc) == 0 {
+		return raw, nil
+	}
+
+	switch desc[0] {
+	case removeGTPUUDPIPv4, removeGTPUUDPIPv6, removeGTPUUDPIP:
+		if p.Tunnel == nil {
+			return raw, nil
+		}
+		return p.Data, nil
+	case removeUDPIPv4, removeUDPIPv6, removeIPv4, removeIPv6:
+		// the outer IP and UDP headers are not in the packet given.
+		return raw, nil
+	case removeSTAG, removeSTAGAndCTAG:
+		if p.Ethernet == nil {
+			return raw, nil
+		}
+		n := 0
+		if p.Ethernet.STAG != nil {
+			n++
+		}
+		if desc[0] == removeSTAGAndCTAG && p.Ethernet.CTAG != nil {
+			n++
+		}
+		return slices.Concat(raw[:12], raw[12+4*n:]), nil
+	default:
+		return nil, fmt.Errorf("description %d: %w", desc[0], ErrUnsupportedOuterHeaderRemoval)
+	}
+}
+
+// newOutput returns the packet to be sent to the interface with the outer
+// header created as ohc, which can be nil.
+func newOutput(dst uint8, ni string, ohc *ie.OuterHeaderCreationFields, p *packet.Packet) (*Output, error) {
+	o := &Output{DestinationInterface: dst, NetworkInstance: ni, Data: p.Data}
+	if ohc == nil {
+		return o, nil
+	}
+
+	desc := ohc.OuterHeaderCreationDescription
+	if desc&(createCTAG|createSTAG) != 0 {
+		return nil, fmt.Errorf("description %#04x: %w", desc, ErrUnsupportedOuterHeaderCreation)
+	}
+
+	// IPv4 is used if both IPv4 and IPv6 are given.
+	var addr net.IP
+	switch {
+	case desc&(createGTPUUDPIPv4|createUDPIPv4|createIPv4) != 0:
+		addr = ohc.IPv4Address
+	case desc&(createGTPUUDPIPv6|createUDPIPv6|createIPv6) != 0:
+		addr = ohc.IPv6Address
+	}
+	a, ok := netip.AddrFromSlice(addr)
+	if !ok {
+		return nil, fmt.Errorf("description %#04x: %w: no address", desc, ErrUnsupportedOuterHeaderCreation)
+	}
+
+	switch {
+	case desc&(createGTPUUDPIPv4|createGTPUUDPIPv6) != 0:
+		o.Peer = netip.AddrPortFrom(a.Unmap(), GTPUPort)
+		o.Data = encapsulate(ohc.TEID, p.QFI, dst == ie.DstInterfaceAccess, p.Data)
+	case desc&(createUDPIPv4|createUDPIPv6) != 0:
+		o.Peer = netip.AddrPortFrom(a.Unmap(), ohc.PortNumber)
+	default:
+		o.Peer = netip.AddrPortFrom(a.Unmap(), 0)
+	}
+	return o, nil
+}
+
+// encapsulate returns the G-PDU with the data. The PDU Session Container is
+// added if qfi is given, with the PDU Type DL PDU SESSION INFORMATION if dl is
+// true, or UL PDU SESSION INFORMATION otherwise.
+func encapsulate(teid uint32, qfi *uint8, dl bool, data []byte) []byte {
+	hlen := 8
+	if qfi != nil {
+		hlen += 8
+	}
+
+	b := make([]byte, hlen+len(data))
+	b[0] = 0x30 // version 1 and PT
+	b[1] = packet.GTPUMessageTypeGPDU
+	binary.BigEndian.PutUint16(b[2:4], uint16(hlen-8+len(data)))
+	binary.BigEndian.PutUint32(b[4:8], teid)
+	if qfi != nil {
+		b[0] |= 0x04 // E
+		b[11] = 0x85 // PDU Session Container
+		b[12] = 1    // length in 4 octets
+		if !dl {
+			b[13] = 0x10
+		}
+		b[14] = *qfi & 0x3f
+	}
+	copy(b[hlen:], data)
+	return b
+}
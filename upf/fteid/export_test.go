@@ -0,0 +1,11 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package fteid
+
+// SetNext sets the TEID to try first in the next allocation from the n-th
+// resource, so that the tests can reach the end of the range.
+func (a *Allocator) SetNext(n int, teid uint32) {
+	a.resources[n].next = teid
+}
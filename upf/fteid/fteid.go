@@ -0,0 +1,252 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package fteid provides the F-TEID allocator that answers the requests with
+// CH and CHID flags in F-TEID, as specified in 3GPP TS 29.244 clause 5.5.3,
+// using the addresses and TEID ranges in User Plane IP Resource Information.
+//
+// The Allocator implements the F-TEID part of session.Allocator.
+package fteid
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+// Error definitions.
+var (
+	ErrInvalidResource   = errors.New("invalid user plane IP resource")
+	ErrNoResource        = errors.New("no user plane IP resource for the request")
+	ErrResourceExhausted = errors.New("no TEID available")
+)
+
+// Allocator allocates the F-TEIDs with the User Plane IP Resource Information.
+//
+// The resource for the request is the first one that matches all of the
+// following:
+//
+//   - The Network Instance is the one in the request, if the resource has it.
+//   - The Source Interface is the one in the request, if the resource has it.
+//   - The resource has the IPv4 and/or IPv6 addresses requested with V4 and V6
+//     flags, if any.
+//
+// The TEIDs are unique in the Allocator, and within the TEID range of the
+// resource if it has one. The PDRs that request with the same Choose ID in a
+// session share the same F-TEID, which is released when all of them release it.
+//
+// Allocator is safe for concurrent use.
+type Allocator struct {
+	mu        sync.Mutex
+	resources []*resource
+	teids     map[uint32]*allocation
+	chosen    map[chooseKey]*allocation
+}
+
+type resource struct {
+	*ie.UserPlaneIPResourceInformationFields
+
+	// first and size are the TEID range, and next is the one to try first in
+	// the next allocation. size is in uint64 as the whole range has 1<<32 TEIDs.
+	first, next uint32
+	size        uint64
+}
+
+type chooseKey struct {
+	seid uint64
+	chid uint8
+}
+
+// allocation is an F-TEID allocated and the number of the requests sharing it.
+type allocation struct {
+	fteid *ie.FTEIDFields
+	seid  uint64
+	chid  *uint8
+	refs  int
+}
+
+// New creates a new Allocator with the User Plane IP Resource Information IEs,
+// which are tried in the order given.
+func New(res ...*ie.IE) (*Allocator, error) {
+	fields := make([]*ie.UserPlaneIPResourceInformationFields, 0, len(res))
+	for _, i := range res {
+		f, err := i.UserPlaneIPResourceInformation()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return NewFromFields(fields...)
+}
+
+// NewFromFields creates a new Allocator with the User Plane IP Resource
+// Information in the typed fields, in the same way as New.
+func NewFromFields(res ...*ie.UserPlaneIPResourceInformationFields) (*Allocator, error) {
+	a := &Allocator{teids: map[uint32]*allocation{}, chosen: map[chooseKey]*allocation{}}
+	for n, f := range res {
+		if f.IPv4Address == nil && f.IPv6Address == nil {
+			return nil, fmt.Errorf("resource %d: %w: no address", n, ErrInvalidResource)
+		}
+
+		r := &resource{UserPlaneIPResourceInformationFields: f, size: 1 << 32}
+		if bits := (f.Flags >> 2) & 0x07; bits != 0 {
+			if f.TEIDRange>>bits != 0 {
+				return nil, fmt.Errorf("resource %d: %w: TEID range %d in %d bits", n, ErrInvalidResource, f.TEIDRange, bits)
+			}
+			r.first = uint32(f.TEIDRange) << (32 - bits)
+			r.size = 1 << (32 - bits)
+		}
+		// TEID 0 is not allocated.
+		if r.first == 0 {
+			r.first, r.size = 1, r.size-1
+		}
+		r.next = r.first
+		a.resources = append(a.resources, r)
+	}
+	return a, nil
+}
+
+// AllocateFTEID allocates the F-TEID requested with CH flag in fteid. The one
+// allocated for the same Choose ID in the session is returned if CHID flag is
+// set.
+func (a *Allocator) AllocateFTEID(req *session.AllocationRequest, fteid *ie.FTEIDFields) (*ie.FTEIDFields, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := chooseKey{req.SEID, fteid.ChooseID}
+	if fteid.HasChID() {
+		if x, ok := a.chosen[key]; ok {
+			x.refs++
+			return copyFTEID(x.fteid), nil
+		}
+	}
+
+	want := fteid.Flags & (ie.FTEIDV4 | ie.FTEIDV6)
+	r, err := a.resource(req, want)
+	if err != nil {
+		return nil, err
+	}
+	teid, ok := a.allocate(r)
+	if !ok {
+		return nil, fmt.Errorf("source interface %d in %q: %w", req.SourceInterface, req.NetworkInstance, ErrResourceExhausted)
+	}
+
+	f := &ie.FTEIDFields{TEID: teid}
+	if want == 0 || want&ie.FTEIDV4 != 0 {
+		if r.IPv4Address != nil {
+			f.SetIPv4Flag()
+			f.IPv4Address = r.IPv4Address
+		}
+	}
+	if want == 0 || want&ie.FTEIDV6 != 0 {
+		if r.IPv6Address != nil {
+			f.SetIPv6Flag()
+			f.IPv6Address = r.IPv6Address
+		}
+	}
+
+	x := &allocation{fteid: f, seid: req.SEID, refs: 1}
+	if fteid.HasChID() {
+		chid := fteid.ChooseID
+		x.chid = &chid
+		a.chosen[key] = x
+	}
+	a.teids[teid] = x
+	return copyFTEID(f), nil
+}
+
+// ReleaseFTEID releases the F-TEID returned by AllocateFTEID. The ones not
+// allocated by the Allocator are ignored.
+func (a *Allocator) ReleaseFTEID(_ *session.AllocationRequest, fteid *ie.FTEIDFields) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	x, ok := a.teids[fteid.TEID]
+	if !ok || !x.fteid.IPv4Address.Equal(fteid.IPv4Address) || !x.fteid.IPv6Address.Equal(fteid.IPv6Address) {
+		return
+	}
+	if x.refs--; x.refs == 0 {
+		a.free(fteid.TEID, x)
+	}
+}
+
+// ReleaseSession releases all the F-TEIDs allocated for the session, e.g., the
+// ones left when the session is lost without Session Deletion Request.
+func (a *Allocator) ReleaseSession(seid uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for teid, x := range a.teids {
+		if x.seid == seid {
+			a.free(teid, x)
+		}
+	}
+}
+
+// InUse reports whether the TEID is allocated.
+func (a *Allocator) InUse(teid uint32) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.teids[teid]
+	return ok
+}
+
+// Len returns the number of the F-TEIDs allocated.
+func (a *Allocator) Len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.teids)
+}
+
+func (a *Allocator) resource(req *session.AllocationRequest, want uint8) (*resource, error) {
+	for _, r := range a.resources {
+		if r.HasASSONI() && r.NetworkInstance != req.NetworkInstance {
+			continue
+		}
+		if r.HasASSOSI() && r.SourceInterface != req.SourceInterface {
+			continue
+		}
+		if want&ie.FTEIDV4 != 0 && r.IPv4Address == nil || want&ie.FTEIDV6 != 0 && r.IPv6Address == nil {
+			continue
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("source interface %d in %q: %w", req.SourceInterface, req.NetworkInstance, ErrNoResource)
+}
+
+// allocate returns the first TEID available from next in the range of r, or
+// false if all of them are in use.
+func (a *Allocator) allocate(r *resource) (uint32, bool) {
+	for range r.size {
+		teid := r.next
+		r.next++
+		if uint64(r.next-r.first) >= r.size {
+			r.next = r.first
+		}
+		if _, ok := a.teids[teid]; !ok {
+			return teid, true
+		}
+	}
+	return 0, false
+}
+
+func (a *Allocator) free(teid uint32, x *allocation) {
+	delete(a.teids, teid)
+	if x.chid != nil {
+		delete(a.chosen, chooseKey{x.seid, *x.chid})
+	}
+}
+
+func copyFTEID(f *ie.FTEIDFields) *ie.FTEIDFields {
+	c := *f
+	c.IPv4Address = append(net.IP(nil), f.IPv4Address...)
+	c.IPv6Address = append(net.IP(nil), f.IPv6Address...)
+	return &c
+}
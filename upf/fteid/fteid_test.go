@@ -0,0 +1,261 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package fteid_test
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+	"github.com/wmnsk/go-pfcp/upf/fteid"
+	"github.com/wmnsk/go-pfcp/upf/ueip"
+)
+
+func TestAllocator(t *testing.T) {
+	a, err := fteid.New(
+		// TEIDs in 0x40000000-0x7fffffff for Access in "internet".
+		ie.NewUserPlaneIPResourceInformation(0x69, 1, "192.168.0.1", "", "internet", ie.SrcInterfaceAccess),
+		ie.NewUserPlaneIPResourceInformation(0x03, 0, "192.168.1.1", "2001:db8::1", "", 0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	access := &session.AllocationRequest{SEID: 1, SourceInterface: ie.SrcInterfaceAccess, NetworkInstance: "internet"}
+	core := &session.AllocationRequest{SEID: 1, SourceInterface: ie.SrcInterfaceCore, NetworkInstance: "internet"}
+
+	t.Run("Resource", func(t *testing.T) {
+		for _, c := range []struct {
+			req  *session.AllocationRequest
+			f    *ie.FTEIDFields
+			want *ie.FTEIDFields
+		}{
+			{
+				access,
+				ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDCH, 0, nil, nil, 0),
+				ie.NewFTEIDFields(ie.FTEIDV4, 0x40000000, net.IP{192, 168, 0, 1}, nil, 0),
+			}, {
+				core,
+				ie.NewFTEIDFields(ie.FTEIDCH, 0, nil, nil, 0),
+				ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDV6, 1, net.IP{192, 168, 1, 1}, net.ParseIP("2001:db8::1"), 0),
+			}, {
+				// IPv6 is only in the second one.
+				access,
+				ie.NewFTEIDFields(ie.FTEIDV6|ie.FTEIDCH, 0, nil, nil, 0),
+				ie.NewFTEIDFields(ie.FTEIDV6, 2, nil, net.ParseIP("2001:db8::1"), 0),
+			},
+		} {
+			got, err := a.AllocateFTEID(c.req, c.f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.want); diff != "" {
+				t.Error(diff)
+			}
+		}
+	})
+
+	t.Run("ChooseID", func(t *testing.T) {
+		req := &session.AllocationRequest{SEID: 2, SourceInterface: ie.SrcInterfaceCore}
+		chid := ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDCH|ie.FTEIDCHID, 0, nil, nil, 5)
+		first, err := a.AllocateFTEID(req, chid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := a.AllocateFTEID(req, chid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(first, second); diff != "" {
+			t.Errorf("not shared: %s", diff)
+		}
+
+		// another session has its own one for the same Choose ID.
+		other, err := a.AllocateFTEID(&session.AllocationRequest{SEID: 3, SourceInterface: ie.SrcInterfaceCore}, chid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if other.TEID == first.TEID {
+			t.Errorf("shared with another session: %#x", other.TEID)
+		}
+
+		a.ReleaseFTEID(req, first)
+		if !a.InUse(first.TEID) {
+			t.Fatal("released while shared")
+		}
+		a.ReleaseFTEID(req, second)
+		if a.InUse(first.TEID) {
+			t.Fatal("not released")
+		}
+		got, err := a.AllocateFTEID(req, chid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.TEID == first.TEID {
+			t.Errorf("reused %#x immediately", got.TEID)
+		}
+	})
+
+	t.Run("ReleaseSession", func(t *testing.T) {
+		n := a.Len()
+		req := &session.AllocationRequest{SEID: 4, SourceInterface: ie.SrcInterfaceCore}
+		for _, f := range []*ie.FTEIDFields{
+			ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDCH, 0, nil, nil, 0),
+			ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDCH|ie.FTEIDCHID, 0, nil, nil, 1),
+		} {
+			if _, err := a.AllocateFTEID(req, f); err != nil {
+				t.Fatal(err)
+			}
+		}
+		a.ReleaseSession(4)
+		if a.Len() != n {
+			t.Errorf("got %d allocated, want %d", a.Len(), n)
+		}
+	})
+
+	t.Run("NoResource", func(t *testing.T) {
+		req := &session.AllocationRequest{SourceInterface: ie.SrcInterfaceAccess, NetworkInstance: "ims"}
+		f := ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDCH, 0, nil, nil, 0)
+		if _, err := a.AllocateFTEID(req, f); err != nil {
+			t.Fatal(err)
+		}
+
+		empty, err := fteid.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := empty.AllocateFTEID(req, f); !errors.Is(err, fteid.ErrNoResource) {
+			t.Errorf("got error %v", err)
+		}
+	})
+}
+
+func TestAllocatorInvalidResource(t *testing.T) {
+	for _, f := range []*ie.UserPlaneIPResourceInformationFields{
+		ie.NewUserPlaneIPResourceInformationFields(0x20, 0, "", "", "internet", 0),
+		ie.NewUserPlaneIPResourceInformationFields(0x05, 2, "192.168.0.1", "", "", 0),
+	} {
+		if _, err := fteid.NewFromFields(f); !errors.Is(err, fteid.ErrInvalidResource) {
+			t.Errorf("%+v: got error %v", f, err)
+		}
+	}
+}
+
+func TestAllocatorWrapAround(t *testing.T) {
+	cases := []struct {
+		description string
+		res         *ie.IE
+		next        uint32
+		want        []uint32
+	}{
+		{
+			"NoTEIDRI",
+			ie.NewUserPlaneIPResourceInformation(0x01, 0, "192.168.0.1", "", "", 0),
+			0xfffffffe,
+			[]uint32{0xfffffffe, 0xffffffff, 1},
+		}, {
+			"TEIDRI/Last",
+			ie.NewUserPlaneIPResourceInformation(0x1d, 0x7f, "192.168.0.1", "", "", 0),
+			0xffffffff,
+			[]uint32{0xffffffff, 0xfe000000, 0xfe000001},
+		}, {
+			"TEIDRI/First",
+			ie.NewUserPlaneIPResourceInformation(0x05, 0, "192.168.0.1", "", "", 0),
+			0x7fffffff,
+			[]uint32{0x7fffffff, 1, 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			a, err := fteid.New(c.res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a.SetNext(0, c.next)
+
+			var got []uint32
+			for range c.want {
+				f, err := a.AllocateFTEID(&session.AllocationRequest{}, ie.NewFTEIDFields(ie.FTEIDV4|ie.FTEIDCH, 0, nil, nil, 0))
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, f.TEID)
+			}
+			if diff := cmp.Diff(got, c.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAllocatorWithStore(t *testing.T) {
+	fteids, err := fteid.New(ie.NewUserPlaneIPResourceInformation(0x01, 0, "192.168.0.1", "", "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ueips, err := ueip.New(&ueip.Pool{NetworkInstance: "internet", Prefix: netip.MustParsePrefix("10.0.0.0/24")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := session.NewStore(session.NewAllocator(fteids, ueips))
+
+	newPDR := func(id uint16, ies ...*ie.IE) *ie.IE {
+		return ie.NewCreatePDR(
+			ie.NewPDRID(id),
+			ie.NewPrecedence(100),
+			ie.NewPDI(append([]*ie.IE{
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(ie.FTEIDV4|ie.FTEIDCH|ie.FTEIDCHID, 0, nil, nil, 1),
+				ie.NewNetworkInstance("internet"),
+			}, ies...)...),
+			ie.NewFARID(1),
+		)
+	}
+	r := st.Establish(message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0,
+		ie.NewNodeID("127.0.0.2", "", ""),
+		ie.NewFSEID(0xcafe, net.ParseIP("127.0.0.2"), nil),
+		newPDR(1, ie.NewUEIPAddress(ie.UEIPAddressCHV4|ie.UEIPAddressV4, "", "", 0, 0)),
+		newPDR(2, ie.NewSDFFilter("permit out ip from any to assigned", "", "", "", 0)),
+		ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(0x02)),
+	))
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+
+	created, err := ie.CreatedPDRType.Collect(r.CreatedPDRs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ie.NewFTEIDFields(ie.FTEIDV4, 1, net.IP{192, 168, 0, 1}, nil, 0)
+	for _, c := range created {
+		if diff := cmp.Diff(c.LocalFTEIDs, []*ie.FTEIDFields{want}); diff != "" {
+			t.Errorf("PDR %d: %s", c.PDRID, diff)
+		}
+	}
+	if fteids.Len() != 1 || !ueips.InUse(netip.MustParseAddr("10.0.0.1")) {
+		t.Fatalf("got %d F-TEIDs allocated", fteids.Len())
+	}
+
+	// the F-TEID is kept while PDR 2 uses it.
+	seid := r.Session.LocalSEID
+	if r := st.Modify(message.NewSessionModificationRequest(0, 0, seid, 2, 0, ie.NewRemovePDR(ie.NewPDRID(1)))); !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	if fteids.Len() != 1 || ueips.InUse(netip.MustParseAddr("10.0.0.1")) {
+		t.Errorf("got %d F-TEIDs allocated after removing PDR 1", fteids.Len())
+	}
+
+	if r := st.Delete(message.NewSessionDeletionRequest(0, 0, seid, 3, 0)); !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	if fteids.Len() != 0 {
+		t.Errorf("got %d F-TEIDs allocated after session deletion", fteids.Len())
+	}
+}
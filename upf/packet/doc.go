@@ -0,0 +1,10 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package packet provides the minimal decoding of user plane packets that is
+// required to evaluate PFCP rules, e.g., IP addresses and ports for SDF filters
+// and TEID and QFI for PDIs.
+//
+// It does not aim to be a general-purpose packet decoder.
+package packet
@@ -0,0 +1,87 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package packet
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+// EtherType values used in this package.
+const (
+	EtherTypeIPv4     uint16 = 0x0800
+	EtherTypeIPv6     uint16 = 0x86dd
+	EtherTypeCTAG     uint16 = 0x8100 // IEEE 802.1Q
+	EtherTypeSTAG     uint16 = 0x88a8 // IEEE 802.1ad
+	EtherTypeQinQ9100 uint16 = 0x9100 // pre-standard S-TAG
+)
+
+// ParseEthernet decodes b as an Ethernet frame, and decodes the payload as IP
+// packet if the EtherType is IPv4 or IPv6.
+//
+// Up to two VLAN tags are decoded. The outer tag of a double-tagged frame is
+// the S-TAG and the inner one is the C-TAG, regardless of the TPID (0x88a8,
+// 0x9100, or 0x8100 in the pre-standard Q-in-Q). A single tag is the S-TAG if
+// its TPID is 0x88a8 or 0x9100, and the C-TAG otherwise.
+// Data in the returned Packet is the whole frame.
+func ParseEthernet(b []byte) (*Packet, error) {
+	if len(b) < 14 {
+		return nil, ErrTooShort
+	}
+
+	eth := &Ethernet{
+		Dst: net.HardwareAddr(b[0:6]),
+		Src: net.HardwareAddr(b[6:12]),
+	}
+
+	var tags []*VLAN
+	var tpids []uint16
+	offset := 12
+	for {
+		typ := binary.BigEndian.Uint16(b[offset : offset+2])
+		if !isVLANTPID(typ) || len(tags) == 2 {
+			eth.EtherType = typ
+			offset += 2
+			break
+		}
+		if len(b) < offset+6 {
+			return nil, ErrTooShort
+		}
+
+		tci := binary.BigEndian.Uint16(b[offset+2 : offset+4])
+		tags = append(tags, &VLAN{
+			PCP: uint8(tci >> 13),
+			DEI: tci&0x1000 != 0,
+			VID: tci & 0x0fff,
+		})
+		tpids = append(tpids, typ)
+		offset += 4
+	}
+
+	switch len(tags) {
+	case 1:
+		if tpids[0] == EtherTypeCTAG {
+			eth.CTAG = tags[0]
+		} else {
+			eth.STAG = tags[0]
+		}
+	case 2:
+		eth.STAG, eth.CTAG = tags[0], tags[1]
+	}
+
+	p := &Packet{Ethernet: eth, Data: b}
+	if eth.EtherType == EtherTypeIPv4 || eth.EtherType == EtherTypeIPv6 {
+		ip, err := parseIP(b[offset:])
+		if err != nil {
+			return nil, err
+		}
+		p.IP = ip
+	}
+	return p, nil
+}
+
+func isVLANTPID(typ uint16) bool {
+	return typ == EtherTypeCTAG || typ == EtherTypeSTAG || typ == EtherTypeQinQ9100
+}
@@ -0,0 +1,78 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package packet
+
+import (
+	"encoding/binary"
+)
+
+// GTP-U definitions used in this package.
+const (
+	GTPUMessageTypeGPDU uint8 = 0xff
+
+	// extension header type of PDU Session Container.
+	gtpuExtPDUSessionContainer uint8 = 0x85
+)
+
+// ParseGTPU decodes b as a G-PDU, and decodes the T-PDU in it as IP packet if it
+// is an IPv4 or IPv6 packet.
+//
+// QFI is taken from the PDU Session Container extension header if present.
+// Data in the returned Packet is the T-PDU.
+func ParseGTPU(b []byte) (*Packet, error) {
+	if len(b) < 8 {
+		return nil, ErrTooShort
+	}
+	if b[0]>>5 != 1 {
+		return nil, ErrUnsupportedVersion
+	}
+	if b[1] != GTPUMessageTypeGPDU {
+		return nil, ErrNotGPDU
+	}
+
+	l := 8 + int(binary.BigEndian.Uint16(b[2:4]))
+	if len(b) < l {
+		return nil, ErrTooShort
+	}
+
+	p := &Packet{Tunnel: &Tunnel{TEID: binary.BigEndian.Uint32(b[4:8])}}
+	offset := 8
+
+	// E, S or PN flag
+	if b[0]&0x07 != 0 {
+		if l < offset+4 {
+			return nil, ErrTooShort
+		}
+		next := b[offset+3]
+		offset += 4
+
+		for next != 0 {
+			if l < offset+1 {
+				return nil, ErrTooShort
+			}
+			n := int(b[offset]) * 4
+			if n == 0 || l < offset+n {
+				return nil, ErrTooShort
+			}
+
+			if next == gtpuExtPDUSessionContainer && n >= 4 {
+				qfi := b[offset+2] & 0x3f
+				p.QFI = &qfi
+			}
+			next = b[offset+n-1]
+			offset += n
+		}
+	}
+
+	p.Data = b[offset:l]
+	if len(p.Data) > 0 && (p.Data[0]>>4 == 4 || p.Data[0]>>4 == 6) {
+		ip, err := parseIP(p.Data)
+		if err != nil {
+			return nil, err
+		}
+		p.IP = ip
+	}
+	return p, nil
+}
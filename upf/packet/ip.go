@@ -0,0 +1,127 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package packet
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+// ParseIP decodes b as an IPv4 or IPv6 packet.
+func ParseIP(b []byte) (*Packet, error) {
+	ip, err := parseIP(b)
+	if err != nil {
+		return nil, err
+	}
+	return &Packet{IP: ip, Data: b}, nil
+}
+
+func parseIP(b []byte) (*IP, error) {
+	if len(b) < 1 {
+		return nil, ErrTooShort
+	}
+
+	switch b[0] >> 4 {
+	case 4:
+		return parseIPv4(b)
+	case 6:
+		return parseIPv6(b)
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+func parseIPv4(b []byte) (*IP, error) {
+	if len(b) < 20 {
+		return nil, ErrTooShort
+	}
+	hlen := int(b[0]&0x0f) * 4
+	if hlen < 20 || len(b) < hlen {
+		return nil, ErrTooShort
+	}
+
+	ip := &IP{
+		Version:      4,
+		TrafficClass: b[1],
+		Length:       int(binary.BigEndian.Uint16(b[2:4])),
+		Protocol:     b[9],
+		Src:          net.IP(b[12:16]),
+		Dst:          net.IP(b[16:20]),
+	}
+
+	// the transport header is only in the first fragment.
+	if binary.BigEndian.Uint16(b[6:8])&0x1fff != 0 {
+		return ip, nil
+	}
+	ip.parseTransport(b[hlen:])
+	return ip, nil
+}
+
+func parseIPv6(b []byte) (*IP, error) {
+	if len(b) < 40 {
+		return nil, ErrTooShort
+	}
+
+	ip := &IP{
+		Version:      6,
+		TrafficClass: uint8(binary.BigEndian.Uint16(b[0:2]) >> 4),
+		FlowLabel:    binary.BigEndian.Uint32(b[0:4]) & 0x000fffff,
+		Length:       40 + int(binary.BigEndian.Uint16(b[4:6])),
+		Protocol:     b[6],
+		Src:          net.IP(b[8:24]),
+		Dst:          net.IP(b[24:40]),
+	}
+
+	// skip the extension headers to find the upper-layer protocol.
+	next, b := b[6], b[40:]
+	for {
+		switch next {
+		case 0, 43, 60: // Hop-by-Hop, Routing, Destination Options
+			if len(b) < 8 {
+				return ip, nil
+			}
+			l := (int(b[1]) + 1) * 8
+			if len(b) < l {
+				return ip, nil
+			}
+			next, b = b[0], b[l:]
+		case 44: // Fragment
+			if len(b) < 8 {
+				return ip, nil
+			}
+			if binary.BigEndian.Uint16(b[2:4])&0xfff8 != 0 {
+				ip.Protocol = b[0]
+				return ip, nil
+			}
+			next, b = b[0], b[8:]
+		default:
+			ip.Protocol = next
+			ip.parseTransport(b)
+			return ip, nil
+		}
+	}
+}
+
+// parseTransport sets the ports or SPI from the upper-layer header if present.
+func (ip *IP) parseTransport(b []byte) {
+	switch ip.Protocol {
+	case ProtocolTCP, ProtocolUDP, ProtocolSCTP:
+		if len(b) < 4 {
+			return
+		}
+		ip.SrcPort = binary.BigEndian.Uint16(b[0:2])
+		ip.DstPort = binary.BigEndian.Uint16(b[2:4])
+	case ProtocolESP:
+		if len(b) < 4 {
+			return
+		}
+		ip.SPI = binary.BigEndian.Uint32(b[0:4])
+	case ProtocolAH:
+		if len(b) < 8 {
+			return
+		}
+		ip.SPI = binary.BigEndian.Uint32(b[4:8])
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package packet
+
+import (
+	"errors"
+	"net"
+)
+
+// Error definitions.
+var (
+	ErrTooShort           = errors.New("too short to decode")
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	ErrNotGPDU            = errors.New("not a G-PDU")
+)
+
+// IP protocol numbers used in this package.
+const (
+	ProtocolICMP   uint8 = 1
+	ProtocolTCP    uint8 = 6
+	ProtocolUDP    uint8 = 17
+	ProtocolESP    uint8 = 50
+	ProtocolAH     uint8 = 51
+	ProtocolICMPv6 uint8 = 58
+	ProtocolSCTP   uint8 = 132
+)
+
+// Packet is a user plane packet with the information to be matched against the
+// PFCP rules.
+//
+// SourceInterface and DomainName are not in the packet itself and should be set
+// by the caller if necessary. Tunnel and QFI are set when the packet is received
+// in GTP-U, and Ethernet and IP are set when the packet has the corresponding
+// headers.
+type Packet struct {
+	// SourceInterface is the interface the packet is received from, which is one
+	// of the ie.SrcInterface* values.
+	SourceInterface uint8
+
+	Tunnel   *Tunnel
+	QFI      *uint8
+	Ethernet *Ethernet
+	IP       *IP
+
+	// DomainName is the domain name the packet is destined for, e.g., taken from
+	// the DNS query or the SNI in TLS, which is used to detect applications by PFD.
+	DomainName string
+
+	// Data is the packet without the GTP-U header.
+	Data []byte
+}
+
+// Tunnel is the GTP-U tunnel the packet is received from.
+type Tunnel struct {
+	TEID uint32
+	// Dst is the IP address the GTP-U packet is sent to, if known.
+	Dst net.IP
+}
+
+// IP is the IP header and the transport layer information of a packet.
+//
+// SrcPort and DstPort are set for TCP, UDP and SCTP, and SPI is set for ESP
+// and AH. TrafficClass is the ToS in IPv4, and FlowLabel is always zero in IPv4.
+type IP struct {
+	Version      int
+	Src, Dst     net.IP
+	Protocol     uint8
+	TrafficClass uint8
+	FlowLabel    uint32
+	SrcPort      uint16
+	DstPort      uint16
+	SPI          uint32
+
+	// Length is the total length of the IP packet.
+	Length int
+}
+
+// Len returns the length of the packet in bytes.
+func (p *Packet) Len() int {
+	return len(p.Data)
+}
+
+// Ethernet is the Ethernet header of a packet in Ethernet PDU session.
+//
+// STAG is the outer tag (802.1ad) and CTAG is the inner tag (802.1Q) in a
+// double-tagged frame. A single-tagged frame has either of them depending on
+// the TPID. EtherType is the type of the payload after the tags.
+type Ethernet struct {
+	Src, Dst  net.HardwareAddr
+	STAG      *VLAN
+	CTAG      *VLAN
+	EtherType uint16
+}
+
+// VLAN is the VLAN tag in Ethernet header.
+type VLAN struct {
+	PCP uint8
+	DEI bool
+	VID uint16
+}
@@ -0,0 +1,209 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package packet_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/upf/packet"
+)
+
+var (
+	// IPv4/UDP 10.0.0.1:1234 -> 192.0.2.1:53, ToS 0xb8, 4 bytes of payload.
+	ipv4UDP = []byte{
+		0x45, 0xb8, 0x00, 0x20, 0x00, 0x00, 0x40, 0x00, 0x40, 0x11, 0x00, 0x00,
+		0x0a, 0x00, 0x00, 0x01, 0xc0, 0x00, 0x02, 0x01,
+		0x04, 0xd2, 0x00, 0x35, 0x00, 0x0c, 0x00, 0x00,
+		0xde, 0xad, 0xbe, 0xef,
+	}
+
+	// IPv6 with Hop-by-Hop, TCP [2001:db8::1]:443 -> [2001:db8::2]:50000, TC 0x02, Flow Label 0x82345.
+	ipv6TCP = []byte{
+		0x60, 0x28, 0x23, 0x45, 0x00, 0x1c, 0x00, 0x40,
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01,
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x02,
+		0x06, 0x00, 0x01, 0x04, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0xbb, 0xc3, 0x50, 0, 0, 0, 0, 0, 0, 0, 0, 0x50, 0x02, 0xff, 0xff, 0, 0, 0, 0,
+	}
+)
+
+func TestParseIP(t *testing.T) {
+	t.Run("IPv4", func(t *testing.T) {
+		p, err := packet.ParseIP(ipv4UDP)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &packet.IP{
+			Version:      4,
+			Src:          net.IP{10, 0, 0, 1},
+			Dst:          net.IP{192, 0, 2, 1},
+			Protocol:     packet.ProtocolUDP,
+			TrafficClass: 0xb8,
+			SrcPort:      1234,
+			DstPort:      53,
+			Length:       32,
+		}
+		if diff := cmp.Diff(p.IP, want); diff != "" {
+			t.Error(diff)
+		}
+		if p.Len() != 32 {
+			t.Errorf("got length %d, want 32", p.Len())
+		}
+	})
+
+	t.Run("IPv6", func(t *testing.T) {
+		p, err := packet.ParseIP(ipv6TCP)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &packet.IP{
+			Version:      6,
+			Src:          net.ParseIP("2001:db8::1"),
+			Dst:          net.ParseIP("2001:db8::2"),
+			Protocol:     packet.ProtocolTCP,
+			TrafficClass: 0x02,
+			FlowLabel:    0x82345,
+			SrcPort:      443,
+			DstPort:      50000,
+			Length:       68,
+		}
+		if diff := cmp.Diff(p.IP, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("TooShort", func(t *testing.T) {
+		if _, err := packet.ParseIP(ipv4UDP[:19]); !errors.Is(err, packet.ErrTooShort) {
+			t.Errorf("got %v, want ErrTooShort", err)
+		}
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		if _, err := packet.ParseIP([]byte{0x50}); !errors.Is(err, packet.ErrUnsupportedVersion) {
+			t.Errorf("got %v, want ErrUnsupportedVersion", err)
+		}
+	})
+}
+
+func TestParseGTPU(t *testing.T) {
+	// G-PDU with PDU Session Container (UL, QFI=9).
+	b := append([]byte{
+		0x34, 0xff, 0x00, 0x28, 0x11, 0x22, 0x33, 0x44,
+		0x00, 0x00, 0x00, 0x85,
+		0x01, 0x10, 0x09, 0x00,
+	}, ipv4UDP...)
+
+	p, err := packet.ParseGTPU(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(p.Tunnel, &packet.Tunnel{TEID: 0x11223344}); diff != "" {
+		t.Error(diff)
+	}
+	if p.QFI == nil || *p.QFI != 9 {
+		t.Errorf("got QFI %v, want 9", p.QFI)
+	}
+	if p.IP == nil || p.IP.DstPort != 53 {
+		t.Errorf("inner packet not decoded: %+v", p.IP)
+	}
+	if diff := cmp.Diff(p.Data, ipv4UDP); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := packet.ParseGTPU([]byte{0x30, 0x01, 0x00, 0x00, 0, 0, 0, 0}); !errors.Is(err, packet.ErrNotGPDU) {
+		t.Errorf("got %v, want ErrNotGPDU", err)
+	}
+}
+
+func TestParseEthernet(t *testing.T) {
+	header := []byte{
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x02, // dst
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x01, // src
+	}
+
+	cases := []struct {
+		description string
+		tags        []byte
+		stag, ctag  *packet.VLAN
+	}{
+		{
+			"Untagged", nil, nil, nil,
+		}, {
+			"CTAG",
+			[]byte{0x81, 0x00, 0xa0, 0x64},
+			nil, &packet.VLAN{PCP: 5, VID: 100},
+		}, {
+			"STAG",
+			[]byte{0x88, 0xa8, 0x30, 0xc8},
+			&packet.VLAN{PCP: 1, DEI: true, VID: 200}, nil,
+		}, {
+			"QinQ",
+			[]byte{0x88, 0xa8, 0x00, 0xc8, 0x81, 0x00, 0x00, 0x64},
+			&packet.VLAN{VID: 200}, &packet.VLAN{VID: 100},
+		}, {
+			"PreStandardQinQ",
+			[]byte{0x81, 0x00, 0x00, 0xc8, 0x81, 0x00, 0x00, 0x64},
+			&packet.VLAN{VID: 200}, &packet.VLAN{VID: 100},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			var b []byte
+			b = append(b, header...)
+			b = append(b, c.tags...)
+			b = append(b, 0x08, 0x00)
+			b = append(b, ipv4UDP...)
+
+			p, err := packet.ParseEthernet(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := &packet.Ethernet{
+				Src:       net.HardwareAddr{0x02, 0, 0, 0, 0, 1},
+				Dst:       net.HardwareAddr{0x02, 0, 0, 0, 0, 2},
+				STAG:      c.stag,
+				CTAG:      c.ctag,
+				EtherType: packet.EtherTypeIPv4,
+			}
+			if diff := cmp.Diff(p.Ethernet, want); diff != "" {
+				t.Error(diff)
+			}
+			if p.IP == nil || p.IP.DstPort != 53 {
+				t.Errorf("payload not decoded: %+v", p.IP)
+			}
+		})
+	}
+
+	t.Run("TaggedNoPayload", func(t *testing.T) {
+		b := append(append([]byte{}, header...), 0x81, 0x00, 0x00, 0x64, 0x88, 0xcc)
+		p, err := packet.ParseEthernet(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(p.Ethernet.CTAG, &packet.VLAN{VID: 100}); diff != "" {
+			t.Error(diff)
+		}
+		if p.Ethernet.EtherType != 0x88cc {
+			t.Errorf("got EtherType %#04x, want 0x88cc", p.Ethernet.EtherType)
+		}
+	})
+
+	for _, tags := range [][]byte{{0x81, 0x00, 0x00}, {0x81, 0x00, 0x00, 0x64, 0x88}} {
+		t.Run("TooShort", func(t *testing.T) {
+			b := append(append([]byte{}, header...), tags...)
+			if _, err := packet.ParseEthernet(b); !errors.Is(err, packet.ErrTooShort) {
+				t.Errorf("got %v, want ErrTooShort", err)
+			}
+		})
+	}
+}
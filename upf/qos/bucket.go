@@ -0,0 +1,94 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package qos
+
+import (
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// bucket is the token bucket for the bit rate in kbps, which is full at the
+// amount of the traffic at the rate for the Averaging Window.
+type bucket struct {
+	kbps   uint64
+	tokens float64 // in bits
+	last   time.Time
+}
+
+func newBucket(now time.Time, kbps uint64, window time.Duration) *bucket {
+	b := &bucket{kbps: kbps, last: now}
+	b.tokens = b.size(window)
+	return b
+}
+
+func (b *bucket) size(window time.Duration) float64 {
+	return float64(b.kbps) * float64(window) / float64(time.Millisecond)
+}
+
+// resize changes the rate and the window, keeping the tokens within the size.
+func (b *bucket) resize(now time.Time, kbps uint64, window time.Duration) {
+	b.refill(now, window)
+	b.kbps = kbps
+	b.tokens = min(b.tokens, b.size(window))
+}
+
+func (b *bucket) refill(now time.Time, window time.Duration) {
+	if now.After(b.last) {
+		b.tokens += float64(b.kbps) * float64(now.Sub(b.last)) / float64(time.Millisecond)
+		b.tokens = min(b.tokens, b.size(window))
+		b.last = now
+	}
+}
+
+// conforms reports whether the packet of the size in bytes is within the rate.
+func (b *bucket) conforms(size int) bool {
+	return b.tokens >= float64(size*8)
+}
+
+func (b *bucket) take(size int) {
+	b.tokens -= float64(size * 8)
+}
+
+// timeUnits are the periods of Time Unit in Packet Rate.
+var timeUnits = map[uint8]time.Duration{
+	ie.TimeUnitMinute:   time.Minute,
+	ie.TimeUnit6Minutes: 6 * time.Minute,
+	ie.TimeUnitHour:     time.Hour,
+	ie.TimeUnitDay:      24 * time.Hour,
+	ie.TimeUnitWeek:     7 * 24 * time.Hour,
+}
+
+// window counts the packets in the fixed period of Time Unit, which starts at
+// the first packet after the previous one ends.
+type window struct {
+	limit  uint16
+	period time.Duration
+	start  time.Time
+	count  uint16
+}
+
+func newWindow(unit uint8, limit uint16) *window {
+	period, ok := timeUnits[unit]
+	if !ok {
+		// the spare values shall be interpreted as one minute.
+		period = time.Minute
+	}
+	return &window{limit: limit, period: period}
+}
+
+func (w *window) conforms(now time.Time) bool {
+	if w.start.IsZero() || !now.Before(w.start.Add(w.period)) {
+		return w.limit > 0
+	}
+	return w.count < w.limit
+}
+
+func (w *window) take(now time.Time) {
+	if w.start.IsZero() || !now.Before(w.start.Add(w.period)) {
+		w.start, w.count = now, 0
+	}
+	w.count++
+}
@@ -0,0 +1,338 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package qos provides the QoS enforcement model that applies the Gate Status,
+// the MBR, the GBR and the Packet Rate in QERs to the traffic, as specified in
+// 3GPP TS 29.244 clause 5.2.1.11 and 5.4.
+package qos
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// ErrQERNotFound is returned when the QER ID given is not in the Enforcer.
+var ErrQERNotFound = errors.New("QER not found")
+
+// DefaultAveragingWindow is the Averaging Window used for the QERs without it,
+// which is the default value in 3GPP TS 23.501.
+const DefaultAveragingWindow = 2000 * time.Millisecond
+
+// Direction is the direction of the traffic.
+type Direction uint8
+
+// Direction definitions.
+const (
+	Uplink Direction = iota
+	Downlink
+)
+
+// String returns the name of the direction.
+func (d Direction) String() string {
+	switch d {
+	case Uplink:
+		return "Uplink"
+	case Downlink:
+		return "Downlink"
+	default:
+		return fmt.Sprintf("Direction(%d)", uint8(d))
+	}
+}
+
+// Verdict is the result of the enforcement for a packet.
+type Verdict uint8
+
+// Verdict definitions.
+const (
+	// VerdictPass is returned for the packet within the rates.
+	VerdictPass Verdict = iota
+	// VerdictMark is returned for the packet that exceeds the GBR but not
+	// the MBR, which should be forwarded without the guarantee.
+	VerdictMark
+	// VerdictDrop is returned for the packet on the closed gate, or the one
+	// that exceeds the MBR or the Packet Rate.
+	VerdictDrop
+)
+
+// String returns the name of the verdict.
+func (v Verdict) String() string {
+	switch v {
+	case VerdictPass:
+		return "PASS"
+	case VerdictMark:
+		return "MARK"
+	case VerdictDrop:
+		return "DROP"
+	default:
+		return fmt.Sprintf("Verdict(%d)", uint8(v))
+	}
+}
+
+// Counters is the traffic enforced by a QER in a direction.
+type Counters struct {
+	// Packets and Bytes are the traffic forwarded, including the marked ones.
+	Packets uint64
+	Bytes   uint64
+
+	MarkedPackets uint64
+	MarkedBytes   uint64
+
+	// GateDropped, MBRDropped and PacketRateDropped are the number of packets
+	// dropped by the reasons.
+	GateDropped       uint64
+	MBRDropped        uint64
+	PacketRateDropped uint64
+}
+
+// Stats is the Counters of a QER in both directions.
+type Stats struct {
+	Uplink   Counters
+	Downlink Counters
+}
+
+// Enforcer applies the QERs to the traffic.
+//
+// The QERs are enforced in the following way:
+//
+//   - The packets are dropped if the gate is closed in the direction.
+//   - The MBR and the GBR are enforced with the token buckets, which allow the
+//     burst of the traffic at the rate for the Averaging Window. The packets
+//     that exceed the MBR are dropped, and the ones that exceed the GBR are
+//     marked. A zero rate means not limited.
+//   - The Packet Rate is enforced by counting the packets in the period of the
+//     Time Unit. The Packet Rate for APRC (Additional Packet Rate for exception
+//     reports) is not enforced.
+//   - When multiple QERs apply to a packet, it is dropped if any of them drops
+//     it, and only the QERs that pass it consume their rates.
+//
+// Enforcer is not safe for concurrent use.
+type Enforcer struct {
+	qers map[uint32]*qer
+}
+
+// New creates a new Enforcer with the given CreateQER IEs, whose rates are
+// available from now.
+func New(now time.Time, qers ...*ie.IE) (*Enforcer, error) {
+	e := &Enforcer{qers: map[uint32]*qer{}}
+	if err := e.AddQER(now, qers...); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// AddQER adds the CreateQER IEs to the Enforcer. The QERs that have the same
+// QER ID as the existing ones replace the settings, keeping the counters and
+// the rates consumed.
+func (e *Enforcer) AddQER(now time.Time, qers ...*ie.IE) error {
+	fields := make([]*ie.CreateQERFields, 0, len(qers))
+	for _, i := range qers {
+		f, err := ie.CreateQERType.Get(i)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, f)
+	}
+	e.AddQERFields(now, fields...)
+	return nil
+}
+
+// AddQERFields adds the QERs in the typed fields to the Enforcer, in the same
+// way as AddQER.
+//
+// The QERs updated with Update QER can be given as they are merged into
+// the CreateQER fields, e.g., the ones in session.Session.
+func (e *Enforcer) AddQERFields(now time.Time, qers ...*ie.CreateQERFields) {
+	for _, f := range qers {
+		q, ok := e.qers[f.QERID]
+		if !ok {
+			q = &qer{}
+			e.qers[f.QERID] = q
+		}
+		q.configure(now, f)
+	}
+}
+
+// RemoveQER removes the QERs.
+func (e *Enforcer) RemoveQER(ids ...uint32) {
+	for _, id := range ids {
+		delete(e.qers, id)
+	}
+}
+
+// Enforce applies the QERs, e.g., the ones in QER IDs of the PDR that the
+// packet hits, to the packet of the size in bytes, and returns the verdict.
+func (e *Enforcer) Enforce(now time.Time, dir Direction, size int, ids ...uint32) (Verdict, error) {
+	qs := make([]*qer, 0, len(ids))
+	for _, id := range ids {
+		q, ok := e.qers[id]
+		if !ok {
+			return VerdictDrop, fmt.Errorf("QER %d: %w", id, ErrQERNotFound)
+		}
+		qs = append(qs, q)
+	}
+
+	verdict := VerdictPass
+	verdicts := make([]Verdict, len(qs))
+	dropped := make([]*uint64, len(qs))
+	for n, q := range qs {
+		verdicts[n], dropped[n] = q.check(now, dir, size)
+		verdict = max(verdict, verdicts[n])
+	}
+	for n, q := range qs {
+		switch {
+		case verdicts[n] == VerdictDrop:
+			*dropped[n]++
+		case verdict != VerdictDrop:
+			q.commit(now, dir, size, verdicts[n])
+		}
+	}
+	return verdict, nil
+}
+
+// Stats returns the counters of the QER.
+func (e *Enforcer) Stats(id uint32) (Stats, bool) {
+	q, ok := e.qers[id]
+	if !ok {
+		return Stats{}, false
+	}
+	return Stats{Uplink: q.dirs[Uplink].Counters, Downlink: q.dirs[Downlink].Counters}, true
+}
+
+// IDs returns the QER IDs in the Enforcer in ascending order.
+func (e *Enforcer) IDs() []uint32 {
+	ids := make([]uint32, 0, len(e.qers))
+	for id := range e.qers {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// qer is the state of a QER.
+type qer struct {
+	window time.Duration
+	dirs   [2]direction
+}
+
+// direction is the state of a QER in a direction.
+type direction struct {
+	Counters
+
+	closed   bool
+	mbr, gbr *bucket
+	rate     *window
+}
+
+func (q *qer) configure(now time.Time, f *ie.CreateQERFields) {
+	q.window = DefaultAveragingWindow
+	if f.AveragingWindow != nil && *f.AveragingWindow > 0 {
+		q.window = time.Duration(*f.AveragingWindow) * time.Millisecond
+	}
+
+	ul, dl := &q.dirs[Uplink], &q.dirs[Downlink]
+	ulGate, dlGate, _ := ie.New(ie.GateStatus, []byte{f.GateStatus}).GateStatusULDL()
+	ul.closed = ulGate == ie.GateStatusClosed
+	dl.closed = dlGate == ie.GateStatusClosed
+
+	mbr, gbr := ie.New(ie.MBR, f.MBR), ie.New(ie.GBR, f.GBR)
+	ul.mbr = q.bucket(now, ul.mbr, bitRate(mbr.MBRUL))
+	dl.mbr = q.bucket(now, dl.mbr, bitRate(mbr.MBRDL))
+	ul.gbr = q.bucket(now, ul.gbr, bitRate(gbr.GBRUL))
+	dl.gbr = q.bucket(now, dl.gbr, bitRate(gbr.GBRDL))
+
+	var ulRate, dlRate *window
+	for _, r := range f.PacketRates {
+		if r.HasAPRC() {
+			continue
+		}
+		if r.HasULPR() {
+			ulRate = newWindow(r.UplinkTimeUnit, r.UplinkPacketRate)
+		}
+		if r.HasDLPR() {
+			dlRate = newWindow(r.DownlinkTimeUnit, r.DownlinkPacketRate)
+		}
+	}
+	ul.rate = keepWindow(ul.rate, ulRate)
+	dl.rate = keepWindow(dl.rate, dlRate)
+}
+
+// bitRate returns the rate in kbps got by the accessor of MBR or GBR, or 0 if the
+// IE is not present.
+func bitRate(get func() (uint64, error)) uint64 {
+	kbps, err := get()
+	if err != nil {
+		return 0
+	}
+	return kbps
+}
+
+// bucket returns the bucket for the rate, which is b resized if exists.
+func (q *qer) bucket(now time.Time, b *bucket, kbps uint64) *bucket {
+	switch {
+	case kbps == 0:
+		return nil
+	case b == nil:
+		return newBucket(now, kbps, q.window)
+	default:
+		b.resize(now, kbps, q.window)
+		return b
+	}
+}
+
+// keepWindow returns w with the packets counted in old, if it is in the same
+// period.
+func keepWindow(old, w *window) *window {
+	if old != nil && w != nil && old.period == w.period {
+		w.start, w.count = old.start, old.count
+	}
+	return w
+}
+
+// check returns the verdict for the packet without consuming the rates, and
+// the counter for the reason if it is dropped.
+func (q *qer) check(now time.Time, dir Direction, size int) (Verdict, *uint64) {
+	d := &q.dirs[dir]
+	if d.closed {
+		return VerdictDrop, &d.GateDropped
+	}
+	if d.mbr != nil {
+		d.mbr.refill(now, q.window)
+		if !d.mbr.conforms(size) {
+			return VerdictDrop, &d.MBRDropped
+		}
+	}
+	if d.rate != nil && !d.rate.conforms(now) {
+		return VerdictDrop, &d.PacketRateDropped
+	}
+	if d.gbr != nil {
+		d.gbr.refill(now, q.window)
+		if !d.gbr.conforms(size) {
+			return VerdictMark, nil
+		}
+	}
+	return VerdictPass, nil
+}
+
+// commit consumes the rates for the packet forwarded.
+func (q *qer) commit(now time.Time, dir Direction, size int, v Verdict) {
+	d := &q.dirs[dir]
+	d.Packets++
+	d.Bytes += uint64(size)
+	if d.mbr != nil {
+		d.mbr.take(size)
+	}
+	if d.rate != nil {
+		d.rate.take(now)
+	}
+	if v == VerdictMark {
+		d.MarkedPackets++
+		d.MarkedBytes += uint64(size)
+	} else if d.gbr != nil {
+		d.gbr.take(size)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package qos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/qos"
+)
+
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestEnforcer(t *testing.T) {
+	// packet is given at ms milliseconds to the QERs in ids.
+	type packet struct {
+		ms   int
+		dir  qos.Direction
+		size int
+		ids  []uint32
+		want qos.Verdict
+	}
+
+	cases := []struct {
+		description string
+		qers        []*ie.IE
+		packets     []packet
+		stats       map[uint32]qos.Stats
+	}{
+		{
+			"GateStatus",
+			[]*ie.IE{ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen),
+			)},
+			[]packet{
+				{0, qos.Uplink, 100, []uint32{1}, qos.VerdictDrop},
+				{0, qos.Downlink, 100, []uint32{1}, qos.VerdictPass},
+			},
+			map[uint32]qos.Stats{
+				1: {
+					Uplink:   qos.Counters{GateDropped: 1},
+					Downlink: qos.Counters{Packets: 1, Bytes: 100},
+				},
+			},
+		}, {
+			// 8 kbps for 1 second allows 1000 bytes.
+			"MBR",
+			[]*ie.IE{ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+				ie.NewMBR(8, 0),
+				ie.NewAveragingWindow(1000),
+			)},
+			[]packet{
+				{0, qos.Uplink, 600, []uint32{1}, qos.VerdictPass},
+				{0, qos.Uplink, 600, []uint32{1}, qos.VerdictDrop},
+				{500, qos.Uplink, 600, []uint32{1}, qos.VerdictPass},
+				{500, qos.Downlink, 5000, []uint32{1}, qos.VerdictPass},
+			},
+			map[uint32]qos.Stats{
+				1: {
+					Uplink:   qos.Counters{Packets: 2, Bytes: 1200, MBRDropped: 1},
+					Downlink: qos.Counters{Packets: 1, Bytes: 5000},
+				},
+			},
+		}, {
+			"GBR",
+			[]*ie.IE{ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+				ie.NewMBR(0, 16),
+				ie.NewGBR(0, 8),
+				ie.NewAveragingWindow(1000),
+			)},
+			[]packet{
+				{0, qos.Downlink, 800, []uint32{1}, qos.VerdictPass},
+				{0, qos.Downlink, 800, []uint32{1}, qos.VerdictMark},
+				{0, qos.Downlink, 800, []uint32{1}, qos.VerdictDrop},
+			},
+			map[uint32]qos.Stats{
+				1: {
+					Downlink: qos.Counters{Packets: 2, Bytes: 1600, MarkedPackets: 1, MarkedBytes: 800, MBRDropped: 1},
+				},
+			},
+		}, {
+			"PacketRate",
+			[]*ie.IE{ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+				ie.NewPacketRate(0x01, ie.TimeUnitMinute, 2, 0, 0),
+				ie.NewPacketRate(0x05, ie.TimeUnitMinute, 10, 0, 0),
+			)},
+			[]packet{
+				{0, qos.Uplink, 10, []uint32{1}, qos.VerdictPass},
+				{1000, qos.Uplink, 10, []uint32{1}, qos.VerdictPass},
+				{2000, qos.Uplink, 10, []uint32{1}, qos.VerdictDrop},
+				{2000, qos.Downlink, 10, []uint32{1}, qos.VerdictPass},
+				{60000, qos.Uplink, 10, []uint32{1}, qos.VerdictPass},
+			},
+			map[uint32]qos.Stats{
+				1: {
+					Uplink:   qos.Counters{Packets: 3, Bytes: 30, PacketRateDropped: 1},
+					Downlink: qos.Counters{Packets: 1, Bytes: 10},
+				},
+			},
+		}, {
+			// the uplink rate of QER 1 is not consumed by the packet dropped by QER 2.
+			"MultipleQERs",
+			[]*ie.IE{
+				ie.NewCreateQER(
+					ie.NewQERID(1),
+					ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+					ie.NewMBR(8, 8),
+					ie.NewAveragingWindow(1000),
+				),
+				ie.NewCreateQER(
+					ie.NewQERID(2),
+					ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusOpen),
+				),
+			},
+			[]packet{
+				{0, qos.Uplink, 1000, []uint32{1, 2}, qos.VerdictDrop},
+				{0, qos.Downlink, 1000, []uint32{1, 2}, qos.VerdictPass},
+				{0, qos.Uplink, 1000, []uint32{1}, qos.VerdictPass},
+			},
+			map[uint32]qos.Stats{
+				1: {
+					Uplink:   qos.Counters{Packets: 1, Bytes: 1000},
+					Downlink: qos.Counters{Packets: 1, Bytes: 1000},
+				},
+				2: {
+					Uplink:   qos.Counters{GateDropped: 1},
+					Downlink: qos.Counters{Packets: 1, Bytes: 1000},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			e, err := qos.New(t0, c.qers...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for n, p := range c.packets {
+				got, err := e.Enforce(t0.Add(time.Duration(p.ms)*time.Millisecond), p.dir, p.size, p.ids...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != p.want {
+					t.Errorf("packet %d at %dms: got %v, want %v", n, p.ms, got, p.want)
+				}
+			}
+
+			for id, want := range c.stats {
+				got, ok := e.Stats(id)
+				if !ok {
+					t.Fatalf("QER %d not found", id)
+				}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("QER %d: %s", id, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEnforcerUpdate(t *testing.T) {
+	e, err := qos.New(t0, ie.NewCreateQER(
+		ie.NewQERID(1),
+		ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+		ie.NewMBR(8, 0),
+		ie.NewAveragingWindow(1000),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := e.Enforce(t0, qos.Uplink, 1000, 1); err != nil || v != qos.VerdictPass {
+		t.Fatalf("got %v, %v", v, err)
+	}
+
+	f, err := ie.CreateQERType.Get(ie.NewCreateQER(
+		ie.NewQERID(1),
+		ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+		ie.NewMBR(16, 0),
+		ie.NewAveragingWindow(1000),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.AddQERFields(t0, f)
+
+	// the rate consumed is kept, and refilled at the new rate.
+	if v, err := e.Enforce(t0, qos.Uplink, 1000, 1); err != nil || v != qos.VerdictDrop {
+		t.Errorf("got %v, %v", v, err)
+	}
+	if v, err := e.Enforce(t0.Add(500*time.Millisecond), qos.Uplink, 1000, 1); err != nil || v != qos.VerdictPass {
+		t.Errorf("got %v, %v", v, err)
+	}
+}
+
+func TestEnforcerNotFound(t *testing.T) {
+	e, err := qos.New(t0, ie.NewCreateQER(
+		ie.NewQERID(1),
+		ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Enforce(t0, qos.Uplink, 100, 1, 2); !errors.Is(err, qos.ErrQERNotFound) {
+		t.Errorf("got error %v", err)
+	}
+	if s, _ := e.Stats(1); s != (qos.Stats{}) {
+		t.Errorf("got stats %+v", s)
+	}
+
+	e.RemoveQER(1)
+	if _, ok := e.Stats(1); ok {
+		t.Error("QER not removed")
+	}
+}
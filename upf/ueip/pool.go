@@ -0,0 +1,186 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ueip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+// Pool is the UE IP address pool.
+type Pool struct {
+	// Identity is the UE IP Address Pool Identity, which can be empty if the
+	// pool is not identified.
+	Identity string
+	// NetworkInstance is the Network Instance the pool belongs to, which can
+	// be empty for the default one.
+	NetworkInstance string
+	// SNSSAI is the value of the S-NSSAI IE the pool is dedicated to, if any.
+	SNSSAI []byte
+
+	// Prefix is the IPv4 or IPv6 range of the addresses in the pool.
+	Prefix netip.Prefix
+	// PrefixLength is the length of the IPv6 prefixes allocated from the pool,
+	// which defaults to 64. It is ignored for IPv4.
+	PrefixLength int
+}
+
+// pool is the state of a Pool.
+type pool struct {
+	*Pool
+
+	// bits is the number of the host bits in an allocation, and size is the
+	// number of the allocations in the pool.
+	bits int
+	size uint64
+
+	// first is the index of the first allocation, which skips the network
+	// address in IPv4, and next is the one to try first in the next allocation.
+	first, next uint64
+	used        map[netip.Addr]bool
+}
+
+func newPool(p *Pool) (*pool, error) {
+	if !p.Prefix.IsValid() {
+		return nil, fmt.Errorf("pool %q: %w: %v", p.Identity, ErrInvalidPool, p.Prefix)
+	}
+
+	cp := *p
+	x := &pool{Pool: &cp, used: map[netip.Addr]bool{}}
+	prefix := p.Prefix.Masked()
+	x.Prefix = prefix
+
+	if prefix.Addr().Is4() {
+		x.size = 1 << (32 - prefix.Bits())
+		// the network and broadcast addresses are not allocated.
+		if prefix.Bits() < 31 {
+			x.first, x.size = 1, x.size-2
+		}
+	} else {
+		pl := p.PrefixLength
+		if pl == 0 {
+			pl = 64
+		}
+		if pl < prefix.Bits() || pl > 128 {
+			return nil, fmt.Errorf("pool %q: %w: prefix length %d in %v", p.Identity, ErrInvalidPool, pl, prefix)
+		}
+		x.PrefixLength = pl
+		x.bits = 128 - pl
+		x.size = 1 << min(pl-prefix.Bits(), 63)
+	}
+	x.next = x.first
+	return x, nil
+}
+
+// matches reports whether the pool can be used for the request.
+func (p *pool) matches(req *session.AllocationRequest, v6 bool) bool {
+	if p.Prefix.Addr().Is6() != v6 || p.NetworkInstance != req.NetworkInstance {
+		return false
+	}
+	if p.SNSSAI != nil && !bytes.Equal(p.SNSSAI, req.SNSSAI) {
+		return false
+	}
+	if len(req.UEIPAddressPoolIdentities) == 0 {
+		return true
+	}
+	for _, id := range req.UEIPAddressPoolIdentities {
+		if poolIdentity(id) == p.Identity {
+			return true
+		}
+	}
+	return false
+}
+
+// allocate returns the first address available from next, or false if all
+// the addresses are in use.
+func (p *pool) allocate() (netip.Addr, bool) {
+	for range p.size {
+		addr := p.nth(p.next)
+		p.next++
+		if p.next >= p.first+p.size {
+			p.next = p.first
+		}
+		if !p.used[addr] {
+			p.used[addr] = true
+			return addr, true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+func (p *pool) release(addr netip.Addr) bool {
+	if !p.used[addr] {
+		return false
+	}
+	delete(p.used, addr)
+	return true
+}
+
+// nth returns the n-th address or prefix in the pool.
+func (p *pool) nth(n uint64) netip.Addr {
+	base := p.Prefix.Addr()
+	if base.Is4() {
+		b := base.As4()
+		binary.BigEndian.PutUint32(b[:], binary.BigEndian.Uint32(b[:])+uint32(n))
+		return netip.AddrFrom4(b)
+	}
+
+	b := base.As16()
+	hi, lo := binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:])
+	if p.bits >= 64 {
+		hi += n << (p.bits - 64)
+	} else {
+		off := n << p.bits
+		if lo+off < lo {
+			hi++
+		}
+		lo += off
+		if p.bits > 0 {
+			hi += n >> (64 - p.bits)
+		}
+	}
+	binary.BigEndian.PutUint64(b[:8], hi)
+	binary.BigEndian.PutUint64(b[8:], lo)
+	return netip.AddrFrom16(b)
+}
+
+// usageInformation returns the UE IP Address Usage Information of the pool.
+func (p *pool) usageInformation(seq uint32) *ie.UEIPAddressUsageInformationFields {
+	avail := p.size - uint64(len(p.used))
+	f := &ie.UEIPAddressUsageInformationFields{
+		SequenceNumber:  seq,
+		Metric:          uint8(uint64(len(p.used)) * 100 / p.size),
+		NetworkInstance: p.NetworkInstance,
+		SNSSAI:          p.SNSSAI,
+	}
+	if p.Identity != "" {
+		f.UEIPAddressPoolIdentity = ie.NewUEIPAddressPoolIdentity(p.Identity).Payload
+	}
+
+	n := uint32(min(avail, 1<<32-1))
+	if p.Prefix.Addr().Is4() {
+		f.NumberOfUEIPAddresses = ie.NewNumberOfUEIPAddressesFields(0x01, n, 0)
+	} else {
+		f.NumberOfUEIPAddresses = ie.NewNumberOfUEIPAddressesFields(0x02, 0, n)
+	}
+	return f
+}
+
+// poolIdentity returns the identity in the value of UE IP Address Pool Identity IE.
+func poolIdentity(b []byte) string {
+	if len(b) < 2 {
+		return ""
+	}
+	l := int(binary.BigEndian.Uint16(b[0:2]))
+	if len(b) < 2+l {
+		return ""
+	}
+	return string(b[2 : 2+l])
+}
@@ -0,0 +1,204 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package ueip provides the UE IP address allocator that manages the IPv4 and
+// IPv6 prefix pools and answers the requests with CHV4 and CHV6 flags in UE IP
+// Address, as specified in 3GPP TS 29.244 clause 5.21.
+//
+// The Allocator implements the UE IP address part of session.Allocator.
+package ueip
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/session"
+)
+
+// Error definitions.
+var (
+	ErrInvalidPool   = errors.New("invalid UE IP address pool")
+	ErrNoPool        = errors.New("no UE IP address pool for the request")
+	ErrPoolExhausted = errors.New("all dynamic addresses are occupied")
+)
+
+// Allocator allocates the UE IP addresses from the pools.
+//
+// The pool for the request is the first one that matches all of the following:
+//
+//   - The IP version is the one requested with CHV4 or CHV6.
+//   - The Network Instance is the one in the request.
+//   - The S-NSSAI is the one in the request, if the pool has it.
+//   - The identity is one of the UE IP Address Pool Identities in the request,
+//     if any.
+//
+// When a pool is exhausted, the next matching one is used. The addresses are
+// allocated in turn, so that the one released is not reused immediately.
+//
+// Allocator is safe for concurrent use.
+type Allocator struct {
+	mu    sync.Mutex
+	pools []*pool
+}
+
+// New creates a new Allocator with the pools, which are tried in the order
+// given.
+func New(pools ...*Pool) (*Allocator, error) {
+	a := &Allocator{}
+	for _, p := range pools {
+		x, err := newPool(p)
+		if err != nil {
+			return nil, err
+		}
+		a.pools = append(a.pools, x)
+	}
+	return a, nil
+}
+
+// AllocateUEIPAddress allocates the IPv4 address and/or the IPv6 prefix
+// requested with CHV4 and CHV6 in addr. The S/D flag in addr is kept in the
+// one returned.
+//
+// The IPv6 prefix is returned with IP6PL flag if its length is not 64.
+func (a *Allocator) AllocateUEIPAddress(req *session.AllocationRequest, addr *ie.UEIPAddressFields) (*ie.UEIPAddressFields, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	u := &ie.UEIPAddressFields{Flags: addr.Flags & ie.UEIPAddressSD}
+	if addr.HasCHV4() {
+		v4, _, err := a.allocate(req, false)
+		if err != nil {
+			return nil, err
+		}
+		u.SetIPv4Flag()
+		u.IPv4Address = net.IP(v4.AsSlice())
+	}
+	if addr.HasCHV6() {
+		v6, p, err := a.allocate(req, true)
+		if err != nil {
+			a.release(u)
+			return nil, err
+		}
+		u.SetIPv6Flag()
+		u.IPv6Address = net.IP(v6.AsSlice())
+		if p.PrefixLength != 64 {
+			u.SetIP6PLFlag()
+			u.IPv6PrefixLength = uint8(p.PrefixLength)
+		}
+	}
+	return u, nil
+}
+
+// ReleaseUEIPAddress releases the addresses returned by AllocateUEIPAddress.
+// The ones not allocated by the Allocator are ignored.
+func (a *Allocator) ReleaseUEIPAddress(_ *session.AllocationRequest, addr *ie.UEIPAddressFields) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.release(addr)
+}
+
+// InUse reports whether the IPv4 address or the IPv6 prefix is allocated.
+func (a *Allocator) InUse(addr netip.Addr) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, p := range a.pools {
+		if p.used[addr] {
+			return true
+		}
+	}
+	return false
+}
+
+// PoolInformation returns the UE IP Address Pool Information IEs for the pools,
+// which are advertised in Association Setup Request or Response. The pools that
+// have no identity are omitted.
+func (a *Allocator) PoolInformation() []*ie.IE {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var ies []*ie.IE
+	for _, p := range a.pools {
+		if p.Identity == "" {
+			continue
+		}
+		f := &ie.UEIPAddressPoolInformationFields{
+			UEIPAddressPoolIdentities: [][]byte{ie.NewUEIPAddressPoolIdentity(p.Identity).Payload},
+		}
+		if p.NetworkInstance != "" {
+			f.NetworkInstance = &p.NetworkInstance
+		}
+		if p.SNSSAI != nil {
+			f.SNSSAIs = [][]byte{p.SNSSAI}
+		}
+		ver := uint8(0x01) // V4 in IP Version
+		if p.Prefix.Addr().Is6() {
+			ver = 0x02
+		}
+		f.IPVersion = &ver
+		ies = append(ies, f.ToIE())
+	}
+	return ies
+}
+
+// UsageInformation returns the UE IP Address Usage Information IEs for the
+// pools, which are sent in Association Update Request.
+//
+// Number of UE IP Addresses is the number of the addresses or prefixes that
+// are still available, and Metric is the percentage of the ones in use.
+func (a *Allocator) UsageInformation(seq uint32, validity time.Duration) []*ie.IE {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ies := make([]*ie.IE, 0, len(a.pools))
+	for _, p := range a.pools {
+		f := p.usageInformation(seq)
+		f.ValidityTimer = validity
+		ies = append(ies, f.ToIE())
+	}
+	return ies
+}
+
+func (a *Allocator) allocate(req *session.AllocationRequest, v6 bool) (netip.Addr, *pool, error) {
+	found := false
+	for _, p := range a.pools {
+		if !p.matches(req, v6) {
+			continue
+		}
+		found = true
+		if addr, ok := p.allocate(); ok {
+			return addr, p, nil
+		}
+	}
+
+	ver := "IPv4"
+	if v6 {
+		ver = "IPv6"
+	}
+	if !found {
+		return netip.Addr{}, nil, fmt.Errorf("%s in %q: %w", ver, req.NetworkInstance, ErrNoPool)
+	}
+	return netip.Addr{}, nil, fmt.Errorf("%s in %q: %w", ver, req.NetworkInstance, ErrPoolExhausted)
+}
+
+func (a *Allocator) release(addr *ie.UEIPAddressFields) {
+	for _, ip := range []net.IP{addr.IPv4Address, addr.IPv6Address} {
+		x, ok := netip.AddrFromSlice(ip)
+		if !ok {
+			continue
+		}
+		x = x.Unmap()
+		for _, p := range a.pools {
+			if p.Prefix.Contains(x) && p.release(x) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ueip_test
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/session"
+	"github.com/wmnsk/go-pfcp/upf/ueip"
+)
+
+func newAllocator(t *testing.T, pools ...*ueip.Pool) *ueip.Allocator {
+	t.Helper()
+
+	a, err := ueip.New(pools...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func allocate(t *testing.T, a *ueip.Allocator, req *session.AllocationRequest, flags uint8) *ie.UEIPAddressFields {
+	t.Helper()
+
+	u, err := a.AllocateUEIPAddress(req, &ie.UEIPAddressFields{Flags: flags})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+// newPools returns the pools for TestAllocator.
+func newPools() []*ueip.Pool {
+	return []*ueip.Pool{
+		{Identity: "small", NetworkInstance: "internet", Prefix: netip.MustParsePrefix("10.0.0.0/30")},
+		{Identity: "large", NetworkInstance: "internet", Prefix: netip.MustParsePrefix("10.1.0.0/16")},
+		{Identity: "v6", NetworkInstance: "internet", Prefix: netip.MustParsePrefix("2001:db8::/48")},
+		{Identity: "ims", NetworkInstance: "ims", Prefix: netip.MustParsePrefix("2001:db8:1::/48"), PrefixLength: 56},
+	}
+}
+
+func TestAllocator(t *testing.T) {
+	req := &session.AllocationRequest{NetworkInstance: "internet"}
+
+	t.Run("DualStack", func(t *testing.T) {
+		a := newAllocator(t, newPools()...)
+		got := allocate(t, a, req, ie.UEIPAddressCHV4|ie.UEIPAddressCHV6|ie.UEIPAddressSD)
+		want := &ie.UEIPAddressFields{
+			Flags:       ie.UEIPAddressV6 | ie.UEIPAddressV4 | ie.UEIPAddressSD,
+			IPv4Address: net.IP{10, 0, 0, 1},
+			IPv6Address: net.ParseIP("2001:db8::"),
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("NextPool", func(t *testing.T) {
+		a := newAllocator(t, newPools()...)
+		// the network and broadcast addresses are not allocated.
+		for _, want := range []net.IP{{10, 0, 0, 1}, {10, 0, 0, 2}, {10, 1, 0, 1}} {
+			if got := allocate(t, a, req, ie.UEIPAddressCHV4); !got.IPv4Address.Equal(want) {
+				t.Errorf("got %s, want %s", got.IPv4Address, want)
+			}
+		}
+	})
+
+	t.Run("PoolIdentity", func(t *testing.T) {
+		a := newAllocator(t, newPools()...)
+		req := &session.AllocationRequest{
+			NetworkInstance:           "ims",
+			UEIPAddressPoolIdentities: [][]byte{ie.NewUEIPAddressPoolIdentity("ims").Payload},
+		}
+		got := allocate(t, a, req, ie.UEIPAddressCHV6)
+		want := &ie.UEIPAddressFields{
+			Flags:            ie.UEIPAddressIP6PL | ie.UEIPAddressV6,
+			IPv6Address:      net.ParseIP("2001:db8:1::"),
+			IPv6PrefixLength: 56,
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+		if got := allocate(t, a, req, ie.UEIPAddressCHV6); !got.IPv6Address.Equal(net.ParseIP("2001:db8:1:100::")) {
+			t.Errorf("got %s", got.IPv6Address)
+		}
+
+		req.UEIPAddressPoolIdentities = [][]byte{ie.NewUEIPAddressPoolIdentity("small").Payload}
+		if _, err := a.AllocateUEIPAddress(req, &ie.UEIPAddressFields{Flags: ie.UEIPAddressCHV4}); !errors.Is(err, ueip.ErrNoPool) {
+			t.Errorf("got error %v", err)
+		}
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		a := newAllocator(t, newPools()...)
+		u := allocate(t, a, req, ie.UEIPAddressCHV4)
+		addr := netip.AddrFrom4([4]byte(u.IPv4Address.To4()))
+		if !a.InUse(addr) {
+			t.Fatalf("%s not in use", addr)
+		}
+		a.ReleaseUEIPAddress(req, u)
+		if a.InUse(addr) {
+			t.Errorf("%s not released", addr)
+		}
+	})
+}
+
+func TestAllocatorExhausted(t *testing.T) {
+	a := newAllocator(t, &ueip.Pool{NetworkInstance: "internet", Prefix: netip.MustParsePrefix("10.0.0.0/31")})
+	req := &session.AllocationRequest{NetworkInstance: "internet"}
+
+	first := allocate(t, a, req, ie.UEIPAddressCHV4)
+	allocate(t, a, req, ie.UEIPAddressCHV4)
+	if _, err := a.AllocateUEIPAddress(req, &ie.UEIPAddressFields{Flags: ie.UEIPAddressCHV4}); !errors.Is(err, ueip.ErrPoolExhausted) {
+		t.Fatalf("got error %v", err)
+	}
+
+	// the IPv4 address is released if IPv6 cannot be allocated.
+	a.ReleaseUEIPAddress(req, first)
+	if _, err := a.AllocateUEIPAddress(req, &ie.UEIPAddressFields{Flags: ie.UEIPAddressCHV4 | ie.UEIPAddressCHV6}); !errors.Is(err, ueip.ErrNoPool) {
+		t.Fatalf("got error %v", err)
+	}
+	if got := allocate(t, a, req, ie.UEIPAddressCHV4); !got.IPv4Address.Equal(first.IPv4Address) {
+		t.Errorf("got %s", got.IPv4Address)
+	}
+}
+
+func TestAllocatorInvalidPool(t *testing.T) {
+	for _, p := range []*ueip.Pool{
+		{},
+		{Prefix: netip.MustParsePrefix("2001:db8::/64"), PrefixLength: 48},
+	} {
+		if _, err := ueip.New(p); !errors.Is(err, ueip.ErrInvalidPool) {
+			t.Errorf("%v: got error %v", p.Prefix, err)
+		}
+	}
+}
+
+func TestAllocatorInformation(t *testing.T) {
+	a := newAllocator(t,
+		&ueip.Pool{Identity: "pool1", NetworkInstance: "internet", SNSSAI: ie.NewSNSSAI(1, 0x010203).Payload, Prefix: netip.MustParsePrefix("10.0.0.0/24")},
+		&ueip.Pool{NetworkInstance: "internet", Prefix: netip.MustParsePrefix("2001:db8::/62")},
+	)
+	req := &session.AllocationRequest{NetworkInstance: "internet", SNSSAI: ie.NewSNSSAI(1, 0x010203).Payload}
+	for range 127 {
+		allocate(t, a, req, ie.UEIPAddressCHV4)
+	}
+	allocate(t, a, req, ie.UEIPAddressCHV6)
+
+	pools := a.PoolInformation()
+	if len(pools) != 1 {
+		t.Fatalf("got %d pools", len(pools))
+	}
+	gotPool, err := ie.UEIPAddressPoolInformationType.Get(pools[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	ni, ver := "internet", uint8(0x01)
+	wantPool := &ie.UEIPAddressPoolInformationFields{
+		UEIPAddressPoolIdentities: [][]byte{ie.NewUEIPAddressPoolIdentity("pool1").Payload},
+		NetworkInstance:           &ni,
+		SNSSAIs:                   [][]byte{ie.NewSNSSAI(1, 0x010203).Payload},
+		IPVersion:                 &ver,
+	}
+	if diff := cmp.Diff(gotPool, wantPool); diff != "" {
+		t.Error(diff)
+	}
+
+	var got []*ie.UEIPAddressUsageInformationFields
+	for _, i := range a.UsageInformation(5, time.Minute) {
+		f, err := ie.UEIPAddressUsageInformationType.Get(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, f)
+	}
+	want := []*ie.UEIPAddressUsageInformationFields{
+		{
+			SequenceNumber:          5,
+			Metric:                  50,
+			ValidityTimer:           time.Minute,
+			NumberOfUEIPAddresses:   ie.NewNumberOfUEIPAddressesFields(0x01, 127, 0),
+			NetworkInstance:         "internet",
+			UEIPAddressPoolIdentity: ie.NewUEIPAddressPoolIdentity("pool1").Payload,
+			SNSSAI:                  ie.NewSNSSAI(1, 0x010203).Payload,
+		},
+		{
+			SequenceNumber:        5,
+			Metric:                25,
+			ValidityTimer:         time.Minute,
+			NumberOfUEIPAddresses: ie.NewNumberOfUEIPAddressesFields(0x02, 0, 3),
+			NetworkInstance:       "internet",
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+// fteidAllocator allocates the F-TEIDs sequentially, to be used with
+// ueip.Allocator as session.Allocator.
+type fteidAllocator struct {
+	teid uint32
+}
+
+func (a *fteidAllocator) AllocateFTEID(_ *session.AllocationRequest, _ *ie.FTEIDFields) (*ie.FTEIDFields, error) {
+	a.teid++
+	return ie.NewFTEIDFields(ie.FTEIDV4, a.teid, net.IP{192, 168, 0, 1}, nil, 0), nil
+}
+
+func (a *fteidAllocator) ReleaseFTEID(_ *session.AllocationRequest, _ *ie.FTEIDFields) {}
+
+func TestAllocatorWithStore(t *testing.T) {
+	a := newAllocator(t, &ueip.Pool{NetworkInstance: "internet", Prefix: netip.MustParsePrefix("10.0.0.0/24")})
+	st := session.NewStore(struct {
+		*ueip.Allocator
+		*fteidAllocator
+	}{a, &fteidAllocator{}})
+
+	r := st.Establish(message.NewSessionEstablishmentRequest(0, 0, 0, 1, 0,
+		ie.NewNodeID("127.0.0.2", "", ""),
+		ie.NewFSEID(0xcafe, net.ParseIP("127.0.0.2"), nil),
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(ie.UEIPAddressCHV4|ie.UEIPAddressSD|ie.UEIPAddressV4, "", "", 0, 0),
+			),
+			ie.NewFARID(1),
+		),
+		ie.NewCreateFAR(ie.NewFARID(1), ie.NewApplyAction(0x02)),
+	))
+	if !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+
+	created, err := ie.CreatedPDRType.Collect(r.CreatedPDRs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ie.UEIPAddressFields{{Flags: ie.UEIPAddressV4 | ie.UEIPAddressSD, IPv4Address: net.IP{10, 0, 0, 1}}}
+	if diff := cmp.Diff(created[0].UEIPAddresses, want); diff != "" {
+		t.Error(diff)
+	}
+
+	addr := netip.MustParseAddr("10.0.0.1")
+	if !a.InUse(addr) {
+		t.Fatalf("%s not in use", addr)
+	}
+	if r := st.Delete(message.NewSessionDeletionRequest(0, 0, r.Session.LocalSEID, 2, 0)); !r.Accepted() {
+		t.Fatalf("rejected: %v", r.Err)
+	}
+	if a.InUse(addr) {
+		t.Errorf("%s not released on session deletion", addr)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package usage
+
+import "time"
+
+// Counters is the amount of traffic given to Engine.
+type Counters struct {
+	UplinkVolume    uint64
+	DownlinkVolume  uint64
+	UplinkPackets   uint64
+	DownlinkPackets uint64
+}
+
+// Uplink returns the Counters of an uplink packet of the given size in bytes.
+func Uplink(size int) Counters {
+	return Counters{UplinkVolume: uint64(size), UplinkPackets: 1}
+}
+
+// Downlink returns the Counters of a downlink packet of the given size in bytes.
+func Downlink(size int) Counters {
+	return Counters{DownlinkVolume: uint64(size), DownlinkPackets: 1}
+}
+
+// TotalVolume returns the sum of uplink and downlink volume.
+func (c Counters) TotalVolume() uint64 {
+	return c.UplinkVolume + c.DownlinkVolume
+}
+
+// TotalPackets returns the sum of uplink and downlink packets.
+func (c Counters) TotalPackets() uint64 {
+	return c.UplinkPackets + c.DownlinkPackets
+}
+
+func (c *Counters) add(d Counters) {
+	c.UplinkVolume += d.UplinkVolume
+	c.DownlinkVolume += d.DownlinkVolume
+	c.UplinkPackets += d.UplinkPackets
+	c.DownlinkPackets += d.DownlinkPackets
+}
+
+// meter measures the traffic and the active time from start.
+//
+// The time is measured from the first packet. With Inactivity Detection Time,
+// the measurement stops at the last packet when no packet is received for that
+// time, and resumes at the next packet; the inactive period is not counted.
+type meter struct {
+	start time.Time
+	Counters
+
+	// dur is the time of the active periods that are already closed, and
+	// active is the start of the current one, which is zero if inactive.
+	dur    time.Duration
+	active time.Time
+
+	// first and last are the times of the packets in the meter, and seen is
+	// the time of the last packet including the ones before start.
+	first, last, seen time.Time
+}
+
+func (m *meter) add(t time.Time, c Counters, idt time.Duration) {
+	if !m.active.IsZero() && m.inactive(t, idt) {
+		m.dur += m.seen.Sub(m.active)
+		m.active = time.Time{}
+	}
+	if m.active.IsZero() {
+		m.active = t
+	}
+	if m.first.IsZero() {
+		m.first = t
+	}
+	m.last, m.seen = t, t
+	m.Counters.add(c)
+}
+
+func (m *meter) duration(now time.Time, idt time.Duration) time.Duration {
+	if m.active.IsZero() {
+		return m.dur
+	}
+	end := now
+	if m.inactive(now, idt) {
+		end = m.seen
+	}
+	if end.Before(m.active) {
+		return m.dur
+	}
+	return m.dur + end.Sub(m.active)
+}
+
+func (m *meter) inactive(now time.Time, idt time.Duration) bool {
+	return idt > 0 && now.Sub(m.seen) > idt
+}
+
+// next returns the meter that continues the measurement from now.
+func (m *meter) next(now time.Time, idt time.Duration) meter {
+	n := meter{start: now, seen: m.seen}
+	if !m.active.IsZero() && !m.inactive(now, idt) {
+		n.active = now
+	}
+	return n
+}
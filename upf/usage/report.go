@@ -0,0 +1,71 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package usage
+
+import (
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// UsageInformation values in Report.
+const (
+	UsageInformationBEF uint8 = 0x01
+	UsageInformationAFT uint8 = 0x02
+)
+
+// Report is a usage report generated by Engine.
+type Report struct {
+	URRID   uint32
+	URSEQN  uint32
+	Trigger ie.UsageReportTriggerFlags
+
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Volume and Duration are set if the Measurement Method has VOLUM and
+	// DURAT respectively.
+	Volume   *ie.VolumeMeasurementFields
+	Duration *time.Duration
+
+	// FirstPacket and LastPacket are zero if no packet is measured.
+	FirstPacket time.Time
+	LastPacket  time.Time
+
+	// UsageInformation is UsageInformationBEF or UsageInformationAFT if the
+	// usage is split at the Monitoring Time, or 0 otherwise.
+	UsageInformation uint8
+}
+
+// IE returns the report as the UsageReport IE of the given type, i.e., one of
+// ie.UsageReportWithinSessionReportRequest, ie.UsageReportWithinSessionModificationResponse
+// and ie.UsageReportWithinSessionDeletionResponse.
+func (r *Report) IE(typ ie.IEType) *ie.IE {
+	ies := []*ie.IE{
+		ie.NewURRID(r.URRID),
+		ie.NewURSEQN(r.URSEQN),
+		ie.NewUsageReportTrigger(r.Trigger...),
+		ie.NewStartTime(r.StartTime),
+		ie.NewEndTime(r.EndTime),
+	}
+	if v := r.Volume; v != nil {
+		ies = append(ies, ie.NewVolumeMeasurement(
+			v.Flags, v.TotalVolume, v.UplinkVolume, v.DownlinkVolume,
+			v.TotalNumberOfPackets, v.UplinkNumberOfPackets, v.DownlinkNumberOfPackets,
+		))
+	}
+	if r.Duration != nil {
+		ies = append(ies, ie.NewDurationMeasurement(*r.Duration))
+	}
+	if !r.FirstPacket.IsZero() {
+		ies = append(ies, ie.NewTimeOfFirstPacket(r.FirstPacket), ie.NewTimeOfLastPacket(r.LastPacket))
+	}
+	if r.UsageInformation != 0 {
+		ies = append(ies, ie.NewUsageInformation(
+			int(r.UsageInformation&UsageInformationBEF), int(r.UsageInformation&UsageInformationAFT)>>1, 0, 0,
+		))
+	}
+	return ie.NewUsageReport(typ, ies...)
+}
@@ -0,0 +1,433 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package usage provides the usage reporting engine that measures the traffic
+// and generates the usage reports as specified for URRs in 3GPP TS 29.244
+// clause 5.2.2.
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// ErrURRNotFound is returned when the URR ID given is not in the Engine.
+var ErrURRNotFound = errors.New("URR not found")
+
+// Measurement Method flags.
+const (
+	measureDURAT uint8 = 0x01
+	measureVOLUM uint8 = 0x02
+)
+
+// Engine measures the usage for the URRs, and generates the usage reports when
+// the thresholds or the quotas are reached or the other reporting triggers are
+// met.
+//
+// The measurement and the time are evaluated in the following way:
+//
+//   - The volume and the duration are measured from the last report, and the
+//     thresholds are compared with them. The quotas are compared with the usage
+//     since they are provisioned, and the URR is exhausted once it is reached.
+//   - The duration is measured from the first packet. With Inactivity Detection
+//     Time, the inactive period after the last packet is not counted.
+//   - At the Monitoring Time, the subsequent thresholds and quotas replace the
+//     ones before, and the next report is split into two with UsageInformation.
+//   - The URRs with LIUSA trigger report together with the ones in their
+//     Linked URR IDs.
+//
+// Engine is not safe for concurrent use.
+type Engine struct {
+	urrs map[uint32]*urr
+}
+
+// New creates a new Engine with the given CreateURR IEs, which start measuring
+// at now.
+func New(now time.Time, urrs ...*ie.IE) (*Engine, error) {
+	e := &Engine{urrs: map[uint32]*urr{}}
+	if err := e.AddURR(now, urrs...); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// AddURR adds the CreateURR IEs to the Engine. The URRs that have the same URR
+// ID as the existing ones replace the settings, keeping the usage measured.
+func (e *Engine) AddURR(now time.Time, urrs ...*ie.IE) error {
+	fields := make([]*ie.CreateURRFields, 0, len(urrs))
+	for _, i := range urrs {
+		f, err := ie.CreateURRType.Get(i)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, f)
+	}
+	e.AddURRFields(now, fields...)
+	return nil
+}
+
+// AddURRFields adds the URRs in the typed fields to the Engine, in the same way
+// as AddURR. The fields must not be modified after given.
+//
+// The URRs updated with Update URR can be given as they are merged into
+// the CreateURR fields, e.g., the ones in session.Session.
+func (e *Engine) AddURRFields(now time.Time, urrs ...*ie.CreateURRFields) {
+	for _, f := range urrs {
+		u, ok := e.urrs[f.URRID]
+		if !ok {
+			u = &urr{period: meter{start: now}}
+			e.urrs[f.URRID] = u
+		}
+		u.configure(now, f)
+	}
+}
+
+// RemoveURR removes the URRs and returns the final reports for them with the
+// TERMR trigger, which are sent in Session Modification Response. All the URRs
+// are removed if no ID is given.
+func (e *Engine) RemoveURR(now time.Time, ids ...uint32) ([]*Report, error) {
+	if len(ids) == 0 {
+		ids = e.ids()
+	}
+	rs, err := e.Query(now, ie.UsageReportTriggerTERMR, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		delete(e.urrs, id)
+	}
+	return rs, nil
+}
+
+// Close removes all the URRs and returns the final reports for them with the
+// TERMR trigger, which are sent in Session Deletion Response.
+func (e *Engine) Close(now time.Time) []*Report {
+	rs, _ := e.RemoveURR(now)
+	return rs
+}
+
+// Query returns the reports for the URRs immediately with the trigger given,
+// e.g., IMMER for the Query URR in Session Modification Request. All the URRs
+// are reported if no ID is given.
+func (e *Engine) Query(now time.Time, trigger ie.UsageReportTriggerFlag, ids ...uint32) ([]*Report, error) {
+	if len(ids) == 0 {
+		ids = e.ids()
+	}
+	if err := e.check(ids); err != nil {
+		return nil, err
+	}
+
+	var rs []*Report
+	for _, id := range ids {
+		u := e.urrs[id]
+		u.monitor(now)
+		rs = append(rs, u.report(id, now, ie.NewUsageReportTriggerFlags(trigger))...)
+	}
+	return rs, nil
+}
+
+// Record adds the traffic to the URRs, e.g., the ones in URR IDs of the PDR
+// that the packet hits, and returns the reports triggered by it.
+func (e *Engine) Record(now time.Time, c Counters, ids ...uint32) ([]*Report, error) {
+	if err := e.check(ids); err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		e.urrs[id].add(now, c)
+	}
+	return e.evaluate(now), nil
+}
+
+// Tick evaluates the URRs at now, and returns the reports triggered by the
+// time, e.g., Time Threshold, Measurement Period and Quota Holding Time.
+func (e *Engine) Tick(now time.Time) []*Report {
+	return e.evaluate(now)
+}
+
+// Exhausted reports whether the quota of the URR is exhausted, in which case
+// the packets should be handled with FAR ID for Quota Action or dropped.
+func (e *Engine) Exhausted(id uint32) bool {
+	u, ok := e.urrs[id]
+	return ok && u.exhausted
+}
+
+// Usage returns the usage of the URR measured since the last report.
+func (e *Engine) Usage(id uint32) (Counters, bool) {
+	u, ok := e.urrs[id]
+	if !ok {
+		return Counters{}, false
+	}
+	return u.period.Counters, true
+}
+
+func (e *Engine) ids() []uint32 {
+	ids := make([]uint32, 0, len(e.urrs))
+	for id := range e.urrs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (e *Engine) check(ids []uint32) error {
+	for _, id := range ids {
+		if _, ok := e.urrs[id]; !ok {
+			return fmt.Errorf("URR %d: %w", id, ErrURRNotFound)
+		}
+	}
+	return nil
+}
+
+// evaluate checks the triggers of all the URRs in the order of URR ID, and
+// the linked usage reporting for the ones reported.
+func (e *Engine) evaluate(now time.Time) []*Report {
+	var rs []*Report
+	reported := map[uint32]bool{}
+	for _, id := range e.ids() {
+		u := e.urrs[id]
+		if t := u.evaluate(now); len(t.Flags()) > 0 {
+			rs = append(rs, u.report(id, now, t)...)
+			reported[id] = true
+		}
+	}
+
+	for _, id := range e.ids() {
+		u := e.urrs[id]
+		if reported[id] || !u.triggers.Has(ie.ReportingTriggerLIUSA) {
+			continue
+		}
+		if slices.ContainsFunc(u.f.LinkedURRIDs, func(l uint32) bool { return reported[l] }) {
+			rs = append(rs, u.report(id, now, ie.NewUsageReportTriggerFlags(ie.UsageReportTriggerLIUSA))...)
+		}
+	}
+	return rs
+}
+
+// volume is the Volume Threshold or Quota, or the subsequent ones.
+type volume struct {
+	flags                   uint8
+	total, uplink, downlink uint64
+}
+
+func (v *volume) reached(c Counters) bool {
+	return v.flags&0x01 != 0 && c.TotalVolume() >= v.total ||
+		v.flags&0x02 != 0 && c.UplinkVolume >= v.uplink ||
+		v.flags&0x04 != 0 && c.DownlinkVolume >= v.downlink
+}
+
+// urr is the state of a URR.
+type urr struct {
+	f        *ie.CreateURRFields
+	triggers ie.ReportingTriggerFlags
+	seqn     uint32
+
+	// period is the usage since the last report, and before is the one before
+	// the Monitoring Time if it is not reported yet.
+	period meter
+	before *meter
+
+	// quota is the usage since the quotas are provisioned, and exhausted and
+	// held are set when the quota is reached and Quota Holding Time expires.
+	quota           meter
+	exhausted, held bool
+
+	// the thresholds and quotas in effect, which are replaced with the
+	// subsequent ones at the Monitoring Time.
+	volumeThreshold *volume
+	volumeQuota     *volume
+	timeThreshold   *time.Duration
+	timeQuota       *time.Duration
+	monitored       bool
+
+	nextPeriod time.Time
+}
+
+func (u *urr) configure(now time.Time, f *ie.CreateURRFields) {
+	old := u.f
+	u.f = f
+	u.triggers = ie.ReportingTriggerFlags(f.ReportingTriggers)
+
+	u.volumeThreshold, u.timeThreshold = nil, f.TimeThreshold
+	if v := f.VolumeThreshold; v != nil {
+		u.volumeThreshold = &volume{v.Flags, v.TotalVolume, v.UplinkVolume, v.DownlinkVolume}
+	}
+
+	// the quotas are provisioned again if they are given, and the usage
+	// for them is measured from now.
+	if old == nil || f.VolumeQuota != nil || f.TimeQuota != nil {
+		u.volumeQuota, u.timeQuota = nil, f.TimeQuota
+		if v := f.VolumeQuota; v != nil {
+			u.volumeQuota = &volume{v.Flags, v.TotalVolume, v.UplinkVolume, v.DownlinkVolume}
+		}
+		u.quota = u.period.next(now, u.idt())
+		u.exhausted, u.held = false, false
+	}
+
+	if old == nil || !equalTime(old.MonitoringTime, f.MonitoringTime) {
+		u.monitored = false
+	}
+	if old == nil || !equalDuration(old.MeasurementPeriod, f.MeasurementPeriod) {
+		u.nextPeriod = time.Time{}
+		if f.MeasurementPeriod != nil && *f.MeasurementPeriod > 0 {
+			u.nextPeriod = now.Add(*f.MeasurementPeriod)
+		}
+	}
+}
+
+func (u *urr) idt() time.Duration {
+	if u.f.InactivityDetectionTime == nil {
+		return 0
+	}
+	return time.Duration(*u.f.InactivityDetectionTime) * time.Second
+}
+
+func (u *urr) add(now time.Time, c Counters) {
+	u.monitor(now)
+	u.period.add(now, c, u.idt())
+	u.quota.add(now, c, u.idt())
+}
+
+// monitor splits the measurement at the Monitoring Time and applies the
+// subsequent thresholds and quotas, if the time has come.
+func (u *urr) monitor(now time.Time) {
+	mt := u.f.MonitoringTime
+	if mt == nil || u.monitored || now.Before(*mt) {
+		return
+	}
+	u.monitored = true
+
+	b := u.period
+	u.before = &b
+	u.period = b.next(*mt, u.idt())
+
+	f := u.f
+	if v := f.SubsequentVolumeThreshold; v != nil {
+		u.volumeThreshold = &volume{v.Flags, v.TotalVolume, v.UplinkVolume, v.DownlinkVolume}
+	}
+	if f.SubsequentTimeThreshold != nil {
+		u.timeThreshold = f.SubsequentTimeThreshold
+	}
+	if f.SubsequentVolumeQuota != nil || f.SubsequentTimeQuota != nil {
+		u.volumeQuota, u.timeQuota = nil, f.SubsequentTimeQuota
+		if v := f.SubsequentVolumeQuota; v != nil {
+			u.volumeQuota = &volume{v.Flags, v.TotalVolume, v.UplinkVolume, v.DownlinkVolume}
+		}
+		u.quota = u.quota.next(*mt, u.idt())
+		u.exhausted, u.held = false, false
+	}
+}
+
+// evaluate returns the triggers met at now.
+func (u *urr) evaluate(now time.Time) ie.UsageReportTriggerFlags {
+	u.monitor(now)
+	t := ie.NewUsageReportTriggerFlags()
+	idt := u.idt()
+
+	if u.volumeThreshold != nil && u.volumeThreshold.reached(u.period.Counters) && u.triggers.Has(ie.ReportingTriggerVOLTH) {
+		t = t.Set(ie.UsageReportTriggerVOLTH)
+	}
+	if u.timeThreshold != nil && u.period.duration(now, idt) >= *u.timeThreshold && u.triggers.Has(ie.ReportingTriggerTIMTH) {
+		t = t.Set(ie.UsageReportTriggerTIMTH)
+	}
+
+	if !u.exhausted && !u.held {
+		if u.volumeQuota != nil && u.volumeQuota.reached(u.quota.Counters) {
+			u.exhausted = true
+			if u.triggers.Has(ie.ReportingTriggerVOLQU) {
+				t = t.Set(ie.UsageReportTriggerVOLQU)
+			}
+		}
+		if u.timeQuota != nil && u.quota.duration(now, idt) >= *u.timeQuota {
+			u.exhausted = true
+			if u.triggers.Has(ie.ReportingTriggerTIMQU) {
+				t = t.Set(ie.UsageReportTriggerTIMQU)
+			}
+		}
+	}
+
+	// Quota Holding Time is the time without traffic after which the quota is
+	// no longer held.
+	if qht := u.f.QuotaHoldingTime; qht != nil && *qht > 0 && !u.exhausted && !u.held &&
+		(u.volumeQuota != nil || u.timeQuota != nil) {
+		last := u.quota.start
+		if u.quota.seen.After(last) {
+			last = u.quota.seen
+		}
+		if now.Sub(last) >= *qht {
+			u.held = true
+			if u.triggers.Has(ie.ReportingTriggerQUHTI) {
+				t = t.Set(ie.UsageReportTriggerQUHTI)
+			}
+		}
+	}
+
+	if p := u.f.MeasurementPeriod; !u.nextPeriod.IsZero() && !now.Before(u.nextPeriod) {
+		for !now.Before(u.nextPeriod) {
+			u.nextPeriod = u.nextPeriod.Add(*p)
+		}
+		if u.triggers.Has(ie.ReportingTriggerPERIO) {
+			t = t.Set(ie.UsageReportTriggerPERIO)
+		}
+	}
+	return t
+}
+
+// report generates the reports of the usage since the last report, which is
+// split into two if the Monitoring Time has passed, and starts a new period.
+func (u *urr) report(id uint32, now time.Time, t ie.UsageReportTriggerFlags) []*Report {
+	var rs []*Report
+	if u.before != nil {
+		rs = append(rs, u.newReport(id, u.before, *u.f.MonitoringTime, t, UsageInformationBEF))
+		rs = append(rs, u.newReport(id, &u.period, now, t, UsageInformationAFT))
+		u.before = nil
+	} else {
+		rs = append(rs, u.newReport(id, &u.period, now, t, 0))
+	}
+	u.period = u.period.next(now, u.idt())
+	return rs
+}
+
+func (u *urr) newReport(id uint32, m *meter, end time.Time, t ie.UsageReportTriggerFlags, info uint8) *Report {
+	r := &Report{
+		URRID:            id,
+		URSEQN:           u.seqn,
+		Trigger:          t,
+		StartTime:        m.start,
+		EndTime:          end,
+		FirstPacket:      m.first,
+		LastPacket:       m.last,
+		UsageInformation: info,
+	}
+	u.seqn++
+
+	if u.f.MeasurementMethod&measureVOLUM != 0 {
+		r.Volume = ie.NewVolumeMeasurementFields(
+			0x3f, m.TotalVolume(), m.UplinkVolume, m.DownlinkVolume,
+			m.TotalPackets(), m.UplinkPackets, m.DownlinkPackets,
+		)
+	}
+	if u.f.MeasurementMethod&measureDURAT != 0 {
+		d := m.duration(end, u.idt())
+		r.Duration = &d
+	}
+	return r
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func equalDuration(a, b *time.Duration) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,305 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package usage_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/upf/usage"
+)
+
+var t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// summary is the part of Report compared in the tests.
+type summary struct {
+	URRID    uint32
+	URSEQN   uint32
+	Trigger  string
+	Start    int
+	End      int
+	Volume   uint64
+	Duration time.Duration
+	Info     uint8
+}
+
+func summarize(rs []*usage.Report) []summary {
+	var ss []summary
+	for _, r := range rs {
+		s := summary{
+			URRID:   r.URRID,
+			URSEQN:  r.URSEQN,
+			Trigger: r.Trigger.String(),
+			Start:   int(r.StartTime.Sub(t0) / time.Second),
+			End:     int(r.EndTime.Sub(t0) / time.Second),
+			Info:    r.UsageInformation,
+		}
+		if r.Volume != nil {
+			s.Volume = r.Volume.TotalVolume
+		}
+		if r.Duration != nil {
+			s.Duration = *r.Duration
+		}
+		ss = append(ss, s)
+	}
+	return ss
+}
+
+func TestEngine(t *testing.T) {
+	// event is the traffic recorded at sec seconds, or the Tick if ids is empty.
+	type event struct {
+		sec  int
+		c    usage.Counters
+		ids  []uint32
+		want []summary
+	}
+
+	cases := []struct {
+		description string
+		urrs        []*ie.IE
+		events      []event
+		usage       uint64
+		exhausted   bool
+	}{
+		{
+			"VolumeThreshold",
+			[]*ie.IE{ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 1, 0),
+				ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLTH)...),
+				ie.NewVolumeThreshold(0x01, 1000, 0, 0),
+			)},
+			[]event{
+				{1, usage.Uplink(600), []uint32{1}, nil},
+				{2, usage.Downlink(500), []uint32{1}, []summary{{URRID: 1, Trigger: "VOLTH", End: 2, Volume: 1100}}},
+				{3, usage.Uplink(100), []uint32{1}, nil},
+			},
+			100, false,
+		}, {
+			"VolumeQuota",
+			[]*ie.IE{ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 1, 0),
+				ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLQU)...),
+				ie.NewVolumeQuota(0x02, 0, 1000, 0),
+			)},
+			[]event{
+				{1, usage.Downlink(1000), []uint32{1}, nil},
+				{2, usage.Uplink(1000), []uint32{1}, []summary{{URRID: 1, Trigger: "VOLQU", End: 2, Volume: 2000}}},
+				{3, usage.Uplink(1000), []uint32{1}, nil},
+			},
+			1000, true,
+		}, {
+			// active for 0-4s and 20-26s; the inactive period is not counted.
+			"TimeThresholdWithInactivity",
+			[]*ie.IE{ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 0, 1),
+				ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerTIMTH)...),
+				ie.NewTimeThreshold(10*time.Second),
+				ie.NewInactivityDetectionTime(2),
+			)},
+			[]event{
+				{0, usage.Uplink(100), []uint32{1}, nil},
+				{2, usage.Uplink(100), []uint32{1}, nil},
+				{4, usage.Uplink(100), []uint32{1}, nil},
+				{20, usage.Uplink(100), []uint32{1}, nil},
+				{22, usage.Uplink(100), []uint32{1}, nil},
+				{24, usage.Uplink(100), []uint32{1}, nil},
+				{25, usage.Counters{}, nil, nil},
+				{26, usage.Counters{}, nil, []summary{{URRID: 1, Trigger: "TIMTH", End: 26, Duration: 10 * time.Second}}},
+			},
+			0, false,
+		}, {
+			"Periodic",
+			[]*ie.IE{ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 1, 1),
+				ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerPERIO)...),
+				ie.NewMeasurementPeriod(60*time.Second),
+			)},
+			[]event{
+				{10, usage.Uplink(100), []uint32{1}, nil},
+				{59, usage.Counters{}, nil, nil},
+				{60, usage.Counters{}, nil, []summary{{URRID: 1, Trigger: "PERIO", End: 60, Volume: 100, Duration: 50 * time.Second}}},
+				{130, usage.Counters{}, nil, []summary{{URRID: 1, URSEQN: 1, Trigger: "PERIO", Start: 60, End: 130, Duration: 70 * time.Second}}},
+				{179, usage.Counters{}, nil, nil},
+			},
+			0, false,
+		}, {
+			"MonitoringTime",
+			[]*ie.IE{ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 1, 0),
+				ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLTH)...),
+				ie.NewVolumeThreshold(0x01, 1000, 0, 0),
+				ie.NewMonitoringTime(t0.Add(30*time.Second)),
+				ie.NewSubsequentVolumeThreshold(0x01, 100, 0, 0),
+			)},
+			[]event{
+				{10, usage.Uplink(500), []uint32{1}, nil},
+				{40, usage.Uplink(200), []uint32{1}, []summary{
+					{URRID: 1, Trigger: "VOLTH", End: 30, Volume: 500, Info: usage.UsageInformationBEF},
+					{URRID: 1, URSEQN: 1, Trigger: "VOLTH", Start: 30, End: 40, Volume: 200, Info: usage.UsageInformationAFT},
+				}},
+				{50, usage.Uplink(100), []uint32{1}, []summary{{URRID: 1, URSEQN: 2, Trigger: "VOLTH", Start: 40, End: 50, Volume: 100}}},
+			},
+			0, false,
+		}, {
+			"QuotaHoldingTime",
+			[]*ie.IE{ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 1, 0),
+				ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerQUHTI)...),
+				ie.NewVolumeQuota(0x01, 10000, 0, 0),
+				ie.NewQuotaHoldingTime(30*time.Second),
+			)},
+			[]event{
+				{5, usage.Uplink(100), []uint32{1}, nil},
+				{34, usage.Counters{}, nil, nil},
+				{35, usage.Counters{}, nil, []summary{{URRID: 1, Trigger: "QUHTI", End: 35, Volume: 100}}},
+				{70, usage.Counters{}, nil, nil},
+			},
+			0, false,
+		}, {
+			"LinkedURR",
+			[]*ie.IE{
+				ie.NewCreateURR(
+					ie.NewURRID(1),
+					ie.NewMeasurementMethod(0, 1, 0),
+					ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerVOLTH)...),
+					ie.NewVolumeThreshold(0x01, 100, 0, 0),
+				),
+				ie.NewCreateURR(
+					ie.NewURRID(2),
+					ie.NewMeasurementMethod(0, 1, 0),
+					ie.NewReportingTriggers(ie.NewReportingTriggerFlags(ie.ReportingTriggerLIUSA)...),
+					ie.NewLinkedURRID(1),
+				),
+			},
+			[]event{
+				{1, usage.Uplink(150), []uint32{1, 2}, []summary{
+					{URRID: 1, Trigger: "VOLTH", End: 1, Volume: 150},
+					{URRID: 2, Trigger: "LIUSA", End: 1, Volume: 150},
+				}},
+			},
+			0, false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			e, err := usage.New(t0, c.urrs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, ev := range c.events {
+				now := t0.Add(time.Duration(ev.sec) * time.Second)
+
+				var rs []*usage.Report
+				if len(ev.ids) == 0 {
+					rs = e.Tick(now)
+				} else if rs, err = e.Record(now, ev.c, ev.ids...); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(summarize(rs), ev.want); diff != "" {
+					t.Errorf("at %ds: %s", ev.sec, diff)
+				}
+			}
+
+			if u, _ := e.Usage(1); u.TotalVolume() != c.usage {
+				t.Errorf("got usage %+v, want total volume %d", u, c.usage)
+			}
+			if e.Exhausted(1) != c.exhausted {
+				t.Errorf("got exhausted %v, want %v", e.Exhausted(1), c.exhausted)
+			}
+		})
+	}
+}
+
+func TestEngineRemove(t *testing.T) {
+	e, err := usage.New(t0, ie.NewCreateURR(
+		ie.NewURRID(1),
+		ie.NewMeasurementMethod(0, 1, 1),
+		ie.NewReportingTriggers(0x00, 0x00),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Record(t0.Add(time.Second), usage.Uplink(100), 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Record(t0.Add(3*time.Second), usage.Downlink(200), 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Record(t0.Add(3*time.Second), usage.Downlink(200), 2); !errors.Is(err, usage.ErrURRNotFound) {
+		t.Errorf("got error %v", err)
+	}
+
+	rs := e.Close(t0.Add(5 * time.Second))
+	if len(rs) != 1 {
+		t.Fatalf("got %d reports", len(rs))
+	}
+
+	// the report is encoded and parsed as the SMF would do.
+	got, err := ie.UsageReportWithinSessionDeletionResponseType.Get(rs[0].IE(ie.UsageReportWithinSessionDeletionResponse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, end, first, last, dur := t0, t0.Add(5*time.Second), t0.Add(time.Second), t0.Add(3*time.Second), 4*time.Second
+	want := &ie.UsageReportWithinSessionDeletionResponseFields{
+		URRID:               1,
+		URSEQN:              0,
+		UsageReportTrigger:  ie.NewUsageReportTriggerFlags(ie.UsageReportTriggerTERMR),
+		StartTime:           &start,
+		EndTime:             &end,
+		VolumeMeasurement:   ie.NewVolumeMeasurementFields(0x3f, 300, 100, 200, 2, 1, 1),
+		DurationMeasurement: &dur,
+		TimeOfFirstPacket:   &first,
+		TimeOfLastPacket:    &last,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, ok := e.Usage(1); ok {
+		t.Error("URR not removed")
+	}
+}
+
+func TestEngineRemoveAll(t *testing.T) {
+	e, err := usage.New(t0,
+		ie.NewCreateURR(ie.NewURRID(1), ie.NewMeasurementMethod(0, 1, 0), ie.NewReportingTriggers(0x00, 0x00)),
+		ie.NewCreateURR(ie.NewURRID(2), ie.NewMeasurementMethod(0, 1, 0), ie.NewReportingTriggers(0x00, 0x00)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := e.RemoveURR(t0.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint32
+	for _, r := range rs {
+		if !r.Trigger.Has(ie.UsageReportTriggerTERMR) {
+			t.Errorf("URR %d: got trigger %v", r.URRID, r.Trigger)
+		}
+		got = append(got, r.URRID)
+	}
+	if diff := cmp.Diff(got, []uint32{1, 2}); diff != "" {
+		t.Error(diff)
+	}
+
+	for _, id := range []uint32{1, 2} {
+		if _, ok := e.Usage(id); ok {
+			t.Errorf("URR %d not removed", id)
+		}
+	}
+}